@@ -4,15 +4,14 @@
 package main
 
 import (
-	"bufio"
 	"context"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"os/signal"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -22,12 +21,19 @@ import (
 	"github.com/open-edge-platform/edge-node-agents/common/pkg/metrics"
 	"github.com/open-edge-platform/edge-node-agents/common/pkg/status"
 	"github.com/open-edge-platform/edge-node-agents/common/pkg/utils"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/collector"
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/comms"
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/config"
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/info"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/inventory"
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/logger"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/smart"
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/system"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/uevent"
+	proto "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 var log = logger.Logger
@@ -40,6 +46,9 @@ func main() {
 		fmt.Printf("%v v%v\n", info.Component, info.Version)
 		os.Exit(0)
 	}
+	if len(os.Args) >= 2 && os.Args[1] == "collect" {
+		os.Exit(collect(os.Args[2:], os.Stdout))
+	}
 
 	log.Infof("Starting Hardware Discovery Agent.")
 	ctx, cancel := context.WithCancel(context.Background())
@@ -113,40 +122,73 @@ func main() {
 	log.Info("Hardware Discovery Agent has successfully connected to orchestrator")
 
 	var wg sync.WaitGroup
-	update := make(chan string)
+	// A nil list of collectors requests a full re-collection.
+	update := make(chan []uevent.Collector)
+
+	diskHealth := &smart.Store{}
+	collectors := append(collector.Builtin(exec.Command), collector.NewSmart(exec.Command, cfg.SmartThresholds, diskHealth))
+	registry, err := collector.NewRegistry(collectors, cfg.Collectors)
+	if err != nil {
+		log.Errorf("collector configuration failed : %v", err)
+		os.Exit(1)
+	}
+	if _, err := smart.RegisterMetrics(otel.Meter(info.Component), diskHealth); err != nil {
+		log.Errorf("registering disk health metrics failed : %v", err)
+	}
+
+	monitor, err := uevent.NewMonitor(cfg.UdevSubsystems)
+	if err != nil {
+		log.Errorf("udev monitoring failure : %v", err)
+		os.Exit(1)
+	}
+	events := make(chan uevent.Event)
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		if err := startmonitoringUdev(ctx, update); err != nil {
-			if strings.Contains(err.Error(), "signal") {
-				log.Infof("udev monitoring finished: %v", err)
-				return
-			}
+		if err := monitor.Run(ctx, events); err != nil {
 			log.Errorf("udev monitoring failure : %v", err)
 			os.Exit(1)
 		}
+		log.Infof("udev monitoring finished")
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		uevent.Coalesce(ctx, events, cfg.UdevDebounce, update)
 	}()
 
 	wg.Add(1)
 	var lastUpdateTimestamp int64
 	cyclicalTicker := time.NewTicker(cfg.UpdateInterval)
+	tracker := inventory.NewTracker(cfg.FullReportInterval)
 	go func() {
 		defer wg.Done()
-		op := func() error {
-			return sendStatusUpdate(ctx, cli, guid, cfg.JWT.AccessTokenPath)
+		op := func(collectors []uevent.Collector) func() error {
+			names := []string{}
+			for _, c := range collectors {
+				names = append(names, string(c))
+			}
+			return func() error {
+				return sendStatusUpdate(ctx, cli, guid, cfg.JWT.AccessTokenPath, tracker, registry.Collect(ctx, names...))
+			}
 		}
 		for {
 			select {
 			case <-ctx.Done():
 				return
-			case msg := <-update:
+			case collectors := <-update:
 				cyclicalTicker.Stop()
-				log.Infof("%s", msg)
-				updateWithRetry(ctx, op, &lastUpdateTimestamp)
+				if collectors == nil {
+					log.Infof("Sending initial update")
+				} else {
+					log.Infof("hardware change detected, re-collecting %v", collectors)
+				}
+				updateWithRetry(ctx, op(collectors), &lastUpdateTimestamp)
 			case <-cyclicalTicker.C:
 				cyclicalTicker.Stop()
-				updateWithRetry(ctx, op, &lastUpdateTimestamp)
+				updateWithRetry(ctx, op(nil), &lastUpdateTimestamp)
 			}
 			cyclicalTicker.Reset(cfg.UpdateInterval)
 		}
@@ -189,7 +231,7 @@ func main() {
 		}
 	}()
 
-	update <- "Sending initial update"
+	update <- nil
 	wg.Wait()
 	log.Infof("Hardware Discovery Agent finished.")
 }
@@ -203,44 +245,64 @@ func updateWithRetry(ctx context.Context, op func() error, lastUpdateTimestamp *
 	}
 }
 
-func startmonitoringUdev(ctx context.Context, update chan string) error {
-	cmd := exec.CommandContext(ctx, "udevadm", "monitor", "--udev", "--subsystem-match=block", "--subsystem-match=net")
-
-	// Starting to record output of udevadm monitor
-	cmdReader, err := cmd.StdoutPipe()
+func sendStatusUpdate(ctx context.Context, cli *comms.Client, guid string, tokenFile string, tracker *inventory.Tracker,
+	updateDeviceRequest *proto.SystemInfo) error {
+	changes, report := tracker.Compare(updateDeviceRequest, time.Now())
+	if !report {
+		log.Debugf("hardware inventory unchanged, skipping update")
+		return nil
+	}
+	_, err := cli.UpdateHostSystemInfoByGUID(utils.GetAuthContext(ctx, tokenFile), guid, updateDeviceRequest)
 	if err != nil {
-		log.Errorf("failed creating StdoutPipe : %v", err)
+		log.Errorf("update device failure : %v", err)
 		return err
 	}
+	tracker.Reported(updateDeviceRequest, time.Now())
+	inventory.LogChanges(changes)
+	return nil
+}
 
-	scanner := bufio.NewScanner(cmdReader)
-	go func() {
-		for scanner.Scan() {
-			if strings.Contains(scanner.Text(), "devices") {
-				fields := strings.Fields(scanner.Text())
-				update <- fmt.Sprintf("hardware %s detected, name=%s", fields[2], fields[3])
-			}
-		}
-	}()
-
-	if err := cmd.Start(); err != nil {
-		return fmt.Errorf("failed starting command: %w", err)
+// collect prints the inventory assembled by the collectors as JSON, without reporting it.
+func collect(args []string, out io.Writer) int {
+	flags := flag.NewFlagSet("collect", flag.ContinueOnError)
+	configPath := flags.String("config", "", "the hd-agent configuration file location, for the collector settings")
+	dryRun := flags.Bool("dry-run", false, "print the collected SystemInfo instead of reporting it")
+	if err := flags.Parse(args); err != nil {
+		return 1
 	}
-
-	if err := cmd.Wait(); err != nil {
-		return fmt.Errorf("finished waiting for command: %w", err)
+	if !*dryRun {
+		log.Errorf("collect supports only --dry-run")
+		flags.Usage()
+		return 1
 	}
+	// Keep stdout for the inventory.
+	log.Logger.SetOutput(os.Stderr)
 
-	return nil
-}
+	var settings map[string]config.Collector
+	thresholds := smart.DefaultThresholds
+	if *configPath != "" {
+		cfg, err := config.New(*configPath)
+		if err != nil {
+			log.Errorf("loading configuration failed : %v", err)
+			return 1
+		}
+		settings = cfg.Collectors
+		thresholds = cfg.SmartThresholds
+	}
 
-func sendStatusUpdate(ctx context.Context, cli *comms.Client, guid string, tokenFile string) error {
-	updateDeviceRequest := comms.GenerateSystemInfoRequest(exec.Command)
-	_, err := cli.UpdateHostSystemInfoByGUID(utils.GetAuthContext(ctx, tokenFile), guid, updateDeviceRequest)
+	collectors := append(collector.Builtin(exec.Command), collector.NewSmart(exec.Command, thresholds, &smart.Store{}))
+	registry, err := collector.NewRegistry(collectors, settings)
 	if err != nil {
-		log.Errorf("update device failure : %v", err)
+		log.Errorf("collector configuration failed : %v", err)
+		return 1
+	}
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(registry.Collect(context.Background()))
+	if err != nil {
+		log.Errorf("marshaling system info failed : %v", err)
+		return 1
 	}
-	return err
+	fmt.Fprintln(out, string(data))
+	return 0
 }
 
 func initStatusClientAndTicker(ctx context.Context, cancel context.CancelFunc, statusServer string) (*status.StatusClient, time.Duration) {
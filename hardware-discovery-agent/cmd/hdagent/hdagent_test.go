@@ -67,6 +67,19 @@ func TestVersion(t *testing.T) {
 	require.Contains(t, string(out), "v"+version)
 }
 
+func TestCollectDryRun(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, hdAgentBinary, "collect", "--dry-run").Output()
+	require.NoError(t, err)
+	require.Contains(t, string(out), `"hwInfo"`)
+
+	out, err = exec.CommandContext(ctx, hdAgentBinary, "collect").CombinedOutput()
+	require.Error(t, err)
+	require.Contains(t, string(out), "collect supports only --dry-run")
+}
+
 // Execute Hardware Discovery Agent smoke test
 // The test intent is to check if Hardware Discovery Agent is able to discover and send hardware info.
 // Host manager mock is used to return static data to HDA.
@@ -0,0 +1,350 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	proto "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/cpu"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/disk"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/gpu"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/memory"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/network"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/smart"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/system"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/usb"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/utils"
+)
+
+// Names of the built-in collectors.
+const (
+	System  = "system"
+	Os      = "os"
+	Bios    = "bios"
+	CPU     = "cpu"
+	Memory  = "memory"
+	Disk    = "disk"
+	Gpu     = "gpu"
+	Network = "network"
+	Usb     = "usb"
+	Smart   = "smart"
+)
+
+type funcCollector struct {
+	name    string
+	collect func(ctx context.Context) (Apply, error)
+}
+
+func (c *funcCollector) Name() string {
+	return c.name
+}
+
+func (c *funcCollector) Collect(ctx context.Context) (Apply, error) {
+	return c.collect(ctx)
+}
+
+// New wraps a function into a Collector.
+func New(name string, collect func(ctx context.Context) (Apply, error)) Collector {
+	return &funcCollector{name: name, collect: collect}
+}
+
+// Builtin returns the collectors of the hardware-discovery-agent inventory, running their commands with the executor.
+// The commands are killed when the context of the collector run is done.
+func Builtin(executor utils.CmdExecutor) []Collector {
+	return []Collector{
+		New(System, func(ctx context.Context) (Apply, error) {
+			executor := utils.WithContext(ctx, executor)
+			sn, snErr := system.GetSerialNumber(executor)
+			productName, productErr := system.GetProductName(executor)
+			return func(info *proto.SystemInfo) {
+				info.HwInfo.SerialNum = sn
+				info.HwInfo.ProductName = productName
+			}, errors.Join(snErr, productErr)
+		}),
+		New(Os, func(ctx context.Context) (Apply, error) {
+			executor := utils.WithContext(ctx, executor)
+			osInfo, err := system.GetOsInfo(executor)
+			return func(info *proto.SystemInfo) {
+				info.OsInfo = parseOs(osInfo)
+			}, err
+		}),
+		New(Bios, func(ctx context.Context) (Apply, error) {
+			executor := utils.WithContext(ctx, executor)
+			biosInfo, err := system.GetBiosInfo(executor)
+			return func(info *proto.SystemInfo) {
+				info.BiosInfo = &proto.BiosInfo{
+					Version:     biosInfo.Version,
+					ReleaseDate: biosInfo.RelDate,
+					Vendor:      biosInfo.Vendor,
+				}
+			}, err
+		}),
+		New(CPU, func(ctx context.Context) (Apply, error) {
+			executor := utils.WithContext(ctx, executor)
+			cpuInfo, err := cpu.GetCPUList(executor)
+			return func(info *proto.SystemInfo) {
+				info.HwInfo.Cpu = parseCPU(cpuInfo)
+			}, wrap("cpu description", err)
+		}),
+		New(Memory, func(ctx context.Context) (Apply, error) {
+			executor := utils.WithContext(ctx, executor)
+			mem, err := memory.GetMemory(executor)
+			return func(info *proto.SystemInfo) {
+				info.HwInfo.Memory = &proto.SystemMemory{Size: mem}
+			}, wrap("memory description", err)
+		}),
+		New(Disk, func(ctx context.Context) (Apply, error) {
+			executor := utils.WithContext(ctx, executor)
+			storage, err := disk.GetDiskList(executor)
+			return func(info *proto.SystemInfo) {
+				info.HwInfo.Storage = &proto.Storage{Disk: parseDisks(storage)}
+			}, wrap("disk description", err)
+		}),
+		New(Gpu, func(_ context.Context) (Apply, error) {
+			gpuList, err := gpu.GetGpuList()
+			return func(info *proto.SystemInfo) {
+				info.HwInfo.Gpu = parseGpus(gpuList)
+			}, wrap("gpu description", err)
+		}),
+		New(Network, func(ctx context.Context) (Apply, error) {
+			executor := utils.WithContext(ctx, executor)
+			networkList, bmType, bmcAddr, err := network.GetNICList(executor)
+			return func(info *proto.SystemInfo) {
+				info.HwInfo.Network = parseNetworks(networkList)
+				info.BmCtlInfo = &proto.BmInfo{
+					BmType: bmType,
+					BmcInfo: &proto.BmcInfo{
+						BmIp: bmcAddr,
+					},
+				}
+			}, wrap("network interface description", err)
+		}),
+		New(Usb, func(ctx context.Context) (Apply, error) {
+			executor := utils.WithContext(ctx, executor)
+			usbList, err := usb.GetUsbList(executor)
+			return func(info *proto.SystemInfo) {
+				info.HwInfo.Usb = parseUsbs(usbList)
+			}, wrap("usb description", err)
+		}),
+	}
+}
+
+// NewSmart returns the collector of the disk health, kept in the store for the metrics. The SystemDisk of the
+// SystemInfo has no fields for the health, percentage used or media errors of a disk, so the collector applies
+// nothing; reporting them to the Edge Infrastructure Manager needs such fields in the hostmgr API first.
+func NewSmart(executor utils.CmdExecutor, thresholds smart.Thresholds, store *smart.Store) Collector {
+	return New(Smart, func(ctx context.Context) (Apply, error) {
+		executor := utils.WithContext(ctx, executor)
+		disks, err := disk.GetDiskList(executor)
+		if err != nil {
+			return nil, wrap("disk description", err)
+		}
+		health := []*smart.Health{}
+		errs := []error{}
+		for _, d := range disks {
+			if ctx.Err() != nil {
+				errs = append(errs, ctx.Err())
+				break
+			}
+			h, err := smart.GetHealth(executor, d.Name, thresholds)
+			if errors.Is(err, smart.ErrUnsupported) {
+				log.Debugf("skipping health of disk %s : %v", d.Name, err)
+				continue
+			}
+			if errors.Is(err, smart.ErrStandby) {
+				// Keep the health read while the disk was active rather than waking it up.
+				log.Debugf("keeping the last health of disk %s : %v", d.Name, err)
+				if last := store.Get(d.Name); last != nil {
+					health = append(health, last)
+				}
+				continue
+			}
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			health = append(health, h)
+		}
+		store.Update(health)
+		return func(_ *proto.SystemInfo) {}, wrap("disk health", errors.Join(errs...))
+	})
+}
+
+func wrap(what string, err error) error {
+	if err != nil {
+		return fmt.Errorf("unable to get %s : %w", what, err)
+	}
+	return nil
+}
+
+func parseOs(osInfo *system.Os) *proto.OsInfo {
+	osKern := proto.OsKernel{}
+	if osInfo.Kernel != nil {
+		kernConfig := []*proto.Config{}
+		for _, config := range osInfo.Kernel.Config {
+			kernConfig = append(kernConfig, &proto.Config{
+				Key:   config.Key,
+				Value: config.Value,
+			})
+		}
+		osKern = proto.OsKernel{
+			Version: osInfo.Kernel.Version,
+			Config:  kernConfig,
+		}
+	}
+
+	osRelease := proto.OsRelease{}
+	if osInfo.Release != nil {
+		relMetadata := []*proto.Metadata{}
+		for _, metadata := range osInfo.Release.Metadata {
+			relMetadata = append(relMetadata, &proto.Metadata{
+				Key:   metadata.Key,
+				Value: metadata.Value,
+			})
+		}
+		osRelease = proto.OsRelease{
+			Id:       osInfo.Release.ID,
+			Version:  osInfo.Release.Version,
+			Metadata: relMetadata,
+		}
+	}
+
+	return &proto.OsInfo{Kernel: &osKern, Release: &osRelease}
+}
+
+func parseCPU(cpu *cpu.CPU) *proto.SystemCPU {
+	cpuInfo := proto.SystemCPU{}
+	if cpu != nil {
+		if cpu.Topology != nil {
+			sockets := []*proto.Socket{}
+			for _, socket := range cpu.Topology.Sockets {
+				coreGroups := []*proto.CoreGroup{}
+				for _, coreGroup := range socket.CoreGroups {
+					coreGroups = append(coreGroups, &proto.CoreGroup{
+						CoreType: coreGroup.Type,
+						CoreList: coreGroup.List,
+					})
+				}
+				sockets = append(sockets, &proto.Socket{
+					SocketId:   socket.SocketID,
+					CoreGroups: coreGroups,
+				})
+			}
+			cpuInfo = proto.SystemCPU{
+				Arch:        cpu.Arch,
+				Vendor:      cpu.Vendor,
+				Model:       cpu.Model,
+				Sockets:     cpu.Sockets,
+				Cores:       cpu.Cores,
+				Threads:     cpu.Threads,
+				Features:    cpu.Features,
+				CpuTopology: &proto.CPUTopology{Sockets: sockets},
+			}
+		} else {
+			cpuInfo = proto.SystemCPU{
+				Arch:     cpu.Arch,
+				Vendor:   cpu.Vendor,
+				Model:    cpu.Model,
+				Sockets:  cpu.Sockets,
+				Cores:    cpu.Cores,
+				Threads:  cpu.Threads,
+				Features: cpu.Features,
+			}
+		}
+	}
+
+	return &cpuInfo
+}
+
+func parseGpus(gpu []*gpu.Gpu) []*proto.SystemGPU {
+	gpuList := []*proto.SystemGPU{}
+	for _, gpuDetails := range gpu {
+		gpuList = append(gpuList, &proto.SystemGPU{
+			PciId:       gpuDetails.PciID,
+			Product:     gpuDetails.Product,
+			Vendor:      gpuDetails.Vendor,
+			Name:        gpuDetails.Name,
+			Description: gpuDetails.Description,
+			Features:    gpuDetails.Features,
+		})
+	}
+	return gpuList
+}
+
+func parseDisks(storage []*disk.Disk) []*proto.SystemDisk {
+	diskList := []*proto.SystemDisk{}
+	for _, diskDetails := range storage {
+		diskList = append(diskList, &proto.SystemDisk{
+			SerialNumber: diskDetails.SerialNum,
+			Name:         diskDetails.Name,
+			Vendor:       diskDetails.Vendor,
+			Model:        diskDetails.Model,
+			Size:         diskDetails.Size,
+			Wwid:         diskDetails.Wwid,
+		})
+	}
+	return diskList
+}
+
+func parseNetworks(networks []*network.Network) []*proto.SystemNetwork {
+	networkList := []*proto.SystemNetwork{}
+	for _, networkDetails := range networks {
+		ipAddressList := []*proto.IPAddress{}
+		for _, ipAddress := range networkDetails.IPAddresses {
+			ipAddressList = append(ipAddressList, &proto.IPAddress{
+				IpAddress:         ipAddress.IPAddress,
+				NetworkPrefixBits: ipAddress.NetPrefBits,
+				ConfigMode:        ipAddress.ConfigMode,
+			})
+		}
+		networkList = append(networkList, &proto.SystemNetwork{
+			Name:                networkDetails.Name,
+			PciId:               networkDetails.PciID,
+			Mac:                 networkDetails.Mac,
+			LinkState:           networkDetails.LinkState,
+			CurrentSpeed:        networkDetails.CurrentSpeed,
+			CurrentDuplex:       networkDetails.CurrentDuplex,
+			SupportedLinkMode:   networkDetails.SupportedLinkMode,
+			AdvertisingLinkMode: networkDetails.AdvertisingLinkMode,
+			Features:            networkDetails.Features,
+			Sriovenabled:        networkDetails.SriovEnabled,
+			Sriovnumvfs:         networkDetails.SriovNumVfs,
+			SriovVfsTotal:       networkDetails.SriovVfsTotal,
+			PeerName:            networkDetails.PeerName,
+			PeerDescription:     networkDetails.PeerDescription,
+			PeerMac:             networkDetails.PeerMac,
+			PeerMgmtIp:          networkDetails.PeerManagementIP,
+			PeerPort:            networkDetails.PeerPort,
+			IpAddresses:         ipAddressList,
+			Mtu:                 networkDetails.Mtu,
+			BmcNet:              networkDetails.BmcNet,
+		})
+	}
+	return networkList
+}
+
+func parseUsbs(usbInfo []*usb.Usb) []*proto.SystemUSB {
+	usbList := []*proto.SystemUSB{}
+	for _, usbDetails := range usbInfo {
+		interfacesList := []*proto.Interfaces{}
+		for _, interfaces := range usbDetails.Interfaces {
+			interfacesList = append(interfacesList, &proto.Interfaces{Class: interfaces.Class})
+		}
+		usbList = append(usbList, &proto.SystemUSB{
+			Class:       usbDetails.Class,
+			Idvendor:    usbDetails.VendorID,
+			Idproduct:   usbDetails.ProductID,
+			Bus:         usbDetails.Bus,
+			Addr:        usbDetails.Address,
+			Description: usbDetails.Description,
+			Serial:      usbDetails.Serial,
+			Interfaces:  interfacesList,
+		})
+	}
+	return usbList
+}
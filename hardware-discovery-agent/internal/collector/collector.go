@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package collector assembles the hardware inventory from independent collectors, each filling its part of the
+// SystemInfo reported to the Edge Infrastructure Manager.
+package collector
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	proto "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/logger"
+)
+
+var log = logger.Logger
+
+// DefaultTimeout bounds a collector run unless configured otherwise.
+const DefaultTimeout = 30 * time.Second
+
+// defaultIntervals are the intervals of the collectors that do not run on every collection unless configured
+// otherwise. Reading SMART data wakes up and queries every disk, so it is not repeated more often than needed.
+var defaultIntervals = map[string]time.Duration{
+	Smart: 10 * time.Minute,
+}
+
+// Apply sets the collected data on the SystemInfo.
+type Apply func(info *proto.SystemInfo)
+
+// Collector gathers one part of the inventory. On error, the returned Apply may still set partial data; it is used
+// only when the collector never succeeded before.
+type Collector interface {
+	Name() string
+	Collect(ctx context.Context) (Apply, error)
+}
+
+type entry struct {
+	collector Collector
+	enabled   bool
+	interval  time.Duration
+	timeout   time.Duration
+	lastRun   time.Time
+	succeeded bool
+	result    Apply
+}
+
+// Registry runs the collectors and keeps the last result of each.
+type Registry struct {
+	mu      sync.Mutex
+	entries []*entry
+}
+
+// NewRegistry creates a registry of the collectors with their settings, keyed by collector name.
+func NewRegistry(collectors []Collector, settings map[string]config.Collector) (*Registry, error) {
+	registry := &Registry{}
+	names := []string{}
+	for _, collector := range collectors {
+		names = append(names, collector.Name())
+		e := &entry{collector: collector, enabled: true, interval: defaultIntervals[collector.Name()], timeout: DefaultTimeout}
+		if setting, ok := settings[collector.Name()]; ok {
+			if setting.Enabled != nil {
+				e.enabled = *setting.Enabled
+			}
+			if setting.Interval > 0 {
+				e.interval = setting.Interval
+			}
+			if setting.Timeout > 0 {
+				e.timeout = setting.Timeout
+			}
+		}
+		registry.entries = append(registry.entries, e)
+	}
+	for name := range settings {
+		if !slices.Contains(names, name) {
+			return nil, fmt.Errorf("unknown collector %q", name)
+		}
+	}
+	return registry, nil
+}
+
+// Collect runs the given collectors, or without names every collector whose interval elapsed, and assembles the
+// SystemInfo from the last result of every enabled collector.
+func (r *Registry) Collect(ctx context.Context, names ...string) *proto.SystemInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, e := range r.entries {
+		if !e.enabled {
+			continue
+		}
+		if len(names) > 0 && !slices.Contains(names, e.collector.Name()) {
+			continue
+		}
+		if len(names) == 0 && e.succeeded && time.Since(e.lastRun) < e.interval {
+			continue
+		}
+		r.run(ctx, e)
+	}
+
+	info := newSystemInfo()
+	for _, e := range r.entries {
+		if e.enabled && e.result != nil {
+			e.result(info)
+		}
+	}
+	return info
+}
+
+// run collects in a goroutine so that a hanging command cannot hold up the other collectors beyond the timeout.
+func (r *Registry) run(ctx context.Context, e *entry) {
+	type outcome struct {
+		apply Apply
+		err   error
+	}
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	done := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- outcome{err: fmt.Errorf("collector panicked: %v", p)}
+			}
+		}()
+		apply, err := e.collector.Collect(ctx)
+		done <- outcome{apply, err}
+	}()
+
+	e.lastRun = time.Now()
+	select {
+	case <-ctx.Done():
+		log.Errorf("collector %s timed out after %v : %v", e.collector.Name(), e.timeout, ctx.Err())
+	case result := <-done:
+		if result.err != nil {
+			log.Errorf("collector %s failed : %v", e.collector.Name(), result.err)
+			if !e.succeeded && result.apply != nil {
+				e.result = result.apply
+			}
+			return
+		}
+		e.succeeded = true
+		e.result = result.apply
+	}
+}
+
+// newSystemInfo returns the SystemInfo reported for collectors without data.
+func newSystemInfo() *proto.SystemInfo {
+	return &proto.SystemInfo{
+		HwInfo: &proto.HWInfo{
+			Cpu:     &proto.SystemCPU{},
+			Memory:  &proto.SystemMemory{},
+			Storage: &proto.Storage{Disk: []*proto.SystemDisk{}},
+			Gpu:     []*proto.SystemGPU{},
+			Network: []*proto.SystemNetwork{},
+			Usb:     []*proto.SystemUSB{},
+		},
+		OsInfo: &proto.OsInfo{
+			Kernel:  &proto.OsKernel{},
+			Release: &proto.OsRelease{},
+		},
+		BmCtlInfo: &proto.BmInfo{
+			BmcInfo: &proto.BmcInfo{},
+		},
+		BiosInfo: &proto.BiosInfo{},
+	}
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package collector_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	proto "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/collector"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/config"
+)
+
+// serialCollector reports its serial numbers in turn, failing once they run out.
+type serialCollector struct {
+	serials []string
+	runs    int
+	block   chan struct{}
+}
+
+func (*serialCollector) Name() string {
+	return collector.System
+}
+
+func (c *serialCollector) Collect(_ context.Context) (collector.Apply, error) {
+	if c.block != nil {
+		<-c.block
+	}
+	c.runs++
+	if c.runs > len(c.serials) {
+		return func(info *proto.SystemInfo) { info.HwInfo.SerialNum = "" }, errors.New("dmidecode failed")
+	}
+	serial := c.serials[c.runs-1]
+	return func(info *proto.SystemInfo) { info.HwInfo.SerialNum = serial }, nil
+}
+
+func memoryCollector(size uint64) collector.Collector {
+	return collector.New(collector.Memory, func(_ context.Context) (collector.Apply, error) {
+		return func(info *proto.SystemInfo) { info.HwInfo.Memory.Size = size }, nil
+	})
+}
+
+func enabled(value bool) *bool {
+	return &value
+}
+
+func TestCollect(t *testing.T) {
+	registry, err := collector.NewRegistry([]collector.Collector{&serialCollector{serials: []string{"S1"}}, memoryCollector(1024)}, nil)
+	require.NoError(t, err)
+
+	info := registry.Collect(context.Background())
+	assert.Equal(t, "S1", info.HwInfo.SerialNum)
+	assert.Equal(t, uint64(1024), info.HwInfo.Memory.Size)
+	assert.NotNil(t, info.OsInfo.Kernel)
+	assert.NotNil(t, info.BmCtlInfo.BmcInfo)
+}
+
+func TestCollectKeepsLastResultOnError(t *testing.T) {
+	serials := &serialCollector{serials: []string{"S1"}}
+	registry, err := collector.NewRegistry([]collector.Collector{serials, memoryCollector(1024)}, nil)
+	require.NoError(t, err)
+
+	registry.Collect(context.Background())
+	info := registry.Collect(context.Background())
+	assert.Equal(t, 2, serials.runs)
+	assert.Equal(t, "S1", info.HwInfo.SerialNum, "a failing collector does not blank the report")
+	assert.Equal(t, uint64(1024), info.HwInfo.Memory.Size)
+}
+
+func TestCollectPartialResultWithoutSuccess(t *testing.T) {
+	registry, err := collector.NewRegistry([]collector.Collector{&serialCollector{}, memoryCollector(1024)}, nil)
+	require.NoError(t, err)
+
+	info := registry.Collect(context.Background())
+	assert.Empty(t, info.HwInfo.SerialNum)
+	assert.Equal(t, uint64(1024), info.HwInfo.Memory.Size)
+}
+
+func TestCollectTimeout(t *testing.T) {
+	serials := &serialCollector{serials: []string{"S1"}, block: make(chan struct{})}
+	defer close(serials.block)
+	registry, err := collector.NewRegistry([]collector.Collector{serials, memoryCollector(1024)},
+		map[string]config.Collector{collector.System: {Timeout: 10 * time.Millisecond}})
+	require.NoError(t, err)
+
+	info := registry.Collect(context.Background())
+	assert.Empty(t, info.HwInfo.SerialNum)
+	assert.Equal(t, uint64(1024), info.HwInfo.Memory.Size, "a hanging collector does not hold up the others")
+}
+
+func TestCollectPanic(t *testing.T) {
+	panicking := collector.New(collector.Gpu, func(_ context.Context) (collector.Apply, error) {
+		panic("index out of range")
+	})
+	registry, err := collector.NewRegistry([]collector.Collector{panicking, memoryCollector(1024)}, nil)
+	require.NoError(t, err)
+
+	info := registry.Collect(context.Background())
+	assert.Empty(t, info.HwInfo.Gpu)
+	assert.Equal(t, uint64(1024), info.HwInfo.Memory.Size)
+}
+
+func TestCollectDisabled(t *testing.T) {
+	serials := &serialCollector{serials: []string{"S1"}}
+	registry, err := collector.NewRegistry([]collector.Collector{serials, memoryCollector(1024)},
+		map[string]config.Collector{collector.System: {Enabled: enabled(false)}, collector.Memory: {Enabled: enabled(true)}})
+	require.NoError(t, err)
+
+	info := registry.Collect(context.Background())
+	assert.Zero(t, serials.runs)
+	assert.Empty(t, info.HwInfo.SerialNum)
+	assert.Equal(t, uint64(1024), info.HwInfo.Memory.Size)
+}
+
+func TestCollectInterval(t *testing.T) {
+	serials := &serialCollector{serials: []string{"S1", "S2", "S3"}}
+	registry, err := collector.NewRegistry([]collector.Collector{serials},
+		map[string]config.Collector{collector.System: {Interval: time.Hour}})
+	require.NoError(t, err)
+
+	registry.Collect(context.Background())
+	info := registry.Collect(context.Background())
+	assert.Equal(t, 1, serials.runs, "the collector runs once per interval")
+	assert.Equal(t, "S1", info.HwInfo.SerialNum)
+
+	info = registry.Collect(context.Background(), collector.System)
+	assert.Equal(t, 2, serials.runs, "a collector requested by name runs regardless of its interval")
+	assert.Equal(t, "S2", info.HwInfo.SerialNum)
+}
+
+func TestCollectDefaultInterval(t *testing.T) {
+	runs := 0
+	smart := collector.New(collector.Smart, func(_ context.Context) (collector.Apply, error) {
+		runs++
+		return func(*proto.SystemInfo) {}, nil
+	})
+	registry, err := collector.NewRegistry([]collector.Collector{smart}, nil)
+	require.NoError(t, err)
+
+	registry.Collect(context.Background())
+	registry.Collect(context.Background())
+	assert.Equal(t, 1, runs, "the smart collector does not run on every collection by default")
+}
+
+func TestCollectByName(t *testing.T) {
+	serials := &serialCollector{serials: []string{"S1", "S2"}}
+	registry, err := collector.NewRegistry([]collector.Collector{serials, memoryCollector(1024)}, nil)
+	require.NoError(t, err)
+
+	registry.Collect(context.Background())
+	info := registry.Collect(context.Background(), collector.Memory)
+	assert.Equal(t, 1, serials.runs)
+	assert.Equal(t, "S1", info.HwInfo.SerialNum, "the other collectors keep their last result")
+}
+
+func TestUnknownCollector(t *testing.T) {
+	_, err := collector.NewRegistry(collector.Builtin(nil), map[string]config.Collector{"sensors": {}})
+	require.ErrorContains(t, err, `unknown collector "sensors"`)
+}
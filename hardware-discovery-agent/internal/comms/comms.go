@@ -15,14 +15,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
-	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/cpu"
-	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/disk"
-	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/gpu"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/collector"
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/logger"
-	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/memory"
-	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/network"
-	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/system"
-	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/usb"
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/utils"
 )
 
@@ -125,237 +119,12 @@ func ConnectToEdgeInfrastructureManager(serverAddr string, tlsConfig *tls.Config
 	return hostManager, nil
 }
 
-func parseSystemInfo(serialNumber string, productName string, bmcAddr string, osInfo *system.Os, biosInfo *system.Bios, cpu *cpu.CPU,
-	storage []*disk.Disk, gpu []*gpu.Gpu, mem uint64, networks []*network.Network, bmType proto.BmInfo_BmType, usbInfo []*usb.Usb) *proto.SystemInfo {
-
-	gpuList := []*proto.SystemGPU{}
-	for _, gpuDetails := range gpu {
-		gpuList = append(gpuList, &proto.SystemGPU{
-			PciId:       gpuDetails.PciID,
-			Product:     gpuDetails.Product,
-			Vendor:      gpuDetails.Vendor,
-			Name:        gpuDetails.Name,
-			Description: gpuDetails.Description,
-			Features:    gpuDetails.Features,
-		})
-	}
-
-	diskList := []*proto.SystemDisk{}
-	for _, diskDetails := range storage {
-		diskList = append(diskList, &proto.SystemDisk{
-			SerialNumber: diskDetails.SerialNum,
-			Name:         diskDetails.Name,
-			Vendor:       diskDetails.Vendor,
-			Model:        diskDetails.Model,
-			Size:         diskDetails.Size,
-			Wwid:         diskDetails.Wwid,
-		})
-	}
-
-	networkList := []*proto.SystemNetwork{}
-	for _, networkDetails := range networks {
-		ipAddressList := []*proto.IPAddress{}
-		for _, ipAddress := range networkDetails.IPAddresses {
-			ipAddressList = append(ipAddressList, &proto.IPAddress{
-				IpAddress:         ipAddress.IPAddress,
-				NetworkPrefixBits: ipAddress.NetPrefBits,
-				ConfigMode:        ipAddress.ConfigMode,
-			})
-		}
-		networkList = append(networkList, &proto.SystemNetwork{
-			Name:                networkDetails.Name,
-			PciId:               networkDetails.PciID,
-			Mac:                 networkDetails.Mac,
-			LinkState:           networkDetails.LinkState,
-			CurrentSpeed:        networkDetails.CurrentSpeed,
-			CurrentDuplex:       networkDetails.CurrentDuplex,
-			SupportedLinkMode:   networkDetails.SupportedLinkMode,
-			AdvertisingLinkMode: networkDetails.AdvertisingLinkMode,
-			Features:            networkDetails.Features,
-			Sriovenabled:        networkDetails.SriovEnabled,
-			Sriovnumvfs:         networkDetails.SriovNumVfs,
-			SriovVfsTotal:       networkDetails.SriovVfsTotal,
-			PeerName:            networkDetails.PeerName,
-			PeerDescription:     networkDetails.PeerDescription,
-			PeerMac:             networkDetails.PeerMac,
-			PeerMgmtIp:          networkDetails.PeerManagementIP,
-			PeerPort:            networkDetails.PeerPort,
-			IpAddresses:         ipAddressList,
-			Mtu:                 networkDetails.Mtu,
-			BmcNet:              networkDetails.BmcNet,
-		})
-	}
-
-	osKern := proto.OsKernel{}
-	if osInfo.Kernel != nil {
-		kernConfig := []*proto.Config{}
-		for _, config := range osInfo.Kernel.Config {
-			kernConfig = append(kernConfig, &proto.Config{
-				Key:   config.Key,
-				Value: config.Value,
-			})
-		}
-		osKern = proto.OsKernel{
-			Version: osInfo.Kernel.Version,
-			Config:  kernConfig,
-		}
-	}
-
-	osRelease := proto.OsRelease{}
-	if osInfo.Release != nil {
-		relMetadata := []*proto.Metadata{}
-		for _, metadata := range osInfo.Release.Metadata {
-			relMetadata = append(relMetadata, &proto.Metadata{
-				Key:   metadata.Key,
-				Value: metadata.Value,
-			})
-		}
-		osRelease = proto.OsRelease{
-			Id:       osInfo.Release.ID,
-			Version:  osInfo.Release.Version,
-			Metadata: relMetadata,
-		}
-	}
-
-	usbList := []*proto.SystemUSB{}
-	for _, usbDetails := range usbInfo {
-		interfacesList := []*proto.Interfaces{}
-		for _, interfaces := range usbDetails.Interfaces {
-			interfacesList = append(interfacesList, &proto.Interfaces{Class: interfaces.Class})
-		}
-		usbList = append(usbList, &proto.SystemUSB{
-			Class:       usbDetails.Class,
-			Idvendor:    usbDetails.VendorID,
-			Idproduct:   usbDetails.ProductID,
-			Bus:         usbDetails.Bus,
-			Addr:        usbDetails.Address,
-			Description: usbDetails.Description,
-			Serial:      usbDetails.Serial,
-			Interfaces:  interfacesList,
-		})
-	}
-
-	cpuInfo := proto.SystemCPU{}
-	if cpu != nil {
-		if cpu.Topology != nil {
-			sockets := []*proto.Socket{}
-			for _, socket := range cpu.Topology.Sockets {
-				coreGroups := []*proto.CoreGroup{}
-				for _, coreGroup := range socket.CoreGroups {
-					coreGroups = append(coreGroups, &proto.CoreGroup{
-						CoreType: coreGroup.Type,
-						CoreList: coreGroup.List,
-					})
-				}
-				sockets = append(sockets, &proto.Socket{
-					SocketId:   socket.SocketID,
-					CoreGroups: coreGroups,
-				})
-			}
-			cpuInfo = proto.SystemCPU{
-				Arch:        cpu.Arch,
-				Vendor:      cpu.Vendor,
-				Model:       cpu.Model,
-				Sockets:     cpu.Sockets,
-				Cores:       cpu.Cores,
-				Threads:     cpu.Threads,
-				Features:    cpu.Features,
-				CpuTopology: &proto.CPUTopology{Sockets: sockets},
-			}
-		} else {
-			cpuInfo = proto.SystemCPU{
-				Arch:     cpu.Arch,
-				Vendor:   cpu.Vendor,
-				Model:    cpu.Model,
-				Sockets:  cpu.Sockets,
-				Cores:    cpu.Cores,
-				Threads:  cpu.Threads,
-				Features: cpu.Features,
-			}
-		}
-	}
-
-	systemInfo := &proto.SystemInfo{
-		HwInfo: &proto.HWInfo{
-			SerialNum:   serialNumber,
-			ProductName: productName,
-			Cpu:         &cpuInfo,
-			Memory:      &proto.SystemMemory{Size: mem},
-			Storage:     &proto.Storage{Disk: diskList},
-			Gpu:         gpuList,
-			Network:     networkList,
-			Usb:         usbList,
-		},
-		OsInfo: &proto.OsInfo{
-			Kernel:  &osKern,
-			Release: &osRelease,
-		},
-		BmCtlInfo: &proto.BmInfo{
-			BmType: bmType,
-			BmcInfo: &proto.BmcInfo{
-				BmIp: bmcAddr,
-			},
-		},
-		BiosInfo: &proto.BiosInfo{
-			Version:     biosInfo.Version,
-			ReleaseDate: biosInfo.RelDate,
-			Vendor:      biosInfo.Vendor,
-		},
-	}
-
-	return systemInfo
-}
-
+// GenerateSystemInfoRequest runs every built-in collector with its default settings.
 func GenerateSystemInfoRequest(executor utils.CmdExecutor) *proto.SystemInfo {
-	storage, err := disk.GetDiskList(executor)
+	registry, err := collector.NewRegistry(collector.Builtin(executor), nil)
 	if err != nil {
-		log.Errorf("unable to get disk description : %v", err)
-	}
-
-	sn, err := system.GetSerialNumber(executor)
-	if err != nil {
-		log.Errorf("unable to get system serial number : %v", err)
-	}
-
-	productName, err := system.GetProductName(executor)
-	if err != nil {
-		log.Errorf("unable to get system product name : %v", err)
-	}
-
-	osInfo, err := system.GetOsInfo(executor)
-	if err != nil {
-		log.Errorf("unable to get system OS information : %v", err)
-	}
-
-	biosInfo, err := system.GetBiosInfo(executor)
-	if err != nil {
-		log.Errorf("unable to get system BIOS information : %v", err)
-	}
-
-	cpu, err := cpu.GetCPUList(executor)
-	if err != nil {
-		log.Errorf("unable to get cpu description : %v", err)
-	}
-
-	gpu, err := gpu.GetGpuList(executor)
-	if err != nil {
-		log.Errorf("unable to get gpu description : %v", err)
-	}
-
-	mem, err := memory.GetMemory(executor)
-	if err != nil {
-		log.Errorf("unable to get memory description : %v", err)
-	}
-
-	networkList, bmType, bmcAddr, err := network.GetNICList(executor)
-	if err != nil {
-		log.Errorf("unable to get network interface description : %v", err)
-	}
-
-	usbList, err := usb.GetUsbList(executor)
-	if err != nil {
-		log.Errorf("unable to get usb description : %v", err)
+		log.Errorf("unable to create the collector registry : %v", err)
+		return nil
 	}
-
-	return parseSystemInfo(sn, productName, bmcAddr, osInfo, biosInfo, cpu, storage, gpu, mem, networkList, bmType, usbList)
+	return registry.Collect(context.Background())
 }
@@ -27,6 +27,7 @@ import (
 	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/comms"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/gpu"
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/network"
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/tool"
 )
@@ -348,17 +349,50 @@ func getStorageInfo() []*proto.SystemDisk {
 }
 
 func getGpuInfo() []*proto.SystemGPU {
-	result := []*proto.SystemGPU{}
-	gpuDevice := &proto.SystemGPU{
-		PciId:       "03:00.0",
-		Product:     "Graphics Controller",
-		Vendor:      "Graphics",
-		Name:        "Graphics Controller",
-		Description: "VGA compatible controller",
-		Features:    []string{"pm", "vga_controller", "bus_master", "cap_list", "rom", "fb"},
-	}
-	result = append(result, gpuDevice)
-	return result
+	return []*proto.SystemGPU{
+		{
+			PciId:       "00:02.0",
+			Product:     "Raptor Lake-P [Iris Xe Graphics]",
+			Vendor:      "Intel Corporation",
+			Name:        "Intel Corporation Raptor Lake-P [Iris Xe Graphics]",
+			Description: "VGA compatible controller",
+			Features: []string{"driver=i915", "memory=6000000000-6000ffffff", "memory=4000000000-400fffffff",
+				"sriov_totalvfs=7", "sriov_numvfs=1", "card=/dev/dri/card0", "render=/dev/dri/renderD128"},
+		},
+		{
+			PciId:       "03:00.0",
+			Product:     "DG2 [Arc A770]",
+			Vendor:      "Intel Corporation",
+			Name:        "Intel Corporation DG2 [Arc A770]",
+			Description: "VGA compatible controller",
+			Features: []string{"driver=xe", "numa_node=0", "memory=a1000000-a1ffffff", "memory=4800000000-4bffffffff",
+				"card=/dev/dri/card1", "render=/dev/dri/renderD129"},
+		},
+		{
+			PciId:       "6a:00.0",
+			Product:     "Gaudi2 AI Training Accelerator",
+			Vendor:      "Habana Labs Ltd.",
+			Name:        "Habana Labs Ltd. Gaudi2 AI Training Accelerator",
+			Description: "Processing accelerators",
+			Features:    []string{"driver=habanalabs", "numa_node=1", "memory=d9000000-d9ffffff", "accel=/dev/accel/accel0"},
+		},
+	}
+}
+
+// useFakeSysfs makes the GPU discovery read the fake sysfs tree instead of the one of the host running the tests.
+func useFakeSysfs(t *testing.T) {
+	sysRoot, pciIDsFiles := gpu.SYSROOT, gpu.PCIIDSFILES
+	t.Cleanup(func() {
+		gpu.SYSROOT, gpu.PCIIDSFILES = sysRoot, pciIDsFiles
+	})
+	gpu.SYSROOT = "../../test/data/sysfs"
+	gpu.PCIIDSFILES = []string{"../../test/data/pci.ids"}
+}
+
+func TestMain(m *testing.M) {
+	// No GPU is discovered unless a test uses the fake sysfs tree.
+	gpu.SYSROOT = "../../test/data/non-existent"
+	os.Exit(m.Run())
 }
 
 func getNetworkInfo() []*proto.SystemNetwork {
@@ -450,6 +484,7 @@ func TestGenerateUpdateDeviceRequestSuccessAllInfo(t *testing.T) {
 	network.Readlink = mockedReadlink
 	network.CollectEthtoolData = mockedCollectEthtoolData
 	network.Stat = mockedStat
+	useFakeSysfs(t)
 	json := comms.GenerateSystemInfoRequest(testCmdExecutorCommandPassed)
 	expected := expectedSystemInfoResult("12A34B5", "Test Product", "192.168.1.50", getOsInfo(), getBiosInfo(), getCpuInfo(), getStorageInfo(), getGpuInfo(), 17179869184, getNetworkInfo(), proto.BmInfo_IPMI, getUsbInfo())
 	require.NotNil(t, json)
@@ -521,7 +556,8 @@ func TestGenerateUpdateDeviceRequestSuccessCpuOnly(t *testing.T) {
 }
 
 func TestGenerateUpdateDeviceRequestSuccessGpuOnly(t *testing.T) {
-	json := comms.GenerateSystemInfoRequest(testCmdExecutorCommandPassedGpuOnly)
+	useFakeSysfs(t)
+	json := comms.GenerateSystemInfoRequest(testCmdExecutorCommandFailed)
 	osKern := proto.OsKernel{}
 	osRelease := proto.OsRelease{}
 	osInfo := &proto.OsInfo{
@@ -611,8 +647,6 @@ func testCmdExecutorCommandPassed(command string, args ...string) *exec.Cmd {
 		}
 	} else if strings.Contains(command, "lsblk") {
 		return testCmd("TestGenerateUpdateDeviceRequestCommandDiskDetails", command, args...)
-	} else if strings.Contains(command, "lspci") {
-		return testCmd("TestGenerateUpdateDeviceRequestCommandGpuPciDetails", command, args...)
 	} else if strings.Contains(command, "lsmem") {
 		return testCmd("TestGenerateUpdateDeviceRequestCommandMemoryDetails", command, args...)
 	} else if strings.Contains(command, "ip") {
@@ -640,9 +674,7 @@ func testCmdExecutorCommandPassed(command string, args ...string) *exec.Cmd {
 			return testCmd("TestGenerateUpdateDeviceRequestCommandReleaseMetadata", command, args...)
 		}
 	} else if strings.Contains(command, "sudo") {
-		if strings.Contains(args[0], "lshw") {
-			return testCmd("TestGenerateUpdateDeviceRequestCommandGpuDetails", command, args...)
-		} else if strings.Contains(args[0], "ipmitool") {
+		if strings.Contains(args[0], "ipmitool") {
 			return testCmd("TestGenerateUpdateDeviceRequestCommandIpmiDetails", command, args...)
 		} else {
 			if strings.Contains(args[2], "bios-version") {
@@ -720,16 +752,6 @@ func testCmdExecutorCommandPassedCpuOnly(command string, args ...string) *exec.C
 	}
 }
 
-func testCmdExecutorCommandPassedGpuOnly(command string, args ...string) *exec.Cmd {
-	if strings.Contains(command, "sudo") && strings.Contains(args[0], "lshw") {
-		return testCmd("TestGenerateUpdateDeviceRequestCommandGpuDetails", command, args...)
-	} else if strings.Contains(command, "lspci") {
-		return testCmd("TestGenerateUpdateDeviceRequestCommandGpuPciDetails", command, args...)
-	} else {
-		return testCmd("TestGenerateUpdateDeviceRequestCommandFailed", command, args...)
-	}
-}
-
 func testCmdExecutorCommandPassedMemoryOnly(command string, args ...string) *exec.Cmd {
 	if strings.Contains(command, "lsmem") {
 		return testCmd("TestGenerateUpdateDeviceRequestCommandMemoryDetails", command, args...)
@@ -798,26 +820,6 @@ func TestGenerateUpdateDeviceRequestCommandDiskDetails(t *testing.T) {
 	os.Exit(0)
 }
 
-func TestGenerateUpdateDeviceRequestCommandGpuDetails(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
-func TestGenerateUpdateDeviceRequestCommandGpuPciDetails(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu_name.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
 func TestGenerateUpdateDeviceRequestCommandMemoryDetails(t *testing.T) {
 	if os.Getenv("GO_TEST_PROCESS") != "1" {
 		return
@@ -5,6 +5,7 @@ package config
 
 import (
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/logger"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/smart"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/uevent"
 )
 
 var log = logger.Logger
@@ -24,15 +27,26 @@ type JWT struct {
 	AccessTokenPath string `yaml:"accessTokenPath"`
 }
 
+type Collector struct {
+	Enabled  *bool         `yaml:"enabled"`
+	Interval time.Duration `yaml:"interval"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
 type Config struct {
-	Version         string        `json:"version" yaml:"version"`
-	LogLevel        string        `json:"logLevel" yaml:"logLevel"`
-	Onboarding      Onboarding    `json:"onboarding" yaml:"onboarding"`
-	MetricsEndpoint string        `yaml:"metricsEndpoint"`
-	MetricsInterval time.Duration `yaml:"metricsInterval"`
-	StatusEndpoint  string        `yaml:"statusEndpoint"`
-	UpdateInterval  time.Duration `yaml:"interval"`
-	JWT             JWT           `yaml:"jwt"`
+	Version            string               `json:"version" yaml:"version"`
+	LogLevel           string               `json:"logLevel" yaml:"logLevel"`
+	Onboarding         Onboarding           `json:"onboarding" yaml:"onboarding"`
+	MetricsEndpoint    string               `yaml:"metricsEndpoint"`
+	MetricsInterval    time.Duration        `yaml:"metricsInterval"`
+	StatusEndpoint     string               `yaml:"statusEndpoint"`
+	UpdateInterval     time.Duration        `yaml:"interval"`
+	FullReportInterval time.Duration        `yaml:"fullReportInterval"`
+	UdevSubsystems     []string             `yaml:"udevSubsystems"`
+	UdevDebounce       time.Duration        `yaml:"udevDebounce"`
+	Collectors         map[string]Collector `yaml:"collectors"`
+	SmartThresholds    smart.Thresholds     `yaml:"smartThresholds"`
+	JWT                JWT                  `yaml:"jwt"`
 }
 
 func New(configFile string) (*Config, error) {
@@ -68,6 +82,19 @@ func New(configFile string) (*Config, error) {
 		log.Warnf("interval not provided by %s, setting to default value", configFile)
 		config.UpdateInterval = 30 * time.Second
 	}
+	if config.FullReportInterval <= 0 {
+		log.Warnf("fullReportInterval not provided by %s, setting to default value", configFile)
+		config.FullReportInterval = time.Hour
+	}
+	if len(config.UdevSubsystems) == 0 {
+		log.Warnf("udevSubsystems not provided by %s, setting to default value", configFile)
+		config.UdevSubsystems = slices.Clone(uevent.SupportedSubsystems)
+	}
+	if config.UdevDebounce <= 0 {
+		log.Warnf("udevDebounce not provided by %s, setting to default value", configFile)
+		config.UdevDebounce = 2 * time.Second
+	}
+	config.SmartThresholds = config.SmartThresholds.WithDefaults()
 
 	if config.LogLevel != "info" && config.LogLevel != "debug" && config.LogLevel != "warning" && config.LogLevel != "error" {
 		log.Errorf("unsupported logLevel value provided by %s, exiting : %v", configFile, err)
@@ -81,6 +108,22 @@ func New(configFile string) (*Config, error) {
 		log.Errorf("JWT not provided by %s, exiting : %v", configFile, err)
 		return nil, errors.New("JWT not provided by config file")
 	}
+	for name, collector := range config.Collectors {
+		if collector.Interval < 0 || collector.Timeout < 0 {
+			log.Errorf("negative interval or timeout of collector %s provided by %s, exiting", name, configFile)
+			return nil, errors.New("negative collector interval or timeout provided by config file")
+		}
+	}
+	if !config.SmartThresholds.Valid() {
+		log.Errorf("smartThresholds provided by %s set a warning threshold above its critical threshold, exiting", configFile)
+		return nil, errors.New("invalid smartThresholds provided by config file")
+	}
+	for _, subsystem := range config.UdevSubsystems {
+		if !slices.Contains(uevent.SupportedSubsystems, subsystem) {
+			log.Errorf("unsupported udevSubsystems value %q provided by %s, exiting", subsystem, configFile)
+			return nil, errors.New("unsupported udevSubsystems value provided by config file")
+		}
+	}
 
 	log.Debugf("Loaded configuration: %v", config)
 	return &config, nil
@@ -12,6 +12,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/smart"
 )
 
 func createConfigFile(t *testing.T, version string, logLevel string, url string, interval time.Duration, accessTokenPath string) string {
@@ -110,6 +111,92 @@ func TestPartialConfigFile(t *testing.T) {
 	assert.Equal(t, url, cfg.Onboarding.ServiceURL)
 	assert.Equal(t, "/etc/intel_edge_node/tokens/hd-agent/access_token", cfg.JWT.AccessTokenPath)
 	assert.Equal(t, 30*time.Second, cfg.UpdateInterval)
+	assert.Equal(t, time.Hour, cfg.FullReportInterval)
+	assert.Equal(t, []string{"block", "net", "usb", "pci", "drm", "thunderbolt"}, cfg.UdevSubsystems)
+	assert.Equal(t, 2*time.Second, cfg.UdevDebounce)
+	assert.Equal(t, smart.DefaultThresholds, cfg.SmartThresholds)
+}
+
+func TestUdevSubsystems(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "test_config")
+	require.NoError(t, err)
+	_, err = f.WriteString("onboarding:\n  serviceURL: localhost\njwt:\n  accessTokenPath: /tmp/token\nudevSubsystems: [block, usb]\nudevDebounce: 500ms\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := config.New(f.Name())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"block", "usb"}, cfg.UdevSubsystems)
+	assert.Equal(t, 500*time.Millisecond, cfg.UdevDebounce)
+}
+
+func TestCollectors(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "test_config")
+	require.NoError(t, err)
+	_, err = f.WriteString("onboarding:\n  serviceURL: localhost\njwt:\n  accessTokenPath: /tmp/token\n" +
+		"collectors:\n  usb:\n    enabled: false\n  os:\n    interval: 24h\n    timeout: 1m\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := config.New(f.Name())
+	require.NoError(t, err)
+	disabled := false
+	assert.Equal(t, map[string]config.Collector{
+		"usb": {Enabled: &disabled},
+		"os":  {Interval: 24 * time.Hour, Timeout: time.Minute},
+	}, cfg.Collectors)
+}
+
+func TestNegativeCollectorTimeout(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "test_config")
+	require.NoError(t, err)
+	_, err = f.WriteString("onboarding:\n  serviceURL: localhost\njwt:\n  accessTokenPath: /tmp/token\ncollectors:\n  cpu:\n    timeout: -1s\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := config.New(f.Name())
+	require.Error(t, err)
+	require.Nil(t, cfg)
+}
+
+func TestSmartThresholds(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "test_config")
+	require.NoError(t, err)
+	_, err = f.WriteString("onboarding:\n  serviceURL: localhost\njwt:\n  accessTokenPath: /tmp/token\n" +
+		"smartThresholds:\n  percentageUsedWarning: 90\n  temperatureCritical: 75\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := config.New(f.Name())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(90), cfg.SmartThresholds.PercentageUsedWarning)
+	assert.Equal(t, uint64(100), cfg.SmartThresholds.PercentageUsedCritical)
+	assert.Equal(t, uint64(75), cfg.SmartThresholds.TemperatureCritical)
+}
+
+func TestInvalidSmartThresholds(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "test_config")
+	require.NoError(t, err)
+	_, err = f.WriteString("onboarding:\n  serviceURL: localhost\njwt:\n  accessTokenPath: /tmp/token\n" +
+		"smartThresholds:\n  temperatureWarning: 90\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := config.New(f.Name())
+	require.Error(t, err)
+	require.Nil(t, cfg)
+}
+
+func TestUnsupportedUdevSubsystem(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "test_config")
+	require.NoError(t, err)
+	_, err = f.WriteString("onboarding:\n  serviceURL: localhost\njwt:\n  accessTokenPath: /tmp/token\nudevSubsystems: [block, sound]\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cfg, err := config.New(f.Name())
+	require.Error(t, err)
+	require.Nil(t, cfg)
 }
 
 func TestInvalidLogLevel(t *testing.T) {
@@ -5,80 +5,313 @@
 package gpu
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
-	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/utils"
+	"github.com/open-edge-platform/edge-node-agents/common/pkg/utils"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/logger"
+)
+
+var log = logger.Logger
+
+// ioresourceMem is the IORESOURCE_MEM flag of a region in the sysfs resource file.
+const ioresourceMem = 0x200
+
+var (
+	// The variables will be mocked during the testing.
+	ReadFile    = utils.ReadFileNoLinks
+	SYSROOT     = "/sys"
+	PCIIDSFILES = []string{"/usr/share/misc/pci.ids", "/usr/share/hwdata/pci.ids", "/usr/share/pci.ids"}
 )
 
+type MemoryBar struct {
+	Index int
+	Start uint64
+	Size  uint64
+}
+
 type Gpu struct {
-	PciID       string
-	Product     string
-	Vendor      string
-	Name        string
-	Description string
-	Features    []string
+	PciID         string
+	Product       string
+	Vendor        string
+	Name          string
+	Description   string
+	Features      []string
+	VendorID      string
+	DeviceID      string
+	Driver        string
+	NumaNode      int
+	MemoryBars    []MemoryBar
+	SriovNumVfs   uint32
+	SriovTotalVfs uint32
+	DrmNodes      []string
+	AccelNodes    []string
+}
+
+// GetGpuList reports the display controllers, processing accelerators and any other PCI device exposing an
+// accel node, as found in sysfs. SR-IOV virtual functions are accounted for in their physical function.
+func GetGpuList() ([]*Gpu, error) {
+	pciRoot := filepath.Join(SYSROOT, "bus", "pci", "devices")
+	devices, err := os.ReadDir(pciRoot)
+	if err != nil {
+		return []*Gpu{}, fmt.Errorf("failed to read PCI devices; error: %w", err)
+	}
+
+	drmNodes := classNodes("drm", "dri")
+	accelNodes := classNodes("accel", "accel")
+	ids := loadPciIDs()
+
+	gpuList := []*Gpu{}
+	for _, device := range devices {
+		address := device.Name()
+		devPath, err := filepath.EvalSymlinks(filepath.Join(pciRoot, address))
+		if err != nil {
+			continue
+		}
+		class, err := readHex(filepath.Join(devPath, "class"))
+		if err != nil {
+			continue
+		}
+		if !isGpuClass(class) && len(accelNodes[address]) == 0 {
+			continue
+		}
+		if _, err := os.Lstat(filepath.Join(devPath, "physfn")); err == nil {
+			continue
+		}
+
+		vendorID := readID(filepath.Join(devPath, "vendor"))
+		deviceID := readID(filepath.Join(devPath, "device"))
+		vendor, product := ids.device(vendorID, deviceID)
+		gpu := &Gpu{
+			PciID:       strings.TrimPrefix(address, "0000:"),
+			Vendor:      vendor,
+			Product:     product,
+			Name:        vendor + " " + product,
+			Description: ids.class(class),
+			VendorID:    vendorID,
+			DeviceID:    deviceID,
+			Driver:      readDriver(devPath),
+			NumaNode:    readNumaNode(devPath),
+			MemoryBars:  readMemoryBars(devPath),
+			DrmNodes:    drmNodes[address],
+			AccelNodes:  accelNodes[address],
+		}
+		gpu.SriovNumVfs, _ = readUint(filepath.Join(devPath, "sriov_numvfs"))
+		gpu.SriovTotalVfs, _ = readUint(filepath.Join(devPath, "sriov_totalvfs"))
+		gpu.Features = features(gpu)
+		gpuList = append(gpuList, gpu)
+	}
+
+	return gpuList, nil
 }
 
-var infoNotFound = "Not Available"
+// isGpuClass matches display controllers (0x03), processing accelerators (0x12) and co-processors (0x0b40).
+func isGpuClass(class uint64) bool {
+	return class>>16 == 0x03 || class>>16 == 0x12 || class>>8 == 0x0b40
+}
+
+// classNodes maps the PCI address of the parent device to the /dev paths of the nodes of a sysfs class.
+func classNodes(class string, devDir string) map[string][]string {
+	nodes := map[string][]string{}
+	entries, err := os.ReadDir(filepath.Join(SYSROOT, "class", class))
+	if err != nil {
+		return nodes
+	}
+	for _, entry := range entries {
+		// Connectors such as card0-DP-1 are no device nodes.
+		if strings.Contains(entry.Name(), "-") || entry.Name() == "version" {
+			continue
+		}
+		devPath, err := filepath.EvalSymlinks(filepath.Join(SYSROOT, "class", class, entry.Name(), "device"))
+		if err != nil {
+			continue
+		}
+		address := filepath.Base(devPath)
+		nodes[address] = append(nodes[address], filepath.Join("/dev", devDir, entry.Name()))
+	}
+	for _, list := range nodes {
+		slices.Sort(list)
+	}
+	return nodes
+}
 
-func parseGpuDetails(gpuDevice, infoType string) []string {
-	gpuInfo := strings.Split(gpuDevice, infoType)
-	if len(gpuInfo) == 1 {
-		// Return empty string if infoType is not found
-		return []string{infoNotFound}
+func readDriver(devPath string) string {
+	dest, err := os.Readlink(filepath.Join(devPath, "driver"))
+	if err != nil {
+		return ""
 	}
-	return strings.Split(gpuInfo[1], "\n")
+	return filepath.Base(dest)
 }
 
-func GetGpuList(executor utils.CmdExecutor) ([]*Gpu, error) {
-	gpuInfo, err := utils.ReadFromCommand(executor, "sudo", "lshw", "-C", "display")
+func readNumaNode(devPath string) int {
+	contents, err := ReadFile(filepath.Join(devPath, "numa_node"))
 	if err != nil {
-		return []*Gpu{}, fmt.Errorf("failed to read data from command; error: %w", err)
+		return -1
 	}
+	node, err := strconv.Atoi(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return -1
+	}
+	return node
+}
 
-	gpuDevices := strings.Split(string(gpuInfo), "*-display")
-	gpuList := []*Gpu{}
-	for _, gpu := range gpuDevices {
-		if strings.TrimSpace(gpu) == "" {
+// readMemoryBars parses the "start end flags" lines of the resource file, the first six being the BARs.
+func readMemoryBars(devPath string) []MemoryBar {
+	contents, err := ReadFile(filepath.Join(devPath, "resource"))
+	if err != nil {
+		return nil
+	}
+	bars := []MemoryBar{}
+	for index, line := range strings.Split(strings.TrimSpace(string(contents)), "\n") {
+		if index > 5 {
+			break
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		start, err1 := strconv.ParseUint(fields[0], 0, 64)
+		end, err2 := strconv.ParseUint(fields[1], 0, 64)
+		flags, err3 := strconv.ParseUint(fields[2], 0, 64)
+		if err1 != nil || err2 != nil || err3 != nil || end <= start || flags&ioresourceMem == 0 {
 			continue
 		}
+		bars = append(bars, MemoryBar{Index: index, Start: start, Size: end - start + 1})
+	}
+	return bars
+}
 
-		pciInfo := parseGpuDetails(gpu, "bus info: ")
-		pciAddress := strings.TrimPrefix(pciInfo[0], "pci@0000:")
+func readHex(path string) (uint64, error) {
+	contents, err := ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(contents)), 0, 64)
+}
+
+func readID(path string) string {
+	id, err := readHex(path)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%04x", id)
+}
 
-		productName := parseGpuDetails(gpu, "product: ")
-		vendor := parseGpuDetails(gpu, "vendor: ")
-		description := parseGpuDetails(gpu, "description: ")
+func readUint(path string) (uint32, error) {
+	contents, err := ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 32)
+	return uint32(value), err
+}
 
-		deviceFeatures := parseGpuDetails(gpu, "capabilities: ")
-		features := deviceFeatures
-		if features[0] != infoNotFound {
-			features = strings.Split(deviceFeatures[0], " ")
+// features lists the attributes that have no dedicated field in the inventory, in the key=value form lshw uses
+// for the configuration of a device.
+func features(gpu *Gpu) []string {
+	result := []string{}
+	if gpu.Driver != "" {
+		result = append(result, "driver="+gpu.Driver)
+	}
+	if gpu.NumaNode >= 0 {
+		result = append(result, fmt.Sprintf("numa_node=%d", gpu.NumaNode))
+	}
+	for _, bar := range gpu.MemoryBars {
+		result = append(result, fmt.Sprintf("memory=%x-%x", bar.Start, bar.Start+bar.Size-1))
+	}
+	if gpu.SriovTotalVfs > 0 {
+		result = append(result, fmt.Sprintf("sriov_totalvfs=%d", gpu.SriovTotalVfs), fmt.Sprintf("sriov_numvfs=%d", gpu.SriovNumVfs))
+	}
+	for _, node := range gpu.DrmNodes {
+		if strings.HasPrefix(filepath.Base(node), "renderD") {
+			result = append(result, "render="+node)
+		} else {
+			result = append(result, "card="+node)
 		}
+	}
+	for _, node := range gpu.AccelNodes {
+		result = append(result, "accel="+node)
+	}
+	return result
+}
 
-		device := "Info Not Available"
-		if pciAddress != infoNotFound {
-			deviceInfo, err := utils.ReadFromCommand(executor, "lspci", "-v", "-s", pciAddress)
-			if err != nil {
-				return []*Gpu{}, fmt.Errorf("failed to read data from command; error: %w", err)
-			}
-			deviceName := strings.Split(string(deviceInfo), " (")
-			devName := strings.Split(deviceName[0], ": ")
-			if len(devName) > 1 {
-				device = devName[1]
+type pciIDs struct {
+	vendors    map[string]string
+	devices    map[string]string
+	classes    map[string]string
+	subclasses map[string]string
+}
+
+// loadPciIDs reads the first pci.ids database found. Without one, IDs are reported instead of names.
+func loadPciIDs() *pciIDs {
+	ids := &pciIDs{vendors: map[string]string{}, devices: map[string]string{}, classes: map[string]string{}, subclasses: map[string]string{}}
+	for _, path := range PCIIDSFILES {
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		ids.parse(f)
+		f.Close()
+		return ids
+	}
+	log.Warnf("no pci.ids database found, reporting GPU vendor and device IDs")
+	return ids
+}
+
+func (ids *pciIDs) parse(f *os.File) {
+	var vendor, class string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "\t\t") {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, "C "); ok {
+			id, name, _ := strings.Cut(rest, "  ")
+			vendor, class = "", id
+			ids.classes[id] = name
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, "\t"); ok {
+			id, name, _ := strings.Cut(rest, "  ")
+			if vendor != "" {
+				ids.devices[vendor+":"+id] = name
+			} else if class != "" {
+				ids.subclasses[class+id] = name
 			}
+			continue
 		}
+		id, name, _ := strings.Cut(line, "  ")
+		vendor, class = id, ""
+		ids.vendors[id] = name
+	}
+}
 
-		gpuList = append(gpuList, &Gpu{
-			PciID:       pciAddress,
-			Product:     productName[0],
-			Vendor:      vendor[0],
-			Name:        device,
-			Description: description[0],
-			Features:    features,
-		})
+func (ids *pciIDs) device(vendorID, deviceID string) (string, string) {
+	vendor, ok := ids.vendors[vendorID]
+	if !ok {
+		vendor = "Vendor " + vendorID
 	}
+	product, ok := ids.devices[vendorID+":"+deviceID]
+	if !ok {
+		product = "Device " + deviceID
+	}
+	return vendor, product
+}
 
-	return gpuList, nil
+func (ids *pciIDs) class(class uint64) string {
+	classID := fmt.Sprintf("%02x", class>>16)
+	if name, ok := ids.subclasses[fmt.Sprintf("%s%02x", classID, (class>>8)&0xff)]; ok {
+		return name
+	}
+	if name, ok := ids.classes[classID]; ok {
+		return name
+	}
+	return fmt.Sprintf("Class %04x", class>>8)
 }
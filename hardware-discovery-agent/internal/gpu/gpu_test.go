@@ -5,10 +5,6 @@
 package gpu_test
 
 import (
-	"fmt"
-	"os"
-	"os/exec"
-	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -17,379 +13,90 @@ import (
 	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/gpu"
 )
 
-var testPciAddr1 = "03:00.0"
-var testPciAddr2 = "57:00.0"
-var testPciAddr3 = "5b:00.0"
-var testProduct1 = "Graphics Controller"
-var testProduct2 = "Graphics Card"
-var testProduct3 = "Graphics Card"
-var testVendor1 = "Graphics"
-var testVendor2 = "PCI"
-var testVendor3 = "PCI"
-var testName = "Graphics Controller"
-var testDescription1 = "VGA compatible controller"
-var testDescription2 = "PCI graphics card"
-var testDescription3 = "PCI graphics card"
-var testFeatures1 = []string{"pm", "vga_controller", "bus_master", "cap_list", "rom", "fb"}
-var testFeatures2 = []string{"pciexpress", "msi", "pm", "bus_master", "cap_list"}
-var testFeatures3 = []string{"pciexpress", "msi", "pm", "bus_master", "cap_list"}
-var testNotFoundMsg = "Not Available"
-var testNameNotFound = "Info Not Available"
-
-func expectedOutput(expect []*gpu.Gpu, pci, prod, vendor, name, desc string, features []string) []*gpu.Gpu {
-	return append(expect, &gpu.Gpu{
-		PciID:       pci,
-		Product:     prod,
-		Vendor:      vendor,
-		Name:        name,
-		Description: desc,
-		Features:    features,
+// The fake sysfs tree holds an integrated GPU with one SR-IOV VF, a discrete GPU, a processing accelerator and an
+// audio device.
+func useFakeSysfs(t *testing.T, pciIDs ...string) {
+	sysRoot, pciIDsFiles := gpu.SYSROOT, gpu.PCIIDSFILES
+	t.Cleanup(func() {
+		gpu.SYSROOT, gpu.PCIIDSFILES = sysRoot, pciIDsFiles
 	})
+	gpu.SYSROOT = "../../test/data/sysfs"
+	gpu.PCIIDSFILES = pciIDs
 }
 
 func TestGetGpuList(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorSuccess)
-	expect := []*gpu.Gpu{}
-	expect = expectedOutput(expect, testPciAddr1, testProduct1,
-		testVendor1, testName, testDescription1, testFeatures1)
-	require.NoError(t, err)
-	assert.NotNil(t, out)
-	assert.Equal(t, expect, out)
-}
-
-func TestGetGpuListFailed(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorLshwFailed)
+	useFakeSysfs(t, "/non/existent/pci.ids", "../../test/data/pci.ids")
+
+	out, err := gpu.GetGpuList()
+	require.NoError(t, err)
+	assert.Equal(t, []*gpu.Gpu{
+		{
+			PciID:       "00:02.0",
+			Product:     "Raptor Lake-P [Iris Xe Graphics]",
+			Vendor:      "Intel Corporation",
+			Name:        "Intel Corporation Raptor Lake-P [Iris Xe Graphics]",
+			Description: "VGA compatible controller",
+			Features: []string{"driver=i915", "memory=6000000000-6000ffffff", "memory=4000000000-400fffffff",
+				"sriov_totalvfs=7", "sriov_numvfs=1", "card=/dev/dri/card0", "render=/dev/dri/renderD128"},
+			VendorID:      "8086",
+			DeviceID:      "a7a0",
+			Driver:        "i915",
+			NumaNode:      -1,
+			MemoryBars:    []gpu.MemoryBar{{Index: 0, Start: 0x6000000000, Size: 16 << 20}, {Index: 2, Start: 0x4000000000, Size: 256 << 20}},
+			SriovNumVfs:   1,
+			SriovTotalVfs: 7,
+			DrmNodes:      []string{"/dev/dri/card0", "/dev/dri/renderD128"},
+		},
+		{
+			PciID:       "03:00.0",
+			Product:     "DG2 [Arc A770]",
+			Vendor:      "Intel Corporation",
+			Name:        "Intel Corporation DG2 [Arc A770]",
+			Description: "VGA compatible controller",
+			Features: []string{"driver=xe", "numa_node=0", "memory=a1000000-a1ffffff", "memory=4800000000-4bffffffff",
+				"card=/dev/dri/card1", "render=/dev/dri/renderD129"},
+			VendorID:   "8086",
+			DeviceID:   "56a0",
+			Driver:     "xe",
+			NumaNode:   0,
+			MemoryBars: []gpu.MemoryBar{{Index: 0, Start: 0xa1000000, Size: 16 << 20}, {Index: 2, Start: 0x4800000000, Size: 16 << 30}},
+			DrmNodes:   []string{"/dev/dri/card1", "/dev/dri/renderD129"},
+		},
+		{
+			PciID:       "6a:00.0",
+			Product:     "Gaudi2 AI Training Accelerator",
+			Vendor:      "Habana Labs Ltd.",
+			Name:        "Habana Labs Ltd. Gaudi2 AI Training Accelerator",
+			Description: "Processing accelerators",
+			Features:    []string{"driver=habanalabs", "numa_node=1", "memory=d9000000-d9ffffff", "accel=/dev/accel/accel0"},
+			VendorID:    "1da3",
+			DeviceID:    "1020",
+			Driver:      "habanalabs",
+			NumaNode:    1,
+			MemoryBars:  []gpu.MemoryBar{{Index: 0, Start: 0xd9000000, Size: 16 << 20}},
+			AccelNodes:  []string{"/dev/accel/accel0"},
+		},
+	}, out)
+}
+
+func TestGetGpuListWithoutPciIDs(t *testing.T) {
+	useFakeSysfs(t)
+
+	out, err := gpu.GetGpuList()
+	require.NoError(t, err)
+	require.Len(t, out, 3)
+	assert.Equal(t, "Vendor 8086", out[0].Vendor)
+	assert.Equal(t, "Device a7a0", out[0].Product)
+	assert.Equal(t, "Vendor 8086 Device a7a0", out[0].Name)
+	assert.Equal(t, "Class 0300", out[0].Description)
+	assert.Equal(t, "Class 1200", out[2].Description)
+}
+
+func TestGetGpuListNoPciBus(t *testing.T) {
+	useFakeSysfs(t)
+	gpu.SYSROOT = t.TempDir()
+
+	out, err := gpu.GetGpuList()
 	require.Error(t, err)
 	assert.Equal(t, []*gpu.Gpu{}, out)
 }
-
-func TestGetGpuListLspciFailed(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorLspciFailed)
-	require.Error(t, err)
-	assert.Equal(t, []*gpu.Gpu{}, out)
-}
-
-func TestGetGpuListMultiDevicesSuccess(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorMultiDevSuccess)
-	expect := []*gpu.Gpu{}
-	expect = expectedOutput(expect, testPciAddr1, testProduct1,
-		testVendor1, testName, testDescription1, testFeatures1)
-	expect = expectedOutput(expect, testPciAddr2, testProduct2,
-		testVendor2, testName, testDescription2, testFeatures2)
-	expect = expectedOutput(expect, testPciAddr3, testProduct3,
-		testVendor3, testName, testDescription3, testFeatures3)
-	require.NoError(t, err)
-	assert.NotNil(t, out)
-	assert.Equal(t, expect, out)
-}
-
-func TestGetGpuListEmptyPciInfo(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorEmptyPciInfo)
-	expect := []*gpu.Gpu{}
-	expect = expectedOutput(expect, testNotFoundMsg, testProduct1,
-		testVendor1, testNameNotFound, testDescription1, testFeatures1)
-	require.NoError(t, err)
-	assert.NotNil(t, out)
-	assert.Equal(t, expect, out)
-}
-
-func TestGetGpuListEmptyProductName(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorEmptyProductName)
-	expect := []*gpu.Gpu{}
-	expect = expectedOutput(expect, testPciAddr1, testNotFoundMsg,
-		testVendor1, testName, testDescription1, testFeatures1)
-	require.NoError(t, err)
-	assert.NotNil(t, out)
-	assert.Equal(t, expect, out)
-}
-
-func TestGetGpuListEmptyVendor(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorEmptyVendorName)
-	expect := []*gpu.Gpu{}
-	expect = expectedOutput(expect, testPciAddr1, testProduct1,
-		testNotFoundMsg, testName, testDescription1, testFeatures1)
-	require.NoError(t, err)
-	assert.NotNil(t, out)
-	assert.Equal(t, expect, out)
-}
-
-func TestGetGpuListEmptyDescription(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorEmptyDescription)
-	expect := []*gpu.Gpu{}
-	expect = expectedOutput(expect, testPciAddr1, testProduct1,
-		testVendor1, testName, testNotFoundMsg, testFeatures1)
-	require.NoError(t, err)
-	assert.NotNil(t, out)
-	assert.Equal(t, expect, out)
-}
-
-func TestGetGpuListEmptyFeatures(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorEmptyFeatures)
-	expect := []*gpu.Gpu{}
-	expect = expectedOutput(expect, testPciAddr1, testProduct1,
-		testVendor1, testName, testDescription1, []string{testNotFoundMsg})
-	require.NoError(t, err)
-	assert.NotNil(t, out)
-	assert.Equal(t, expect, out)
-}
-
-func TestGetGpuListEmptyDeviceName(t *testing.T) {
-	out, err := gpu.GetGpuList(testCmdExecutorEmptyDeviceName)
-	expect := []*gpu.Gpu{}
-	expect = expectedOutput(expect, testPciAddr1, testProduct1,
-		testVendor1, testNameNotFound, testDescription1, testFeatures1)
-	require.NoError(t, err)
-	assert.NotNil(t, out)
-	assert.Equal(t, expect, out)
-}
-
-func testCmdReceived(args ...string) bool {
-	for _, arg := range args {
-		if strings.Contains(arg, "lshw") {
-			return true
-		}
-	}
-	return false
-}
-
-func testCmdExecutorSuccess(command string, args ...string) *exec.Cmd {
-	if testCmdReceived(args...) {
-		cs := []string{"-test.run=TestGpuListExecutionLshwSuccess", "--", command}
-		cs = append(cs, args...)
-		cmd := exec.Command(os.Args[0], cs...)
-		cmd.Env = []string{"GO_TEST_PROCESS=1"}
-		return cmd
-	}
-
-	cs := []string{"-test.run=TestGpuListExecutionLspciSuccess", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func testCmdExecutorLshwFailed(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestGpuListExecutionFailed", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func testCmdExecutorLspciFailed(command string, args ...string) *exec.Cmd {
-	if testCmdReceived(args...) {
-		cs := []string{"-test.run=TestGpuListExecutionLshwSuccess", "--", command}
-		cs = append(cs, args...)
-		cmd := exec.Command(os.Args[0], cs...)
-		cmd.Env = []string{"GO_TEST_PROCESS=1"}
-		return cmd
-	}
-
-	cs := []string{"-test.run=TestGpuListExecutionFailed", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func testCmdExecutorMultiDevSuccess(command string, args ...string) *exec.Cmd {
-	if testCmdReceived(args...) {
-		cs := []string{"-test.run=TestGpuListExecutionMultiDevSuccess", "--", command}
-		cs = append(cs, args...)
-		cmd := exec.Command(os.Args[0], cs...)
-		cmd.Env = []string{"GO_TEST_PROCESS=1"}
-		return cmd
-	}
-
-	cs := []string{"-test.run=TestGpuListExecutionLspciSuccess", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func testCmdExecutorEmptyPciInfo(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestGpuListExecutionEmptyPciInfo", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func testCmdExecutorEmptyProductName(command string, args ...string) *exec.Cmd {
-	if testCmdReceived(args...) {
-		cs := []string{"-test.run=TestGpuListExecutionEmptyProductName", "--", command}
-		cs = append(cs, args...)
-		cmd := exec.Command(os.Args[0], cs...)
-		cmd.Env = []string{"GO_TEST_PROCESS=1"}
-		return cmd
-	}
-
-	cs := []string{"-test.run=TestGpuListExecutionLspciSuccess", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func testCmdExecutorEmptyVendorName(command string, args ...string) *exec.Cmd {
-	if testCmdReceived(args...) {
-		cs := []string{"-test.run=TestGpuListExecutionEmptyVendor", "--", command}
-		cs = append(cs, args...)
-		cmd := exec.Command(os.Args[0], cs...)
-		cmd.Env = []string{"GO_TEST_PROCESS=1"}
-		return cmd
-	}
-
-	cs := []string{"-test.run=TestGpuListExecutionLspciSuccess", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func testCmdExecutorEmptyDescription(command string, args ...string) *exec.Cmd {
-	if testCmdReceived(args...) {
-		cs := []string{"-test.run=TestGpuListExecutionEmptyDescription", "--", command}
-		cs = append(cs, args...)
-		cmd := exec.Command(os.Args[0], cs...)
-		cmd.Env = []string{"GO_TEST_PROCESS=1"}
-		return cmd
-	}
-
-	cs := []string{"-test.run=TestGpuListExecutionLspciSuccess", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func testCmdExecutorEmptyFeatures(command string, args ...string) *exec.Cmd {
-	if testCmdReceived(args...) {
-		cs := []string{"-test.run=TestGpuListExecutionEmptyFeatures", "--", command}
-		cs = append(cs, args...)
-		cmd := exec.Command(os.Args[0], cs...)
-		cmd.Env = []string{"GO_TEST_PROCESS=1"}
-		return cmd
-	}
-
-	cs := []string{"-test.run=TestGpuListExecutionLspciSuccess", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func testCmdExecutorEmptyDeviceName(command string, args ...string) *exec.Cmd {
-	if testCmdReceived(args...) {
-		cs := []string{"-test.run=TestGpuListExecutionLshwSuccess", "--", command}
-		cs = append(cs, args...)
-		cmd := exec.Command(os.Args[0], cs...)
-		cmd.Env = []string{"GO_TEST_PROCESS=1"}
-		return cmd
-	}
-
-	cs := []string{"-test.run=TestGpuListExecutionLspciNoDeviceName", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_TEST_PROCESS=1"}
-	return cmd
-}
-
-func TestGpuListExecutionLshwSuccess(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
-func TestGpuListExecutionLspciSuccess(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu_name.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
-func TestGpuListExecutionFailed(_ *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	fmt.Fprintf(os.Stdout, "failed to execute command")
-	os.Exit(1)
-}
-
-func TestGpuListExecutionMultiDevSuccess(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_multi_gpu.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
-func TestGpuListExecutionEmptyPciInfo(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu_empty_pci_info.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
-func TestGpuListExecutionEmptyProductName(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu_empty_product.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
-func TestGpuListExecutionEmptyVendor(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu_empty_vendor.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
-func TestGpuListExecutionEmptyDescription(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu_empty_description.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
-func TestGpuListExecutionEmptyFeatures(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu_empty_features.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
-
-func TestGpuListExecutionLspciNoDeviceName(t *testing.T) {
-	if os.Getenv("GO_TEST_PROCESS") != "1" {
-		return
-	}
-	testData, err := os.ReadFile("../../test/data/mock_gpu_no_name.txt")
-	require.NoError(t, err)
-	fmt.Fprintf(os.Stdout, "%v", string(testData))
-	os.Exit(0)
-}
@@ -0,0 +1,359 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package inventory compares the hardware inventory with the one last reported to the Edge
+// Infrastructure Manager, so that an unchanged inventory is not sent again and every change is
+// logged.
+package inventory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	proto "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/logger"
+)
+
+var log = logger.Logger
+
+// Kinds of change.
+const (
+	Added   = "added"
+	Removed = "removed"
+	Changed = "changed"
+)
+
+// FieldChange is a field of a component whose value changed.
+type FieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// Change is a component of the inventory that was added, removed or changed.
+type Change struct {
+	// Component is the kind of component: system, cpu, memory, disk, gpu, network, usb, os,
+	// bios or bmc.
+	Component string
+	// Name identifies the device for components that can have several, e.g. the disk name.
+	Name   string
+	Kind   string
+	Fields []FieldChange
+}
+
+// String describes the change, e.g. "network eth0 changed: current_speed 1000 -> 10000".
+func (c Change) String() string {
+	s := c.Component
+	if c.Name != "" {
+		s += " " + c.Name
+	}
+	s += " " + c.Kind
+	fields := []string{}
+	for _, f := range c.Fields {
+		fields = append(fields, fmt.Sprintf("%s %q -> %q", f.Field, f.Old, f.New))
+	}
+	if len(fields) > 0 {
+		s += ": " + strings.Join(fields, ", ")
+	}
+	return s
+}
+
+// LogChanges logs every change as an event with the component, name and kind as fields.
+func LogChanges(changes []Change) {
+	for _, c := range changes {
+		entry := log.WithField("event", "inventory_change").
+			WithField("hw_component", c.Component).
+			WithField("change", c.Kind)
+		if c.Name != "" {
+			entry = entry.WithField("device", c.Name)
+		}
+		for _, f := range c.Fields {
+			entry = entry.WithField("old_"+f.Field, f.Old).WithField("new_"+f.Field, f.New)
+		}
+		entry.Infof("hardware inventory changed: %s", c)
+	}
+}
+
+// Tracker keeps the last inventory that was successfully reported.
+type Tracker struct {
+	mu                 sync.Mutex
+	last               *proto.SystemInfo
+	lastReported       time.Time
+	fullReportInterval time.Duration
+}
+
+// NewTracker returns a tracker that requests a report of an unchanged inventory after
+// fullReportInterval, so that the Edge Infrastructure Manager is eventually corrected if it lost
+// the inventory.  A zero interval never reports an unchanged inventory.
+func NewTracker(fullReportInterval time.Duration) *Tracker {
+	return &Tracker{fullReportInterval: fullReportInterval}
+}
+
+// Compare returns the changes of an inventory since the last report, and whether it has to be
+// reported.  The first inventory is always reported.
+func (t *Tracker) Compare(current *proto.SystemInfo, now time.Time) ([]Change, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.last == nil {
+		return nil, true
+	}
+	if !protobuf.Equal(t.last, current) {
+		// A field that Diff does not describe may have changed, so report even without changes.
+		return Diff(t.last, current), true
+	}
+	return nil, t.fullReportInterval > 0 && now.Sub(t.lastReported) >= t.fullReportInterval
+}
+
+// Reported records an inventory that was successfully reported.
+func (t *Tracker) Reported(current *proto.SystemInfo, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.last = protobuf.Clone(current).(*proto.SystemInfo)
+	t.lastReported = now
+}
+
+// Diff returns the changes from the previous to the current inventory, components in a fixed
+// order and devices sorted by name.
+func Diff(previous, current *proto.SystemInfo) []Change {
+	if protobuf.Equal(previous, current) {
+		return nil
+	}
+	previousHw, currentHw := previous.GetHwInfo(), current.GetHwInfo()
+
+	changes := []Change{}
+	changes = appendChanged(changes, "system", "", systemFields(previous), systemFields(current))
+	changes = appendChanged(changes, "cpu", "", cpuFields(previousHw.GetCpu()), cpuFields(currentHw.GetCpu()))
+	changes = appendChanged(changes, "memory", "", memoryFields(previousHw.GetMemory()), memoryFields(currentHw.GetMemory()))
+	changes = append(changes, diffDevices("disk", devices(previousHw.GetStorage().GetDisk(), diskKey, diskFields), devices(currentHw.GetStorage().GetDisk(), diskKey, diskFields))...)
+	changes = append(changes, diffDevices("gpu", devices(previousHw.GetGpu(), gpuKey, gpuFields), devices(currentHw.GetGpu(), gpuKey, gpuFields))...)
+	changes = append(changes, diffDevices("network", devices(previousHw.GetNetwork(), networkKey, networkFields), devices(currentHw.GetNetwork(), networkKey, networkFields))...)
+	changes = append(changes, diffDevices("usb", devices(previousHw.GetUsb(), usbKey, usbFields), devices(currentHw.GetUsb(), usbKey, usbFields))...)
+	changes = appendChanged(changes, "os", "", osFields(previous.GetOsInfo()), osFields(current.GetOsInfo()))
+	changes = appendChanged(changes, "bios", "", biosFields(previous.GetBiosInfo()), biosFields(current.GetBiosInfo()))
+	changes = appendChanged(changes, "bmc", "", bmcFields(previous.GetBmCtlInfo()), bmcFields(current.GetBmCtlInfo()))
+	return changes
+}
+
+// field is a field of a component and its value as reported.
+type field struct {
+	name  string
+	value string
+}
+
+func f(name string, value any) field {
+	return field{name: name, value: fmt.Sprint(value)}
+}
+
+func appendChanged(changes []Change, component, name string, previous, current []field) []Change {
+	fieldChanges := []FieldChange{}
+	for i := range previous {
+		if previous[i].value != current[i].value {
+			fieldChanges = append(fieldChanges, FieldChange{Field: previous[i].name, Old: previous[i].value, New: current[i].value})
+		}
+	}
+	if len(fieldChanges) == 0 {
+		return changes
+	}
+	return append(changes, Change{Component: component, Name: name, Kind: Changed, Fields: fieldChanges})
+}
+
+// devices maps the devices of a component by key.  Devices with the same key are told apart by
+// a suffix in the order they are reported.
+func devices[T any](list []T, key func(T) string, fields func(T) []field) map[string][]field {
+	byKey := map[string][]field{}
+	for _, d := range list {
+		k := key(d)
+		for i := 2; ; i++ {
+			if _, ok := byKey[k]; !ok {
+				break
+			}
+			k = fmt.Sprintf("%s#%d", key(d), i)
+		}
+		byKey[k] = fields(d)
+	}
+	return byKey
+}
+
+func diffDevices(component string, previous, current map[string][]field) []Change {
+	keys := []string{}
+	for k := range previous {
+		keys = append(keys, k)
+	}
+	for k := range current {
+		if _, ok := previous[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := []Change{}
+	for _, k := range keys {
+		previousFields, inPrevious := previous[k]
+		currentFields, inCurrent := current[k]
+		switch {
+		case !inPrevious:
+			changes = append(changes, Change{Component: component, Name: k, Kind: Added})
+		case !inCurrent:
+			changes = append(changes, Change{Component: component, Name: k, Kind: Removed})
+		default:
+			changes = appendChanged(changes, component, k, previousFields, currentFields)
+		}
+	}
+	return changes
+}
+
+func systemFields(s *proto.SystemInfo) []field {
+	return []field{
+		f("serial_num", s.GetHwInfo().GetSerialNum()),
+		f("product_name", s.GetHwInfo().GetProductName()),
+	}
+}
+
+func cpuFields(c *proto.SystemCPU) []field {
+	sockets := []string{}
+	for _, s := range c.GetCpuTopology().GetSockets() {
+		groups := []string{}
+		for _, g := range s.GetCoreGroups() {
+			groups = append(groups, fmt.Sprintf("%s:%v", g.GetCoreType(), g.GetCoreList()))
+		}
+		sockets = append(sockets, fmt.Sprintf("%d[%s]", s.GetSocketId(), strings.Join(groups, " ")))
+	}
+	return []field{
+		f("arch", c.GetArch()),
+		f("vendor", c.GetVendor()),
+		f("model", c.GetModel()),
+		f("sockets", c.GetSockets()),
+		f("cores", c.GetCores()),
+		f("threads", c.GetThreads()),
+		f("features", c.GetFeatures()),
+		f("topology", strings.Join(sockets, " ")),
+	}
+}
+
+func memoryFields(m *proto.SystemMemory) []field {
+	return []field{f("size", m.GetSize())}
+}
+
+func diskKey(d *proto.SystemDisk) string {
+	return d.GetName()
+}
+
+func diskFields(d *proto.SystemDisk) []field {
+	return []field{
+		f("serial_number", d.GetSerialNumber()),
+		f("vendor", d.GetVendor()),
+		f("model", d.GetModel()),
+		f("size", d.GetSize()),
+		f("wwid", d.GetWwid()),
+	}
+}
+
+func gpuKey(g *proto.SystemGPU) string {
+	if g.GetPciId() != "" {
+		return g.GetPciId()
+	}
+	return g.GetName()
+}
+
+func gpuFields(g *proto.SystemGPU) []field {
+	return []field{
+		f("product", g.GetProduct()),
+		f("vendor", g.GetVendor()),
+		f("name", g.GetName()),
+		f("description", g.GetDescription()),
+		f("features", g.GetFeatures()),
+	}
+}
+
+func networkKey(n *proto.SystemNetwork) string {
+	return n.GetName()
+}
+
+func networkFields(n *proto.SystemNetwork) []field {
+	addresses := []string{}
+	for _, a := range n.GetIpAddresses() {
+		addresses = append(addresses, fmt.Sprintf("%s/%d (%v)", a.GetIpAddress(), a.GetNetworkPrefixBits(), a.GetConfigMode()))
+	}
+	return []field{
+		f("pci_id", n.GetPciId()),
+		f("mac", n.GetMac()),
+		f("link_state", n.GetLinkState()),
+		f("current_speed", n.GetCurrentSpeed()),
+		f("current_duplex", n.GetCurrentDuplex()),
+		f("supported_link_mode", n.GetSupportedLinkMode()),
+		f("advertising_link_mode", n.GetAdvertisingLinkMode()),
+		f("features", n.GetFeatures()),
+		f("sriov_enabled", n.GetSriovenabled()),
+		f("sriov_num_vfs", n.GetSriovnumvfs()),
+		f("sriov_vfs_total", n.GetSriovVfsTotal()),
+		f("peer_name", n.GetPeerName()),
+		f("peer_description", n.GetPeerDescription()),
+		f("peer_mac", n.GetPeerMac()),
+		f("peer_mgmt_ip", n.GetPeerMgmtIp()),
+		f("peer_port", n.GetPeerPort()),
+		f("ip_addresses", strings.Join(addresses, " ")),
+		f("mtu", n.GetMtu()),
+		f("bmc_net", n.GetBmcNet()),
+	}
+}
+
+// usbKey identifies a USB device by vendor, product and serial number, or by its port if it has no
+// serial number, so that a device plugged into another port is reported as moved.
+func usbKey(u *proto.SystemUSB) string {
+	if u.GetSerial() != "" {
+		return fmt.Sprintf("%s:%s %s", u.GetIdvendor(), u.GetIdproduct(), u.GetSerial())
+	}
+	return fmt.Sprintf("%s:%s bus %d addr %d", u.GetIdvendor(), u.GetIdproduct(), u.GetBus(), u.GetAddr())
+}
+
+func usbFields(u *proto.SystemUSB) []field {
+	interfaces := []string{}
+	for _, i := range u.GetInterfaces() {
+		interfaces = append(interfaces, i.GetClass())
+	}
+	return []field{
+		f("class", u.GetClass()),
+		f("bus", u.GetBus()),
+		f("addr", u.GetAddr()),
+		f("description", u.GetDescription()),
+		f("interfaces", interfaces),
+	}
+}
+
+func osFields(o *proto.OsInfo) []field {
+	config := []string{}
+	for _, c := range o.GetKernel().GetConfig() {
+		config = append(config, c.GetKey()+"="+c.GetValue())
+	}
+	metadata := []string{}
+	for _, m := range o.GetRelease().GetMetadata() {
+		metadata = append(metadata, m.GetKey()+"="+m.GetValue())
+	}
+	return []field{
+		f("kernel_version", o.GetKernel().GetVersion()),
+		f("kernel_config", strings.Join(config, " ")),
+		f("release_id", o.GetRelease().GetId()),
+		f("release_version", o.GetRelease().GetVersion()),
+		f("release_metadata", strings.Join(metadata, " ")),
+	}
+}
+
+func biosFields(b *proto.BiosInfo) []field {
+	return []field{
+		f("version", b.GetVersion()),
+		f("release_date", b.GetReleaseDate()),
+		f("vendor", b.GetVendor()),
+	}
+}
+
+func bmcFields(b *proto.BmInfo) []field {
+	return []field{
+		f("bm_type", b.GetBmType()),
+		f("bm_ip", b.GetBmcInfo().GetBmIp()),
+	}
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory_test
+
+import (
+	"testing"
+	"time"
+
+	proto "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/stretchr/testify/assert"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/inventory"
+)
+
+func systemInfo() *proto.SystemInfo {
+	return &proto.SystemInfo{
+		HwInfo: &proto.HWInfo{
+			SerialNum:   "12A34B5",
+			ProductName: "Test Product",
+			Cpu:         &proto.SystemCPU{Arch: "x86_64", Vendor: "GenuineIntel", Model: "Test CPU", Sockets: 1, Cores: 8, Threads: 16},
+			Memory:      &proto.SystemMemory{Size: 17179869184},
+			Storage: &proto.Storage{Disk: []*proto.SystemDisk{
+				{SerialNumber: "S1", Name: "nvme0n1", Vendor: "unknown", Model: "Test NVMe", Size: 512110190592},
+			}},
+			Network: []*proto.SystemNetwork{
+				{Name: "eth0", Mac: "00:11:22:33:44:55", LinkState: true, CurrentSpeed: 1000, CurrentDuplex: "full", Mtu: 1500},
+			},
+			Usb: []*proto.SystemUSB{
+				{Class: "Hub", Idvendor: "1d6b", Idproduct: "0002", Bus: 1, Addr: 1, Description: "Linux Foundation 2.0 root hub"},
+				{Class: "Mass Storage", Idvendor: "0781", Idproduct: "5581", Bus: 2, Addr: 3, Serial: "4C530001"},
+			},
+		},
+		OsInfo:    &proto.OsInfo{Kernel: &proto.OsKernel{Version: "6.8.0"}, Release: &proto.OsRelease{Id: "Ubuntu", Version: "24.04"}},
+		BmCtlInfo: &proto.BmInfo{BmType: proto.BmInfo_NONE, BmcInfo: &proto.BmcInfo{}},
+		BiosInfo:  &proto.BiosInfo{Version: "1.0", ReleaseDate: "01/01/2025", Vendor: "Test Vendor"},
+	}
+}
+
+func TestDiffUnchanged(t *testing.T) {
+	assert.Empty(t, inventory.Diff(systemInfo(), systemInfo()))
+}
+
+func TestDiff(t *testing.T) {
+	current := systemInfo()
+	current.HwInfo.Storage.Disk = append(current.HwInfo.Storage.Disk, &proto.SystemDisk{Name: "sda", SerialNumber: "S2", Size: 1000204886016})
+	current.HwInfo.Network[0].CurrentSpeed = 10000
+	current.HwInfo.Usb = current.HwInfo.Usb[:1]
+	current.OsInfo.Kernel.Version = "6.8.1"
+
+	changes := inventory.Diff(systemInfo(), current)
+	assert.Equal(t, []inventory.Change{
+		{Component: "disk", Name: "sda", Kind: inventory.Added},
+		{Component: "network", Name: "eth0", Kind: inventory.Changed, Fields: []inventory.FieldChange{{Field: "current_speed", Old: "1000", New: "10000"}}},
+		{Component: "usb", Name: "0781:5581 4C530001", Kind: inventory.Removed},
+		{Component: "os", Kind: inventory.Changed, Fields: []inventory.FieldChange{{Field: "kernel_version", Old: "6.8.0", New: "6.8.1"}}},
+	}, changes)
+	assert.Equal(t, `network eth0 changed: current_speed "1000" -> "10000"`, changes[1].String())
+	assert.Equal(t, "disk sda added", changes[0].String())
+}
+
+func TestDiffDuplicateDevices(t *testing.T) {
+	previous := systemInfo()
+	hub := &proto.SystemUSB{Class: "Hub", Idvendor: "05e3", Idproduct: "0610", Bus: 3, Addr: 2}
+	previous.HwInfo.Gpu = []*proto.SystemGPU{{Name: "VGA", Product: "Test GPU"}, {Name: "VGA", Product: "Test GPU"}}
+	current := protobuf.Clone(previous).(*proto.SystemInfo)
+	current.HwInfo.Gpu = current.HwInfo.Gpu[:1]
+	current.HwInfo.Usb = append(current.HwInfo.Usb, hub)
+
+	assert.Equal(t, []inventory.Change{
+		{Component: "gpu", Name: "VGA#2", Kind: inventory.Removed},
+		{Component: "usb", Name: "05e3:0610 bus 3 addr 2", Kind: inventory.Added},
+	}, inventory.Diff(previous, current))
+}
+
+func TestTracker(t *testing.T) {
+	tracker := inventory.NewTracker(time.Hour)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	changes, report := tracker.Compare(systemInfo(), now)
+	assert.True(t, report, "the first inventory is reported")
+	assert.Empty(t, changes)
+
+	// An inventory that was not reported successfully is compared again.
+	_, report = tracker.Compare(systemInfo(), now.Add(time.Minute))
+	assert.True(t, report)
+
+	reported := systemInfo()
+	tracker.Reported(reported, now)
+	reported.HwInfo.Memory.Size = 1 // The tracker keeps a copy.
+
+	changes, report = tracker.Compare(systemInfo(), now.Add(time.Minute))
+	assert.False(t, report, "an unchanged inventory is not reported")
+	assert.Empty(t, changes)
+
+	current := systemInfo()
+	current.HwInfo.Network[0].LinkState = false
+	changes, report = tracker.Compare(current, now.Add(time.Minute))
+	assert.True(t, report)
+	assert.Equal(t, []inventory.Change{
+		{Component: "network", Name: "eth0", Kind: inventory.Changed, Fields: []inventory.FieldChange{{Field: "link_state", Old: "true", New: "false"}}},
+	}, changes)
+
+	_, report = tracker.Compare(systemInfo(), now.Add(time.Hour))
+	assert.True(t, report, "an unchanged inventory is reported after the full report interval")
+}
+
+func TestTrackerWithoutFullReports(t *testing.T) {
+	tracker := inventory.NewTracker(0)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tracker.Reported(systemInfo(), now)
+
+	_, report := tracker.Compare(systemInfo(), now.Add(24*time.Hour))
+	assert.False(t, report)
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package smart
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/logger"
+)
+
+var log = logger.Logger
+
+// Thresholds at which an attribute classifies a disk as warning or critical. Zero values take the default.
+type Thresholds struct {
+	PercentageUsedWarning      uint64 `yaml:"percentageUsedWarning"`
+	PercentageUsedCritical     uint64 `yaml:"percentageUsedCritical"`
+	TemperatureWarning         uint64 `yaml:"temperatureWarning"`
+	TemperatureCritical        uint64 `yaml:"temperatureCritical"`
+	ReallocatedSectorsWarning  uint64 `yaml:"reallocatedSectorsWarning"`
+	ReallocatedSectorsCritical uint64 `yaml:"reallocatedSectorsCritical"`
+	MediaErrorsWarning         uint64 `yaml:"mediaErrorsWarning"`
+	MediaErrorsCritical        uint64 `yaml:"mediaErrorsCritical"`
+}
+
+var DefaultThresholds = Thresholds{
+	PercentageUsedWarning:      80,
+	PercentageUsedCritical:     100,
+	TemperatureWarning:         70,
+	TemperatureCritical:        80,
+	ReallocatedSectorsWarning:  1,
+	ReallocatedSectorsCritical: 100,
+	MediaErrorsWarning:         1,
+	MediaErrorsCritical:        100,
+}
+
+// WithDefaults returns the thresholds with the unset ones taken from DefaultThresholds.
+func (t Thresholds) WithDefaults() Thresholds {
+	set := func(value *uint64, fallback uint64) {
+		if *value == 0 {
+			*value = fallback
+		}
+	}
+	set(&t.PercentageUsedWarning, DefaultThresholds.PercentageUsedWarning)
+	set(&t.PercentageUsedCritical, DefaultThresholds.PercentageUsedCritical)
+	set(&t.TemperatureWarning, DefaultThresholds.TemperatureWarning)
+	set(&t.TemperatureCritical, DefaultThresholds.TemperatureCritical)
+	set(&t.ReallocatedSectorsWarning, DefaultThresholds.ReallocatedSectorsWarning)
+	set(&t.ReallocatedSectorsCritical, DefaultThresholds.ReallocatedSectorsCritical)
+	set(&t.MediaErrorsWarning, DefaultThresholds.MediaErrorsWarning)
+	set(&t.MediaErrorsCritical, DefaultThresholds.MediaErrorsCritical)
+	return t
+}
+
+// Valid reports whether every warning threshold is at most its critical threshold.
+func (t Thresholds) Valid() bool {
+	return t.PercentageUsedWarning <= t.PercentageUsedCritical && t.TemperatureWarning <= t.TemperatureCritical &&
+		t.ReallocatedSectorsWarning <= t.ReallocatedSectorsCritical && t.MediaErrorsWarning <= t.MediaErrorsCritical
+}
+
+func (t Thresholds) classify(health *Health) {
+	health.Status = Good
+	health.Reasons = nil
+	raise := func(status Status, reason string) {
+		if status == Critical || health.Status == Good {
+			health.Status = status
+		}
+		health.Reasons = append(health.Reasons, reason)
+	}
+	check := func(value *uint64, warning, critical uint64, attribute string) {
+		switch {
+		case value == nil:
+		case *value >= critical:
+			raise(Critical, fmt.Sprintf("%s %d reached the critical threshold %d", attribute, *value, critical))
+		case *value >= warning:
+			raise(Warning, fmt.Sprintf("%s %d reached the warning threshold %d", attribute, *value, warning))
+		}
+	}
+
+	if !health.Passed {
+		raise(Critical, "SMART overall health self-assessment failed")
+	}
+	if health.CriticalWarning != 0 {
+		raise(Critical, fmt.Sprintf("NVMe critical warning 0x%02x", health.CriticalWarning))
+	}
+	check(health.PercentageUsed, t.PercentageUsedWarning, t.PercentageUsedCritical, "percentage used")
+	check(health.MediaErrors, t.MediaErrorsWarning, t.MediaErrorsCritical, "media errors")
+	check(health.ReallocatedSectors, t.ReallocatedSectorsWarning, t.ReallocatedSectorsCritical, "reallocated sectors")
+	check(health.Temperature, t.TemperatureWarning, t.TemperatureCritical, "temperature")
+}
+
+// Store keeps the latest health of every disk for the metrics and logs status changes.
+type Store struct {
+	mu     sync.Mutex
+	health []*Health
+}
+
+// Update replaces the health of all disks.
+func (s *Store) Update(health []*Health) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, current := range health {
+		previous := Good
+		if i := slices.IndexFunc(s.health, func(h *Health) bool { return h.Device == current.Device }); i >= 0 {
+			previous = s.health[i].Status
+		}
+		if current.Status == previous {
+			continue
+		}
+		entry := log.WithField("event", "disk_health").WithField("device", current.Device).
+			WithField("serial", current.SerialNum).WithField("status", current.Status)
+		if current.Status == Good {
+			entry.Infof("disk %s health recovered", current.Device)
+		} else {
+			entry.Warnf("disk %s health %s: %v", current.Device, current.Status, current.Reasons)
+		}
+	}
+	s.health = health
+}
+
+// Get returns the latest health of a disk, or nil if it has none.
+func (s *Store) Get(device string) *Health {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i := slices.IndexFunc(s.health, func(h *Health) bool { return h.Device == device }); i >= 0 {
+		return s.health[i]
+	}
+	return nil
+}
+
+// Health returns the latest health of all disks.
+func (s *Store) Health() []*Health {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.health
+}
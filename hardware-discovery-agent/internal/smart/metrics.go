@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package smart
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var statusValues = map[Status]int64{Good: 0, Warning: 1, Critical: 2}
+
+// RegisterMetrics reports the latest health of the store as gauges, one series per disk.
+func RegisterMetrics(meter metric.Meter, store *Store) (metric.Registration, error) {
+	status, err := meter.Int64ObservableGauge("hda.disk.health.status",
+		metric.WithDescription("Disk health classification: 0 good, 1 warning, 2 critical"))
+	if err != nil {
+		return nil, err
+	}
+	percentageUsed, err := meter.Int64ObservableGauge("hda.disk.smart.percentage_used", metric.WithUnit("%"),
+		metric.WithDescription("Estimate of the disk life used"))
+	if err != nil {
+		return nil, err
+	}
+	mediaErrors, err := meter.Int64ObservableGauge("hda.disk.smart.media_errors",
+		metric.WithDescription("Unrecovered data integrity errors"))
+	if err != nil {
+		return nil, err
+	}
+	reallocatedSectors, err := meter.Int64ObservableGauge("hda.disk.smart.reallocated_sectors",
+		metric.WithDescription("Sectors remapped to the spare area"))
+	if err != nil {
+		return nil, err
+	}
+	temperature, err := meter.Int64ObservableGauge("hda.disk.smart.temperature", metric.WithUnit("Cel"),
+		metric.WithDescription("Current disk temperature"))
+	if err != nil {
+		return nil, err
+	}
+	powerOnHours, err := meter.Int64ObservableGauge("hda.disk.smart.power_on_hours", metric.WithUnit("h"),
+		metric.WithDescription("Hours the disk has been powered on"))
+	if err != nil {
+		return nil, err
+	}
+
+	return meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		for _, health := range store.Health() {
+			attributes := metric.WithAttributes(
+				attribute.String("device", health.Device),
+				attribute.String("model", health.Model),
+				attribute.String("serial", health.SerialNum),
+				attribute.String("protocol", health.Protocol),
+			)
+			observer.ObserveInt64(status, statusValues[health.Status], attributes)
+			observe := func(gauge metric.Int64ObservableGauge, value *uint64) {
+				if value != nil {
+					observer.ObserveInt64(gauge, int64(*value), attributes) //nolint:gosec // SMART counters fit in int64
+				}
+			}
+			observe(percentageUsed, health.PercentageUsed)
+			observe(mediaErrors, health.MediaErrors)
+			observe(reallocatedSectors, health.ReallocatedSectors)
+			observe(temperature, health.Temperature)
+			observe(powerOnHours, health.PowerOnHours)
+		}
+		return nil
+	}, status, percentageUsed, mediaErrors, reallocatedSectors, temperature, powerOnHours)
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package smart reads the SMART health of disks with smartctl and classifies it against thresholds.
+package smart
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/utils"
+)
+
+type Status string
+
+const (
+	Good     Status = "good"
+	Warning  Status = "warning"
+	Critical Status = "critical"
+)
+
+// ErrUnsupported is returned for disks without SMART, e.g. virtual disks.
+var ErrUnsupported = errors.New("SMART not supported")
+
+// ErrStandby is returned for disks in standby or sleep, which are not woken up to read their SMART data.
+var ErrStandby = errors.New("disk in standby")
+
+// smartctl exit status bits 0 and 1 report that the command line could not be parsed or the device not be
+// opened; the other bits describe the disk and come with valid output.
+const smartctlFatalBits = 0x3
+
+// ATA SMART attribute IDs.
+const (
+	ataReallocatedSectors = 5
+	ataPowerOnHours       = 9
+	ataReportedUncorrect  = 187
+	ataTemperature        = 194
+)
+
+// Health is the SMART data of a disk. Attributes the disk does not report are nil.
+type Health struct {
+	Device             string
+	Protocol           string
+	Model              string
+	SerialNum          string
+	Passed             bool
+	CriticalWarning    uint64
+	PercentageUsed     *uint64
+	MediaErrors        *uint64
+	ReallocatedSectors *uint64
+	Temperature        *uint64
+	PowerOnHours       *uint64
+	Status             Status
+	Reasons            []string
+}
+
+type ataAttribute struct {
+	ID  int `json:"id"`
+	Raw struct {
+		Value uint64 `json:"value"`
+	} `json:"raw"`
+}
+
+type smartctlOutput struct {
+	Smartctl struct {
+		Messages []struct {
+			String string `json:"string"`
+		} `json:"messages"`
+	} `json:"smartctl"`
+	Device struct {
+		Protocol string `json:"protocol"`
+	} `json:"device"`
+	ModelName    string `json:"model_name"`
+	SerialNumber string `json:"serial_number"`
+	SmartStatus  *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	NvmeLog *struct {
+		CriticalWarning uint64 `json:"critical_warning"`
+		PercentageUsed  uint64 `json:"percentage_used"`
+		MediaErrors     uint64 `json:"media_errors"`
+	} `json:"nvme_smart_health_information_log"`
+	AtaAttributes *struct {
+		Table []ataAttribute `json:"table"`
+	} `json:"ata_smart_attributes"`
+	AtaStatistics *struct {
+		Pages []struct {
+			Table []struct {
+				Name  string `json:"name"`
+				Value uint64 `json:"value"`
+			} `json:"table"`
+		} `json:"pages"`
+	} `json:"ata_device_statistics"`
+	Temperature *struct {
+		Current uint64 `json:"current"`
+	} `json:"temperature"`
+	PowerOnTime *struct {
+		Hours uint64 `json:"hours"`
+	} `json:"power_on_time"`
+}
+
+// GetHealth reads the SMART data of the disk, e.g. "nvme0n1" or "sda", and classifies it.
+func GetHealth(executor utils.CmdExecutor, device string, thresholds Thresholds) (*Health, error) {
+	var errbuf strings.Builder
+	// With -n standby, smartctl does not spin up a disk in standby or sleep; it exits with status 2 instead.
+	cmd := executor("sudo", "smartctl", "--json", "--all", "-n", "standby", "/dev/"+device)
+	cmd.Stderr = &errbuf
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if err != nil && (!errors.As(err, &exitErr) || exitErr.ExitCode()&smartctlFatalBits != 0) {
+		var output smartctlOutput
+		if json.Unmarshal(out, &output) == nil && output.inStandby() {
+			return nil, fmt.Errorf("device %s: %w", device, ErrStandby)
+		}
+		return nil, fmt.Errorf("failed to read SMART data of %s; %v; error: %w", device, errbuf.String(), err)
+	}
+
+	var output smartctlOutput
+	if err := json.Unmarshal(out, &output); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal SMART data of %s; error: %w", device, err)
+	}
+	if output.SmartStatus == nil {
+		return nil, fmt.Errorf("device %s: %w", device, ErrUnsupported)
+	}
+
+	health := parse(&output)
+	health.Device = device
+	thresholds.classify(health)
+	return health, nil
+}
+
+// inStandby reports whether smartctl skipped the disk because of its power mode, e.g.
+// "Device is in STANDBY mode, exit(2)".
+func (output *smartctlOutput) inStandby() bool {
+	for _, message := range output.Smartctl.Messages {
+		if strings.HasPrefix(message.String, "Device is in ") && strings.Contains(message.String, " mode, exit(") {
+			return true
+		}
+	}
+	return false
+}
+
+func parse(output *smartctlOutput) *Health {
+	health := &Health{
+		Protocol:  output.Device.Protocol,
+		Model:     output.ModelName,
+		SerialNum: output.SerialNumber,
+		Passed:    output.SmartStatus.Passed,
+	}
+	if output.Temperature != nil {
+		health.Temperature = &output.Temperature.Current
+	}
+	if output.PowerOnTime != nil {
+		health.PowerOnHours = &output.PowerOnTime.Hours
+	}
+
+	if output.NvmeLog != nil {
+		health.CriticalWarning = output.NvmeLog.CriticalWarning
+		health.PercentageUsed = &output.NvmeLog.PercentageUsed
+		health.MediaErrors = &output.NvmeLog.MediaErrors
+	}
+
+	if output.AtaAttributes != nil {
+		for _, attribute := range output.AtaAttributes.Table {
+			value := attribute.Raw.Value
+			switch attribute.ID {
+			case ataReallocatedSectors:
+				health.ReallocatedSectors = &value
+			case ataReportedUncorrect:
+				health.MediaErrors = &value
+			case ataPowerOnHours:
+				if health.PowerOnHours == nil {
+					health.PowerOnHours = &value
+				}
+			case ataTemperature:
+				// The upper bytes of the raw value hold the min/max temperature on some disks.
+				if health.Temperature == nil {
+					value &= 0xff
+					health.Temperature = &value
+				}
+			}
+		}
+	}
+	if output.AtaStatistics != nil {
+		for _, page := range output.AtaStatistics.Pages {
+			for _, statistic := range page.Table {
+				if statistic.Name == "Percentage Used Endurance Indicator" {
+					value := statistic.Value
+					health.PercentageUsed = &value
+				}
+			}
+		}
+	}
+	return health
+}
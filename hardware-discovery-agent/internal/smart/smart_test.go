@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package smart_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/smart"
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/utils"
+)
+
+func value(v uint64) *uint64 {
+	return &v
+}
+
+func Test_GetHealthNvme(t *testing.T) {
+	health, err := smart.GetHealth(smartctlExecutor("mock_smartctl_nvme.json", 0), "nvme0n1", smart.DefaultThresholds)
+	require.NoError(t, err)
+	assert.Equal(t, &smart.Health{
+		Device:         "nvme0n1",
+		Protocol:       "NVMe",
+		Model:          "INTEL SSDPEDMD800G4",
+		SerialNum:      "CVFT521000J6800CGN",
+		Passed:         true,
+		PercentageUsed: value(83),
+		MediaErrors:    value(0),
+		Temperature:    value(41),
+		PowerOnHours:   value(31877),
+		Status:         smart.Warning,
+		Reasons:        []string{"percentage used 83 reached the warning threshold 80"},
+	}, health)
+}
+
+func Test_GetHealthSata(t *testing.T) {
+	health, err := smart.GetHealth(smartctlExecutor("mock_smartctl_sata.json", 0), "sda", smart.DefaultThresholds)
+	require.NoError(t, err)
+	assert.Equal(t, "ATA", health.Protocol)
+	assert.Equal(t, value(1), health.PercentageUsed)
+	assert.Equal(t, value(0), health.ReallocatedSectors)
+	assert.Equal(t, value(0), health.MediaErrors)
+	assert.Equal(t, value(33), health.Temperature)
+	assert.Equal(t, value(8134), health.PowerOnHours)
+	assert.Equal(t, smart.Good, health.Status)
+	assert.Empty(t, health.Reasons)
+}
+
+func Test_GetHealthSataFailing(t *testing.T) {
+	health, err := smart.GetHealth(smartctlExecutor("mock_smartctl_sata_failing.json", 24), "sdb", smart.DefaultThresholds)
+	require.NoError(t, err, "smartctl reports disk problems through its exit status")
+	assert.False(t, health.Passed)
+	assert.Equal(t, smart.Critical, health.Status)
+	assert.Equal(t, []string{
+		"SMART overall health self-assessment failed",
+		"media errors 12 reached the warning threshold 1",
+		"reallocated sectors 3912 reached the critical threshold 100",
+	}, health.Reasons)
+}
+
+func Test_GetHealthThresholds(t *testing.T) {
+	thresholds := smart.Thresholds{PercentageUsedWarning: 90, TemperatureWarning: 40}.WithDefaults()
+	assert.Equal(t, uint64(100), thresholds.PercentageUsedCritical)
+
+	health, err := smart.GetHealth(smartctlExecutor("mock_smartctl_nvme.json", 0), "nvme0n1", thresholds)
+	require.NoError(t, err)
+	assert.Equal(t, smart.Warning, health.Status)
+	assert.Equal(t, []string{"temperature 41 reached the warning threshold 40"}, health.Reasons)
+}
+
+func Test_GetHealthCommandFailed(t *testing.T) {
+	_, err := smart.GetHealth(smartctlExecutor("", 2), "sdc", smart.DefaultThresholds)
+	assert.ErrorContains(t, err, "failed to read SMART data of sdc")
+}
+
+func Test_GetHealthStandby(t *testing.T) {
+	_, err := smart.GetHealth(smartctlExecutor("mock_smartctl_standby.json", 2), "sdd", smart.DefaultThresholds)
+	assert.ErrorIs(t, err, smart.ErrStandby)
+}
+
+func Test_GetHealthUnmarshalFailed(t *testing.T) {
+	_, err := smart.GetHealth(smartctlExecutor("", 0), "sda", smart.DefaultThresholds)
+	assert.ErrorContains(t, err, "failed to unmarshal SMART data of sda")
+}
+
+func Test_StoreMetrics(t *testing.T) {
+	nvme, err := smart.GetHealth(smartctlExecutor("mock_smartctl_nvme.json", 0), "nvme0n1", smart.DefaultThresholds)
+	require.NoError(t, err)
+	sdb, err := smart.GetHealth(smartctlExecutor("mock_smartctl_sata_failing.json", 24), "sdb", smart.DefaultThresholds)
+	require.NoError(t, err)
+
+	store := &smart.Store{}
+	store.Update([]*smart.Health{nvme, sdb})
+	assert.Len(t, store.Health(), 2)
+	assert.Equal(t, sdb, store.Get("sdb"))
+	assert.Nil(t, store.Get("sdc"))
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	_, err = smart.RegisterMetrics(provider.Meter("test"), store)
+	require.NoError(t, err)
+
+	var data metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &data))
+	require.Len(t, data.ScopeMetrics, 1)
+
+	gauges := map[string]map[string]int64{}
+	for _, m := range data.ScopeMetrics[0].Metrics {
+		gauge, ok := m.Data.(metricdata.Gauge[int64])
+		require.True(t, ok)
+		gauges[m.Name] = map[string]int64{}
+		for _, point := range gauge.DataPoints {
+			device, _ := point.Attributes.Value("device")
+			gauges[m.Name][device.AsString()] = point.Value
+		}
+	}
+	assert.Equal(t, map[string]int64{"nvme0n1": 1, "sdb": 2}, gauges["hda.disk.health.status"])
+	assert.Equal(t, map[string]int64{"nvme0n1": 83}, gauges["hda.disk.smart.percentage_used"])
+	assert.Equal(t, map[string]int64{"sdb": 3912}, gauges["hda.disk.smart.reallocated_sectors"])
+	assert.Equal(t, map[string]int64{"nvme0n1": 41, "sdb": 38}, gauges["hda.disk.smart.temperature"])
+}
+
+// smartctlExecutor runs the helper process printing the fixture and exiting with the status, like smartctl does.
+func smartctlExecutor(fixture string, exitStatus int) utils.CmdExecutor {
+	return func(command string, args ...string) *exec.Cmd {
+		cs := []string{"-test.run=TestSmartctlExecution", "--", command}
+		cs = append(cs, args...)
+		cmd := exec.Command(os.Args[0], cs...)
+		cmd.Env = []string{"GO_TEST_PROCESS=1", "SMARTCTL_FIXTURE=" + fixture, "SMARTCTL_EXIT_STATUS=" + strconv.Itoa(exitStatus)}
+		return cmd
+	}
+}
+
+func TestSmartctlExecution(t *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	exitStatus, err := strconv.Atoi(os.Getenv("SMARTCTL_EXIT_STATUS"))
+	require.NoError(t, err)
+	if fixture := os.Getenv("SMARTCTL_FIXTURE"); fixture != "" {
+		testData, err := os.ReadFile("../../test/data/" + fixture)
+		require.NoError(t, err)
+		fmt.Fprintf(os.Stdout, "%v", string(testData))
+	} else if exitStatus == 0 {
+		fmt.Fprintf(os.Stdout, "%v", "not a json")
+	} else {
+		fmt.Fprintf(os.Stderr, "smartctl open device: /dev/sdc failed: No such device")
+	}
+	os.Exit(exitStatus)
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package uevent
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// maxDelayFactor bounds how long a continuous event storm can postpone
+// re-collection, as a multiple of the quiet period.
+const maxDelayFactor = 10
+
+// Coalesce collects the collectors of bursts of events and sends them once no
+// event arrived for the quiet period, or at the latest maxDelayFactor quiet
+// periods after the first event of the burst. Events that need no collector
+// are dropped.
+func Coalesce(ctx context.Context, events <-chan Event, quiet time.Duration, updates chan<- []Collector) {
+	pending := map[Collector]bool{}
+	var quietTimer, maxTimer <-chan time.Time
+
+	flush := func() {
+		quietTimer, maxTimer = nil, nil
+		collectors := make([]Collector, 0, len(pending))
+		for collector := range pending {
+			collectors = append(collectors, collector)
+		}
+		slices.Sort(collectors)
+		clear(pending)
+		select {
+		case updates <- collectors:
+		case <-ctx.Done():
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				if len(pending) > 0 {
+					flush()
+				}
+				return
+			}
+			collectors := event.Collectors()
+			if len(collectors) == 0 {
+				continue
+			}
+			for _, collector := range collectors {
+				pending[collector] = true
+			}
+			quietTimer = time.After(quiet)
+			if maxTimer == nil {
+				maxTimer = time.After(maxDelayFactor * quiet)
+			}
+		case <-quietTimer:
+			flush()
+		case <-maxTimer:
+			flush()
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package uevent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// kernelGroup is the multicast group of uevents sent by the kernel itself,
+	// as opposed to those re-broadcast by udevd after rule processing.
+	kernelGroup   = 1
+	receiveBuffer = 4 * 1024 * 1024
+	pollTimeout   = time.Second
+)
+
+// Monitor reads kernel uevents from the kobject-uevent netlink socket.
+type Monitor struct {
+	fd     int
+	accept func(*Event) bool
+}
+
+// NewMonitor opens the netlink socket, accepting only events of the given
+// subsystems.
+func NewMonitor(subsystems []string) (*Monitor, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_KOBJECT_UEVENT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open uevent socket: %w", err)
+	}
+	if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_RCVBUF, receiveBuffer); err != nil {
+		log.Warnf("unable to enlarge uevent socket buffer : %v", err)
+	}
+	timeout := unix.NsecToTimeval(pollTimeout.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to set uevent socket timeout: %w", err)
+	}
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: kernelGroup}); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to bind uevent socket: %w", err)
+	}
+	return &Monitor{fd: fd, accept: Filter(subsystems)}, nil
+}
+
+// Run sends the accepted events to the channel until the context is done.
+// Lost events are reported as a single ActionOverflow event.
+func (m *Monitor) Run(ctx context.Context, events chan<- Event) error {
+	defer unix.Close(m.fd)
+
+	buf := make([]byte, 64*1024)
+	for ctx.Err() == nil {
+		n, _, err := unix.Recvfrom(m.fd, buf, 0)
+		switch {
+		case errors.Is(err, unix.EAGAIN), errors.Is(err, unix.EINTR):
+			continue
+		case errors.Is(err, unix.ENOBUFS):
+			log.Warnf("uevents were dropped, re-collecting all devices")
+			m.send(ctx, events, &Event{Action: ActionOverflow})
+			continue
+		case err != nil:
+			return fmt.Errorf("failed to read uevent: %w", err)
+		}
+
+		event, err := Parse(buf[:n])
+		if err != nil {
+			log.Debugf("ignoring uevent : %v", err)
+			continue
+		}
+		m.send(ctx, events, event)
+	}
+	return nil
+}
+
+func (m *Monitor) send(ctx context.Context, events chan<- Event, event *Event) {
+	if !m.accept(event) {
+		return
+	}
+	log.Debugf("uevent %s %s subsystem=%s", event.Action, event.DevPath, event.Subsystem)
+	select {
+	case events <- *event:
+	case <-ctx.Done():
+	}
+}
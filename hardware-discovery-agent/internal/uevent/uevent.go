@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package uevent listens for kernel device events on the kobject-uevent
+// netlink socket and maps them to the hardware collectors that must re-run.
+package uevent
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/logger"
+)
+
+var log = logger.Logger
+
+// Collector names a hardware collector that can be re-run on its own.
+type Collector string
+
+const (
+	Disk    Collector = "disk"
+	Network Collector = "network"
+	Usb     Collector = "usb"
+	Gpu     Collector = "gpu"
+)
+
+// ActionOverflow is reported when the kernel dropped uevents because the
+// socket buffer was full, so every collector has to re-run.
+const ActionOverflow = "overflow"
+
+// SupportedSubsystems lists the subsystems whose events map to a collector.
+var SupportedSubsystems = []string{"block", "net", "usb", "pci", "drm", "thunderbolt"}
+
+// Event is a kernel uevent.
+type Event struct {
+	Action    string
+	DevPath   string
+	Subsystem string
+	DevType   string
+	Env       map[string]string
+}
+
+// Parse decodes a kernel uevent message: an "action@devpath" header
+// followed by NUL separated KEY=VALUE pairs.
+func Parse(msg []byte) (*Event, error) {
+	fields := bytes.Split(bytes.TrimRight(msg, "\x00"), []byte{0})
+	header := string(fields[0])
+	if strings.HasPrefix(header, "libudev") {
+		return nil, errors.New("udev daemon message, expected kernel uevent")
+	}
+	action, devPath, ok := strings.Cut(header, "@")
+	if !ok || action == "" || devPath == "" {
+		return nil, fmt.Errorf("malformed uevent header %q", header)
+	}
+
+	event := &Event{Action: action, DevPath: devPath, Env: map[string]string{}}
+	for _, field := range fields[1:] {
+		key, value, ok := strings.Cut(string(field), "=")
+		if !ok {
+			continue
+		}
+		event.Env[key] = value
+	}
+	event.Subsystem = event.Env["SUBSYSTEM"]
+	event.DevType = event.Env["DEVTYPE"]
+	return event, nil
+}
+
+// Collectors returns the collectors whose data the event may have changed.
+func (e *Event) Collectors() []Collector {
+	switch e.Subsystem {
+	case "block":
+		// Partition events follow the event of their disk.
+		if e.DevType == "partition" {
+			return nil
+		}
+		return []Collector{Disk}
+	case "net":
+		return []Collector{Network}
+	case "usb":
+		// Each interface of a USB device raises its own event.
+		if e.DevType == "usb_interface" {
+			return nil
+		}
+		return []Collector{Usb}
+	case "drm":
+		return []Collector{Gpu}
+	case "pci":
+		return pciCollectors(e.Env["PCI_CLASS"])
+	case "thunderbolt":
+		// A dock or enclosure may tunnel any kind of device.
+		return []Collector{Disk, Network, Usb, Gpu}
+	}
+	if e.Action == ActionOverflow {
+		return []Collector{Disk, Network, Usb, Gpu}
+	}
+	return nil
+}
+
+// pciCollectors maps the PCI class code, e.g. "30000" for a VGA controller,
+// to the collector reporting that device class.
+func pciCollectors(class string) []Collector {
+	class = fmt.Sprintf("%06s", strings.ToUpper(class))
+	switch {
+	case strings.HasPrefix(class, "01"):
+		return []Collector{Disk}
+	case strings.HasPrefix(class, "02"):
+		return []Collector{Network}
+	case strings.HasPrefix(class, "03"):
+		return []Collector{Gpu}
+	case strings.HasPrefix(class, "0C03"):
+		return []Collector{Usb}
+	}
+	return nil
+}
+
+// Filter returns a predicate matching events of the given subsystems.
+func Filter(subsystems []string) func(*Event) bool {
+	return func(e *Event) bool {
+		return e.Action == ActionOverflow || slices.Contains(subsystems, e.Subsystem)
+	}
+}
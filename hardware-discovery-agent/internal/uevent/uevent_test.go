@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package uevent_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/edge-node-agents/hardware-discovery-agent/internal/uevent"
+)
+
+// loadFixture reads uevents recorded with "udevadm monitor --kernel --property"
+// and encodes them the way the kernel sends them on the netlink socket.
+func loadFixture(t *testing.T, name string) [][]byte {
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	messages := [][]byte{}
+	for _, block := range strings.Split(strings.TrimSpace(string(content)), "\n\n") {
+		messages = append(messages, []byte(strings.ReplaceAll(block, "\n", "\x00")+"\x00"))
+	}
+	return messages
+}
+
+func replay(t *testing.T, name string, subsystems []string) [][]uevent.Collector {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := make(chan uevent.Event)
+	updates := make(chan []uevent.Collector, 10)
+	done := make(chan struct{})
+	go func() {
+		uevent.Coalesce(ctx, events, 50*time.Millisecond, updates)
+		close(done)
+	}()
+
+	accept := uevent.Filter(subsystems)
+	for _, msg := range loadFixture(t, name) {
+		event, err := uevent.Parse(msg)
+		require.NoError(t, err)
+		if accept(event) {
+			events <- *event
+		}
+	}
+	close(events)
+	<-done
+	close(updates)
+
+	result := [][]uevent.Collector{}
+	for update := range updates {
+		result = append(result, update)
+	}
+	return result
+}
+
+func TestParse(t *testing.T) {
+	event, err := uevent.Parse(loadFixture(t, "usb-storage-plug.uevents")[3])
+	require.NoError(t, err)
+	assert.Equal(t, "add", event.Action)
+	assert.Equal(t, "/devices/pci0000:00/0000:00:14.0/usb2/2-1/2-1:1.0/host6/target6:0:0/6:0:0:0/block/sdb", event.DevPath)
+	assert.Equal(t, "block", event.Subsystem)
+	assert.Equal(t, "disk", event.DevType)
+	assert.Equal(t, "sdb", event.Env["DEVNAME"])
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, msg := range []string{"", "add", "@/devices/foo\x00", "libudev\x00\xfe\xed\xca\xfe"} {
+		_, err := uevent.Parse([]byte(msg))
+		assert.Error(t, err, "%q", msg)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	tests := []struct {
+		fixture    string
+		subsystems []string
+		expected   [][]uevent.Collector
+	}{
+		{"usb-hub-reset.uevents", uevent.SupportedSubsystems, [][]uevent.Collector{{uevent.Usb}}},
+		{"usb-hub-reset.uevents", []string{"block", "net"}, [][]uevent.Collector{}},
+		{"usb-storage-plug.uevents", uevent.SupportedSubsystems, [][]uevent.Collector{{uevent.Disk, uevent.Usb}}},
+		{"usb-storage-plug.uevents", []string{"block"}, [][]uevent.Collector{{uevent.Disk}}},
+		{"thunderbolt-dock.uevents", uevent.SupportedSubsystems, [][]uevent.Collector{{uevent.Disk, uevent.Gpu, uevent.Network, uevent.Usb}}},
+		{"thunderbolt-dock.uevents", []string{"pci", "net"}, [][]uevent.Collector{{uevent.Network}}},
+		{"gpu-bind.uevents", uevent.SupportedSubsystems, [][]uevent.Collector{{uevent.Gpu}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.fixture+" "+strings.Join(tc.subsystems, ","), func(t *testing.T) {
+			assert.Equal(t, tc.expected, replay(t, tc.fixture, tc.subsystems))
+		})
+	}
+}
+
+func TestOverflowCollectsAll(t *testing.T) {
+	event := uevent.Event{Action: uevent.ActionOverflow}
+	assert.True(t, uevent.Filter(nil)(&event))
+	assert.Equal(t, []uevent.Collector{uevent.Disk, uevent.Network, uevent.Usb, uevent.Gpu}, event.Collectors())
+}
+
+func TestCoalesceSeparateBursts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan uevent.Event)
+	updates := make(chan []uevent.Collector)
+	go uevent.Coalesce(ctx, events, 20*time.Millisecond, updates)
+
+	events <- uevent.Event{Action: "add", Subsystem: "net"}
+	assert.Equal(t, []uevent.Collector{uevent.Network}, <-updates)
+	events <- uevent.Event{Action: "remove", Subsystem: "drm"}
+	assert.Equal(t, []uevent.Collector{uevent.Gpu}, <-updates)
+}
+
+func TestCoalesceStormIsBounded(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan uevent.Event)
+	updates := make(chan []uevent.Collector, 1)
+	go uevent.Coalesce(ctx, events, 20*time.Millisecond, updates)
+
+	// Events keep arriving within the quiet period, yet an update is sent.
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case update := <-updates:
+			assert.Equal(t, []uevent.Collector{uevent.Usb}, update)
+			return
+		case <-deadline:
+			t.Fatal("event storm postponed the update indefinitely")
+		case events <- uevent.Event{Action: "change", Subsystem: "usb", DevType: "usb_device"}:
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+}
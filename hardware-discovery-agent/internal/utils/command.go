@@ -5,13 +5,34 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"strings"
+	"time"
 )
 
 type CmdExecutor = func(name string, args ...string) *exec.Cmd
 
+// waitDelay bounds the wait for the output of a killed command, which its child processes may keep open.
+const waitDelay = time.Second
+
+// WithContext returns an executor whose commands are killed when the context is done.
+func WithContext(ctx context.Context, executor CmdExecutor) CmdExecutor {
+	return func(name string, args ...string) *exec.Cmd {
+		cmd := executor(name, args...)
+		ctxCmd := exec.CommandContext(ctx, cmd.Path)
+		ctxCmd.Args = cmd.Args
+		ctxCmd.Env = cmd.Env
+		ctxCmd.Dir = cmd.Dir
+		if cmd.Err != nil {
+			ctxCmd.Err = cmd.Err
+		}
+		ctxCmd.WaitDelay = waitDelay
+		return ctxCmd
+	}
+}
+
 // ReadFromCommand executes a command in the operating system and returns the output,
 //
 //	if return status != 0, then it will return with error message
@@ -3,8 +3,10 @@
 package utils_test
 
 import (
+	"context"
 	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,3 +33,19 @@ func TestReadFromCommandFailure(t *testing.T) {
 	assert.NotEmpty(t, err.Error())
 	assert.Empty(t, string(output))
 }
+
+func TestWithContextKillsCommand(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := utils.ReadFromCommand(utils.WithContext(ctx, exec.Command), "sleep", "10")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestWithContextKeepsCommand(t *testing.T) {
+	output, err := utils.ReadFromCommand(utils.WithContext(context.Background(), exec.Command), "echo", "-n", "output")
+	require.NoError(t, err)
+	assert.Equal(t, "output", string(output))
+}
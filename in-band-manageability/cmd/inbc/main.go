@@ -23,27 +23,37 @@ func main() {
 		Short:   "INBC - CLI for Intel Manageability",
 		Version: Version,
 		Long:    `INBC is a CLI to access and perform manageability commands.`,
+		// A failed request is not a usage error, and the error is printed once below.
+		SilenceUsage:  true,
+		SilenceErrors: true,
 	}
-	verbose := rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enable verbose logging")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enable verbose logging")
+	commands.AddOutputFlag(rootCmd)
 
 	// Add subcommands
 	rootCmd.AddCommand(commands.SOTACmd(), commands.SourceCmd(), commands.FOTACmd())
+	rootCmd.AddCommand(commands.StagedCmd())
 
 	rootCmd.AddCommand(commands.ConfigLoadCmd())
 	rootCmd.AddCommand(commands.ConfigGetCmd())
 	rootCmd.AddCommand(commands.ConfigSetCmd())
 	rootCmd.AddCommand(commands.ConfigAppendCmd())
 	rootCmd.AddCommand(commands.ConfigRemoveCmd())
+	rootCmd.AddCommand(commands.ConfigCmd())
 	rootCmd.AddCommand(commands.RestartCmd())
 	rootCmd.AddCommand(commands.ShutdownCmd())
+	rootCmd.AddCommand(commands.SuspendCmd())
+	rootCmd.AddCommand(commands.HibernateCmd())
+	rootCmd.AddCommand(commands.PowerCmd())
 
 	rootCmd.AddCommand(commands.QueryCmd())
+	rootCmd.AddCommand(commands.SBOMCmd())
+	rootCmd.AddCommand(commands.VulnDBCmd())
+	rootCmd.AddCommand(commands.CompletionCmd())
 
 	// Execute CLI
 	if err := rootCmd.Execute(); err != nil {
-		if *verbose {
-			fmt.Println(err)
-		}
-		os.Exit(1)
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(commands.ExitCode(err))
 	}
 }
@@ -0,0 +1,183 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package common provides utilities used by multiple packages
+package common
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sync"
+)
+
+// CommandRule allows a command from the configuration in addition to the builtinCommandRules.
+// Args holds one regular expression per argument; every pattern must match the whole argument
+// and the number of arguments must match the number of patterns.
+type CommandRule struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args"`
+}
+
+type compiledCommandRule struct {
+	command string
+	args    []*regexp.Regexp
+	// variadic repeats the last pattern for any further arguments.  It is only used by the
+	// built-in rules, for commands that take a list of packages.
+	variadic bool
+}
+
+var (
+	commandRulesMutex sync.RWMutex
+	commandRules      []compiledCommandRule
+)
+
+// Argument patterns of the built-in command rules.  A path segment may not start with a dot, so
+// that a path can not leave the directory it is allowed in.
+const (
+	pathPattern          = `(?:/[^/.][^/]*)+`
+	cachePathPattern     = `/var/cache/manageability` + pathPattern
+	stateFilePattern     = `/var/intel-manageability/[^/.][^/]*`
+	keyringPattern       = `/usr/share/keyrings/[^/.][^/]*`
+	debPackagePattern    = `[a-z0-9][a-z0-9+.-]*(?::[a-z0-9-]+)?(?:=[A-Za-z0-9.+~:-]+)?`
+	rpmPackagePattern    = `[A-Za-z0-9_][A-Za-z0-9_+.:-]*`
+	logicalVolumePattern = `[A-Za-z0-9_+.][A-Za-z0-9_+.-]*/[A-Za-z0-9_+.][A-Za-z0-9_+.-]*`
+	lvmSnapshotPattern   = `[A-Za-z0-9_+.][A-Za-z0-9_+.-]*/inbm_sota_[0-9]+`
+	dnfCacheModePattern  = `--downloadonly|--cacheonly`
+	// aptGetArgPattern matches every option, option value, operation and package that INBD
+	// passes to apt-get.  Configuration options are limited to the ones INBD sets, as apt-get
+	// runs commands from options such as APT::Update::Pre-Invoke.
+	aptGetArgPattern = `-o|-c|-yq|-s|-f|-u|update|upgrade|install|remove|` +
+		`--with-new-pkgs|--fix-missing|--download-only|--reinstall|--allow-downgrades|--assume-no|` +
+		`Dpkg::Lock::Timeout=[0-9]+|Dpkg::Options::=--force-conf(?:def|old)|` +
+		`Dir::Cache::archives=/var/intel-manageability/package_snapshots/[^/.][^/]*|` +
+		`/var/cache/manageability/apt-network\.conf|` + debPackagePattern
+)
+
+// builtinCommandRules allows the commands that INBD runs itself, with the arguments it runs them with.
+var builtinCommandRules = []compiledCommandRule{
+	builtinRule(RebootCmd),
+	builtinRule(ShutdownCmd, "now"),
+	builtinRule(SystemctlCmd, "suspend|hibernate|soft-reboot"),
+	builtinRule(TruncateCmd, "-s", "0", stateFilePattern),
+	builtinRule(OsUpdateToolCmd, "-w", "-u", cachePathPattern, "-s", "[0-9A-Fa-f]*"),
+	builtinRule(OsUpdateToolCmd, "-a"),
+	builtinRule(OsUpdateToolCmd, "-c"),
+	builtinRule(GPGCmd, "--dearmor", "--output", keyringPattern, pathPattern),
+	builtinRule(GPGCmd, "--dearmor", "--yes", "--output", keyringPattern, pathPattern),
+	builtinRule(GPGCmd, "--show-keys", "--with-colons", "--with-fingerprint", pathPattern),
+	builtinRule(GPGCmd, "--no-default-keyring", "--keyring", keyringPattern, "--verify", pathPattern),
+	builtinRule(GPGCmd, "--no-default-keyring", "--keyring", keyringPattern, "--verify", pathPattern, pathPattern),
+	builtinRule(IPCmd, "route", "show", "default"),
+	builtinRule(SnapperCmd, "--version"),
+	builtinRule(SnapperCmd, "-c", `\w+`, "list-configs"),
+	builtinRule(SnapperCmd, "-c", `\w+`, "create-config", "/"),
+	builtinRule(SnapperCmd, "-c", "rootConfig", "create", "-p", "--description", "sota_update"),
+	builtinRule(SnapperCmd, "-c", "rootConfig", "undochange", `[0-9]+\.\.0`),
+	builtinRule(SnapperCmd, "-c", "rootConfig", "delete", "[0-9]+"),
+	builtinVariadicRule(AptGetCmd, aptGetArgPattern),
+	builtinRule(DpkgCmd, "--configure", "-a", "--force-confdef", "--force-confold"),
+	builtinRule(DpkgCmd, "-l", debPackagePattern),
+	builtinRule(DpkgQueryCmd, "-W", regexp.QuoteMeta("-f=${db:Status-Abbrev}\t${Package}\t${Version}\n")),
+	builtinRule(DnfCmd, "check"),
+	builtinRule(DnfCmd, "-y", "makecache"),
+	builtinRule(DnfCmd, "-y", "upgrade"),
+	builtinRule(DnfCmd, "-y", dnfCacheModePattern, "upgrade"),
+	builtinVariadicRule(DnfCmd, "-y", "install", rpmPackagePattern),
+	builtinVariadicRule(DnfCmd, "-y", dnfCacheModePattern, "install", rpmPackagePattern),
+	builtinRule(RpmCmd, "-q", rpmPackagePattern),
+	builtinRule(FindmntCmd, "-n", "-o", "SOURCE", "/"),
+	builtinRule(LvsCmd, "--noheadings", "--separator", ",", "-o", "vg_name,lv_name,pool_lv", "/dev"+pathPattern),
+	builtinRule(LvcreateCmd, "-s", "-n", "inbm_sota_[0-9]+", logicalVolumePattern),
+	builtinRule(LvconvertCmd, "--merge", lvmSnapshotPattern),
+	builtinRule(LvremoveCmd, "-y", lvmSnapshotPattern),
+	builtinRule(SmartctlCmd, "--health", "--json", `/dev/[A-Za-z0-9_-]+`),
+}
+
+func builtinRule(command string, patterns ...string) compiledCommandRule {
+	rule := compiledCommandRule{command: command}
+	for _, pattern := range patterns {
+		rule.args = append(rule.args, regexp.MustCompile("^(?:"+pattern+")$"))
+	}
+	return rule
+}
+
+func builtinVariadicRule(command string, patterns ...string) compiledCommandRule {
+	rule := builtinRule(command, patterns...)
+	rule.variadic = true
+	return rule
+}
+
+// SetCommandAllowlist replaces the command rules loaded from the configuration.
+// The rules are validated first; on error the current rules are kept.
+func SetCommandAllowlist(rules []CommandRule) error {
+	compiled, err := compileCommandRules(rules)
+	if err != nil {
+		return err
+	}
+
+	commandRulesMutex.Lock()
+	defer commandRulesMutex.Unlock()
+	commandRules = compiled
+	return nil
+}
+
+// ValidateCommandAllowlist checks the command rules without applying them.
+func ValidateCommandAllowlist(rules []CommandRule) error {
+	_, err := compileCommandRules(rules)
+	return err
+}
+
+func compileCommandRules(rules []CommandRule) ([]compiledCommandRule, error) {
+	compiled := make([]compiledCommandRule, 0, len(rules))
+	for _, rule := range rules {
+		if !filepath.IsAbs(rule.Command) {
+			return nil, fmt.Errorf("command %q in allowlist must be an absolute path", rule.Command)
+		}
+		c := compiledCommandRule{command: filepath.Clean(rule.Command)}
+		for _, pattern := range rule.Args {
+			re, err := regexp.Compile("^(?:" + pattern + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("invalid argument pattern %q for %s: %w", pattern, rule.Command, err)
+			}
+			c.args = append(c.args, re)
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// matchesCommandRule checks if the command and its arguments match a built-in rule or a rule
+// from the configuration.
+func matchesCommandRule(args []string) bool {
+	if slices.ContainsFunc(builtinCommandRules, func(rule compiledCommandRule) bool { return rule.matches(args) }) {
+		return true
+	}
+
+	commandRulesMutex.RLock()
+	defer commandRulesMutex.RUnlock()
+	return slices.ContainsFunc(commandRules, func(rule compiledCommandRule) bool { return rule.matches(args) })
+}
+
+func (rule compiledCommandRule) matches(args []string) bool {
+	if rule.command != args[0] {
+		return false
+	}
+	if rule.variadic {
+		if len(args)-1 < len(rule.args)-1 {
+			return false
+		}
+	} else if len(rule.args) != len(args)-1 {
+		return false
+	}
+	for i, arg := range args[1:] {
+		re := rule.args[min(i, len(rule.args)-1)]
+		if !re.MatchString(arg) {
+			return false
+		}
+	}
+	return true
+}
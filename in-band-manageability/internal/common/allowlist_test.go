@@ -0,0 +1,72 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCommandAllowlist(t *testing.T) {
+	defer func() { _ = SetCommandAllowlist(nil) }()
+
+	t.Run("rejects relative command", func(t *testing.T) {
+		err := SetCommandAllowlist([]CommandRule{{Command: "ls"}})
+		assert.ErrorContains(t, err, "absolute path")
+	})
+
+	t.Run("rejects invalid pattern and keeps current rules", func(t *testing.T) {
+		require.NoError(t, SetCommandAllowlist([]CommandRule{{Command: "/usr/bin/ls"}}))
+		err := SetCommandAllowlist([]CommandRule{{Command: "/usr/bin/ls", Args: []string{"(["}}})
+		assert.ErrorContains(t, err, "invalid argument pattern")
+		assert.True(t, matchesCommandRule([]string{"/usr/bin/ls"}))
+	})
+}
+
+func TestMatchesCommandRule(t *testing.T) {
+	defer func() { _ = SetCommandAllowlist(nil) }()
+	require.NoError(t, SetCommandAllowlist([]CommandRule{
+		{Command: "/usr/bin/systemctl", Args: []string{"restart", "(nginx|sshd)\\.service"}},
+		{Command: "/usr/bin/uptime"},
+	}))
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected bool
+	}{
+		{"matching arguments", []string{"/usr/bin/systemctl", "restart", "nginx.service"}, true},
+		{"alternate argument", []string{"/usr/bin/systemctl", "restart", "sshd.service"}, true},
+		{"pattern must match whole argument", []string{"/usr/bin/systemctl", "restart", "nginx.service;reboot"}, false},
+		{"too few arguments", []string{"/usr/bin/systemctl", "restart"}, false},
+		{"too many arguments", []string{"/usr/bin/systemctl", "restart", "nginx.service", "--now"}, false},
+		{"no arguments allowed", []string{"/usr/bin/uptime"}, true},
+		{"unexpected argument", []string{"/usr/bin/uptime", "-p"}, false},
+		{"unknown command", []string{"/usr/bin/ls"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matchesCommandRule(tt.args))
+		})
+	}
+}
+
+func TestExecute_CommandAllowlist(t *testing.T) {
+	auditLogPath = t.TempDir() + "/audit.log"
+	defer func() { auditLogPath = AuditLogPath }()
+	defer func() { _ = SetCommandAllowlist(nil) }()
+
+	executor := NewExecutor(createMockCmd, executeMockCmd)
+
+	_, _, err := executor.Execute([]string{"/usr/bin/uptime"})
+	assert.ErrorContains(t, err, "is not allowed")
+
+	require.NoError(t, SetCommandAllowlist([]CommandRule{{Command: "/usr/bin/uptime"}}))
+	_, _, err = executor.Execute([]string{"/usr/bin/uptime"})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package common provides utilities used by multiple packages
+package common
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// AuditLogPath is the file where every command run by the executor is recorded as one JSON entry per line.
+const AuditLogPath = "/var/log/inbm-audit.log"
+
+// maxAuditLogSize is the size at which the audit log is rotated to AuditLogPath.1.
+const maxAuditLogSize = 5 * 1024 * 1024
+
+// AuditEntry is a single entry of the command audit log.
+type AuditEntry struct {
+	Time       time.Time `json:"time"`
+	Caller     string    `json:"caller"`
+	Command    string    `json:"command"`
+	Args       []string  `json:"args"`
+	ExitCode   int       `json:"exit_code"`
+	DurationMs int64     `json:"duration_ms"`
+	Allowed    bool      `json:"allowed"`
+	Error      string    `json:"error,omitempty"`
+}
+
+var (
+	auditLogPath  = AuditLogPath
+	auditLogMutex sync.Mutex
+)
+
+// Caller identifies the RPC that a command is run for in the audit log.
+type Caller struct {
+	// Method is the full method name of the RPC, e.g. /inbd.v1.InbService/UpdateFirmware.
+	Method string
+	// UID is the user ID of the peer that called the RPC, or nil if it is not known.
+	UID *uint32
+}
+
+// String describes the caller as recorded in the audit log, e.g.
+// "/inbd.v1.InbService/UpdateFirmware (uid 1000)".
+func (c Caller) String() string {
+	if c.UID == nil {
+		return c.Method
+	}
+	return fmt.Sprintf("%s (uid %d)", c.Method, *c.UID)
+}
+
+type callerKey struct{}
+
+// WithCaller returns a context carrying the caller that commands run by executors created
+// with NewExecutorWithContext are attributed to.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns the caller carried by the context, or "inbd" for commands run
+// outside of an RPC such as the post-reboot verification.
+func CallerFromContext(ctx context.Context) Caller {
+	if ctx != nil {
+		if caller, ok := ctx.Value(callerKey{}).(Caller); ok {
+			return caller
+		}
+	}
+	return Caller{Method: "inbd"}
+}
+
+// exitCode returns the exit code of a command from the error returned when running it.
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr interface{ ExitCode() int }
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
+
+// writeAuditEntry appends the entry to the audit log.  Failures are logged and do not
+// fail the command.
+func writeAuditEntry(entry AuditEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[Warning] Error writing audit log: %v", err)
+		return
+	}
+
+	auditLogMutex.Lock()
+	defer auditLogMutex.Unlock()
+
+	if info, err := os.Stat(auditLogPath); err == nil && info.Size() > maxAuditLogSize {
+		if err := os.Rename(auditLogPath, auditLogPath+".1"); err != nil {
+			log.Printf("[Warning] Error rotating audit log: %v", err)
+		}
+	}
+
+	file, err := os.OpenFile(auditLogPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		log.Printf("[Warning] Error writing audit log: %v", err)
+		return
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		log.Printf("[Warning] Error writing audit log: %v", err)
+	}
+}
+
+// ReadAuditLog returns up to limit of the most recent audit log entries, oldest first.
+func ReadAuditLog(limit int) ([]AuditEntry, error) {
+	auditLogMutex.Lock()
+	defer auditLogMutex.Unlock()
+
+	file, err := os.Open(auditLogPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []AuditEntry{}, nil
+		}
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			log.Printf("[Warning] Skipping invalid audit log entry: %v", err)
+			continue
+		}
+		entries = append(entries, entry)
+		if len(entries) > limit {
+			entries = entries[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func useTempAuditLog(t *testing.T) {
+	auditLogPath = t.TempDir() + "/audit.log"
+	t.Cleanup(func() { auditLogPath = AuditLogPath })
+}
+
+func TestExecute_WritesAuditLog(t *testing.T) {
+	useTempAuditLog(t)
+
+	failing := func(_ *mockCmd) ([]byte, []byte, error) {
+		return nil, nil, errors.New("failed")
+	}
+
+	uid := uint32(1000)
+	ctx := WithCaller(context.Background(), Caller{Method: "/inbd.v1.InbService/UpdateSystemSoftware", UID: &uid})
+	_, _, err := NewExecutorWithContext(ctx, createMockCmd, executeMockCmd).Execute([]string{RebootCmd})
+	require.NoError(t, err)
+	_, _, err = NewExecutorWithContext(ctx, createMockCmd, failing).Execute([]string{SnapperCmd, "--version"})
+	require.Error(t, err)
+	_, _, err = NewExecutor(createMockCmd, executeMockCmd).Execute([]string{"/usr/bin/uptime", "-p"})
+	require.Error(t, err)
+
+	entries, err := ReadAuditLog(100)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, "/inbd.v1.InbService/UpdateSystemSoftware (uid 1000)", entries[0].Caller)
+	assert.Equal(t, RebootCmd, entries[0].Command)
+	assert.True(t, entries[0].Allowed)
+	assert.Equal(t, 0, entries[0].ExitCode)
+
+	assert.Equal(t, []string{"--version"}, entries[1].Args)
+	assert.Equal(t, -1, entries[1].ExitCode)
+	assert.Equal(t, "failed", entries[1].Error)
+
+	assert.Equal(t, "inbd", entries[2].Caller)
+	assert.False(t, entries[2].Allowed)
+	assert.Equal(t, -1, entries[2].ExitCode)
+}
+
+func TestReadAuditLog(t *testing.T) {
+	useTempAuditLog(t)
+
+	t.Run("missing log is empty", func(t *testing.T) {
+		entries, err := ReadAuditLog(10)
+		assert.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("returns most recent entries", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			writeAuditEntry(AuditEntry{Command: fmt.Sprintf("/bin/cmd%d", i)})
+		}
+		entries, err := ReadAuditLog(2)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "/bin/cmd3", entries[0].Command)
+		assert.Equal(t, "/bin/cmd4", entries[1].Command)
+	})
+}
+
+func TestWriteAuditEntry_Rotates(t *testing.T) {
+	useTempAuditLog(t)
+	require.NoError(t, os.WriteFile(auditLogPath, make([]byte, maxAuditLogSize+1), 0600))
+
+	writeAuditEntry(AuditEntry{Command: RebootCmd})
+
+	_, err := os.Stat(auditLogPath + ".1")
+	assert.NoError(t, err)
+	entries, err := ReadAuditLog(10)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, 0, exitCode(nil))
+	assert.Equal(t, -1, exitCode(errors.New("not started")))
+
+	err := exec.Command("sh", "-c", "exit 3").Run()
+	assert.Equal(t, 3, exitCode(fmt.Errorf("wrapped: %w", err)))
+}
+
+func TestExecute_ConcurrentCallers(t *testing.T) {
+	useTempAuditLog(t)
+
+	// Each command is attributed to the RPC whose executor ran it, not to every RPC in flight.
+	ctxA := WithCaller(context.Background(), Caller{Method: "/a"})
+	ctxB := WithCaller(context.Background(), Caller{Method: "/b"})
+	_, _, err := NewExecutorWithContext(ctxA, createMockCmd, executeMockCmd).Execute([]string{RebootCmd})
+	require.NoError(t, err)
+	_, _, err = NewExecutorWithContext(ctxB, createMockCmd, executeMockCmd).Execute([]string{ShutdownCmd, "now"})
+	require.NoError(t, err)
+
+	entries, err := ReadAuditLog(10)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "/a", entries[0].Caller)
+	assert.Equal(t, "/b", entries[1].Caller)
+}
+
+func TestCallerFromContext(t *testing.T) {
+	assert.Equal(t, "inbd", CallerFromContext(context.Background()).String())
+	uid := uint32(0)
+	ctx := WithCaller(context.Background(), Caller{Method: "/a", UID: &uid})
+	assert.Equal(t, "/a (uid 0)", CallerFromContext(ctx).String())
+}
@@ -7,17 +7,25 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
-	"slices"
 	"strings"
+	"time"
 )
 
-// NewExecutor creates a new executor.
+// NewExecutor creates a new executor whose commands are attributed to inbd in the audit log.
 func NewExecutor[C any](createCmdFn func(name string, args ...string) *C, execCmdFn func(*C) ([]byte, []byte, error)) Executor {
+	return NewExecutorWithContext(context.Background(), createCmdFn, execCmdFn)
+}
+
+// NewExecutorWithContext creates a new executor whose commands are attributed in the audit log
+// to the caller carried by the context, see WithCaller.
+func NewExecutorWithContext[C any](ctx context.Context, createCmdFn func(name string, args ...string) *C, execCmdFn func(*C) ([]byte, []byte, error)) Executor {
 	return &executor[C]{
 		createExecutableCommand: createCmdFn,
 		commandExecutor:         execCmdFn,
+		caller:                  CallerFromContext(ctx),
 	}
 }
 
@@ -29,33 +37,37 @@ type Executor interface {
 type executor[C any] struct {
 	createExecutableCommand func(name string, args ...string) *C
 	commandExecutor         func(*C) ([]byte, []byte, error)
-}
-
-var allowedCommands = []string{
-	RebootCmd,
-	ShutdownCmd,
-	TruncateCmd,
-	OsUpdateToolCmd,
-	GPGCmd,
-	IPCmd,
-	SnapperCmd,
-	AptGetCmd,
-	DpkgCmd,
-}
-
-func isAllowedCommand(cmd string) bool {
-	return slices.Contains(allowedCommands, cmd)
+	caller                  Caller
 }
 
 func (i *executor[C]) Execute(args []string) ([]byte, []byte, error) {
 	if len(args) == 0 {
 		return nil, nil, fmt.Errorf("command '' is not allowed")
 	}
-	if !isAllowedCommand(args[0]) {
+	entry := AuditEntry{
+		Time:    time.Now(),
+		Caller:  i.caller.String(),
+		Command: args[0],
+		Args:    args[1:],
+	}
+	if !matchesCommandRule(args) {
+		entry.ExitCode = -1
+		entry.Error = "command is not allowed"
+		writeAuditEntry(entry)
 		return nil, nil, fmt.Errorf("command '%s' is not allowed", args[0])
 	}
+	entry.Allowed = true
+
 	executableCommand := i.createExecutableCommand(args[0], args[1:]...)
-	return i.commandExecutor(executableCommand)
+	stdout, stderr, err := i.commandExecutor(executableCommand)
+
+	entry.DurationMs = time.Since(entry.Time).Milliseconds()
+	entry.ExitCode = exitCode(err)
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	writeAuditEntry(entry)
+	return stdout, stderr, err
 }
 
 // ExecuteAndReadOutput executes a command in the operating system and returns the output.
@@ -81,7 +93,7 @@ func ExecuteAndReadOutput(executableCommand *exec.Cmd) ([]byte, []byte, error) {
 
 	fmt.Printf("'%v' stderr: %v, stdout: %v\n", executableCommand.String(), stderr.String(), stdout.String())
 	if err != nil {
-		return []byte(stdout.String()), []byte(stderr.String()), fmt.Errorf("failed to run '%v' command - %v", executableCommand.String(), err)
+		return []byte(stdout.String()), []byte(stderr.String()), fmt.Errorf("failed to run '%v' command - %w", executableCommand.String(), err)
 	}
 
 	return []byte(stdout.String()), []byte(stderr.String()), nil
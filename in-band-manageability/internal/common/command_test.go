@@ -37,83 +37,54 @@ func TestNewExecutor(t *testing.T) {
 	})
 }
 
-func TestIsAllowedCommand(t *testing.T) {
+func TestBuiltinCommandRules(t *testing.T) {
 	tests := []struct {
 		name     string
-		command  string
+		args     []string
 		expected bool
 	}{
-		{
-			name:     "reboot command is allowed",
-			command:  RebootCmd,
-			expected: true,
-		},
-		{
-			name:     "shutdown command is allowed",
-			command:  ShutdownCmd,
-			expected: true,
-		},
-		{
-			name:     "truncate command is allowed",
-			command:  TruncateCmd,
-			expected: true,
-		},
-		{
-			name:     "os update tool command is allowed",
-			command:  OsUpdateToolCmd,
-			expected: true,
-		},
-		{
-			name:     "gpg command is allowed",
-			command:  GPGCmd,
-			expected: true,
-		},
-		{
-			name:     "ip command is allowed",
-			command:  IPCmd,
-			expected: true,
-		},
-		{
-			name:     "snapper command is allowed",
-			command:  SnapperCmd,
-			expected: true,
-		},
-		{
-			name:     "apt-get command is allowed",
-			command:  AptGetCmd,
-			expected: true,
-		},
-		{
-			name:     "dpkg command is allowed",
-			command:  DpkgCmd,
-			expected: true,
-		},
-		{
-			name:     "unknown command is not allowed",
-			command:  "/bin/rm",
-			expected: false,
-		},
-		{
-			name:     "malicious command is not allowed",
-			command:  "/usr/bin/curl",
-			expected: false,
-		},
-		{
-			name:     "empty command is not allowed",
-			command:  "",
-			expected: false,
-		},
-		{
-			name:     "relative path command is not allowed",
-			command:  "ls",
-			expected: false,
-		},
+		{"reboot", []string{RebootCmd}, true},
+		{"reboot with an argument", []string{RebootCmd, "--force"}, false},
+		{"shutdown", []string{ShutdownCmd, "now"}, true},
+		{"systemctl power action", []string{SystemctlCmd, "hibernate"}, true},
+		{"systemctl other verb", []string{SystemctlCmd, "start", "evil.service"}, false},
+		{"truncate state file", []string{TruncateCmd, "-s", "0", "/var/intel-manageability/inbd_state"}, true},
+		{"truncate other file", []string{TruncateCmd, "-s", "0", "/etc/shadow"}, false},
+		{"truncate outside state directory", []string{TruncateCmd, "-s", "0", "/var/intel-manageability/../../etc/shadow"}, false},
+		{"os update tool write", []string{OsUpdateToolCmd, "-w", "-u", "/var/cache/manageability/repository-tool/sota/image.raw.gz", "-s", "abc123"}, true},
+		{"os update tool apply", []string{OsUpdateToolCmd, "-a"}, true},
+		{"gpg dearmor into keyrings", []string{GPGCmd, "--dearmor", "--output", "/usr/share/keyrings/repo.gpg", "/tmp/gpgkey-123.asc"}, true},
+		{"gpg dearmor elsewhere", []string{GPGCmd, "--dearmor", "--output", "/etc/cron.d/job", "/tmp/gpgkey-123.asc"}, false},
+		{"gpg verify", []string{GPGCmd, "--no-default-keyring", "--keyring", "/usr/share/keyrings/repo.gpg", "--verify", "/tmp/Release-1.gpg", "/tmp/Release-2"}, true},
+		{"ip default route", []string{IPCmd, "route", "show", "default"}, true},
+		{"ip other", []string{IPCmd, "link", "set", "eth0", "down"}, false},
+		{"snapper undochange", []string{SnapperCmd, "-c", "rootConfig", "undochange", "3..0"}, true},
+		{"apt-get update", []string{AptGetCmd, "-o", "Dpkg::Lock::Timeout=300", "update"}, true},
+		{"apt-get install", []string{AptGetCmd, "-o", "Dpkg::Lock::Timeout=300", "-yq", "install", "nginx", "libc6:amd64=2.39-0ubuntu8"}, true},
+		{"apt-get with network configuration", []string{AptGetCmd, "-c", "/var/cache/manageability/apt-network.conf", "update"}, true},
+		{"apt-get hook option", []string{AptGetCmd, "-o", "APT::Update::Pre-Invoke::=/bin/sh", "update"}, false},
+		{"apt-get other configuration file", []string{AptGetCmd, "-c", "/tmp/apt.conf", "update"}, false},
+		{"dpkg configure", []string{DpkgCmd, "--configure", "-a", "--force-confdef", "--force-confold"}, true},
+		{"dpkg install file", []string{DpkgCmd, "-i", "/tmp/evil.deb"}, false},
+		{"dpkg-query", []string{DpkgQueryCmd, "-W", "-f=${db:Status-Abbrev}\t${Package}\t${Version}\n"}, true},
+		{"dnf install", []string{DnfCmd, "-y", "--downloadonly", "install", "nginx", "kernel-6.1.0"}, true},
+		{"dnf install option", []string{DnfCmd, "-y", "install", "--setopt=tsflags=noscripts"}, false},
+		{"dnf history undo", []string{DnfCmd, "-y", "history", "undo", "1"}, false},
+		{"rpm query", []string{RpmCmd, "-q", "nginx"}, true},
+		{"rpm erase", []string{RpmCmd, "-e", "nginx"}, false},
+		{"findmnt root", []string{FindmntCmd, "-n", "-o", "SOURCE", "/"}, true},
+		{"lvcreate snapshot", []string{LvcreateCmd, "-s", "-n", "inbm_sota_20250101120000", "vg0/root"}, true},
+		{"lvremove snapshot", []string{LvremoveCmd, "-y", "vg0/inbm_sota_20250101120000"}, true},
+		{"lvremove origin", []string{LvremoveCmd, "-y", "vg0/root"}, false},
+		{"smartctl health", []string{SmartctlCmd, "--health", "--json", "/dev/nvme0n1"}, true},
+		{"smartctl write", []string{SmartctlCmd, "--smart=off", "/dev/sda"}, false},
+		{"unknown command", []string{"/bin/rm", "-rf", "/"}, false},
+		{"relative path command", []string{"ls"}, false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := isAllowedCommand(tt.command)
-			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.expected, matchesCommandRule(tt.args))
 		})
 	}
 }
@@ -124,13 +95,24 @@ func TestExecutor_Execute(t *testing.T) {
 			return []byte("stdout output"), []byte("stderr output"), nil
 		})
 
-		stdout, stderr, err := executor.Execute([]string{RebootCmd, "arg1", "arg2"})
+		stdout, stderr, err := executor.Execute([]string{RebootCmd})
 
 		assert.NoError(t, err)
 		assert.Equal(t, []byte("stdout output"), stdout)
 		assert.Equal(t, []byte("stderr output"), stderr)
 	})
 
+	t.Run("built-in command with other arguments should fail", func(t *testing.T) {
+		executor := NewExecutor(createMockCmd, executeMockCmd)
+
+		stdout, stderr, err := executor.Execute([]string{RebootCmd, "arg1", "arg2"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not allowed")
+		assert.Nil(t, stdout)
+		assert.Nil(t, stderr)
+	})
+
 	t.Run("empty arguments should fail", func(t *testing.T) {
 		executor := NewExecutor(createMockCmd, executeMockCmd)
 
@@ -158,7 +140,7 @@ func TestExecutor_Execute(t *testing.T) {
 			return []byte("stdout"), []byte("stderr"), errors.New("execution failed")
 		})
 
-		stdout, stderr, err := executor.Execute([]string{DpkgCmd, "--configure", "-a"})
+		stdout, stderr, err := executor.Execute([]string{DpkgCmd, "--configure", "-a", "--force-confdef", "--force-confold"})
 
 		assert.Error(t, err)
 		assert.Equal(t, "execution failed", err.Error())
@@ -180,11 +162,11 @@ func TestExecutor_Execute(t *testing.T) {
 			return []byte("success"), []byte(""), nil
 		})
 
-		stdout, stderr, err := executor.Execute([]string{AptGetCmd, "update", "-y", "--force"})
+		stdout, stderr, err := executor.Execute([]string{AptGetCmd, "-o", "Dpkg::Lock::Timeout=300", "-yq", "update"})
 
 		assert.NoError(t, err)
 		assert.Equal(t, AptGetCmd, capturedName)
-		assert.Equal(t, []string{"update", "-y", "--force"}, capturedArgs)
+		assert.Equal(t, []string{"-o", "Dpkg::Lock::Timeout=300", "-yq", "update"}, capturedArgs)
 		assert.Equal(t, []byte("success"), stdout)
 		assert.Equal(t, []byte(""), stderr)
 	})
@@ -300,7 +282,7 @@ func TestExecutorIntegration(t *testing.T) {
 		executor := NewExecutor(createCmdFunc, execCmdFunc)
 
 		// Test with IP command (assuming it's available)
-		stdout, _, err := executor.Execute([]string{IPCmd, "--version"})
+		stdout, _, err := executor.Execute([]string{IPCmd, "route", "show", "default"})
 
 		// The command should either succeed or fail, but it should be allowed
 		if err != nil {
@@ -333,16 +315,16 @@ func TestExecutorIntegration(t *testing.T) {
 }
 
 // Benchmark tests for performance validation
-func BenchmarkIsAllowedCommand(b *testing.B) {
+func BenchmarkMatchesCommandRule(b *testing.B) {
 	b.Run("allowed command", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			isAllowedCommand(RebootCmd)
+			matchesCommandRule([]string{AptGetCmd, "-o", "Dpkg::Lock::Timeout=300", "-yq", "install", "nginx"})
 		}
 	})
 
 	b.Run("disallowed command", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			isAllowedCommand("/bin/rm")
+			matchesCommandRule([]string{"/bin/rm", "-rf", "/"})
 		}
 	})
 }
@@ -354,6 +336,6 @@ func BenchmarkExecutorExecute(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, _, _ = executor.Execute([]string{RebootCmd, "arg1"})
+		_, _, _ = executor.Execute([]string{RebootCmd})
 	}
 }
@@ -13,6 +13,9 @@ const RebootCmd = "/usr/sbin/reboot"
 // ShutdownCmd is the command used to shutdown the system.
 const ShutdownCmd = "/usr/sbin/shutdown"
 
+// SystemctlCmd is the command used to suspend, hibernate and soft-reboot the system.
+const SystemctlCmd = "/usr/bin/systemctl"
+
 // TruncateCmd is the command used to truncate the state file.
 const TruncateCmd = "truncate"
 
@@ -35,3 +38,30 @@ const AptGetCmd = "/usr/bin/apt-get"
 
 // DpkgCmd is the command to execute the dpkg tool.
 const DpkgCmd = "/usr/bin/dpkg"
+
+// DnfCmd is the command to execute the dnf package manager on RHEL-family systems.
+const DnfCmd = "/usr/bin/dnf"
+
+// RpmCmd is the command to execute the rpm tool on RHEL-family systems.
+const RpmCmd = "/usr/bin/rpm"
+
+// DpkgQueryCmd is the command to query the dpkg package database.
+const DpkgQueryCmd = "/usr/bin/dpkg-query"
+
+// FindmntCmd is the command to find the device mounted on a path.
+const FindmntCmd = "/usr/bin/findmnt"
+
+// LvsCmd is the command to list LVM logical volumes.
+const LvsCmd = "/usr/sbin/lvs"
+
+// LvcreateCmd is the command to create LVM logical volumes and snapshots.
+const LvcreateCmd = "/usr/sbin/lvcreate"
+
+// LvconvertCmd is the command to merge an LVM snapshot back into its origin.
+const LvconvertCmd = "/usr/sbin/lvconvert"
+
+// LvremoveCmd is the command to remove LVM logical volumes and snapshots.
+const LvremoveCmd = "/usr/sbin/lvremove"
+
+// SmartctlCmd is the command to read the SMART data of disks.
+const SmartctlCmd = "/usr/sbin/smartctl"
@@ -7,11 +7,14 @@
 package common
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
 	"log"
+	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 )
 
 // OSType represents the type of OS.
@@ -36,8 +39,13 @@ var (
 		return cmd.CombinedOutput()
 	}
 	getOS OSGetter = func() string { return runtime.GOOS }
+	// readOSRelease reads the os-release file used when lsb_release is not installed.
+	readOSRelease = func() ([]byte, error) { return os.ReadFile("/etc/os-release") }
 )
 
+// rhelFamilyIDs are the os-release IDs of distributions handled by the dnf-based updater.
+var rhelFamilyIDs = []string{"rhel", "centos", "rocky", "almalinux", "ol"}
+
 // DetectOS detects the OS.
 func DetectOS() (string, error) {
 	osType := getOSType()
@@ -51,20 +59,71 @@ func DetectOS() (string, error) {
 func detectLinuxDistribution() (string, error) {
 	output, err := execCommand("lsb_release", "-a")
 	if err != nil {
+		// lsb_release is not installed by default on Debian and RHEL-family systems.
+		if distribution, osReleaseErr := detectFromOSRelease(); osReleaseErr == nil {
+			return distribution, nil
+		}
 		return "", err
 	}
 	output = bytes.ReplaceAll(output, []byte("\n"), []byte(""))
+	lowerOutput := bytes.ToLower(output)
 
 	switch {
 	case bytes.Contains(output, []byte("Ubuntu")):
 		return "Ubuntu", nil
-	case bytes.Contains(bytes.ToLower(output), []byte("microvisor")):
+	case bytes.Contains(lowerOutput, []byte("microvisor")):
 		return "EMT", nil
+	case bytes.Contains(output, []byte("Debian")):
+		return "Debian", nil
+	case bytes.Contains(lowerOutput, []byte("redhat")), bytes.Contains(lowerOutput, []byte("red hat")),
+		bytes.Contains(lowerOutput, []byte("centos")), bytes.Contains(lowerOutput, []byte("rocky")),
+		bytes.Contains(lowerOutput, []byte("almalinux")), bytes.Contains(lowerOutput, []byte("oracle")):
+		return "RHEL", nil
 	}
 
 	return string(output), nil
 }
 
+// detectFromOSRelease detects the distribution from the ID and ID_LIKE fields of /etc/os-release.
+func detectFromOSRelease() (string, error) {
+	data, err := readOSRelease()
+	if err != nil {
+		return "", err
+	}
+
+	var id, idLike string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if !found {
+			continue
+		}
+		value = strings.ToLower(strings.Trim(value, "\"'"))
+		switch key {
+		case "ID":
+			id = value
+		case "ID_LIKE":
+			idLike = value
+		}
+	}
+
+	switch {
+	case id == "ubuntu":
+		return "Ubuntu", nil
+	case id == "debian":
+		return "Debian", nil
+	}
+	for _, rhelID := range rhelFamilyIDs {
+		if id == rhelID || strings.Contains(idLike, "rhel") {
+			return "RHEL", nil
+		}
+	}
+	if id == "" {
+		return "", fmt.Errorf("no distribution ID found in os-release")
+	}
+	return id, nil
+}
+
 func getOSType() OSType {
 	os := getOS()
 	log.Printf("os: %v\n", os)
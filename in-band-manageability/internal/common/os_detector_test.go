@@ -16,9 +16,11 @@ import (
 func TestDetectOS(t *testing.T) {
 	originalExecCommand := execCommand
 	originalGetOS := getOS
+	originalReadOSRelease := readOSRelease
 	defer func() {
 		execCommand = originalExecCommand
 		getOS = originalGetOS
+		readOSRelease = originalReadOSRelease
 	}()
 
 	t.Run("detects Ubuntu OS", func(t *testing.T) {
@@ -52,11 +54,50 @@ func TestDetectOS(t *testing.T) {
 		assert.Equal(t, "unsupported OS type detected", err.Error())
 	})
 
+	t.Run("detects Debian OS", func(t *testing.T) {
+		getOS = func() string { return "linux" }
+		execCommand = func(name string, arg ...string) ([]byte, error) {
+			return []byte("Distributor ID:\tDebian\nDescription:\tDebian GNU/Linux 12 (bookworm)\n"), nil
+		}
+
+		os, err := DetectOS()
+		assert.NoError(t, err)
+		assert.Equal(t, "Debian", os)
+	})
+
+	t.Run("detects RHEL OS", func(t *testing.T) {
+		getOS = func() string { return "linux" }
+		execCommand = func(name string, arg ...string) ([]byte, error) {
+			return []byte("Distributor ID:\tRedHatEnterprise\n"), nil
+		}
+
+		os, err := DetectOS()
+		assert.NoError(t, err)
+		assert.Equal(t, "RHEL", os)
+	})
+
+	t.Run("falls back to os-release without lsb_release", func(t *testing.T) {
+		getOS = func() string { return "linux" }
+		execCommand = func(name string, arg ...string) ([]byte, error) {
+			return nil, errors.New("command error")
+		}
+		readOSRelease = func() ([]byte, error) {
+			return []byte("NAME=\"Rocky Linux\"\nID=\"rocky\"\nID_LIKE=\"rhel centos fedora\"\n"), nil
+		}
+
+		os, err := DetectOS()
+		assert.NoError(t, err)
+		assert.Equal(t, "RHEL", os)
+	})
+
 	t.Run("error detecting Linux distribution", func(t *testing.T) {
 		getOS = func() string { return "linux" }
 		execCommand = func(name string, arg ...string) ([]byte, error) {
 			return nil, errors.New("command error")
 		}
+		readOSRelease = func() ([]byte, error) {
+			return nil, errors.New("file not found")
+		}
 
 		os, err := DetectOS()
 		assert.Error(t, err)
@@ -65,6 +106,40 @@ func TestDetectOS(t *testing.T) {
 	})
 }
 
+func TestDetectFromOSRelease(t *testing.T) {
+	originalReadOSRelease := readOSRelease
+	defer func() {
+		readOSRelease = originalReadOSRelease
+	}()
+
+	tests := []struct {
+		name      string
+		osRelease string
+		expected  string
+		wantErr   bool
+	}{
+		{"ubuntu", "ID=ubuntu\nID_LIKE=debian\n", "Ubuntu", false},
+		{"debian", "ID=debian\n", "Debian", false},
+		{"rhel", "ID=\"rhel\"\n", "RHEL", false},
+		{"almalinux", "ID=\"almalinux\"\nID_LIKE=\"rhel centos fedora\"\n", "RHEL", false},
+		{"unknown distribution", "ID=arch\n", "arch", false},
+		{"missing ID", "NAME=Linux\n", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readOSRelease = func() ([]byte, error) { return []byte(tt.osRelease), nil }
+			distribution, err := detectFromOSRelease()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, distribution)
+		})
+	}
+}
+
 func TestGetOSType(t *testing.T) {
 	originalGetOS := getOS
 	defer func() {
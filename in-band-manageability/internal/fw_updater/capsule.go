@@ -0,0 +1,155 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package fwupdater
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+
+	telemetry "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/telemetry"
+	"github.com/spf13/afero"
+)
+
+// CapsuleHeader is the parsed EFI_CAPSULE_HEADER of a UEFI capsule.
+type CapsuleHeader struct {
+	GUID             string
+	HeaderSize       uint32
+	Flags            uint32
+	CapsuleImageSize uint32
+}
+
+// parseCapsuleHeader parses and validates the EFI_CAPSULE_HEADER at the start of the capsule.
+func parseCapsuleHeader(data []byte) (CapsuleHeader, error) {
+	if len(data) < capsuleHeaderSize {
+		return CapsuleHeader{}, fmt.Errorf("capsule is too small (%d bytes) to contain an EFI capsule header", len(data))
+	}
+
+	header := CapsuleHeader{
+		GUID:             formatEFIGUID(data[0:16]),
+		HeaderSize:       binary.LittleEndian.Uint32(data[16:20]),
+		Flags:            binary.LittleEndian.Uint32(data[20:24]),
+		CapsuleImageSize: binary.LittleEndian.Uint32(data[24:28]),
+	}
+
+	if header.HeaderSize < capsuleHeaderSize || header.HeaderSize > header.CapsuleImageSize {
+		return CapsuleHeader{}, fmt.Errorf("invalid capsule header size %d", header.HeaderSize)
+	}
+	if int64(header.CapsuleImageSize) != int64(len(data)) {
+		return CapsuleHeader{}, fmt.Errorf("capsule image size %d does not match file size %d", header.CapsuleImageSize, len(data))
+	}
+	return header, nil
+}
+
+// capsuleTargets returns the firmware classes the capsule updates. A Firmware Management Protocol capsule
+// targets the UpdateImageTypeId of each of its payload items; any other capsule targets its own GUID.
+func capsuleTargets(data []byte, header CapsuleHeader) ([]string, error) {
+	if header.GUID != fmpCapsuleGUID {
+		return []string{header.GUID}, nil
+	}
+
+	// EFI_FIRMWARE_MANAGEMENT_CAPSULE_HEADER: Version, EmbeddedDriverCount, PayloadItemCount, ItemOffsetList.
+	fmp := data[header.HeaderSize:]
+	if len(fmp) < fmpCapsuleHeaderSize {
+		return nil, fmt.Errorf("FMP capsule is too small (%d bytes) to contain an FMP capsule header", len(fmp))
+	}
+	driverCount := int(binary.LittleEndian.Uint16(fmp[4:6]))
+	payloadCount := int(binary.LittleEndian.Uint16(fmp[6:8]))
+	if payloadCount == 0 {
+		return nil, fmt.Errorf("FMP capsule contains no payload")
+	}
+	offsetListEnd := fmpCapsuleHeaderSize + 8*(driverCount+payloadCount)
+	if len(fmp) < offsetListEnd {
+		return nil, fmt.Errorf("FMP capsule item offset list is truncated")
+	}
+
+	targets := make([]string, 0, payloadCount)
+	// The payload items follow the embedded drivers in the item offset list.
+	for i := driverCount; i < driverCount+payloadCount; i++ {
+		offset := binary.LittleEndian.Uint64(fmp[fmpCapsuleHeaderSize+8*i:])
+		// EFI_FIRMWARE_MANAGEMENT_CAPSULE_IMAGE_HEADER starts with Version followed by UpdateImageTypeId.
+		if offset < uint64(offsetListEnd) || offset+20 > uint64(len(fmp)) {
+			return nil, fmt.Errorf("invalid offset %d of FMP payload item %d", offset, i-driverCount)
+		}
+		targets = append(targets, formatEFIGUID(fmp[offset+4:offset+20]))
+	}
+	return targets, nil
+}
+
+// checkCapsuleTargets verifies that every firmware class targeted by the capsule is listed in the ESRT,
+// so that capsules for other platforms or components are rejected before they are staged.
+func checkCapsuleTargets(fs afero.Fs, targets []string) error {
+	components, err := telemetry.ReadESRTEntries(fs, telemetry.ESRT_ENTRIES_PATH)
+	if err != nil {
+		return err
+	}
+	fwClasses := make([]string, 0, len(components))
+	for _, component := range components {
+		fwClasses = append(fwClasses, component.FwClass)
+	}
+	for _, target := range targets {
+		if !slices.Contains(fwClasses, target) {
+			return fmt.Errorf("capsule targets firmware class %s, which is not listed in the ESRT", target)
+		}
+	}
+	return nil
+}
+
+// formatEFIGUID formats a 16 byte EFI_GUID using the mixed-endian UEFI representation.
+func formatEFIGUID(b []byte) string {
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(b[0:4]),
+		binary.LittleEndian.Uint16(b[4:6]),
+		binary.LittleEndian.Uint16(b[6:8]),
+		b[8:10],
+		b[10:16])
+}
+
+// stageCapsule hands a standard UEFI capsule to the kernel capsule loader.
+// The firmware applies the capsule on the next reboot and reports the result
+// through the ESRT last attempt status.
+func stageCapsule(fs afero.Fs, capsulePath string) error {
+	log.Printf("Staging UEFI capsule: %s", capsulePath)
+
+	data, err := afero.ReadFile(fs, capsulePath)
+	if err != nil {
+		return fmt.Errorf("firmware update aborted: failed to read capsule: %w", err)
+	}
+
+	header, err := parseCapsuleHeader(data)
+	if err != nil {
+		return fmt.Errorf("firmware update aborted: %w", err)
+	}
+	log.Printf("Capsule GUID: %s, flags: 0x%x, size: %d", header.GUID, header.Flags, header.CapsuleImageSize)
+
+	targets, err := capsuleTargets(data, header)
+	if err != nil {
+		return fmt.Errorf("firmware update aborted: %w", err)
+	}
+	if err := checkCapsuleTargets(fs, targets); err != nil {
+		return fmt.Errorf("firmware update aborted: %w", err)
+	}
+	log.Printf("Capsule targets ESRT firmware classes: %v", targets)
+
+	loader, err := fs.OpenFile(capsuleLoaderPath, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("firmware update aborted: UEFI capsule loader is not available: %w", err)
+	}
+
+	if _, err := loader.Write(data); err != nil {
+		loader.Close()
+		return fmt.Errorf("firmware update aborted: failed to write capsule to loader: %w", err)
+	}
+	// The kernel validates and queues the capsule once the loader is closed.
+	if err := loader.Close(); err != nil {
+		return fmt.Errorf("firmware update aborted: capsule was rejected by the loader: %w", err)
+	}
+
+	log.Println("UEFI capsule staged. It will be applied on the next reboot.")
+	return nil
+}
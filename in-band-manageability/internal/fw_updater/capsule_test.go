@@ -0,0 +1,185 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package fwupdater
+
+import (
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+
+	telemetry "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/telemetry"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildCapsule creates a capsule with a valid EFI_CAPSULE_HEADER and the given payload size.
+func buildCapsule(payloadSize int) []byte {
+	data := make([]byte, capsuleHeaderSize+payloadSize)
+	guid := []byte{0xd3, 0x6e, 0x7c, 0x6d, 0x4f, 0x1a, 0x3c, 0x4e, 0x8f, 0x5a, 0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+	copy(data[0:16], guid)
+	binary.LittleEndian.PutUint32(data[16:20], capsuleHeaderSize)
+	binary.LittleEndian.PutUint32(data[20:24], 0x50000)
+	binary.LittleEndian.PutUint32(data[24:28], uint32(len(data)))
+	return data
+}
+
+// capsuleGUID is the GUID written by buildCapsule.
+const capsuleGUID = "6d7c6ed3-1a4f-4e3c-8f5a-001122334455"
+
+// buildFMPCapsule creates a Firmware Management Protocol capsule with one payload item for imageTypeID.
+func buildFMPCapsule(imageTypeID []byte) []byte {
+	fmp := make([]byte, fmpCapsuleHeaderSize+8+20)
+	binary.LittleEndian.PutUint32(fmp[0:4], 1)
+	binary.LittleEndian.PutUint16(fmp[6:8], 1)
+	binary.LittleEndian.PutUint64(fmp[8:16], 16)
+	copy(fmp[20:36], imageTypeID)
+
+	data := make([]byte, capsuleHeaderSize, capsuleHeaderSize+len(fmp))
+	copy(data[0:16], []byte{0xed, 0xd5, 0xcb, 0x6d, 0x2d, 0xe8, 0x44, 0x4c, 0xbd, 0xa1, 0x71, 0x94, 0x19, 0x9a, 0xd9, 0x2a})
+	binary.LittleEndian.PutUint32(data[16:20], capsuleHeaderSize)
+	data = append(data, fmp...)
+	binary.LittleEndian.PutUint32(data[24:28], uint32(len(data)))
+	return data
+}
+
+// writeESRTFwClass creates an ESRT entry for fwClass.
+func writeESRTFwClass(t *testing.T, fs afero.Fs, fwClass string) {
+	t.Helper()
+	attrs := map[string]string{
+		"fw_class":                    fwClass,
+		"fw_type":                     "1",
+		"fw_version":                  "1",
+		"lowest_supported_fw_version": "1",
+		"capsule_flags":               "0",
+		"last_attempt_version":        "1",
+		"last_attempt_status":         "0",
+	}
+	for attr, value := range attrs {
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(telemetry.ESRT_ENTRIES_PATH, "entry0", attr), []byte(value+"\n"), 0644))
+	}
+}
+
+func TestCapsuleTargets(t *testing.T) {
+	t.Run("capsule GUID", func(t *testing.T) {
+		data := buildCapsule(64)
+		header, err := parseCapsuleHeader(data)
+		require.NoError(t, err)
+		targets, err := capsuleTargets(data, header)
+		require.NoError(t, err)
+		assert.Equal(t, []string{capsuleGUID}, targets)
+	})
+
+	t.Run("FMP payload image type", func(t *testing.T) {
+		data := buildFMPCapsule([]byte{0xd3, 0x6e, 0x7c, 0x6d, 0x4f, 0x1a, 0x3c, 0x4e, 0x8f, 0x5a, 0x00, 0x11, 0x22, 0x33, 0x44, 0x66})
+		header, err := parseCapsuleHeader(data)
+		require.NoError(t, err)
+		require.Equal(t, fmpCapsuleGUID, header.GUID)
+		targets, err := capsuleTargets(data, header)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"6d7c6ed3-1a4f-4e3c-8f5a-001122334466"}, targets)
+	})
+
+	t.Run("FMP invalid item offset", func(t *testing.T) {
+		data := buildFMPCapsule(make([]byte, 16))
+		binary.LittleEndian.PutUint64(data[capsuleHeaderSize+8:], 1000)
+		header, err := parseCapsuleHeader(data)
+		require.NoError(t, err)
+		_, err = capsuleTargets(data, header)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid offset")
+	})
+}
+
+func TestParseCapsuleHeader(t *testing.T) {
+	t.Run("valid capsule", func(t *testing.T) {
+		header, err := parseCapsuleHeader(buildCapsule(64))
+		require.NoError(t, err)
+		assert.Equal(t, capsuleGUID, header.GUID)
+		assert.Equal(t, uint32(capsuleHeaderSize), header.HeaderSize)
+		assert.Equal(t, uint32(0x50000), header.Flags)
+		assert.Equal(t, uint32(capsuleHeaderSize+64), header.CapsuleImageSize)
+	})
+
+	t.Run("too small", func(t *testing.T) {
+		_, err := parseCapsuleHeader(make([]byte, 10))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "too small")
+	})
+
+	t.Run("image size mismatch", func(t *testing.T) {
+		data := buildCapsule(64)
+		_, err := parseCapsuleHeader(data[:len(data)-1])
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "does not match file size")
+	})
+
+	t.Run("invalid header size", func(t *testing.T) {
+		data := buildCapsule(64)
+		binary.LittleEndian.PutUint32(data[16:20], 4)
+		_, err := parseCapsuleHeader(data)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid capsule header size")
+	})
+}
+
+func TestStageCapsule(t *testing.T) {
+	capsulePath := "/var/cache/manageability/firmware.cap"
+
+	t.Run("writes capsule to loader", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		capsule := buildCapsule(128)
+		require.NoError(t, afero.WriteFile(fs, capsulePath, capsule, 0644))
+		require.NoError(t, afero.WriteFile(fs, capsuleLoaderPath, nil, 0600))
+		writeESRTFwClass(t, fs, capsuleGUID)
+
+		require.NoError(t, stageCapsule(fs, capsulePath))
+
+		staged, err := afero.ReadFile(fs, capsuleLoaderPath)
+		require.NoError(t, err)
+		assert.Equal(t, capsule, staged)
+	})
+
+	t.Run("capsule not for this platform", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, capsulePath, buildCapsule(128), 0644))
+		require.NoError(t, afero.WriteFile(fs, capsuleLoaderPath, nil, 0600))
+		writeESRTFwClass(t, fs, "a5b2f1a8-0000-4000-8000-000000000000")
+
+		err := stageCapsule(fs, capsulePath)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not listed in the ESRT")
+		staged, err := afero.ReadFile(fs, capsuleLoaderPath)
+		require.NoError(t, err)
+		assert.Empty(t, staged, "Capsule should not be staged")
+	})
+
+	t.Run("loader not available", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, capsulePath, buildCapsule(128), 0644))
+		writeESRTFwClass(t, fs, capsuleGUID)
+
+		err := stageCapsule(fs, capsulePath)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "UEFI capsule loader is not available")
+	})
+
+	t.Run("invalid capsule", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, capsulePath, []byte("not a capsule"), 0644))
+		require.NoError(t, afero.WriteFile(fs, capsuleLoaderPath, nil, 0600))
+
+		err := stageCapsule(fs, capsulePath)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "firmware update aborted")
+	})
+
+	t.Run("missing capsule file", func(t *testing.T) {
+		err := stageCapsule(afero.NewMemMapFs(), capsulePath)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read capsule")
+	})
+}
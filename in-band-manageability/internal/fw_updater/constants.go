@@ -10,6 +10,18 @@ const firmwareToolInfoFilePath = "/etc/firmware_tool_info.conf"
 
 const firmwareToolInfoSchemaFilePath = "/usr/share/firmware_tool_config_schema.json"
 
+// capsuleLoaderPath is the kernel device used to stage UEFI capsules for the next reboot.
+const capsuleLoaderPath = "/dev/efi_capsule_loader"
+
+// capsuleHeaderSize is the size of the EFI_CAPSULE_HEADER structure in bytes.
+const capsuleHeaderSize = 28
+
+// fmpCapsuleGUID is EFI_FIRMWARE_MANAGEMENT_CAPSULE_ID_GUID, the capsule GUID of Firmware Management Protocol capsules.
+const fmpCapsuleGUID = "6dcbd5ed-e82d-4c44-bda1-7194199ad92a"
+
+// fmpCapsuleHeaderSize is the size of the EFI_FIRMWARE_MANAGEMENT_CAPSULE_HEADER without its item offset list.
+const fmpCapsuleHeaderSize = 8
+
 // FirmwareToolInfo is the matching firmware tool information for the platform.
 type FirmwareToolInfo struct {
 	Name                  string `json:"name"`
@@ -7,6 +7,7 @@
 package fwupdater
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os/exec"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/provenance"
 	telemetry "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/telemetry"
 	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
@@ -43,6 +45,7 @@ type FWUpdater struct {
 	req        *pb.UpdateFirmwareRequest
 	fs         afero.Fs
 	hwProvider HardwareInfoProvider
+	ctx        context.Context
 }
 
 // NewFWUpdater creates a new FWUpdater instance.
@@ -74,6 +77,13 @@ func NewFWUpdaterWithMocks(req *pb.UpdateFirmwareRequest, fs afero.Fs, hwProvide
 	}
 }
 
+// WithContext sets the context carrying the caller that the commands of the update are
+// attributed to in the audit log.
+func (u *FWUpdater) WithContext(ctx context.Context) *FWUpdater {
+	u.ctx = ctx
+	return u
+}
+
 // UpdateFirmware updates the firmware based on the request.
 func (u *FWUpdater) UpdateFirmware() (*pb.UpdateResponse, error) {
 	log.Println("Starting firmware update process.")
@@ -98,29 +108,40 @@ func (u *FWUpdater) UpdateFirmware() (*pb.UpdateResponse, error) {
 	}
 	log.Printf("platform name: %+v", hwInfo.GetSystemProductName())
 	// Get the firmware update tool info.
+	// Platforms without a vendor tool entry fall back to standard UEFI capsules when the ESRT is available.
+	useCapsule := false
 	firmwareToolInfo, err := GetFirmwareUpdateToolInfo(u.fs, hwInfo.GetSystemProductName())
 	if err != nil {
-		return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+		if !telemetry.IsESRTAvailable(u.fs) {
+			return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+		}
+		log.Printf("No firmware update tool configured (%v). Using UEFI capsule update.", err)
+		useCapsule = true
+	} else {
+		log.Printf("Firmware update tool info: %+v", firmwareToolInfo)
 	}
-	log.Printf("Firmware update tool info: %+v", firmwareToolInfo)
 
-	// Get the firmware information for the release date check.
-	fwInfo, err := u.hwProvider.GetFirmwareInfo()
-	if err != nil {
-		return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
-	}
+	// The BIOS release date only describes the system firmware, so it is not checked for capsules, which
+	// may target any ESRT component. The firmware itself rejects capsules older than the lowest supported version.
+	if !useCapsule {
+		// Get the firmware information for the release date check.
+		fwInfo, err := u.hwProvider.GetFirmwareInfo()
+		if err != nil {
+			return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+		}
 
-	// Check if the firmware update is required.
-	// Compare timestamps properly: if current BIOS date is newer than or equal to requested date, skip update
-	currentBiosTime := fwInfo.GetBiosReleaseDate().AsTime()
-	requestedTime := u.req.ReleaseDate.AsTime()
+		// Check if the firmware update is required.
+		// Compare timestamps properly: if current BIOS date is newer than or equal to requested date, skip update
+		currentBiosTime := fwInfo.GetBiosReleaseDate().AsTime()
+		requestedTime := u.req.ReleaseDate.AsTime()
 
-	if currentBiosTime.After(requestedTime) || currentBiosTime.Equal(requestedTime) {
-		return &pb.UpdateResponse{
-			StatusCode: 400,
-			Error: fmt.Sprintf("Firmware update is not required. Current firmware (%s) is up to date or newer than requested (%s).",
-				currentBiosTime.Format(time.RFC3339), requestedTime.Format(time.RFC3339)),
-		}, nil
+		if currentBiosTime.After(requestedTime) || currentBiosTime.Equal(requestedTime) {
+			return &pb.UpdateResponse{
+				StatusCode: 400,
+				Error: fmt.Sprintf("Firmware update is not required. Current firmware (%s) is up to date or newer than requested (%s).",
+					currentBiosTime.Format(time.RFC3339), requestedTime.Format(time.RFC3339)),
+			}, nil
+		}
 	}
 
 	// Download the firmware update file.
@@ -154,6 +175,13 @@ func (u *FWUpdater) UpdateFirmware() (*pb.UpdateResponse, error) {
 		log.Printf("No signature provided, proceeding without signature verification.")
 	}
 
+	if err := u.verifyProvenance(firmwareFilePath); err != nil {
+		if removeErr := u.fs.Remove(firmwareFilePath); removeErr != nil {
+			log.Printf("Warning: failed to remove firmware file %s: %v", firmwareFilePath, removeErr)
+		}
+		return &pb.UpdateResponse{StatusCode: 400, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+
 	// Extract firmware file info and unpack if needed
 	fwFile, certFile, err := u.extractFileInfo(firmwareFilePath, utils.IntelManageabilityCachePathPrefix)
 	if err != nil {
@@ -162,7 +190,12 @@ func (u *FWUpdater) UpdateFirmware() (*pb.UpdateResponse, error) {
 
 	// Perform the firmware update using the extracted firmware file and the firmware update tool info
 	actualFirmwarePath := filepath.Join(utils.IntelManageabilityCachePathPrefix, fwFile)
-	if err := u.applyFirmware(actualFirmwarePath, firmwareToolInfo); err != nil {
+	if useCapsule {
+		err = stageCapsule(u.fs, actualFirmwarePath)
+	} else {
+		err = u.applyFirmware(actualFirmwarePath, firmwareToolInfo)
+	}
+	if err != nil {
 		// Clean up files before returning error
 		u.deleteFiles(filepath.Base(u.req.Url), fwFile, certFile)
 		return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
@@ -176,11 +209,13 @@ func (u *FWUpdater) UpdateFirmware() (*pb.UpdateResponse, error) {
 	// Check if reboot is requested
 	if !u.req.DoNotReboot {
 		log.Println("Firmware update completed successfully. Rebooting system...")
-		executor := common.NewExecutor(exec.Command, common.ExecuteAndReadOutput)
+		executor := common.NewExecutorWithContext(u.ctx, exec.Command, common.ExecuteAndReadOutput)
 		if err := utils.RebootSystem(executor); err != nil {
 			log.Printf("Warning: Failed to reboot system: %v", err)
 			// Don't return error here as firmware update was successful
 		}
+	} else if useCapsule {
+		log.Println("UEFI capsule staged. Reboot skipped as requested; the capsule is applied on the next reboot.")
 	} else {
 		log.Println("Firmware update completed successfully. Reboot skipped as requested.")
 	}
@@ -188,6 +223,27 @@ func (u *FWUpdater) UpdateFirmware() (*pb.UpdateResponse, error) {
 	return &pb.UpdateResponse{StatusCode: 200, Error: "Success"}, nil
 }
 
+// verifyProvenance verifies the provenance of the downloaded package if it was supplied
+// or is required by the configuration.
+func (u *FWUpdater) verifyProvenance(firmwareFilePath string) error {
+	verifier := provenance.NewVerifier(u.fs)
+	provenancePath := ""
+	if u.req.ProvenanceUrl != "" {
+		var err error
+		provenancePath, err = verifier.Fetch(u.req.ProvenanceUrl, utils.IntelManageabilityCachePathPrefix)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := u.fs.Remove(provenancePath); err != nil {
+				log.Printf("Warning: failed to remove provenance file %s: %v", provenancePath, err)
+			}
+		}()
+	}
+	_, err := verifier.VerifyPackage(provenance.PackageTypeFOTA, firmwareFilePath, provenancePath)
+	return err
+}
+
 // applyFirmware applies the firmware update using the firmware tool
 func (u *FWUpdater) applyFirmware(firmwareFilePath string, toolInfo FirmwareToolInfo) error {
 	log.Printf("Applying firmware using tool: %s", toolInfo.FirmwareTool)
@@ -9,6 +9,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
@@ -16,6 +17,17 @@ import (
 	"google.golang.org/grpc"
 )
 
+// applicationSourceFlags holds the flags of a structured application source.
+type applicationSourceFlags struct {
+	name           string
+	types          []string
+	uris           []string
+	suites         []string
+	components     []string
+	architectures  []string
+	keyFingerprint string
+}
+
 // AddApplicationSourceCmd returns a cobra command for the AddApplicationSource command
 func AddApplicationSourceCmd() *cobra.Command {
 	var socket string
@@ -23,21 +35,35 @@ func AddApplicationSourceCmd() *cobra.Command {
 	var filename string
 	var gpgKeyURI string
 	var gpgKeyName string
+	var structured applicationSourceFlags
 
 	cmd := &cobra.Command{
 		Use:   "add",
 		Short: "Adds a new application source",
 		Long:  "Add command is used to add a new application source to the list of sources.",
-		RunE:  handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, Dial),
+		Example: `  inbc source application add --sources "deb [signed-by=/usr/share/keyrings/example.gpg] https://repo.example.com/apt noble main" --filename example.list --gpgKeyUri https://repo.example.com/key.asc --gpgKeyName example.gpg
+  inbc source application add --name example --uris https://repo.example.com/apt --suites noble --components main --architectures amd64 --gpgKeyUri https://repo.example.com/key.asc --keyFingerprint 0123456789ABCDEF0123456789ABCDEF01234567`,
+		RunE: handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, &structured, Dial),
 	}
 
 	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
 	cmd.Flags().StringSliceVar(&sources, "sources", nil, "List of application sources to add")
-	must(cmd.MarkFlagRequired("sources"))
 	cmd.Flags().StringVar(&filename, "filename", "", "Filename of the source")
-	must(cmd.MarkFlagRequired("filename"))
 	cmd.Flags().StringVar(&gpgKeyURI, "gpgKeyUri", "", "GPG key URI")
 	cmd.Flags().StringVar(&gpgKeyName, "gpgKeyName", "", "GPG key name")
+	cmd.Flags().StringVar(&structured.name, "name", "", "Name of a structured source, written as /etc/apt/sources.list.d/<name>.sources with the keyring /usr/share/keyrings/<name>.gpg")
+	cmd.Flags().StringSliceVar(&structured.types, "types", nil, "Types of a structured source: deb, deb-src (default deb)")
+	cmd.Flags().StringSliceVar(&structured.uris, "uris", nil, "Repository URIs of a structured source")
+	cmd.Flags().StringSliceVar(&structured.suites, "suites", nil, "Suites of a structured source, e.g. noble; a suite ending in / is a flat repository")
+	cmd.Flags().StringSliceVar(&structured.components, "components", nil, "Components of a structured source, e.g. main")
+	cmd.Flags().StringSliceVar(&structured.architectures, "architectures", nil, "Architectures of a structured source, e.g. amd64")
+	cmd.Flags().StringVar(&structured.keyFingerprint, "keyFingerprint", "", "Pinned fingerprint of the key at --gpgKeyUri that signs a structured source")
+	cmd.MarkFlagsOneRequired("sources", "uris")
+	cmd.MarkFlagsMutuallyExclusive("sources", "uris")
+	cmd.MarkFlagsOneRequired("filename", "name")
+	cmd.MarkFlagsMutuallyExclusive("filename", "name")
+	cmd.MarkFlagsMutuallyExclusive("gpgKeyName", "name")
+	cmd.MarkFlagsRequiredTogether("name", "uris", "suites", "keyFingerprint")
 
 	return cmd
 }
@@ -49,10 +75,11 @@ func handleAddApplicationSource(
 	filename *string,
 	gpgKeyURI *string,
 	gpgKeyName *string,
+	structured *applicationSourceFlags,
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("SOURCE APPLICATION ADD INBC Command was invoked.\n")
+		fmt.Fprintf(textOut, "SOURCE APPLICATION ADD INBC Command was invoked.\n")
 
 		// Validate and parse the package list
 		sourcesSet := make(map[string]struct{})
@@ -69,31 +96,48 @@ func handleAddApplicationSource(
 			GpgKeyUri:  *gpgKeyURI,
 			GpgKeyName: *gpgKeyName,
 		}
+		timeout := sourceTimeoutInSeconds
+		if structured.name != "" {
+			if *gpgKeyURI == "" {
+				return fmt.Errorf("--gpgKeyUri is required with --name")
+			}
+			request.ApplicationSource = &pb.ApplicationSource{
+				Name:           structured.name,
+				Types:          structured.types,
+				Uris:           structured.uris,
+				Suites:         structured.suites,
+				Components:     structured.components,
+				Architectures:  structured.architectures,
+				KeyFingerprint: structured.keyFingerprint,
+			}
+			// inbd downloads the key and the Release files of every suite.
+			timeout = signedSourceTimeoutInSeconds
+		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeoutInSeconds*time.Second)
 		defer cancel()
 
 		inbdClient, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
 
-		ctx, cancel = context.WithTimeout(context.Background(), sourceTimeoutInSeconds*time.Second)
+		ctx, cancel = context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
 		defer cancel()
 
 		resp, err := inbdClient.AddApplicationSource(ctx, request)
 		if err != nil {
-			return fmt.Errorf("error adding application source: %v", err)
+			return fmt.Errorf("error adding application source: %w", err)
 		}
 
-		fmt.Printf("SOURCE APPLICATION ADD Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
-		return nil
+		fmt.Fprintf(textOut, "SOURCE APPLICATION ADD Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		return finishResponse("source application add", resp)
 	}
 }
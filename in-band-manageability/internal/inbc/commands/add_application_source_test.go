@@ -9,6 +9,7 @@ package commands
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
@@ -68,7 +69,7 @@ func TestHandleAddApplicationSource(t *testing.T) {
 			return MockDialer(ctx, socket, mockClient, false)
 		}
 
-		err := handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, dialer)(cmd, args)
+		err := handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, &applicationSourceFlags{}, dialer)(cmd, args)
 		assert.NoError(t, err, "handleAddApplicationSource should not return an error")
 
 		mockClient.AssertExpectations(t)
@@ -81,7 +82,7 @@ func TestHandleAddApplicationSource(t *testing.T) {
 
 		sameSources := []string{"source1", "source1"}
 
-		err := handleAddApplicationSource(&socket, &sameSources, &filename, &gpgKeyURI, &gpgKeyName, dialer)(cmd, args)
+		err := handleAddApplicationSource(&socket, &sameSources, &filename, &gpgKeyURI, &gpgKeyName, &applicationSourceFlags{}, dialer)(cmd, args)
 		assert.Error(t, err, "duplicate source in the sources list: source1")
 	})
 
@@ -90,7 +91,7 @@ func TestHandleAddApplicationSource(t *testing.T) {
 			return MockDialer(ctx, socket, new(MockInbServiceClient), true)
 		}
 
-		err := handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, dialer)(cmd, args)
+		err := handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, &applicationSourceFlags{}, dialer)(cmd, args)
 		assert.Error(t, err, "error setting up new gRPC client")
 	})
 
@@ -102,7 +103,7 @@ func TestHandleAddApplicationSource(t *testing.T) {
 			return MockDialer(ctx, socket, mockClient, false)
 		}
 
-		err := handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, dialer)(cmd, args)
+		err := handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, &applicationSourceFlags{}, dialer)(cmd, args)
 		assert.Error(t, err, "error adding application source")
 	})
 
@@ -117,7 +118,72 @@ func TestHandleAddApplicationSource(t *testing.T) {
 			return mockClient, &mockConnWithCloseError{}, nil
 		}
 
-		err := handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, dialer)(cmd, args)
+		err := handleAddApplicationSource(&socket, &sources, &filename, &gpgKeyURI, &gpgKeyName, &applicationSourceFlags{}, dialer)(cmd, args)
 		assert.NoError(t, err, "handleAddApplicationSource should not return an error even if Close fails")
 	})
+	t.Run("structured source", func(t *testing.T) {
+		noSources := []string{}
+		noFilename := ""
+		noKeyName := ""
+		structured := &applicationSourceFlags{
+			name:           "example",
+			uris:           []string{"https://repo.example.com/apt"},
+			suites:         []string{"noble"},
+			components:     []string{"main"},
+			keyFingerprint: "0123456789ABCDEF0123456789ABCDEF01234567",
+		}
+		mockClient := new(MockInbServiceClient)
+		mockClient.On("AddApplicationSource", mock.Anything, &pb.AddApplicationSourceRequest{
+			Source:    noSources,
+			GpgKeyUri: gpgKeyURI,
+			ApplicationSource: &pb.ApplicationSource{
+				Name:           "example",
+				Uris:           []string{"https://repo.example.com/apt"},
+				Suites:         []string{"noble"},
+				Components:     []string{"main"},
+				KeyFingerprint: "0123456789ABCDEF0123456789ABCDEF01234567",
+			},
+		}, mock.Anything).Return(&pb.UpdateResponse{StatusCode: 200}, nil)
+
+		dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+			return MockDialer(ctx, socket, mockClient, false)
+		}
+
+		err := handleAddApplicationSource(&socket, &noSources, &noFilename, &gpgKeyURI, &noKeyName, structured, dialer)(cmd, args)
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("structured source without GPG key URI", func(t *testing.T) {
+		noSources := []string{}
+		noFilename := ""
+		noKeyURI := ""
+		dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+			return MockDialer(ctx, socket, new(MockInbServiceClient), false)
+		}
+
+		err := handleAddApplicationSource(&socket, &noSources, &noFilename, &noKeyURI, &gpgKeyName, &applicationSourceFlags{name: "example"}, dialer)(cmd, args)
+		assert.ErrorContains(t, err, "--gpgKeyUri is required with --name")
+	})
+}
+
+func TestAddApplicationSourceCmdFlagGroups(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{"URIs without the other structured source flags", []string{"--sources", "deb https://repo.example.com/apt noble main", "--filename", "example.list", "--uris", "https://repo.example.com/apt"}, "missing [keyFingerprint name suites]"},
+		{"no sources", []string{"--filename", "example.list"}, "at least one of the flags in the group [sources uris] is required"},
+		{"structured source without suites", []string{"--name", "example", "--uris", "https://repo.example.com/apt", "--keyFingerprint", "0123"}, "they must all be set; missing [suites]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := AddApplicationSourceCmd()
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			assert.ErrorContains(t, cmd.Execute(), tt.expectedError)
+		})
+	}
 }
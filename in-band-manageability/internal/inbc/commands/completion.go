@@ -0,0 +1,61 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// CompletionCmd returns a cobra command that generates the shell completion scripts
+func CompletionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completion bash|zsh|fish|powershell",
+		Short: "Generate the shell completion script",
+		Long: `Completion command writes the completion script of inbc for the given shell to standard output.
+
+Bash:
+  inbc completion bash | sudo tee /etc/bash_completion.d/inbc > /dev/null
+
+Zsh:
+  inbc completion zsh > "${fpath[1]}/_inbc"
+
+Fish:
+  inbc completion fish > ~/.config/fish/completions/inbc.fish
+
+PowerShell:
+  inbc completion powershell | Out-String | Invoke-Expression`,
+		ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		DisableFlagsInUseLine: true,
+		RunE:                  handleCompletionCmd,
+	}
+
+	return cmd
+}
+
+// handleCompletionCmd is a helper function to handle the CompletionCmd
+func handleCompletionCmd(cmd *cobra.Command, args []string) error {
+	root := cmd.Root()
+	out := cmd.OutOrStdout()
+	switch args[0] {
+	case "bash":
+		return root.GenBashCompletionV2(out, true)
+	case "zsh":
+		return root.GenZshCompletion(out)
+	case "fish":
+		return root.GenFishCompletion(out, true)
+	case "powershell":
+		return root.GenPowerShellCompletionWithDesc(out)
+	default:
+		return fmt.Errorf("unsupported shell '%s'", args[0])
+	}
+}
+
+// hashAlgorithmCompletion completes the --hash_algorithm flags.
+var hashAlgorithmCompletion = cobra.FixedCompletions([]string{"sha256", "sha384", "sha512"}, cobra.ShellCompDirectiveNoFileComp)
@@ -0,0 +1,57 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// completionRoot returns a root command with the completion and query commands.
+func completionRoot() (*cobra.Command, *bytes.Buffer) {
+	var buf bytes.Buffer
+	root := &cobra.Command{Use: "inbc"}
+	root.AddCommand(CompletionCmd(), QueryCmd())
+	root.SetOut(&buf)
+	root.SetErr(&buf)
+	return root, &buf
+}
+
+func TestCompletionCmd(t *testing.T) {
+	for shell, marker := range map[string]string{
+		"bash":       "bash completion V2 for inbc",
+		"zsh":        "#compdef inbc",
+		"fish":       "fish completion for inbc",
+		"powershell": "powershell completion for inbc",
+	} {
+		t.Run(shell, func(t *testing.T) {
+			root, buf := completionRoot()
+			root.SetArgs([]string{"completion", shell})
+			require.NoError(t, root.Execute())
+			assert.Contains(t, buf.String(), marker)
+		})
+	}
+
+	t.Run("unsupported shell", func(t *testing.T) {
+		root, _ := completionRoot()
+		root.SetArgs([]string{"completion", "tcsh"})
+		assert.ErrorContains(t, root.Execute(), `invalid argument "tcsh"`)
+	})
+}
+
+func TestCompletionCmd_QueryOptions(t *testing.T) {
+	root, buf := completionRoot()
+	root.SetArgs([]string{cobra.ShellCompRequestCmd, "query", "--option", ""})
+	require.NoError(t, root.Execute())
+	assert.Contains(t, buf.String(), "fwcomponents\n")
+	assert.Contains(t, buf.String(), "vulnerabilities\n")
+	assert.Contains(t, buf.String(), ":4\n") // ShellCompDirectiveNoFileComp
+}
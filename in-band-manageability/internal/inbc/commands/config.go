@@ -8,8 +8,10 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -21,17 +23,19 @@ import (
 // ConfigLoadCmd returns the 'load' subcommand.
 func ConfigLoadCmd() *cobra.Command {
 	var socket string
-	var uri, signature, hashAlgorithm string
+	var uri, signature, hashAlgorithm, provenanceURI string
 	cmd := &cobra.Command{
 		Use:   "load",
 		Short: "Load a new configuration file",
-		RunE:  handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, Dial),
+		RunE:  handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, Dial),
 	}
 
 	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
 	cmd.Flags().StringVarP(&uri, "uri", "u", "", "URI to config file")
 	cmd.Flags().StringVarP(&signature, "signature", "s", "", "Signature for config file")
 	cmd.Flags().StringVar(&hashAlgorithm, "hash_algorithm", "", "Hash algorithm to use for signature verification (sha256, sha384, sha512). Default is sha384.")
+	must(cmd.RegisterFlagCompletionFunc("hash_algorithm", hashAlgorithmCompletion))
+	cmd.Flags().StringVar(&provenanceURI, "provenance-uri", "", "Local path of the provenance (Sigstore bundle, DSSE envelope or in-toto attestations) of the config file")
 	must(cmd.MarkFlagRequired("uri"))
 
 	return cmd
@@ -43,10 +47,11 @@ func handleConfigLoadCmd(
 	uri *string,
 	signature *string,
 	hashAlgorithm *string,
+	provenanceURI *string,
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Println("CONFIG LOAD command invoked.")
+		fmt.Fprintln(textOut, "CONFIG LOAD command invoked.")
 
 		if uri == nil || *uri == "" {
 			return errors.New("uri is required")
@@ -65,6 +70,7 @@ func handleConfigLoadCmd(
 			Uri:           *uri,
 			Signature:     *signature,
 			HashAlgorithm: finalHashAlgorithm,
+			ProvenanceUri: *provenanceURI,
 		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeoutInSeconds*time.Second)
@@ -72,12 +78,12 @@ func handleConfigLoadCmd(
 
 		client, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
@@ -87,14 +93,10 @@ func handleConfigLoadCmd(
 
 		resp, err := client.LoadConfig(ctx, request)
 		if err != nil {
-			return fmt.Errorf("error performing config load: %v", err)
+			return fmt.Errorf("error performing config load: %w", err)
 		}
-		if resp.StatusCode != 200 || resp.Error != "" {
-			return fmt.Errorf("config load failed: %s", resp.Error)
-		}
-
-		fmt.Printf("CONFIG LOAD Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
-		return nil
+		fmt.Fprintf(textOut, "CONFIG LOAD Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		return finishResponse("config load", resp)
 	}
 }
 
@@ -122,7 +124,7 @@ func handleConfigGetCmd(
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Println("CONFIG GET command invoked.")
+		fmt.Fprintln(textOut, "CONFIG GET command invoked.")
 
 		if path == nil || *path == "" {
 			return errors.New("path is required")
@@ -137,12 +139,12 @@ func handleConfigGetCmd(
 
 		client, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
@@ -152,11 +154,11 @@ func handleConfigGetCmd(
 
 		resp, err := client.GetConfig(ctx, request)
 		if err != nil {
-			return fmt.Errorf("error performing config get: %v", err)
+			return fmt.Errorf("error performing config get: %w", err)
 		}
 
-		fmt.Printf("CONFIG GET Response: %d-%s, value: %s\n", resp.GetStatusCode(), resp.GetError(), resp.GetValue())
-		return nil
+		fmt.Fprintf(textOut, "CONFIG GET Response: %d-%s, value: %s\n", resp.GetStatusCode(), resp.GetError(), resp.GetValue())
+		return finishResponse("config get", resp)
 	}
 }
 
@@ -164,15 +166,18 @@ func handleConfigGetCmd(
 func ConfigSetCmd() *cobra.Command {
 	var socket string
 	var path string
+	var jsonPatch string
 	cmd := &cobra.Command{
 		Use:   "set",
 		Short: "Set key/value pairs in configuration file",
-		RunE:  handleConfigSetCmd(&socket, &path, Dial),
+		RunE:  handleConfigSetCmd(&socket, &path, &jsonPatch, Dial),
 	}
 
 	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
 	cmd.Flags().StringVarP(&path, "path", "p", "", "Key path and value (e.g. key:value)")
-	must(cmd.MarkFlagRequired("path"))
+	cmd.Flags().StringVar(&jsonPatch, "json-patch", "", "RFC 6902 JSON Patch document to apply to the configuration file")
+	cmd.MarkFlagsOneRequired("path", "json-patch")
+	cmd.MarkFlagsMutuallyExclusive("path", "json-patch")
 
 	return cmd
 }
@@ -190,6 +195,7 @@ const (
 func handleConfigCmd(
 	socket *string,
 	path *string,
+	jsonPatch *string,
 	operation configOperation,
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
@@ -204,15 +210,28 @@ func handleConfigCmd(
 			operationName = "REMOVE"
 		}
 
-		fmt.Printf("CONFIG %s command invoked.\n", operationName)
+		fmt.Fprintf(textOut, "CONFIG %s command invoked.\n", operationName)
 
-		if path == nil || *path == "" {
-			return errors.New("path is required")
+		patch := ""
+		if jsonPatch != nil {
+			patch = strings.TrimSpace(*jsonPatch)
 		}
+		if patch != "" {
+			if path != nil && *path != "" {
+				return errors.New("path and json-patch are mutually exclusive")
+			}
+			if !json.Valid([]byte(patch)) {
+				return errors.New("json-patch is not valid JSON")
+			}
+		} else {
+			if path == nil || *path == "" {
+				return errors.New("path is required")
+			}
 
-		// Validate the path format (e.g., key:value)
-		if !strings.Contains(*path, ":") {
-			return fmt.Errorf("path must be in the format 'key:value', got: %s", *path)
+			// Validate the path format (e.g., key:value)
+			if !strings.Contains(*path, ":") {
+				return fmt.Errorf("path must be in the format 'key:value', got: %s", *path)
+			}
 		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeoutInSeconds*time.Second)
@@ -220,12 +239,12 @@ func handleConfigCmd(
 
 		client, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
@@ -236,7 +255,7 @@ func handleConfigCmd(
 		var resp *pb.ConfigResponse
 		switch operation {
 		case configSet:
-			resp, err = client.SetConfig(ctx, &pb.SetConfigRequest{Path: *path})
+			resp, err = client.SetConfig(ctx, &pb.SetConfigRequest{Path: *path, JsonPatch: patch})
 		case configAppend:
 			resp, err = client.AppendConfig(ctx, &pb.AppendConfigRequest{Path: *path})
 		case configRemove:
@@ -247,8 +266,8 @@ func handleConfigCmd(
 			return fmt.Errorf("error performing config %s: %v", strings.ToLower(operationName), err)
 		}
 
-		fmt.Printf("CONFIG %s Response: %d-%s\n", operationName, resp.GetStatusCode(), resp.GetError())
-		return nil
+		fmt.Fprintf(textOut, "CONFIG %s Response: %d-%s\n", operationName, resp.GetStatusCode(), resp.GetError())
+		return finishResponse("config "+strings.ToLower(operationName), resp)
 	}
 }
 
@@ -256,9 +275,10 @@ func handleConfigCmd(
 func handleConfigSetCmd(
 	socket *string,
 	path *string,
+	jsonPatch *string,
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
-	return handleConfigCmd(socket, path, configSet, dialer)
+	return handleConfigCmd(socket, path, jsonPatch, configSet, dialer)
 }
 
 // ConfigAppendCmd returns the 'append' subcommand.
@@ -284,7 +304,7 @@ func handleConfigAppendCmd(
 	path *string,
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
-	return handleConfigCmd(socket, path, configAppend, dialer)
+	return handleConfigCmd(socket, path, nil, configAppend, dialer)
 }
 
 // ConfigRemoveCmd returns the 'remove' subcommand.
@@ -310,5 +330,5 @@ func handleConfigRemoveCmd(
 	path *string,
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
-	return handleConfigCmd(socket, path, configRemove, dialer)
+	return handleConfigCmd(socket, path, nil, configRemove, dialer)
 }
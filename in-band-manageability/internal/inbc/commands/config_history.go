@@ -0,0 +1,227 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// ConfigCmd returns a cobra command for the configuration history commands
+func ConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Shows and restores revisions of the configuration file",
+		Long:  "Config command is used to list, compare and roll back the revisions of the configuration file.",
+	}
+
+	cmd.AddCommand(ConfigHistoryCmd())
+	cmd.AddCommand(ConfigDiffCmd())
+	cmd.AddCommand(ConfigRollbackCmd())
+
+	return cmd
+}
+
+// ConfigHistoryCmd returns the 'config history' subcommand.
+func ConfigHistoryCmd() *cobra.Command {
+	var socket string
+	var limit int32
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List the revisions of the configuration file",
+		RunE:  handleConfigHistoryCmd(&socket, &limit, Dial),
+	}
+
+	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+	cmd.Flags().Int32VarP(&limit, "limit", "l", 0, "Number of revisions to list, newest first (0 lists all)")
+
+	return cmd
+}
+
+// handleConfigHistoryCmd is a helper function to handle the ConfigHistoryCmd
+func handleConfigHistoryCmd(
+	socket *string,
+	limit *int32,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fmt.Fprintln(textOut, "CONFIG HISTORY command invoked.")
+
+		if *limit < 0 {
+			return errors.New("limit must not be negative")
+		}
+
+		client, closeConn, err := dialConfigClient(*socket, dialer)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		ctx, cancel := context.WithTimeout(context.Background(), configTimeoutInSeconds*time.Second)
+		defer cancel()
+
+		resp, err := client.GetConfigHistory(ctx, &pb.GetConfigHistoryRequest{Limit: *limit})
+		if err != nil {
+			return fmt.Errorf("error performing config history: %w", err)
+		}
+
+		fmt.Fprintf(textOut, "CONFIG HISTORY Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		for _, r := range resp.GetRevisions() {
+			timestamp := ""
+			if r.GetTime() != nil {
+				timestamp = r.GetTime().AsTime().Local().Format(time.RFC3339)
+			}
+			fmt.Fprintf(textOut, "  %4d  %s  %-10s  %-8s  %s\n", r.GetRevision(), timestamp, r.GetCaller(), r.GetOperation(), r.GetSummary())
+		}
+		return finishResponse("config history", resp)
+	}
+}
+
+// ConfigDiffCmd returns the 'config diff' subcommand.
+func ConfigDiffCmd() *cobra.Command {
+	var socket string
+	var from int32
+	var to int32
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the changes between two revisions of the configuration file",
+		RunE:  handleConfigDiffCmd(&socket, &from, &to, Dial),
+	}
+
+	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+	cmd.Flags().Int32Var(&from, "from", 0, "Revision to compare from (default: the revision before --to)")
+	cmd.Flags().Int32Var(&to, "to", 0, "Revision to compare to (default: the latest revision)")
+
+	return cmd
+}
+
+// handleConfigDiffCmd is a helper function to handle the ConfigDiffCmd
+func handleConfigDiffCmd(
+	socket *string,
+	from *int32,
+	to *int32,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fmt.Fprintln(textOut, "CONFIG DIFF command invoked.")
+
+		if *from < 0 || *to < 0 {
+			return errors.New("revisions must not be negative")
+		}
+
+		client, closeConn, err := dialConfigClient(*socket, dialer)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		ctx, cancel := context.WithTimeout(context.Background(), configTimeoutInSeconds*time.Second)
+		defer cancel()
+
+		resp, err := client.DiffConfig(ctx, &pb.DiffConfigRequest{FromRevision: *from, ToRevision: *to})
+		if err != nil {
+			return fmt.Errorf("error performing config diff: %w", err)
+		}
+
+		fmt.Fprintf(textOut, "CONFIG DIFF Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		if resp.GetStatusCode() != 200 {
+			return finishResponse("config diff", resp)
+		}
+		fmt.Fprintf(textOut, "Revision %d -> %d\n", resp.GetFromRevision(), resp.GetToRevision())
+		if len(resp.GetChanges()) == 0 {
+			fmt.Fprintln(textOut, "  No changes")
+		}
+		for _, c := range resp.GetChanges() {
+			switch {
+			case c.GetOldValue() == "":
+				fmt.Fprintf(textOut, "  + %s: %s\n", c.GetPath(), c.GetNewValue())
+			case c.GetNewValue() == "":
+				fmt.Fprintf(textOut, "  - %s: %s\n", c.GetPath(), c.GetOldValue())
+			default:
+				fmt.Fprintf(textOut, "  ~ %s: %s -> %s\n", c.GetPath(), c.GetOldValue(), c.GetNewValue())
+			}
+		}
+		return finishResponse("config diff", resp)
+	}
+}
+
+// ConfigRollbackCmd returns the 'config rollback' subcommand.
+func ConfigRollbackCmd() *cobra.Command {
+	var socket string
+	var revision int32
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Restore a revision of the configuration file",
+		RunE:  handleConfigRollbackCmd(&socket, &revision, Dial),
+	}
+
+	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+	cmd.Flags().Int32VarP(&revision, "revision", "r", 0, "Revision to restore")
+	must(cmd.MarkFlagRequired("revision"))
+
+	return cmd
+}
+
+// handleConfigRollbackCmd is a helper function to handle the ConfigRollbackCmd
+func handleConfigRollbackCmd(
+	socket *string,
+	revision *int32,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fmt.Fprintln(textOut, "CONFIG ROLLBACK command invoked.")
+
+		if *revision <= 0 {
+			return errors.New("revision must be a positive number")
+		}
+
+		client, closeConn, err := dialConfigClient(*socket, dialer)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		ctx, cancel := context.WithTimeout(context.Background(), configTimeoutInSeconds*time.Second)
+		defer cancel()
+
+		resp, err := client.RollbackConfig(ctx, &pb.RollbackConfigRequest{Revision: *revision})
+		if err != nil {
+			return fmt.Errorf("error performing config rollback: %w", err)
+		}
+
+		fmt.Fprintf(textOut, "CONFIG ROLLBACK Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		return finishResponse("config rollback", resp)
+	}
+}
+
+// dialConfigClient connects to INBD and returns the client and a function that closes the connection.
+func dialConfigClient(
+	socket string,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) (pb.InbServiceClient, func(), error) {
+	ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeoutInSeconds*time.Second)
+	defer cancel()
+
+	client, conn, err := dialer(ctx, socket)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error setting up new gRPC client: %w", err)
+	}
+	return client, func() {
+		if c, ok := conn.(*grpc.ClientConn); ok {
+			if err := c.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
+			}
+		}
+	}, nil
+}
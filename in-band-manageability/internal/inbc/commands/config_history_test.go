@@ -0,0 +1,146 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestConfigCmd_Subcommands(t *testing.T) {
+	cmd := ConfigCmd()
+	names := []string{}
+	for _, c := range cmd.Commands() {
+		names = append(names, c.Name())
+	}
+	assert.ElementsMatch(t, []string{"history", "diff", "rollback"}, names)
+}
+
+func TestHandleConfigHistoryCmd_Success(t *testing.T) {
+	socket := "/tmp/test.sock"
+	limit := int32(5)
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("GetConfigHistory", mock.Anything, &pb.GetConfigHistoryRequest{Limit: 5}, mock.Anything).
+		Return(&pb.GetConfigHistoryResponse{StatusCode: 200, Revisions: []*pb.ConfigRevision{
+			{Revision: 2, Time: timestamppb.Now(), Caller: "uid 0", Operation: "set", Summary: "set os_updater.maxCacheSize:20"},
+		}}, nil)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return mockClient, &MockClientConn{}, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleConfigHistoryCmd(&socket, &limit, dialer)(cmd, []string{})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleConfigHistoryCmd_NegativeLimit(t *testing.T) {
+	socket := "/tmp/test.sock"
+	limit := int32(-1)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return nil, nil, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleConfigHistoryCmd(&socket, &limit, dialer)(cmd, []string{})
+	assert.ErrorContains(t, err, "limit must not be negative")
+}
+
+func TestHandleConfigDiffCmd_Success(t *testing.T) {
+	socket := "/tmp/test.sock"
+	from := int32(1)
+	to := int32(0)
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("DiffConfig", mock.Anything, &pb.DiffConfigRequest{FromRevision: 1}, mock.Anything).
+		Return(&pb.DiffConfigResponse{StatusCode: 200, FromRevision: 1, ToRevision: 3, Changes: []*pb.ConfigChange{
+			{Path: "os_updater.maxCacheSize", OldValue: "10", NewValue: "20"},
+			{Path: "os_updater.proceedWithoutRollback", NewValue: "true"},
+		}}, nil)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return mockClient, &MockClientConn{}, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleConfigDiffCmd(&socket, &from, &to, dialer)(cmd, []string{})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleConfigDiffCmd_GrpcError(t *testing.T) {
+	socket := "/tmp/test.sock"
+	from := int32(0)
+	to := int32(0)
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("DiffConfig", mock.Anything, mock.Anything, mock.Anything).
+		Return(&pb.DiffConfigResponse{}, errors.New("grpc error"))
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return mockClient, &MockClientConn{}, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleConfigDiffCmd(&socket, &from, &to, dialer)(cmd, []string{})
+	assert.ErrorContains(t, err, "error performing config diff")
+}
+
+func TestHandleConfigRollbackCmd_Success(t *testing.T) {
+	socket := "/tmp/test.sock"
+	revision := int32(3)
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("RollbackConfig", mock.Anything, &pb.RollbackConfigRequest{Revision: 3}, mock.Anything).
+		Return(&pb.ConfigResponse{StatusCode: 200, Success: true}, nil)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return mockClient, &MockClientConn{}, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleConfigRollbackCmd(&socket, &revision, dialer)(cmd, []string{})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleConfigRollbackCmd_InvalidRevision(t *testing.T) {
+	socket := "/tmp/test.sock"
+	revision := int32(0)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return nil, nil, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleConfigRollbackCmd(&socket, &revision, dialer)(cmd, []string{})
+	assert.ErrorContains(t, err, "revision must be a positive number")
+}
+
+func TestHandleConfigRollbackCmd_DialError(t *testing.T) {
+	socket := "/tmp/test.sock"
+	revision := int32(1)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return nil, nil, errors.New("dial error")
+	}
+
+	cmd := &cobra.Command{}
+	err := handleConfigRollbackCmd(&socket, &revision, dialer)(cmd, []string{})
+	assert.ErrorContains(t, err, "error setting up new gRPC client")
+}
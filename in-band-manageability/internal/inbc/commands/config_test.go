@@ -32,8 +32,9 @@ func TestHandleConfigLoadCmd_Success(t *testing.T) {
 	}
 
 	var hashAlgorithm string
+	var provenanceURI string
 	cmd := &cobra.Command{}
-	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, dialer)(cmd, []string{})
 	assert.NoError(t, err)
 	mockClient.AssertCalled(t, "LoadConfig", mock.Anything, mock.Anything, mock.Anything)
 }
@@ -48,8 +49,9 @@ func TestHandleConfigLoadCmd_MissingURI(t *testing.T) {
 	}
 
 	var hashAlgorithm string
+	var provenanceURI string
 	cmd := &cobra.Command{}
-	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, dialer)(cmd, []string{})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "uri is required")
 }
@@ -77,7 +79,7 @@ func TestHandleConfigSetCmd_InvalidPathFormat(t *testing.T) {
 	}
 
 	cmd := &cobra.Command{}
-	err := handleConfigSetCmd(&socket, &path, dialer)(cmd, []string{})
+	err := handleConfigSetCmd(&socket, &path, nil, dialer)(cmd, []string{})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "path must be in the format 'key:value'")
 }
@@ -119,7 +121,7 @@ func TestHandleConfigSetCmd_MissingPath(t *testing.T) {
 	}
 
 	cmd := &cobra.Command{}
-	err := handleConfigSetCmd(&socket, &path, dialer)(cmd, []string{})
+	err := handleConfigSetCmd(&socket, &path, nil, dialer)(cmd, []string{})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "path is required")
 }
@@ -162,8 +164,9 @@ func TestHandleConfigLoadCmd_DialError(t *testing.T) {
 	}
 
 	var hashAlgorithm string
+	var provenanceURI string
 	cmd := &cobra.Command{}
-	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, dialer)(cmd, []string{})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "mock dial error")
 }
@@ -182,8 +185,9 @@ func TestHandleConfigLoadCmd_GrpcError(t *testing.T) {
 	}
 
 	var hashAlgorithm string
+	var provenanceURI string
 	cmd := &cobra.Command{}
-	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, dialer)(cmd, []string{})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "grpc error")
 }
@@ -237,7 +241,7 @@ func TestHandleConfigSetCmd_Success(t *testing.T) {
 	}
 
 	cmd := &cobra.Command{}
-	err := handleConfigSetCmd(&socket, &path, dialer)(cmd, []string{})
+	err := handleConfigSetCmd(&socket, &path, nil, dialer)(cmd, []string{})
 	assert.NoError(t, err)
 	mockClient.AssertCalled(t, "SetConfig", mock.Anything, mock.Anything, mock.Anything)
 }
@@ -255,7 +259,7 @@ func TestHandleConfigSetCmd_GrpcError(t *testing.T) {
 	}
 
 	cmd := &cobra.Command{}
-	err := handleConfigSetCmd(&socket, &path, dialer)(cmd, []string{})
+	err := handleConfigSetCmd(&socket, &path, nil, dialer)(cmd, []string{})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "grpc error")
 }
@@ -345,7 +349,7 @@ func TestHandleConfigSetCmd_MultiKeyValue(t *testing.T) {
 	}
 
 	cmd := &cobra.Command{}
-	err := handleConfigSetCmd(&socket, &path, dialer)(cmd, []string{})
+	err := handleConfigSetCmd(&socket, &path, nil, dialer)(cmd, []string{})
 	assert.NoError(t, err)
 	mockClient.AssertCalled(t, "SetConfig", mock.Anything, mock.Anything, mock.Anything)
 }
@@ -400,8 +404,9 @@ func TestHandleConfigLoadCmd_EmptySignature(t *testing.T) {
 	}
 
 	var hashAlgorithm string
+	var provenanceURI string
 	cmd := &cobra.Command{}
-	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, dialer)(cmd, []string{})
 	assert.NoError(t, err)
 	mockClient.AssertCalled(t, "LoadConfig", mock.Anything, mock.Anything, mock.Anything)
 }
@@ -429,6 +434,7 @@ func TestHandleConfigLoadCmd_HashAlgorithm_PassedAndUsed(t *testing.T) {
 	uri := "file:///tmp/intel_manageability.conf"
 	signature := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
 	hashAlgorithm := "sha512"
+	var provenanceURI string
 
 	mockClient := &MockInbServiceClient{}
 	mockClient.On("LoadConfig", mock.Anything, mock.MatchedBy(func(req *pb.LoadConfigRequest) bool {
@@ -441,7 +447,7 @@ func TestHandleConfigLoadCmd_HashAlgorithm_PassedAndUsed(t *testing.T) {
 	}
 
 	cmd := &cobra.Command{}
-	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, dialer)(cmd, []string{})
 	assert.NoError(t, err)
 	mockClient.AssertCalled(t, "LoadConfig", mock.Anything, mock.MatchedBy(func(req *pb.LoadConfigRequest) bool {
 		return req.HashAlgorithm == "sha512"
@@ -453,6 +459,7 @@ func TestHandleConfigLoadCmd_HashAlgorithm_Invalid(t *testing.T) {
 	uri := "file:///tmp/intel_manageability.conf"
 	signature := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
 	hashAlgorithm := "invalidalgo"
+	var provenanceURI string
 
 	mockClient := &MockInbServiceClient{}
 	mockClient.On("LoadConfig", mock.Anything, mock.Anything, mock.Anything).
@@ -463,7 +470,7 @@ func TestHandleConfigLoadCmd_HashAlgorithm_Invalid(t *testing.T) {
 	}
 
 	cmd := &cobra.Command{}
-	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, dialer)(cmd, []string{})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid hash algorithm")
 }
@@ -473,6 +480,7 @@ func TestHandleConfigLoadCmd_DefaultHashAlgorithm(t *testing.T) {
 	uri := "file:///tmp/intel_manageability.conf"
 	signature := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
 	var hashAlgorithm string // not set, should default to sha384
+	var provenanceURI string
 
 	mockClient := &MockInbServiceClient{}
 	mockClient.On("LoadConfig", mock.Anything, mock.MatchedBy(func(req *pb.LoadConfigRequest) bool {
@@ -485,9 +493,68 @@ func TestHandleConfigLoadCmd_DefaultHashAlgorithm(t *testing.T) {
 	}
 
 	cmd := &cobra.Command{}
-	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, dialer)(cmd, []string{})
 	assert.NoError(t, err)
 	mockClient.AssertCalled(t, "LoadConfig", mock.Anything, mock.MatchedBy(func(req *pb.LoadConfigRequest) bool {
 		return req.HashAlgorithm == "" || req.HashAlgorithm == "sha384"
 	}), mock.Anything)
 }
+
+func TestHandleConfigLoadCmd_ProvenanceURI(t *testing.T) {
+	socket := "/tmp/test.sock"
+	uri := "file:///tmp/intel_manageability.conf"
+	signature := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	var hashAlgorithm string
+	provenanceURI := "/tmp/intel_manageability.conf.sigstore.json"
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("LoadConfig", mock.Anything, mock.MatchedBy(func(req *pb.LoadConfigRequest) bool {
+		return req.ProvenanceUri == provenanceURI
+	}), mock.Anything).
+		Return(&pb.ConfigResponse{StatusCode: 200, Error: "", Success: true, Message: "OK"}, nil)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return mockClient, &MockClientConn{}, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleConfigLoadCmd(&socket, &uri, &signature, &hashAlgorithm, &provenanceURI, dialer)(cmd, []string{})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleConfigSetCmd_JSONPatch(t *testing.T) {
+	socket := "/tmp/test.sock"
+	path := ""
+	jsonPatch := `[{"op":"replace","path":"/os_updater/proceedWithoutRollback","value":true}]`
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("SetConfig", mock.Anything, &pb.SetConfigRequest{JsonPatch: jsonPatch}, mock.Anything).
+		Return(&pb.ConfigResponse{StatusCode: 200, Success: true}, nil)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return mockClient, &MockClientConn{}, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleConfigSetCmd(&socket, &path, &jsonPatch, dialer)(cmd, []string{})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleConfigSetCmd_JSONPatchInvalid(t *testing.T) {
+	socket := "/tmp/test.sock"
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return nil, nil, nil
+	}
+
+	path := ""
+	jsonPatch := `[{"op":`
+	err := handleConfigSetCmd(&socket, &path, &jsonPatch, dialer)(&cobra.Command{}, []string{})
+	assert.ErrorContains(t, err, "json-patch is not valid JSON")
+
+	path = "os_updater.proceedWithoutRollback:true"
+	jsonPatch = `[]`
+	err = handleConfigSetCmd(&socket, &path, &jsonPatch, dialer)(&cobra.Command{}, []string{})
+	assert.ErrorContains(t, err, "mutually exclusive")
+}
@@ -9,8 +9,11 @@ package commands
 // Context Timeouts
 const clientDialTimeoutInSeconds = 5
 const sourceTimeoutInSeconds = 15
+const signedSourceTimeoutInSeconds = 120
 const emtSoftwareUpdateTimerInSeconds = 1200
 const defaultSoftwareUpdateTimerInSeconds = 72000
 const configTimeoutInSeconds = 15
 const firmwareUpdateTimerInSeconds = 90
 const queryTimeoutInSeconds = 15
+const sbomTimeoutInSeconds = 120
+const vulnDBTimeoutInSeconds = 300
@@ -9,6 +9,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -27,31 +28,34 @@ func FOTACmd() *cobra.Command {
 	var userName string
 	var signature string
 	var hashAlgorithm string
+	var provenanceURL string
 
 	cmd := &cobra.Command{
 		Use:   "fota",
 		Short: "Performs Firmware Update",
 		Long:  `Updates the firmware on the device.`,
-		RunE:  handleFOTA(&socket, &uri, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, Dial),
+		RunE:  handleFOTA(&socket, &uri, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, &provenanceURL, Dial),
 	}
 
 	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
 	cmd.Flags().StringVar(&uri, "uri", "", "URI from which to remotely retrieve the package")
 	err := cmd.MarkFlagRequired("uri")
 	if err != nil {
-		fmt.Printf("Error marking 'uri' flag as required: %v\n", err)
+		fmt.Fprintf(textOut, "Error marking 'uri' flag as required: %v\n", err)
 		return nil
 	}
 	cmd.Flags().StringVar(&releaseDate, "releasedate", "", "Release date of the new firmware update in YYYY-MM-DD format (required)")
 	err = cmd.MarkFlagRequired("releasedate")
 	if err != nil {
-		fmt.Printf("Error marking 'releasedate' flag as required: %v\n", err)
+		fmt.Fprintf(textOut, "Error marking 'releasedate' flag as required: %v\n", err)
 		return nil
 	}
 	cmd.Flags().BoolVar(&reboot, "reboot", true, "Whether to reboot after the software update attempt")
 	cmd.Flags().StringVar(&userName, "username", "", "Username if authentication is required for the package source")
 	cmd.Flags().StringVar(&signature, "signature", "", "Signature of the package")
 	cmd.Flags().StringVar(&hashAlgorithm, "hash_algorithm", "", "Hash algorithm to use for signature verification (sha256, sha384, sha512). Default is sha384.")
+	must(cmd.RegisterFlagCompletionFunc("hash_algorithm", hashAlgorithmCompletion))
+	cmd.Flags().StringVar(&provenanceURL, "provenance-url", "", "URL of the provenance (Sigstore bundle, DSSE envelope or in-toto attestations) of the package")
 
 	return cmd
 }
@@ -65,10 +69,11 @@ func handleFOTA(
 	username *string,
 	signature *string,
 	hashAlgorithm *string,
+	provenanceURL *string,
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("FOTA INBC Command was invoked.\n")
+		fmt.Fprintf(textOut, "FOTA INBC Command was invoked.\n")
 
 		// Validate and parse the release date
 		var releaseDateProto *timestamppb.Timestamp
@@ -108,6 +113,7 @@ func handleFOTA(
 			Username:      *username,
 			Signature:     *signature,
 			HashAlgorithm: finalHashAlgorithm,
+			ProvenanceUrl: *provenanceURL,
 		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeoutInSeconds*time.Second)
@@ -115,12 +121,12 @@ func handleFOTA(
 
 		client, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
@@ -130,11 +136,11 @@ func handleFOTA(
 
 		resp, err := client.UpdateFirmware(ctx, request)
 		if err != nil {
-			return fmt.Errorf("error updating firmware: %v", err)
+			return fmt.Errorf("error updating firmware: %w", err)
 		}
 
-		fmt.Printf("FOTA Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		fmt.Fprintf(textOut, "FOTA Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
 
-		return nil
+		return finishResponse("FOTA operation", resp)
 	}
 }
@@ -55,6 +55,7 @@ func TestHandleFOTA(t *testing.T) {
 	reboot := true
 	userName := ""
 	signature := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	provenanceURL := ""
 	cmd := &cobra.Command{}
 	args := []string{}
 
@@ -70,7 +71,7 @@ func TestHandleFOTA(t *testing.T) {
 		}
 
 		var hashAlgorithm string
-		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, dialer)(cmd, args)
+		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, &provenanceURL, dialer)(cmd, args)
 		assert.NoError(t, err, "handleFOTA should not return an error")
 
 		mockClient.AssertExpectations(t)
@@ -89,7 +90,7 @@ func TestHandleFOTA(t *testing.T) {
 		}
 
 		var hashAlgorithm string
-		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &validSignature, &hashAlgorithm, dialer)(cmd, args)
+		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &validSignature, &hashAlgorithm, &provenanceURL, dialer)(cmd, args)
 		assert.NoError(t, err, "handleFOTA should not return an error for valid signature")
 		mockClient.AssertExpectations(t)
 	})
@@ -102,7 +103,7 @@ func TestHandleFOTA(t *testing.T) {
 
 		var hashAlgorithm string
 		err := handleFOTA(&socket, &url, &invalidReleaseDate,
-			&reboot, &userName, &signature, &hashAlgorithm, dialer)(cmd, args)
+			&reboot, &userName, &signature, &hashAlgorithm, &provenanceURL, dialer)(cmd, args)
 		assert.Error(t, err, "error parsing release date: parsing time")
 	})
 
@@ -114,7 +115,7 @@ func TestHandleFOTA(t *testing.T) {
 
 		var hashAlgorithm string
 		err := handleFOTA(&socket, &url, &releaseDate,
-			&reboot, &userName, &signature, &hashAlgorithm, dialer)(cmd, args)
+			&reboot, &userName, &signature, &hashAlgorithm, &provenanceURL, dialer)(cmd, args)
 		assert.Error(t, err, "error setting up new gRPC client")
 	})
 
@@ -127,7 +128,7 @@ func TestHandleFOTA(t *testing.T) {
 		}
 
 		var hashAlgorithm string
-		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, dialer)(cmd, args)
+		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, &provenanceURL, dialer)(cmd, args)
 		assert.Error(t, err, "error updating firmware")
 	})
 
@@ -143,7 +144,7 @@ func TestHandleFOTA(t *testing.T) {
 		}
 
 		var hashAlgorithm string
-		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, dialer)(cmd, args)
+		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, &provenanceURL, dialer)(cmd, args)
 		assert.NoError(t, err, "handleFOTA should not return an error even if Close fails")
 	})
 
@@ -161,7 +162,7 @@ func TestHandleFOTA(t *testing.T) {
 		}
 
 		hashAlgorithm := "sha512"
-		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, dialer)(cmd, args)
+		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, &provenanceURL, dialer)(cmd, args)
 		assert.NoError(t, err, "handleFOTA should not return an error for valid hash algorithm")
 		mockClient.AssertExpectations(t)
 	})
@@ -173,7 +174,7 @@ func TestHandleFOTA(t *testing.T) {
 		}
 
 		hashAlgorithm := "md5"
-		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, dialer)(cmd, args)
+		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, &provenanceURL, dialer)(cmd, args)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid hash algorithm")
 	})
@@ -192,8 +193,28 @@ func TestHandleFOTA(t *testing.T) {
 		}
 
 		var hashAlgorithm string // empty
-		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, dialer)(cmd, args)
+		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, &provenanceURL, dialer)(cmd, args)
 		assert.NoError(t, err, "handleFOTA should default to sha384 when hashAlgorithm is empty")
 		mockClient.AssertExpectations(t)
 	})
+
+	t.Run("provenance URL is passed to inbd", func(t *testing.T) {
+		bundleURL := "https://example.com/firmware.bin.sigstore.json"
+		mockClient := new(MockInbServiceClient)
+		mockClient.On("UpdateFirmware", mock.Anything, mock.MatchedBy(func(req *pb.UpdateFirmwareRequest) bool {
+			return req.ProvenanceUrl == bundleURL
+		}), mock.Anything).Return(&pb.UpdateResponse{
+			StatusCode: 200,
+			Error:      "",
+		}, nil)
+
+		dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+			return MockDialer(ctx, socket, mockClient, false)
+		}
+
+		var hashAlgorithm string
+		err := handleFOTA(&socket, &url, &releaseDate, &reboot, &userName, &signature, &hashAlgorithm, &bundleURL, dialer)(cmd, args)
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
 }
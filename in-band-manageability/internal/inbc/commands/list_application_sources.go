@@ -0,0 +1,91 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// ListApplicationSourcesCmd returns a cobra command for the ListApplicationSources command
+func ListApplicationSourcesCmd() *cobra.Command {
+	var socket string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the application sources",
+		Long:  "List command is used to list the sources of the files under /etc/apt/sources.list.d/, in the deb822 and one-line formats.",
+		RunE:  handleListApplicationSources(&socket, Dial),
+	}
+
+	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+
+	return cmd
+}
+
+// handleListApplicationSources is a helper function to handle the ListApplicationSources command
+func handleListApplicationSources(
+	socket *string,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fmt.Fprintf(textOut, "SOURCE APPLICATION LIST INBC Command was invoked.\n")
+
+		client, closeConn, err := dialConfigClient(*socket, dialer)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		ctx, cancel := context.WithTimeout(context.Background(), sourceTimeoutInSeconds*time.Second)
+		defer cancel()
+
+		resp, err := client.ListApplicationSources(ctx, &pb.ListApplicationSourcesRequest{})
+		if err != nil {
+			return fmt.Errorf("error listing application sources: %w", err)
+		}
+
+		fmt.Fprintf(textOut, "SOURCE APPLICATION LIST Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		displayApplicationSources(resp.GetSources())
+
+		return finishResponse("source application list", resp)
+	}
+}
+
+// displayApplicationSources displays the application sources in the fields of the deb822 format.
+func displayApplicationSources(sources []*pb.ApplicationSource) {
+	for _, src := range sources {
+		fmt.Fprintf(textOut, "\n%s", src.GetFilename())
+		if !src.GetEnabled() {
+			fmt.Fprint(textOut, " (disabled)")
+		}
+		fmt.Fprintln(textOut)
+		fields := []struct {
+			name   string
+			values []string
+		}{
+			{"Types", src.GetTypes()},
+			{"URIs", src.GetUris()},
+			{"Suites", src.GetSuites()},
+			{"Components", src.GetComponents()},
+			{"Architectures", src.GetArchitectures()},
+			{"Signed-By", []string{src.GetSignedBy()}},
+			{"Key-Fingerprint", []string{src.GetKeyFingerprint()}},
+		}
+		for _, field := range fields {
+			if value := strings.Join(field.values, " "); value != "" {
+				fmt.Fprintf(textOut, "  %s: %s\n", field.name, value)
+			}
+		}
+	}
+}
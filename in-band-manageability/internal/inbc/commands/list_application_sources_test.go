@@ -0,0 +1,79 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListApplicationSourcesCmd(t *testing.T) {
+	cmd := ListApplicationSourcesCmd()
+	assert.Equal(t, "list", cmd.Use)
+
+	socket, err := cmd.Flags().GetString("socket")
+	assert.NoError(t, err)
+	assert.Equal(t, "/var/run/inbd.sock", socket)
+}
+
+func TestHandleListApplicationSources(t *testing.T) {
+	socket := "/tmp/test.sock"
+
+	t.Run("success", func(t *testing.T) {
+		var buf bytes.Buffer
+		original := textOut
+		textOut = &buf
+		defer func() { textOut = original }()
+
+		mockClient := &MockInbServiceClient{}
+		mockClient.On("ListApplicationSources", mock.Anything, &pb.ListApplicationSourcesRequest{}, mock.Anything).
+			Return(&pb.ListApplicationSourcesResponse{StatusCode: 200, Error: "Success", Sources: []*pb.ApplicationSource{
+				{
+					Name:           "example",
+					Filename:       "example.sources",
+					Types:          []string{"deb"},
+					Uris:           []string{"https://repo.example.com/apt"},
+					Suites:         []string{"noble"},
+					Components:     []string{"main"},
+					SignedBy:       "/usr/share/keyrings/example.gpg",
+					KeyFingerprint: "0123456789ABCDEF0123456789ABCDEF01234567",
+					Enabled:        true,
+				},
+				{Name: "old", Filename: "old.list", Types: []string{"deb"}, Uris: []string{"http://old.example.com"}, Suites: []string{"jammy"}},
+			}}, nil)
+
+		err := handleListApplicationSources(&socket, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "example.sources\n  Types: deb\n  URIs: https://repo.example.com/apt\n  Suites: noble\n  Components: main\n  Signed-By: /usr/share/keyrings/example.gpg\n  Key-Fingerprint: 0123456789ABCDEF0123456789ABCDEF01234567\n")
+		assert.Contains(t, buf.String(), "old.list (disabled)\n  Types: deb\n")
+		assert.NotContains(t, buf.String(), "Architectures")
+	})
+
+	t.Run("unsupported OS", func(t *testing.T) {
+		mockClient := &MockInbServiceClient{}
+		mockClient.On("ListApplicationSources", mock.Anything, mock.Anything, mock.Anything).
+			Return(&pb.ListApplicationSourcesResponse{StatusCode: 415, Error: "Unsupported OS.  List Application Sources is only for Ubuntu."}, nil)
+
+		err := handleListApplicationSources(&socket, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+		assert.Equal(t, ExitBadRequest, ExitCode(err))
+	})
+
+	t.Run("gRPC error", func(t *testing.T) {
+		mockClient := &MockInbServiceClient{}
+		mockClient.On("ListApplicationSources", mock.Anything, mock.Anything, mock.Anything).
+			Return(&pb.ListApplicationSourcesResponse{}, errors.New("connection refused"))
+
+		err := handleListApplicationSources(&socket, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+		assert.ErrorContains(t, err, "error listing application sources")
+	})
+}
@@ -9,6 +9,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"io"
 
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
 	"github.com/stretchr/testify/mock"
@@ -32,6 +33,24 @@ func (m *MockInbServiceClient) RemoveApplicationSource(ctx context.Context, req
 	return args.Get(0).(*pb.UpdateResponse), args.Error(1)
 }
 
+// ListApplicationSources is a mock implementation of the ListApplicationSources function.
+func (m *MockInbServiceClient) ListApplicationSources(ctx context.Context, req *pb.ListApplicationSourcesRequest, opts ...grpc.CallOption) (*pb.ListApplicationSourcesResponse, error) {
+	args := m.Called(ctx, req, opts)
+	return args.Get(0).(*pb.ListApplicationSourcesResponse), args.Error(1)
+}
+
+// ListStagedArtifacts is a mock implementation of the ListStagedArtifacts function.
+func (m *MockInbServiceClient) ListStagedArtifacts(ctx context.Context, req *pb.ListStagedArtifactsRequest, opts ...grpc.CallOption) (*pb.ListStagedArtifactsResponse, error) {
+	args := m.Called(ctx, req, opts)
+	return args.Get(0).(*pb.ListStagedArtifactsResponse), args.Error(1)
+}
+
+// DeleteStagedArtifact is a mock implementation of the DeleteStagedArtifact function.
+func (m *MockInbServiceClient) DeleteStagedArtifact(ctx context.Context, req *pb.DeleteStagedArtifactRequest, opts ...grpc.CallOption) (*pb.DeleteStagedArtifactResponse, error) {
+	args := m.Called(ctx, req, opts)
+	return args.Get(0).(*pb.DeleteStagedArtifactResponse), args.Error(1)
+}
+
 // UpdateOSSource is a mock implementation of the UpdateOSSource function.
 func (m *MockInbServiceClient) UpdateOSSource(ctx context.Context, req *pb.UpdateOSSourceRequest, opts ...grpc.CallOption) (*pb.UpdateResponse, error) {
 	args := m.Called(ctx, req, opts)
@@ -74,6 +93,24 @@ func (m *MockInbServiceClient) RemoveConfig(ctx context.Context, req *pb.RemoveC
 	return args.Get(0).(*pb.ConfigResponse), args.Error(1)
 }
 
+// GetConfigHistory is a mock implementation of the GetConfigHistory function.
+func (m *MockInbServiceClient) GetConfigHistory(ctx context.Context, req *pb.GetConfigHistoryRequest, opts ...grpc.CallOption) (*pb.GetConfigHistoryResponse, error) {
+	args := m.Called(ctx, req, opts)
+	return args.Get(0).(*pb.GetConfigHistoryResponse), args.Error(1)
+}
+
+// DiffConfig is a mock implementation of the DiffConfig function.
+func (m *MockInbServiceClient) DiffConfig(ctx context.Context, req *pb.DiffConfigRequest, opts ...grpc.CallOption) (*pb.DiffConfigResponse, error) {
+	args := m.Called(ctx, req, opts)
+	return args.Get(0).(*pb.DiffConfigResponse), args.Error(1)
+}
+
+// RollbackConfig is a mock implementation of the RollbackConfig function.
+func (m *MockInbServiceClient) RollbackConfig(ctx context.Context, req *pb.RollbackConfigRequest, opts ...grpc.CallOption) (*pb.ConfigResponse, error) {
+	args := m.Called(ctx, req, opts)
+	return args.Get(0).(*pb.ConfigResponse), args.Error(1)
+}
+
 // UpdateFirmware is a mock implementation of the UpdateFirmware function.
 func (m *MockInbServiceClient) UpdateFirmware(ctx context.Context, req *pb.UpdateFirmwareRequest, opts ...grpc.CallOption) (*pb.UpdateResponse, error) {
 	args := m.Called(ctx, req, opts)
@@ -92,6 +129,46 @@ func (m *MockInbServiceClient) SetPowerState(ctx context.Context, req *pb.SetPow
 	return args.Get(0).(*pb.SetPowerStateResponse), args.Error(1)
 }
 
+// CancelPowerAction is a mock implementation of the CancelPowerAction function.
+func (m *MockInbServiceClient) CancelPowerAction(ctx context.Context, req *pb.CancelPowerActionRequest, opts ...grpc.CallOption) (*pb.CancelPowerActionResponse, error) {
+	args := m.Called(ctx, req, opts)
+	return args.Get(0).(*pb.CancelPowerActionResponse), args.Error(1)
+}
+
+// StreamSoftwareBOM is a mock implementation of the StreamSoftwareBOM function.
+func (m *MockInbServiceClient) StreamSoftwareBOM(ctx context.Context, req *pb.StreamSoftwareBOMRequest, opts ...grpc.CallOption) (pb.InbService_StreamSoftwareBOMClient, error) {
+	args := m.Called(ctx, req, opts)
+	stream, _ := args.Get(0).(pb.InbService_StreamSoftwareBOMClient)
+	return stream, args.Error(1)
+}
+
+// LoadVulnerabilityDatabase is a mock implementation of the LoadVulnerabilityDatabase function.
+func (m *MockInbServiceClient) LoadVulnerabilityDatabase(ctx context.Context, req *pb.LoadVulnerabilityDatabaseRequest, opts ...grpc.CallOption) (*pb.LoadVulnerabilityDatabaseResponse, error) {
+	args := m.Called(ctx, req, opts)
+	return args.Get(0).(*pb.LoadVulnerabilityDatabaseResponse), args.Error(1)
+}
+
+// MockSoftwareBOMStream is a mock implementation of the pb.InbService_StreamSoftwareBOMClient interface.
+// It returns the chunks, followed by io.EOF or Err.
+type MockSoftwareBOMStream struct {
+	grpc.ClientStream
+	Chunks []*pb.SoftwareBOMChunk
+	Err    error
+}
+
+// Recv is a mock implementation of the Recv function.
+func (m *MockSoftwareBOMStream) Recv() (*pb.SoftwareBOMChunk, error) {
+	if len(m.Chunks) == 0 {
+		if m.Err != nil {
+			return nil, m.Err
+		}
+		return nil, io.EOF
+	}
+	chunk := m.Chunks[0]
+	m.Chunks = m.Chunks[1:]
+	return chunk, nil
+}
+
 // MockClientConn is a mock implementation of the grpc.ClientConnInterface interface.
 type MockClientConn struct {
 	grpc.ClientConnInterface
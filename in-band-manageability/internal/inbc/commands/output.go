@@ -0,0 +1,212 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats selected with the global --output flag.
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+)
+
+// Exit codes of inbc.  They are stable, so that scripts can rely on them.
+const (
+	ExitOK          = 0 // Success
+	ExitError       = 1 // Invalid arguments or another error in inbc
+	ExitBadRequest  = 2 // inbd rejected the request (status 4xx other than the ones below)
+	ExitDenied      = 3 // The caller is not allowed to call the RPC (status 401 or 403)
+	ExitNotFound    = 4 // The requested object does not exist (status 404)
+	ExitConflict    = 5 // The request conflicts with the state of the device (status 409)
+	ExitServerError = 6 // inbd failed to perform the request (status 5xx)
+	ExitUnavailable = 7 // inbd is not running or can not be reached
+	ExitTimeout     = 8 // inbd did not respond in time
+)
+
+// outputFormat is the output format selected with the global --output flag.
+var outputFormat = OutputTable
+
+// textOut receives the human readable output.  It is discarded in the json and yaml formats, so
+// that only the responses are written to standard output.
+var textOut io.Writer = os.Stdout
+
+// responseOut receives the responses in the json and yaml formats.  It is a variable for testing.
+var responseOut io.Writer = os.Stdout
+
+// AddOutputFlag adds the global --output flag to the root command.
+func AddOutputFlag(root *cobra.Command) {
+	root.PersistentFlags().StringVar(&outputFormat, "output", OutputTable, "Output format (table, json, yaml)")
+	must(root.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(
+		[]string{OutputTable, OutputJSON, OutputYAML}, cobra.ShellCompDirectiveNoFileComp)))
+	root.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return SetOutputFormat(outputFormat)
+	}
+}
+
+// SetOutputFormat selects the output format of the commands.
+func SetOutputFormat(format string) error {
+	switch format {
+	case OutputTable:
+		textOut = os.Stdout
+	case OutputJSON, OutputYAML:
+		textOut = io.Discard
+	default:
+		return fmt.Errorf("invalid output format '%s'. Valid formats: table, json, yaml", format)
+	}
+	outputFormat = format
+	return nil
+}
+
+// writeResponse writes a response to standard output in the json or yaml format.  In the table
+// format the commands display the response themselves, and nothing is written.  A stream of
+// responses is written as a sequence of JSON or YAML documents.
+func writeResponse(resp proto.Message) error {
+	if outputFormat == OutputTable {
+		return nil
+	}
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("failed to serialize response: %w", err)
+	}
+	if outputFormat == OutputYAML {
+		var doc yaml.Node
+		// JSON is YAML, and decoding into a node keeps the order of the fields.
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("failed to convert response to YAML: %w", err)
+		}
+		setBlockStyle(&doc)
+		var buf bytes.Buffer
+		buf.WriteString("---\n")
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(&doc); err != nil {
+			return fmt.Errorf("failed to serialize response: %w", err)
+		}
+		if err := encoder.Close(); err != nil {
+			return fmt.Errorf("failed to serialize response: %w", err)
+		}
+		data = buf.Bytes()
+	} else {
+		data = append(data, '\n')
+	}
+	_, err = responseOut.Write(data)
+	return err
+}
+
+// setBlockStyle converts the flow style of a node decoded from JSON into block style.
+func setBlockStyle(node *yaml.Node) {
+	node.Style &^= yaml.FlowStyle
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!str" {
+		// Keep strings quoted only where YAML requires it.
+		node.Style &^= yaml.DoubleQuotedStyle
+	}
+	for _, child := range node.Content {
+		setBlockStyle(child)
+	}
+}
+
+// statusResponse is a response of inbd with a status code.
+type statusResponse interface {
+	proto.Message
+	GetStatusCode() int32
+	GetError() string
+}
+
+// finishResponse writes a response in the json or yaml format, and returns a StatusError if its
+// status code is not 200.
+func finishResponse(operation string, resp statusResponse) error {
+	if err := writeResponse(resp); err != nil {
+		return err
+	}
+	return checkStatus(operation, resp.GetStatusCode(), resp.GetError())
+}
+
+// StatusError is returned by a command when inbd responds with a status code other than 200.
+type StatusError struct {
+	Operation  string
+	StatusCode int32
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s failed: %d-%s", e.Operation, e.StatusCode, e.Message)
+}
+
+// checkStatus returns a StatusError if a response has a status code other than 200.
+func checkStatus(operation string, statusCode int32, message string) error {
+	if statusCode == 200 {
+		return nil
+	}
+	return &StatusError{Operation: operation, StatusCode: statusCode, Message: message}
+}
+
+// ExitCode returns the exit code of inbc for the error returned by a command.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return exitCodeForStatus(statusErr.StatusCode)
+	}
+	if s, ok := status.FromError(err); ok && s.Code() != codes.OK {
+		return exitCodeForGRPCCode(s.Code())
+	}
+	return ExitError
+}
+
+// exitCodeForStatus maps a status code of an inbd response to an exit code.
+func exitCodeForStatus(statusCode int32) int {
+	switch {
+	case statusCode == 200:
+		return ExitOK
+	case statusCode == 401 || statusCode == 403:
+		return ExitDenied
+	case statusCode == 404:
+		return ExitNotFound
+	case statusCode == 409:
+		return ExitConflict
+	case statusCode >= 400 && statusCode < 500:
+		return ExitBadRequest
+	default:
+		return ExitServerError
+	}
+}
+
+// exitCodeForGRPCCode maps the code of a failed RPC to an exit code.
+func exitCodeForGRPCCode(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return ExitBadRequest
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return ExitDenied
+	case codes.NotFound:
+		return ExitNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return ExitConflict
+	case codes.Unavailable:
+		return ExitUnavailable
+	case codes.DeadlineExceeded:
+		return ExitTimeout
+	default:
+		return ExitServerError
+	}
+}
@@ -0,0 +1,155 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// captureResponses selects an output format and returns the buffer the responses are written to.
+func captureResponses(t *testing.T, format string) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	originalOut := responseOut
+	t.Cleanup(func() {
+		responseOut = originalOut
+		require.NoError(t, SetOutputFormat(OutputTable))
+	})
+	responseOut = &buf
+	require.NoError(t, SetOutputFormat(format))
+	return &buf
+}
+
+func TestSetOutputFormat(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, SetOutputFormat(OutputTable)) })
+
+	require.NoError(t, SetOutputFormat(OutputJSON))
+	assert.Equal(t, io.Discard, textOut)
+
+	require.NoError(t, SetOutputFormat(OutputTable))
+	assert.Equal(t, os.Stdout, textOut)
+
+	err := SetOutputFormat("xml")
+	assert.EqualError(t, err, "invalid output format 'xml'. Valid formats: table, json, yaml")
+	assert.Equal(t, OutputTable, outputFormat)
+}
+
+func TestAddOutputFlag(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, SetOutputFormat(OutputTable)) })
+
+	root := &cobra.Command{Use: "inbc"}
+	AddOutputFlag(root)
+	flag := root.PersistentFlags().Lookup("output")
+	require.NotNil(t, flag)
+	assert.Equal(t, OutputTable, flag.DefValue)
+
+	require.NoError(t, root.PersistentFlags().Set("output", "yaml"))
+	require.NoError(t, root.PersistentPreRunE(root, nil))
+	assert.Equal(t, OutputYAML, outputFormat)
+}
+
+func TestWriteResponse(t *testing.T) {
+	resp := &pb.UpdateResponse{StatusCode: 200, Error: ""}
+
+	t.Run("table", func(t *testing.T) {
+		buf := captureResponses(t, OutputTable)
+		require.NoError(t, writeResponse(resp))
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		buf := captureResponses(t, OutputJSON)
+		require.NoError(t, writeResponse(&pb.UpdateResponse{StatusCode: 404, Error: "not found"}))
+		assert.JSONEq(t, `{"status_code": 404, "error": "not found"}`, buf.String())
+		assert.True(t, bytes.HasSuffix(buf.Bytes(), []byte("}\n")))
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		buf := captureResponses(t, OutputYAML)
+		require.NoError(t, writeResponse(&pb.QueryResponse{
+			StatusCode: 200,
+			Success:    true,
+			Data: &pb.QueryData{
+				Type:   "version",
+				Values: &pb.QueryData_Version{Version: &pb.VersionInfo{Version: "5.0.0"}},
+			},
+		}))
+		assert.Equal(t, `---
+status_code: 200
+success: true
+data:
+  type: version
+  version:
+    version: 5.0.0
+`, buf.String())
+	})
+}
+
+func TestFinishResponse(t *testing.T) {
+	buf := captureResponses(t, OutputJSON)
+
+	require.NoError(t, finishResponse("restart", &pb.SetPowerStateResponse{StatusCode: 200}))
+	err := finishResponse("restart", &pb.SetPowerStateResponse{StatusCode: 409, Error: "update in progress"})
+	assert.EqualError(t, err, "restart failed: 409-update in progress")
+	assert.Equal(t, ExitConflict, ExitCode(err))
+	assert.Equal(t, 2, bytes.Count(buf.Bytes(), []byte("status_code")))
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"no error", nil, ExitOK},
+		{"plain error", fmt.Errorf("invalid option"), ExitError},
+		{"bad request", &StatusError{StatusCode: 400}, ExitBadRequest},
+		{"unauthorized", &StatusError{StatusCode: 401}, ExitDenied},
+		{"forbidden", &StatusError{StatusCode: 403}, ExitDenied},
+		{"not found", &StatusError{StatusCode: 404}, ExitNotFound},
+		{"conflict", &StatusError{StatusCode: 409}, ExitConflict},
+		{"other client error", &StatusError{StatusCode: 422}, ExitBadRequest},
+		{"server error", &StatusError{StatusCode: 500}, ExitServerError},
+		{"not implemented", &StatusError{StatusCode: 501}, ExitServerError},
+		{"wrapped status", fmt.Errorf("sbom: %w", &StatusError{StatusCode: 404}), ExitNotFound},
+		{"inbd not running", fmt.Errorf("error performing query: %w", status.Error(codes.Unavailable, "connection refused")), ExitUnavailable},
+		{"deadline exceeded", fmt.Errorf("error performing query: %w", status.Error(codes.DeadlineExceeded, "timeout")), ExitTimeout},
+		{"permission denied", status.Error(codes.PermissionDenied, "denied"), ExitDenied},
+		{"invalid argument", status.Error(codes.InvalidArgument, "bad"), ExitBadRequest},
+		{"internal", status.Error(codes.Internal, "panic"), ExitServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExitCode(tt.err))
+		})
+	}
+}
+
+func TestHandleRestart_JSONOutput(t *testing.T) {
+	buf := captureResponses(t, OutputJSON)
+	socket := "/tmp/test.sock"
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("SetPowerState", mock.Anything, mock.Anything, mock.Anything).
+		Return(&pb.SetPowerStateResponse{StatusCode: 403, Error: "denied by policy"}, nil)
+
+	err := handleRestart(&socket, new(bool), &powerSchedule{}, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+	assert.Equal(t, ExitDenied, ExitCode(err))
+	assert.JSONEq(t, `{"status_code": 403, "error": "denied by policy"}`, buf.String())
+}
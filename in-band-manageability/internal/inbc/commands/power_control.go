@@ -9,41 +9,142 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// powerSchedule holds the flags that schedule a power action instead of performing it immediately.
+type powerSchedule struct {
+	delay time.Duration
+	at    string
+}
+
+// addPowerScheduleFlags adds the --delay and --at flags to a power command.
+func addPowerScheduleFlags(cmd *cobra.Command, schedule *powerSchedule) {
+	cmd.Flags().DurationVar(&schedule.delay, "delay", 0, "Perform the action after this delay, e.g. 30m or 2h (at most 720h)")
+	cmd.Flags().StringVar(&schedule.at, "at", "", "Perform the action at this time in RFC3339 format, e.g. 2025-06-01T02:00:00Z")
+	cmd.MarkFlagsMutuallyExclusive("delay", "at")
+}
+
+// newSetPowerStateRequest creates the request for a power action with the schedule of the flags.
+func newSetPowerStateRequest(action pb.SetPowerStateRequest_PowerAction, schedule *powerSchedule) (*pb.SetPowerStateRequest, error) {
+	req := &pb.SetPowerStateRequest{Action: action}
+	if schedule.delay < 0 {
+		return nil, fmt.Errorf("--delay must not be negative")
+	}
+	if schedule.delay > 0 {
+		if schedule.delay%time.Second != 0 {
+			return nil, fmt.Errorf("--delay must be a whole number of seconds")
+		}
+		req.DelaySeconds = uint32(schedule.delay / time.Second)
+	}
+	if schedule.at != "" {
+		at, err := time.Parse(time.RFC3339, schedule.at)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing --at (expected RFC3339 format, e.g. 2025-06-01T02:00:00Z): %w", err)
+		}
+		req.ScheduledTime = timestamppb.New(at)
+	}
+	return req, nil
+}
+
 // RestartCmd returns a cobra command for the Restart command
 func RestartCmd() *cobra.Command {
 	var socket string
+	var soft bool
+	var schedule powerSchedule
 
 	cmd := &cobra.Command{
 		Use:   "restart",
 		Short: "Restarts the device",
-		Long:  `Restarts the device.`,
-		RunE:  handleRestart(&socket, Dial),
+		Long: `Restarts the device, immediately or at a scheduled time.
+
+With --soft, only the userspace is restarted (systemd soft-reboot, systemd 254 or later).`,
+		Example: `  inbc restart
+  inbc restart --delay 30m
+  inbc restart --soft --at 2025-06-01T02:00:00Z`,
+		RunE: handleRestart(&socket, &soft, &schedule, Dial),
 	}
 
 	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+	cmd.Flags().BoolVar(&soft, "soft", false, "Restart the userspace without restarting the kernel")
+	addPowerScheduleFlags(cmd, &schedule)
 
 	return cmd
 }
 
 // ShutdownCmd returns a cobra command for the Shutdown command
 func ShutdownCmd() *cobra.Command {
+	return powerActionCmd("shutdown", "Shuts down the device", "Shutdown", pb.SetPowerStateRequest_POWER_ACTION_OFF)
+}
+
+// SuspendCmd returns a cobra command for the Suspend command
+func SuspendCmd() *cobra.Command {
+	return powerActionCmd("suspend", "Suspends the device to RAM", "Suspend", pb.SetPowerStateRequest_POWER_ACTION_SUSPEND)
+}
+
+// HibernateCmd returns a cobra command for the Hibernate command
+func HibernateCmd() *cobra.Command {
+	return powerActionCmd("hibernate", "Suspends the device to disk", "Hibernate", pb.SetPowerStateRequest_POWER_ACTION_HIBERNATE)
+}
+
+// powerActionCmd returns a cobra command that performs a power action, immediately or at a
+// scheduled time.
+func powerActionCmd(use, short, title string, action pb.SetPowerStateRequest_PowerAction) *cobra.Command {
 	var socket string
+	var schedule powerSchedule
 
 	cmd := &cobra.Command{
-		Use:   "shutdown",
-		Short: "Shuts down the device",
-		Long:  `Shuts down the device.`,
-		RunE:  handleShutdown(&socket, Dial),
+		Use:   use,
+		Short: short,
+		Long:  short + `, immediately or at a scheduled time.`,
+		Example: fmt.Sprintf(`  inbc %s
+  inbc %s --delay 30m
+  inbc %s --at 2025-06-01T02:00:00Z`, use, use, use),
+		RunE: handlePowerAction(&socket, title, func() pb.SetPowerStateRequest_PowerAction { return action }, &schedule, Dial),
 	}
 
 	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+	addPowerScheduleFlags(cmd, &schedule)
+
+	return cmd
+}
+
+// PowerCmd returns a cobra command for the pending power action commands
+func PowerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "power",
+		Short: "Manages pending power actions",
+		Long: `Power command is used to cancel power actions scheduled with the --delay or --at flags of restart, shutdown, suspend and hibernate.
+Use 'inbc query --option power' to list the pending power actions.`,
+	}
+
+	cmd.AddCommand(PowerCancelCmd())
+
+	return cmd
+}
+
+// PowerCancelCmd returns the 'power cancel' subcommand.
+func PowerCancelCmd() *cobra.Command {
+	var socket string
+	var actionID string
+
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel a pending power action, or all of them",
+		Example: `  inbc power cancel --id 3f2a9c4e1b7d6a05
+  inbc power cancel`,
+		RunE: handlePowerCancelCmd(&socket, &actionID, Dial),
+	}
+
+	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+	cmd.Flags().StringVar(&actionID, "id", "", "ID of the pending power action; all pending actions are canceled if not set")
 
 	return cmd
 }
@@ -51,22 +152,45 @@ func ShutdownCmd() *cobra.Command {
 // handleRestart is a helper function to handle the Restart command
 func handleRestart(
 	socket *string,
+	soft *bool,
+	schedule *powerSchedule,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) func(*cobra.Command, []string) error {
+	return handlePowerAction(socket, "Restart", func() pb.SetPowerStateRequest_PowerAction {
+		if *soft {
+			return pb.SetPowerStateRequest_POWER_ACTION_SOFT_REBOOT
+		}
+		return pb.SetPowerStateRequest_POWER_ACTION_CYCLE
+	}, schedule, dialer)
+}
+
+// handlePowerAction is a helper function to handle the power action commands
+func handlePowerAction(
+	socket *string,
+	title string,
+	action func() pb.SetPowerStateRequest_PowerAction,
+	schedule *powerSchedule,
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("Restart INBC Command was invoked.\n")
+		fmt.Fprintf(textOut, "%s INBC Command was invoked.\n", title)
+
+		req, err := newSetPowerStateRequest(action(), schedule)
+		if err != nil {
+			return err
+		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeoutInSeconds*time.Second)
 		defer cancel()
 
 		client, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
@@ -74,54 +198,49 @@ func handleRestart(
 		ctx, cancel = context.WithTimeout(context.Background(), firmwareUpdateTimerInSeconds*time.Second)
 		defer cancel()
 
-		resp, err := client.SetPowerState(ctx, &pb.SetPowerStateRequest{
-			Action: pb.SetPowerStateRequest_POWER_ACTION_CYCLE,
-		})
+		resp, err := client.SetPowerState(ctx, req)
 		if err != nil {
-			return fmt.Errorf("error restarting device: %v", err)
+			return fmt.Errorf("error performing %s: %w", strings.ToLower(title), err)
 		}
 
-		fmt.Printf("Restart Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		fmt.Fprintf(textOut, "%s Command Response: %d-%s\n", title, resp.GetStatusCode(), resp.GetError())
+		if resp.GetActionId() != "" {
+			fmt.Fprintf(textOut, "Scheduled power action %s at %s\n", resp.GetActionId(), resp.GetScheduledTime().AsTime().Local().Format(time.RFC3339))
+		}
 
-		return nil
+		return finishResponse(strings.ToLower(title), resp)
 	}
 }
 
-// handleShutdown is a helper function to handle the Shutdown command
-func handleShutdown(
+// handlePowerCancelCmd is a helper function to handle the PowerCancelCmd
+func handlePowerCancelCmd(
 	socket *string,
+	actionID *string,
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("Shutdown INBC Command was invoked.\n")
-
-		ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeoutInSeconds*time.Second)
-		defer cancel()
+		fmt.Fprintln(textOut, "POWER CANCEL command invoked.")
 
-		client, conn, err := dialer(ctx, *socket)
+		client, closeConn, err := dialConfigClient(*socket, dialer)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return err
 		}
-		defer func() {
-			if c, ok := conn.(*grpc.ClientConn); ok {
-				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
-				}
-			}
-		}()
+		defer closeConn()
 
-		ctx, cancel = context.WithTimeout(context.Background(), firmwareUpdateTimerInSeconds*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeoutInSeconds*time.Second)
 		defer cancel()
 
-		resp, err := client.SetPowerState(ctx, &pb.SetPowerStateRequest{
-			Action: *pb.SetPowerStateRequest_POWER_ACTION_OFF.Enum(),
-		})
+		resp, err := client.CancelPowerAction(ctx, &pb.CancelPowerActionRequest{ActionId: *actionID})
 		if err != nil {
-			return fmt.Errorf("error shutting down device: %v", err)
+			return fmt.Errorf("error performing power cancel: %w", err)
 		}
 
-		fmt.Printf("Shutdown Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		fmt.Fprintf(textOut, "POWER CANCEL Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		if resp.GetStatusCode() == 200 {
+			fmt.Fprintf(textOut, "Canceled %d power action(s)\n", len(resp.GetCanceled()))
+			displayPowerActions(resp.GetCanceled())
+		}
 
-		return nil
+		return finishResponse("power cancel", resp)
 	}
 }
@@ -0,0 +1,120 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPowerCommands_Flags(t *testing.T) {
+	for _, cmd := range []*cobra.Command{RestartCmd(), ShutdownCmd(), SuspendCmd(), HibernateCmd()} {
+		t.Run(cmd.Use, func(t *testing.T) {
+			assert.NotNil(t, cmd.Flags().Lookup("socket"))
+			assert.NotNil(t, cmd.Flags().Lookup("delay"))
+			assert.NotNil(t, cmd.Flags().Lookup("at"))
+		})
+	}
+	assert.NotNil(t, RestartCmd().Flags().Lookup("soft"))
+}
+
+func TestNewSetPowerStateRequest(t *testing.T) {
+	req, err := newSetPowerStateRequest(pb.SetPowerStateRequest_POWER_ACTION_OFF, &powerSchedule{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), req.DelaySeconds)
+	assert.Nil(t, req.ScheduledTime)
+
+	req, err = newSetPowerStateRequest(pb.SetPowerStateRequest_POWER_ACTION_OFF, &powerSchedule{delay: 90 * time.Minute})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5400), req.DelaySeconds)
+
+	req, err = newSetPowerStateRequest(pb.SetPowerStateRequest_POWER_ACTION_SUSPEND, &powerSchedule{at: "2025-06-01T02:00:00Z"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 6, 1, 2, 0, 0, 0, time.UTC), req.ScheduledTime.AsTime())
+
+	_, err = newSetPowerStateRequest(pb.SetPowerStateRequest_POWER_ACTION_OFF, &powerSchedule{at: "tomorrow"})
+	assert.ErrorContains(t, err, "error parsing --at")
+
+	_, err = newSetPowerStateRequest(pb.SetPowerStateRequest_POWER_ACTION_OFF, &powerSchedule{delay: 1500 * time.Millisecond})
+	assert.EqualError(t, err, "--delay must be a whole number of seconds")
+}
+
+func TestHandleRestart_SoftScheduled(t *testing.T) {
+	socket := "/tmp/test.sock"
+	soft := true
+	schedule := &powerSchedule{delay: 10 * time.Minute}
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("SetPowerState", mock.Anything, mock.MatchedBy(func(req *pb.SetPowerStateRequest) bool {
+		return req.Action == pb.SetPowerStateRequest_POWER_ACTION_SOFT_REBOOT && req.DelaySeconds == 600
+	}), mock.Anything).Return(&pb.SetPowerStateResponse{
+		StatusCode:    200,
+		Error:         "SUCCESS",
+		ActionId:      "3f2a9c4e1b7d6a05",
+		ScheduledTime: timestamppb.New(time.Now().Add(10 * time.Minute)),
+	}, nil)
+
+	err := handleRestart(&socket, &soft, schedule, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandlePowerAction_Vetoed(t *testing.T) {
+	socket := "/tmp/test.sock"
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("SetPowerState", mock.Anything, mock.Anything, mock.Anything).
+		Return(&pb.SetPowerStateResponse{StatusCode: 409, Error: "power action vetoed: UpdateFirmware is in progress"}, nil)
+
+	action := func() pb.SetPowerStateRequest_PowerAction { return pb.SetPowerStateRequest_POWER_ACTION_OFF }
+	err := handlePowerAction(&socket, "Shutdown", action, &powerSchedule{}, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+	assert.EqualError(t, err, "shutdown failed: 409-power action vetoed: UpdateFirmware is in progress")
+	assert.Equal(t, ExitConflict, ExitCode(err))
+}
+
+func TestHandlePowerCancelCmd(t *testing.T) {
+	socket := "/tmp/test.sock"
+
+	t.Run("all", func(t *testing.T) {
+		actionID := ""
+		mockClient := &MockInbServiceClient{}
+		mockClient.On("CancelPowerAction", mock.Anything, &pb.CancelPowerActionRequest{}, mock.Anything).
+			Return(&pb.CancelPowerActionResponse{StatusCode: 200, Canceled: []*pb.PowerActionInfo{{
+				ActionId:      "3f2a9c4e1b7d6a05",
+				Action:        pb.SetPowerStateRequest_POWER_ACTION_CYCLE,
+				ScheduledTime: timestamppb.Now(),
+				RequestedTime: timestamppb.Now(),
+				RequestedBy:   "uid 0",
+			}}}, nil)
+
+		err := handlePowerCancelCmd(&socket, &actionID, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		actionID := "unknown"
+		mockClient := &MockInbServiceClient{}
+		mockClient.On("CancelPowerAction", mock.Anything, &pb.CancelPowerActionRequest{ActionId: "unknown"}, mock.Anything).
+			Return(&pb.CancelPowerActionResponse{StatusCode: 404, Error: "power action not found: unknown"}, nil)
+
+		err := handlePowerCancelCmd(&socket, &actionID, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+		assert.Equal(t, ExitNotFound, ExitCode(err))
+	})
+}
+
+func TestPowerActionName(t *testing.T) {
+	assert.Equal(t, "soft_reboot", powerActionName(pb.SetPowerStateRequest_POWER_ACTION_SOFT_REBOOT))
+	assert.Equal(t, "hibernate", powerActionName(pb.SetPowerStateRequest_POWER_ACTION_HIBERNATE))
+}
@@ -9,6 +9,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -27,24 +28,37 @@ func QueryCmd() *cobra.Command {
 		Long: `Query system information including hardware, firmware, OS, software BOM, and version details.
 
 Available options:
-  hw      - Hardware information (manufacturer, product, CPU, memory, disk)
-  fw      - Firmware information (BIOS vendor, version, release date)
-  os      - Operating system information (type, version, release date)
-  swbom   - Software Bill of Materials (installed packages)
-  version - Version information (INBM version, build date, git commit)
-  all     - All available information`,
+  hw           - Hardware information (manufacturer, product, CPU, memory modules, disks, NICs, PCI devices, TPM, Secure Boot)
+  fw           - Firmware information (BIOS vendor, version, release date)
+  fwcomponents - UEFI ESRT firmware components (GUID, version, last attempt status)
+  auditlog     - Recent commands executed by inbd (caller, command, exit code)
+  provenance   - Recent provenance verifications of update and config packages
+  os           - Operating system information (type, version, release date)
+  swbom        - Software Bill of Materials (installed packages)
+  vulnerabilities - Installed packages affected by advisories of the loaded vulnerability database
+  power        - Power capabilities and pending power actions
+  version      - Version information (INBM version, build date, git commit)
+  all          - All available information`,
 		Example: `  inbc query
   inbc query --option hw
   inbc query --option fw
+  inbc query --option fwcomponents
+  inbc query --option auditlog
+  inbc query --option provenance
   inbc query --option os
   inbc query --option swbom
+  inbc query --option vulnerabilities
+  inbc query --option power
   inbc query --option version
   inbc query --option all`,
 		RunE: handleQueryCmd(&socket, &option, Dial),
 	}
 
 	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
-	cmd.Flags().StringVarP(&option, "option", "o", "all", "Query option (hw, fw, os, swbom, version, all)")
+	cmd.Flags().StringVarP(&option, "option", "o", "all", "Query option (hw, fw, fwcomponents, auditlog, provenance, os, swbom, vulnerabilities, power, version, all)")
+	must(cmd.RegisterFlagCompletionFunc("option", cobra.FixedCompletions([]string{
+		"hw", "fw", "fwcomponents", "auditlog", "provenance", "os", "swbom", "vulnerabilities", "power", "version", "all",
+	}, cobra.ShellCompDirectiveNoFileComp)))
 
 	return cmd
 }
@@ -56,7 +70,7 @@ func handleQueryCmd(
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Println("QUERY command invoked.")
+		fmt.Fprintln(textOut, "QUERY command invoked.")
 
 		// Use default value if option is empty
 		var optionValue string
@@ -83,12 +97,12 @@ func handleQueryCmd(
 
 		client, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
@@ -98,7 +112,7 @@ func handleQueryCmd(
 
 		resp, err := client.Query(ctx, request)
 		if err != nil {
-			return fmt.Errorf("error performing query: %v", err)
+			return fmt.Errorf("error performing query: %w", err)
 		}
 
 		if resp == nil {
@@ -107,7 +121,7 @@ func handleQueryCmd(
 
 		// Display response
 		displayQueryResponse(resp, optionValue)
-		return nil
+		return finishResponse("query", resp)
 	}
 }
 
@@ -125,29 +139,39 @@ func parseQueryOption(option string) (pb.QueryOption, error) {
 		return pb.QueryOption_QUERY_OPTION_HARDWARE, nil
 	case "fw", "firmware":
 		return pb.QueryOption_QUERY_OPTION_FIRMWARE, nil
+	case "fwcomponents", "firmware-components":
+		return pb.QueryOption_QUERY_OPTION_FIRMWARE_COMPONENTS, nil
+	case "auditlog", "audit-log":
+		return pb.QueryOption_QUERY_OPTION_AUDIT_LOG, nil
+	case "provenance":
+		return pb.QueryOption_QUERY_OPTION_PROVENANCE, nil
 	case "os", "operating-system":
 		return pb.QueryOption_QUERY_OPTION_OS, nil
 	case "swbom", "software-bom":
 		return pb.QueryOption_QUERY_OPTION_SWBOM, nil
+	case "vulnerabilities", "vulns":
+		return pb.QueryOption_QUERY_OPTION_VULNERABILITIES, nil
+	case "power":
+		return pb.QueryOption_QUERY_OPTION_POWER, nil
 	case "version", "ver":
 		return pb.QueryOption_QUERY_OPTION_VERSION, nil
 	case "all":
 		return pb.QueryOption_QUERY_OPTION_ALL, nil
 	default:
-		return pb.QueryOption_QUERY_OPTION_UNSPECIFIED, fmt.Errorf("invalid query option '%s'. Valid options: hw, fw, os, swbom, version, all", option)
+		return pb.QueryOption_QUERY_OPTION_UNSPECIFIED, fmt.Errorf("invalid query option '%s'. Valid options: hw, fw, fwcomponents, auditlog, provenance, os, swbom, vulnerabilities, power, version, all", option)
 	}
 }
 
 // displayQueryResponse formats and displays the query response
 func displayQueryResponse(resp *pb.QueryResponse, option string) {
-	fmt.Printf("QUERY Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+	fmt.Fprintf(textOut, "QUERY Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
 
 	if resp.GetSuccess() && resp.GetData() != nil {
-		fmt.Printf("Query Type: %s\n", option)
-		fmt.Printf("Data Type: %s\n", resp.GetData().GetType())
+		fmt.Fprintf(textOut, "Query Type: %s\n", option)
+		fmt.Fprintf(textOut, "Data Type: %s\n", resp.GetData().GetType())
 
 		if resp.GetData().GetTimestamp() != nil {
-			fmt.Printf("Timestamp: %s\n", resp.GetData().GetTimestamp().AsTime().Format(time.RFC3339))
+			fmt.Fprintf(textOut, "Timestamp: %s\n", resp.GetData().GetTimestamp().AsTime().Format(time.RFC3339))
 		}
 
 		// Display specific data based on the values oneof
@@ -156,21 +180,31 @@ func displayQueryResponse(resp *pb.QueryResponse, option string) {
 			displayHardwareInfo(values.Hardware)
 		case *pb.QueryData_Firmware:
 			displayFirmwareInfo(values.Firmware)
+		case *pb.QueryData_FirmwareComponents:
+			displayFirmwareComponentsInfo(values.FirmwareComponents)
+		case *pb.QueryData_AuditLog:
+			displayAuditLogInfo(values.AuditLog)
+		case *pb.QueryData_Provenance:
+			displayProvenanceInfo(values.Provenance)
 		case *pb.QueryData_OsInfo:
 			displayOSInfo(values.OsInfo)
 		case *pb.QueryData_Swbom:
 			displaySWBOMInfo(values.Swbom)
+		case *pb.QueryData_Vulnerabilities:
+			displayVulnerabilitiesInfo(values.Vulnerabilities)
+		case *pb.QueryData_Power:
+			displayPowerInfo(values.Power)
 		case *pb.QueryData_Version:
 			displayVersionInfo(values.Version)
 		case *pb.QueryData_AllInfo:
 			displayAllInfo(values.AllInfo)
 		default:
-			fmt.Println("No specific data available")
+			fmt.Fprintln(textOut, "No specific data available")
 		}
 	} else {
 		// Show error details for failed responses
 		if !resp.GetSuccess() {
-			fmt.Printf("Query failed: %s\n", resp.GetError())
+			fmt.Fprintf(textOut, "Query failed: %s\n", resp.GetError())
 		}
 	}
 }
@@ -181,39 +215,201 @@ func displayHardwareInfo(hw *pb.HardwareInfo) {
 		return
 	}
 
-	fmt.Println("\n=== Hardware Information ===")
+	fmt.Fprintln(textOut, "\n=== Hardware Information ===")
 	if hw.GetCpuId() != "" {
-		fmt.Printf("CPU ID: %s\n", hw.GetCpuId())
+		fmt.Fprintf(textOut, "CPU ID: %s\n", hw.GetCpuId())
 	}
 	if hw.GetTotalPhysicalMemory() != "" {
-		fmt.Printf("Total Physical Memory: %s\n", hw.GetTotalPhysicalMemory())
+		fmt.Fprintf(textOut, "Total Physical Memory: %s\n", hw.GetTotalPhysicalMemory())
 	}
 	if hw.GetDiskInformation() != "" {
-		fmt.Printf("Disk Information: %s\n", hw.GetDiskInformation())
+		fmt.Fprintf(textOut, "Disk Information: %s\n", hw.GetDiskInformation())
 	}
 	if hw.GetSystemManufacturer() != "" {
-		fmt.Printf("System Manufacturer: %s\n", hw.GetSystemManufacturer())
+		fmt.Fprintf(textOut, "System Manufacturer: %s\n", hw.GetSystemManufacturer())
 	}
 	if hw.GetSystemProductName() != "" {
-		fmt.Printf("System Product Name: %s\n", hw.GetSystemProductName())
+		fmt.Fprintf(textOut, "System Product Name: %s\n", hw.GetSystemProductName())
+	}
+	if hw.GetSecureBoot() != "" {
+		fmt.Fprintf(textOut, "Secure Boot: %s\n", hw.GetSecureBoot())
+	}
+	if tpm := hw.GetTpm(); tpm != nil {
+		if tpm.GetPresent() {
+			fmt.Fprintf(textOut, "TPM: %s (version %s)\n", tpm.GetDevice(), tpm.GetVersion())
+		} else {
+			fmt.Fprintln(textOut, "TPM: not present")
+		}
+	}
+
+	if len(hw.GetMemoryModules()) > 0 {
+		fmt.Fprintf(textOut, "\nMemory Modules: %d\n", len(hw.GetMemoryModules()))
+		for _, m := range hw.GetMemoryModules() {
+			fmt.Fprintf(textOut, "  - %s: %s %s %s", m.GetLocator(), formatBytes(m.GetSizeBytes()), m.GetType(), m.GetFormFactor())
+			if m.GetConfiguredSpeedMts() > 0 {
+				fmt.Fprintf(textOut, " @ %d MT/s", m.GetConfiguredSpeedMts())
+			}
+			fmt.Fprintln(textOut)
+			if m.GetManufacturer() != "" || m.GetPartNumber() != "" {
+				fmt.Fprintf(textOut, "    Manufacturer: %s, Part Number: %s, Serial: %s\n", m.GetManufacturer(), m.GetPartNumber(), m.GetSerial())
+			}
+		}
+	}
+
+	if len(hw.GetDisks()) > 0 {
+		fmt.Fprintf(textOut, "\nDisks: %d\n", len(hw.GetDisks()))
+		for _, d := range hw.GetDisks() {
+			kind := "SSD"
+			if d.GetRotational() {
+				kind = "HDD"
+			}
+			fmt.Fprintf(textOut, "  - %s: %s %s (%s)\n", d.GetName(), d.GetModel(), formatBytes(d.GetSizeBytes()), kind)
+			if d.GetSerial() != "" {
+				fmt.Fprintf(textOut, "    Serial: %s\n", d.GetSerial())
+			}
+			if d.GetFirmwareRevision() != "" {
+				fmt.Fprintf(textOut, "    Firmware: %s\n", d.GetFirmwareRevision())
+			}
+			if d.GetSmartHealth() != "" {
+				fmt.Fprintf(textOut, "    SMART Health: %s\n", d.GetSmartHealth())
+			}
+		}
+	}
+
+	if len(hw.GetNetworkInterfaces()) > 0 {
+		fmt.Fprintf(textOut, "\nNetwork Interfaces: %d\n", len(hw.GetNetworkInterfaces()))
+		for _, n := range hw.GetNetworkInterfaces() {
+			fmt.Fprintf(textOut, "  - %s: %s, %s", n.GetName(), n.GetMacAddress(), n.GetOperstate())
+			if n.GetSpeedMbps() > 0 {
+				fmt.Fprintf(textOut, ", %d Mb/s", n.GetSpeedMbps())
+			}
+			fmt.Fprintln(textOut)
+			if n.GetDriver() != "" {
+				fmt.Fprintf(textOut, "    Driver: %s", n.GetDriver())
+				if n.GetPciAddress() != "" {
+					fmt.Fprintf(textOut, " (%s)", n.GetPciAddress())
+				}
+				fmt.Fprintln(textOut)
+			}
+		}
+	}
+
+	if len(hw.GetPciDevices()) > 0 {
+		fmt.Fprintf(textOut, "\nPCI Devices: %d\n", len(hw.GetPciDevices()))
+		for _, p := range hw.GetPciDevices() {
+			fmt.Fprintf(textOut, "  - %s [%s:%s] %s", p.GetAddress(), p.GetVendorId(), p.GetDeviceId(), p.GetClassName())
+			if p.GetDriver() != "" {
+				fmt.Fprintf(textOut, ", driver %s", p.GetDriver())
+			}
+			fmt.Fprintln(textOut)
+		}
 	}
 }
 
+// formatBytes formats a size in bytes with a binary unit, e.g. 8.0 GiB
+func formatBytes(size uint64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := uint64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
 // displayFirmwareInfo displays firmware information
 func displayFirmwareInfo(fw *pb.FirmwareInfo) {
 	if fw == nil {
 		return
 	}
 
-	fmt.Println("\n=== Firmware Information ===")
+	fmt.Fprintln(textOut, "\n=== Firmware Information ===")
 	if fw.GetBiosVendor() != "" {
-		fmt.Printf("BIOS Vendor: %s\n", fw.GetBiosVendor())
+		fmt.Fprintf(textOut, "BIOS Vendor: %s\n", fw.GetBiosVendor())
 	}
 	if fw.GetBiosVersion() != "" {
-		fmt.Printf("BIOS Version: %s\n", fw.GetBiosVersion())
+		fmt.Fprintf(textOut, "BIOS Version: %s\n", fw.GetBiosVersion())
 	}
 	if fw.GetBiosReleaseDate() != nil {
-		fmt.Printf("BIOS Release Date: %s\n", fw.GetBiosReleaseDate().AsTime().Format(time.RFC3339))
+		fmt.Fprintf(textOut, "BIOS Release Date: %s\n", fw.GetBiosReleaseDate().AsTime().Format(time.RFC3339))
+	}
+}
+
+// displayFirmwareComponentsInfo displays the UEFI ESRT firmware components
+func displayFirmwareComponentsInfo(info *pb.FirmwareComponentsInfo) {
+	if info == nil {
+		return
+	}
+
+	fmt.Fprintln(textOut, "\n=== Firmware Components ===")
+	if info.GetSource() != "" {
+		fmt.Fprintf(textOut, "Source: %s\n", info.GetSource())
+	}
+	fmt.Fprintf(textOut, "Total Components: %d\n", len(info.GetComponents()))
+	for _, c := range info.GetComponents() {
+		fmt.Fprintf(textOut, "  - %s [%s]\n", c.GetFwClass(), c.GetFwType())
+		fmt.Fprintf(textOut, "    Version: %d (lowest supported: %d)\n", c.GetFwVersion(), c.GetLowestSupportedFwVersion())
+		fmt.Fprintf(textOut, "    Capsule Flags: 0x%x\n", c.GetCapsuleFlags())
+		fmt.Fprintf(textOut, "    Last Attempt: version %d, status %s\n", c.GetLastAttemptVersion(), c.GetLastAttemptStatusDescription())
+	}
+}
+
+// displayAuditLogInfo displays the recent entries of the command audit log
+func displayAuditLogInfo(info *pb.AuditLogInfo) {
+	if info == nil {
+		return
+	}
+
+	fmt.Fprintln(textOut, "\n=== Command Audit Log ===")
+	fmt.Fprintf(textOut, "Total Entries: %d\n", len(info.GetEntries()))
+	for _, e := range info.GetEntries() {
+		status := "allowed"
+		if !e.GetAllowed() {
+			status = "denied"
+		}
+		fmt.Fprintf(textOut, "  - %s %s %s\n", e.GetTime().AsTime().Format(time.RFC3339), e.GetCommand(), strings.Join(e.GetArgs(), " "))
+		fmt.Fprintf(textOut, "    Caller: %s, %s, exit code %d, %d ms\n", e.GetCaller(), status, e.GetExitCode(), e.GetDurationMs())
+		if e.GetError() != "" {
+			fmt.Fprintf(textOut, "    Error: %s\n", e.GetError())
+		}
+	}
+}
+
+// displayProvenanceInfo displays the results of the recent provenance verifications
+func displayProvenanceInfo(info *pb.ProvenanceInfo) {
+	if info == nil {
+		return
+	}
+
+	fmt.Fprintln(textOut, "\n=== Update Provenance ===")
+	fmt.Fprintf(textOut, "Total Records: %d\n", len(info.GetRecords()))
+	for _, r := range info.GetRecords() {
+		status := "verified"
+		if !r.GetVerified() {
+			status = "failed"
+		}
+		fmt.Fprintf(textOut, "  - %s %s %s: %s\n", r.GetTime().AsTime().Format(time.RFC3339), r.GetPackageType(), r.GetArtifact(), status)
+		if r.GetFormat() != "" {
+			fmt.Fprintf(textOut, "    Format: %s, Predicate: %s\n", r.GetFormat(), r.GetPredicateType())
+		}
+		if r.GetBuilderId() != "" {
+			fmt.Fprintf(textOut, "    Builder: %s\n", r.GetBuilderId())
+		}
+		if r.GetSourceRepo() != "" {
+			fmt.Fprintf(textOut, "    Source: %s\n", r.GetSourceRepo())
+		}
+		if r.GetSigner() != "" {
+			fmt.Fprintf(textOut, "    Signer: %s\n", r.GetSigner())
+		}
+		if r.GetArtifactDigest() != "" {
+			fmt.Fprintf(textOut, "    Digest: %s\n", r.GetArtifactDigest())
+		}
+		if r.GetError() != "" {
+			fmt.Fprintf(textOut, "    Error: %s\n", r.GetError())
+		}
 	}
 }
 
@@ -223,9 +419,9 @@ func displayOSInfo(os *pb.OSInfo) {
 		return
 	}
 
-	fmt.Println("\n=== Operating System Information ===")
+	fmt.Fprintln(textOut, "\n=== Operating System Information ===")
 	if os.GetOsInformation() != "" {
-		fmt.Printf("OS Information: %s\n", os.GetOsInformation())
+		fmt.Fprintf(textOut, "OS Information: %s\n", os.GetOsInformation())
 	}
 }
 
@@ -235,86 +431,123 @@ func displaySWBOMInfo(swbom *pb.SWBOMInfo) {
 		return
 	}
 
-	fmt.Println("\n=== Software Bill of Materials ===")
+	fmt.Fprintln(textOut, "\n=== Software Bill of Materials ===")
 
 	// Collection timestamp
 	if swbom.GetCollectionTimestamp() != nil {
-		fmt.Printf("Collection Timestamp: %s\n", swbom.GetCollectionTimestamp().AsTime().Format(time.RFC3339))
+		fmt.Fprintf(textOut, "Collection Timestamp: %s\n", swbom.GetCollectionTimestamp().AsTime().Format(time.RFC3339))
 	}
 
 	// Collection method
 	if swbom.GetCollectionMethod() != "" {
-		fmt.Printf("Collection Method: %s\n", swbom.GetCollectionMethod())
+		fmt.Fprintf(textOut, "Collection Method: %s\n", swbom.GetCollectionMethod())
 	}
 
 	// Packages with ALL fields
 	packages := swbom.GetPackages()
 	if len(packages) > 0 {
-		fmt.Printf("Total Packages: %d\n", len(packages))
-		fmt.Println("\nPackages:")
+		fmt.Fprintf(textOut, "Total Packages: %d\n", len(packages))
+		fmt.Fprintln(textOut, "\nPackages:")
 		for i, pkg := range packages {
 			if i >= 10 { // Limit display to first 10 packages
-				fmt.Printf("... and %d more packages\n", len(packages)-10)
+				fmt.Fprintf(textOut, "... and %d more packages\n", len(packages)-10)
 				break
 			}
 
 			// Package name (required field)
-			fmt.Printf("  - %s", pkg.GetName())
+			fmt.Fprintf(textOut, "  - %s", pkg.GetName())
 
 			// Package version
 			if pkg.GetVersion() != "" {
-				fmt.Printf(" (%s)", pkg.GetVersion())
+				fmt.Fprintf(textOut, " (%s)", pkg.GetVersion())
 			}
 
 			// Package vendor
 			if pkg.GetVendor() != "" {
-				fmt.Printf(" by %s", pkg.GetVendor())
+				fmt.Fprintf(textOut, " by %s", pkg.GetVendor())
 			}
 
 			// Package type
 			if pkg.GetType() != "" {
-				fmt.Printf(" [%s]", pkg.GetType())
+				fmt.Fprintf(textOut, " [%s]", pkg.GetType())
 			}
 
 			// Package architecture
 			if pkg.GetArchitecture() != "" {
-				fmt.Printf(" (%s)", pkg.GetArchitecture())
+				fmt.Fprintf(textOut, " (%s)", pkg.GetArchitecture())
 			}
 
-			fmt.Println()
+			fmt.Fprintln(textOut)
 
 			// Additional details (indented)
 			if pkg.GetDescription() != "" {
-				fmt.Printf("    Description: %s\n", pkg.GetDescription())
+				fmt.Fprintf(textOut, "    Description: %s\n", pkg.GetDescription())
 			}
 			if pkg.GetLicense() != "" {
-				fmt.Printf("    License: %s\n", pkg.GetLicense())
+				fmt.Fprintf(textOut, "    License: %s\n", pkg.GetLicense())
 			}
 			if pkg.GetInstallDate() != nil {
-				fmt.Printf("    Install Date: %s\n", pkg.GetInstallDate().AsTime().Format(time.RFC3339))
+				fmt.Fprintf(textOut, "    Install Date: %s\n", pkg.GetInstallDate().AsTime().Format(time.RFC3339))
 			}
 		}
 	}
 }
 
+// displayVulnerabilitiesInfo displays the installed packages affected by known vulnerabilities
+func displayVulnerabilitiesInfo(info *pb.VulnerabilitiesInfo) {
+	if info == nil {
+		return
+	}
+
+	fmt.Fprintln(textOut, "\n=== Vulnerabilities ===")
+	fmt.Fprintf(textOut, "Database: %s (%s, %d advisories)\n", info.GetDatabaseSource(), info.GetDatabaseFormat(), info.GetAdvisories())
+	if info.GetDatabaseLoaded() != nil {
+		fmt.Fprintf(textOut, "Database Loaded: %s\n", info.GetDatabaseLoaded().AsTime().Format(time.RFC3339))
+	}
+	fmt.Fprintf(textOut, "Total Findings: %d\n", len(info.GetFindings()))
+	for _, f := range info.GetFindings() {
+		fmt.Fprintf(textOut, "  - %s [%s", f.GetId(), f.GetSeverity())
+		if f.GetCvssScore() > 0 {
+			fmt.Fprintf(textOut, ", CVSS %.1f", f.GetCvssScore())
+		}
+		fmt.Fprintln(textOut, "]")
+		fmt.Fprintf(textOut, "    Package: %s %s", f.GetPackage(), f.GetInstalledVersion())
+		if f.GetArchitecture() != "" {
+			fmt.Fprintf(textOut, " (%s)", f.GetArchitecture())
+		}
+		fmt.Fprintln(textOut)
+		if f.GetFixedVersion() != "" {
+			fmt.Fprintf(textOut, "    Fixed In: %s\n", f.GetFixedVersion())
+		} else {
+			fmt.Fprintln(textOut, "    Fixed In: not fixed")
+		}
+		if len(f.GetAliases()) > 0 {
+			fmt.Fprintf(textOut, "    Aliases: %s\n", strings.Join(f.GetAliases(), ", "))
+		}
+		if f.GetSummary() != "" {
+			fmt.Fprintf(textOut, "    Summary: %s\n", f.GetSummary())
+		}
+	}
+}
+
 // displayVersionInfo displays version information with ALL fields
 func displayVersionInfo(version *pb.VersionInfo) {
 	if version == nil {
 		return
 	}
 
-	fmt.Println("\n=== Version Information ===")
+	fmt.Fprintln(textOut, "\n=== Version Information ===")
 	if version.GetVersion() != "" {
-		fmt.Printf("Version: %s\n", version.GetVersion())
+		fmt.Fprintf(textOut, "Version: %s\n", version.GetVersion())
 	}
 	if version.GetInbmVersionCommit() != "" {
-		fmt.Printf("INBM Version Commit: %s\n", version.GetInbmVersionCommit())
+		fmt.Fprintf(textOut, "INBM Version Commit: %s\n", version.GetInbmVersionCommit())
 	}
 	if version.GetGitCommit() != "" {
-		fmt.Printf("Git Commit: %s\n", version.GetGitCommit())
+		fmt.Fprintf(textOut, "Git Commit: %s\n", version.GetGitCommit())
 	}
 	if version.GetBuildDate() != nil {
-		fmt.Printf("Build Date: %s\n", version.GetBuildDate().AsTime().Format(time.RFC3339))
+		fmt.Fprintf(textOut, "Build Date: %s\n", version.GetBuildDate().AsTime().Format(time.RFC3339))
 	}
 }
 
@@ -324,23 +557,54 @@ func displayPowerCapabilities(power *pb.PowerCapabilitiesInfo) {
 		return
 	}
 
-	fmt.Println("\n=== Power Capabilities ===")
-	fmt.Printf("Shutdown: %t\n", power.GetShutdown())
-	fmt.Printf("Reboot: %t\n", power.GetReboot())
-	fmt.Printf("Suspend: %t\n", power.GetSuspend())
-	fmt.Printf("Hibernate: %t\n", power.GetHibernate())
+	fmt.Fprintln(textOut, "\n=== Power Capabilities ===")
+	fmt.Fprintf(textOut, "Shutdown: %t\n", power.GetShutdown())
+	fmt.Fprintf(textOut, "Reboot: %t\n", power.GetReboot())
+	fmt.Fprintf(textOut, "Suspend: %t\n", power.GetSuspend())
+	fmt.Fprintf(textOut, "Hibernate: %t\n", power.GetHibernate())
 	if power.GetCapabilitiesJson() != "" {
-		fmt.Printf("Capabilities JSON: %s\n", power.GetCapabilitiesJson())
+		fmt.Fprintf(textOut, "Capabilities JSON: %s\n", power.GetCapabilitiesJson())
 	}
 }
 
+// displayPowerInfo displays the power capabilities and the pending power actions
+func displayPowerInfo(info *pb.PowerInfo) {
+	if info == nil {
+		return
+	}
+
+	displayPowerCapabilities(info.GetCapabilities())
+	fmt.Fprintln(textOut, "\n=== Pending Power Actions ===")
+	if len(info.GetPendingActions()) == 0 {
+		fmt.Fprintln(textOut, "No pending power actions")
+		return
+	}
+	displayPowerActions(info.GetPendingActions())
+}
+
+// displayPowerActions lists scheduled power actions
+func displayPowerActions(actions []*pb.PowerActionInfo) {
+	for _, a := range actions {
+		fmt.Fprintf(textOut, "  - %s: %s at %s\n", a.GetActionId(), powerActionName(a.GetAction()), a.GetScheduledTime().AsTime().Local().Format(time.RFC3339))
+		fmt.Fprintf(textOut, "    Requested By: %s at %s\n", a.GetRequestedBy(), a.GetRequestedTime().AsTime().Local().Format(time.RFC3339))
+		if a.GetVetoedReason() != "" {
+			fmt.Fprintf(textOut, "    Postponed: %s\n", a.GetVetoedReason())
+		}
+	}
+}
+
+// powerActionName returns the name of a power action, e.g. soft_reboot
+func powerActionName(action pb.SetPowerStateRequest_PowerAction) string {
+	return strings.ToLower(strings.TrimPrefix(action.String(), "POWER_ACTION_"))
+}
+
 // displayAllInfo displays all system information with ALL fields
 func displayAllInfo(all *pb.AllInfo) {
 	if all == nil {
 		return
 	}
 
-	fmt.Println("\n=== All System Information ===")
+	fmt.Fprintln(textOut, "\n=== All System Information ===")
 
 	// Hardware information
 	if all.GetHardware() != nil {
@@ -375,9 +639,9 @@ func displayAllInfo(all *pb.AllInfo) {
 	// Additional information
 	additionalInfo := all.GetAdditionalInfo()
 	if len(additionalInfo) > 0 {
-		fmt.Println("\n=== Additional Information ===")
+		fmt.Fprintln(textOut, "\n=== Additional Information ===")
 		for _, info := range additionalInfo {
-			fmt.Printf("  - %s\n", info)
+			fmt.Fprintf(textOut, "  - %s\n", info)
 		}
 	}
 }
@@ -81,6 +81,30 @@ func TestParseQueryOption(t *testing.T) {
 			expected: pb.QueryOption_QUERY_OPTION_FIRMWARE,
 			wantErr:  false,
 		},
+		{
+			name:     "firmware components option",
+			input:    "fwcomponents",
+			expected: pb.QueryOption_QUERY_OPTION_FIRMWARE_COMPONENTS,
+			wantErr:  false,
+		},
+		{
+			name:     "firmware components option long",
+			input:    "firmware-components",
+			expected: pb.QueryOption_QUERY_OPTION_FIRMWARE_COMPONENTS,
+			wantErr:  false,
+		},
+		{
+			name:     "audit log option",
+			input:    "auditlog",
+			expected: pb.QueryOption_QUERY_OPTION_AUDIT_LOG,
+			wantErr:  false,
+		},
+		{
+			name:     "provenance option",
+			input:    "provenance",
+			expected: pb.QueryOption_QUERY_OPTION_PROVENANCE,
+			wantErr:  false,
+		},
 		{
 			name:     "os option",
 			input:    "os",
@@ -105,6 +129,12 @@ func TestParseQueryOption(t *testing.T) {
 			expected: pb.QueryOption_QUERY_OPTION_SWBOM,
 			wantErr:  false,
 		},
+		{
+			name:     "vulnerabilities option",
+			input:    "vulnerabilities",
+			expected: pb.QueryOption_QUERY_OPTION_VULNERABILITIES,
+			wantErr:  false,
+		},
 		{
 			name:     "version option",
 			input:    "version",
@@ -365,7 +395,8 @@ func TestHandleQueryCmd_UnsuccessfulResponse(t *testing.T) {
 	cmd := QueryCmd()
 	err := handler(cmd, []string{})
 
-	assert.NoError(t, err) // Should not error, just display the response
+	assert.EqualError(t, err, "query failed: 500-Internal server error")
+	assert.Equal(t, ExitServerError, ExitCode(err))
 	mockClient.AssertExpectations(t)
 }
 
@@ -388,7 +419,8 @@ func TestHandleQueryCmd_NotImplementedResponse(t *testing.T) {
 	cmd := QueryCmd()
 	err := handler(cmd, []string{})
 
-	assert.NoError(t, err)
+	assert.EqualError(t, err, "query failed: 501-Query method not implemented yet")
+	assert.Equal(t, ExitServerError, ExitCode(err))
 	mockClient.AssertExpectations(t)
 }
 
@@ -509,6 +541,28 @@ func TestDisplayFunctions(t *testing.T) {
 		displayHardwareInfo(nil) // Test nil case
 	})
 
+	t.Run("displayHardwareInfo with details", func(t *testing.T) {
+		hw := &pb.HardwareInfo{
+			SecureBoot: "enabled",
+			Tpm:        &pb.TPMInfo{Present: true, Version: "2.0", Device: "tpm0"},
+			Disks: []*pb.DiskInfo{
+				{Name: "nvme0n1", Model: "Samsung SSD 980 PRO 1TB", Serial: "S5GXNF0R123456", SizeBytes: 1000204886016, SmartHealth: "passed"},
+				{Name: "sda", Model: "ST500LM012", SizeBytes: 500107862016, Rotational: true},
+			},
+			NetworkInterfaces: []*pb.NetworkInterface{
+				{Name: "eno1", MacAddress: "3c:ec:ef:01:02:03", Operstate: "up", SpeedMbps: 1000, Driver: "e1000e", PciAddress: "0000:00:1f.6"},
+			},
+			PciDevices: []*pb.PCIDevice{
+				{Address: "0000:00:1f.6", VendorId: "8086", DeviceId: "15fa", ClassName: "network controller", Driver: "e1000e"},
+			},
+			MemoryModules: []*pb.MemoryModule{
+				{Locator: "DIMM0", SizeBytes: 8 << 30, Type: "DDR4", FormFactor: "SODIMM", ConfiguredSpeedMts: 3200, Manufacturer: "Samsung", PartNumber: "M471A1K43DB1-CWE"},
+			},
+		}
+		displayHardwareInfo(hw)
+		displayHardwareInfo(&pb.HardwareInfo{Tpm: &pb.TPMInfo{}})
+	})
+
 	t.Run("displayFirmwareInfo", func(t *testing.T) {
 		fw := &pb.FirmwareInfo{
 			BiosVendor:      "Intel",
@@ -548,6 +602,22 @@ func TestDisplayFunctions(t *testing.T) {
 		displaySWBOMInfo(nil) // Test nil case
 	})
 
+	t.Run("displayVulnerabilitiesInfo", func(t *testing.T) {
+		info := &pb.VulnerabilitiesInfo{
+			DatabaseFormat: "osv",
+			DatabaseSource: "ubuntu-osv.zip",
+			DatabaseLoaded: timestamppb.New(time.Now()),
+			Advisories:     2,
+			Findings: []*pb.VulnerabilityFinding{
+				{Id: "UBUNTU-CVE-2024-0001", Aliases: []string{"CVE-2024-0001"}, Package: "openssl", Architecture: "amd64",
+					InstalledVersion: "3.0.2-0ubuntu1.14", FixedVersion: "3.0.2-0ubuntu1.15", Severity: "high", CvssScore: 9.8, Summary: "buffer overflow"},
+				{Id: "UBUNTU-CVE-2024-0003", Package: "vim", InstalledVersion: "2:8.2.3995-1ubuntu2.15", Severity: "low"},
+			},
+		}
+		displayVulnerabilitiesInfo(info)
+		displayVulnerabilitiesInfo(nil) // Test nil case
+	})
+
 	t.Run("displaySWBOMInfo with many packages", func(t *testing.T) {
 		packages := make([]*pb.SoftwarePackage, 15)
 		for i := 0; i < 15; i++ {
@@ -564,6 +634,33 @@ func TestDisplayFunctions(t *testing.T) {
 		displaySWBOMInfo(swbom) // Should limit to 10 packages
 	})
 
+	t.Run("displayProvenanceInfo", func(t *testing.T) {
+		info := &pb.ProvenanceInfo{
+			Records: []*pb.ProvenanceRecord{
+				{
+					Time:           timestamppb.New(time.Now()),
+					PackageType:    "fota",
+					Artifact:       "bios.cap",
+					Verified:       true,
+					Format:         "sigstore-bundle",
+					PredicateType:  "https://slsa.dev/provenance/v1",
+					BuilderId:      "https://github.com/actions/runner",
+					SourceRepo:     "https://github.com/example/firmware",
+					Signer:         "builder@example.com",
+					ArtifactDigest: "sha256:abcd",
+				},
+				{
+					Time:        timestamppb.New(time.Now()),
+					PackageType: "sota",
+					Artifact:    "image.raw.gz",
+					Error:       "provenance is required but was not supplied",
+				},
+			},
+		}
+		displayProvenanceInfo(info)
+		displayProvenanceInfo(nil) // Test nil case
+	})
+
 	t.Run("displayVersionInfo", func(t *testing.T) {
 		version := &pb.VersionInfo{
 			Version:           "1.0.0",
@@ -620,3 +717,10 @@ func TestDisplayFunctions(t *testing.T) {
 		displayAllInfo(nil) // Test nil case
 	})
 }
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", formatBytes(512))
+	assert.Equal(t, "1.5 KiB", formatBytes(1536))
+	assert.Equal(t, "8.0 GiB", formatBytes(8<<30))
+	assert.Equal(t, "931.5 GiB", formatBytes(1000204886016))
+}
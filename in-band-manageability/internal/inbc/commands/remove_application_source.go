@@ -9,6 +9,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
@@ -45,7 +46,7 @@ func handleRemoveApplicationSource(
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("SOURCE APPLICATION REMOVE INBC Command was invoked.\n")
+		fmt.Fprintf(textOut, "SOURCE APPLICATION REMOVE INBC Command was invoked.\n")
 
 		request := &pb.RemoveApplicationSourceRequest{
 			Filename:   *filename,
@@ -57,12 +58,12 @@ func handleRemoveApplicationSource(
 
 		client, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
@@ -72,11 +73,11 @@ func handleRemoveApplicationSource(
 
 		resp, err := client.RemoveApplicationSource(ctx, request)
 		if err != nil {
-			return fmt.Errorf("error removing application source: %v", err)
+			return fmt.Errorf("error removing application source: %w", err)
 		}
 
-		fmt.Printf("SOURCE APPLICATION REMOVE Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		fmt.Fprintf(textOut, "SOURCE APPLICATION REMOVE Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
 
-		return nil
+		return finishResponse("source application remove", resp)
 	}
 }
@@ -0,0 +1,208 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// SBOMCmd returns the 'sbom' command.
+func SBOMCmd() *cobra.Command {
+	var socket string
+	var pageSize int32
+	var since string
+	var format string
+	var outputFile string
+	cmd := &cobra.Command{
+		Use:   "sbom",
+		Short: "Stream the software BOM",
+		Long: `Stream the software Bill of Materials (installed packages) page by page.
+
+With --since, only the packages added, removed or changed since that revision are listed.
+The revision of the current software BOM is printed with every response.
+
+Available formats:
+  packages  - List of packages (default)
+  spdx      - SPDX 2.3 JSON document
+  cyclonedx - CycloneDX 1.5 JSON document`,
+		Example: `  inbc sbom
+  inbc sbom --page-size 100
+  inbc sbom --since sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+  inbc sbom --format spdx --output-file /tmp/sbom.spdx.json
+  inbc sbom --format cyclonedx --output-file /tmp/sbom.cdx.json
+  inbc sbom --output json`,
+		RunE: handleSBOMCmd(&socket, &pageSize, &since, &format, &outputFile, Dial),
+	}
+
+	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+	cmd.Flags().Int32Var(&pageSize, "page-size", 0, "Number of packages per page (0 uses the server default, at most 1000)")
+	cmd.Flags().StringVar(&since, "since", "", "Only list the changes since this software BOM revision")
+	cmd.Flags().StringVarP(&format, "format", "f", "packages", "Software BOM format (packages, spdx, cyclonedx)")
+	cmd.Flags().StringVar(&outputFile, "output-file", "", "File to write an SPDX or CycloneDX document to (default: standard output)")
+	must(cmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(
+		[]string{"packages", "spdx", "cyclonedx"}, cobra.ShellCompDirectiveNoFileComp)))
+
+	return cmd
+}
+
+// handleSBOMCmd is a helper function to handle the SBOMCmd
+func handleSBOMCmd(
+	socket *string,
+	pageSize *int32,
+	since *string,
+	format *string,
+	outputFile *string,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fmt.Fprintln(textOut, "SBOM command invoked.")
+
+		sbomFormat, err := parseSBOMFormat(*format)
+		if err != nil {
+			return err
+		}
+		document := sbomFormat != pb.SBOMFormat_SBOM_FORMAT_PACKAGES
+		if *pageSize < 0 || *pageSize > 1000 {
+			return errors.New("page size must be between 0 and 1000")
+		}
+		if *since != "" && document {
+			return errors.New("--since can only be used with the packages format")
+		}
+		if *outputFile != "" && !document {
+			return errors.New("--output-file can only be used with the spdx or cyclonedx format")
+		}
+
+		client, closeConn, err := dialConfigClient(*socket, dialer)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		ctx, cancel := context.WithTimeout(context.Background(), sbomTimeoutInSeconds*time.Second)
+		defer cancel()
+
+		stream, err := client.StreamSoftwareBOM(ctx, &pb.StreamSoftwareBOMRequest{
+			PageSize:      *pageSize,
+			SinceRevision: *since,
+			Format:        sbomFormat,
+		})
+		if err != nil {
+			return fmt.Errorf("error performing sbom: %w", err)
+		}
+
+		var doc []byte
+		for {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("error receiving sbom: %w", err)
+			}
+			if chunk.GetStatusCode() != 200 {
+				fmt.Fprintf(textOut, "SBOM Response: %d-%s\n", chunk.GetStatusCode(), chunk.GetError())
+				return finishResponse("sbom", chunk)
+			}
+			if document {
+				doc = append(doc, chunk.GetDocument()...)
+				continue
+			}
+			displaySBOMChunk(chunk)
+			if err := writeResponse(chunk); err != nil {
+				return err
+			}
+		}
+
+		if !document {
+			return nil
+		}
+		if *outputFile == "" {
+			// The SPDX and CycloneDX documents are JSON already, and are written as is.
+			fmt.Fprintln(responseOut, string(doc))
+			return nil
+		}
+		if err := os.WriteFile(*outputFile, doc, 0640); err != nil {
+			return fmt.Errorf("error writing sbom document: %v", err)
+		}
+		fmt.Fprintf(textOut, "SBOM Response: 200-\nWrote %d bytes to %s\n", len(doc), *outputFile)
+		return nil
+	}
+}
+
+// parseSBOMFormat converts string to SBOMFormat enum
+func parseSBOMFormat(format string) (pb.SBOMFormat, error) {
+	switch strings.ToLower(format) {
+	case "", "packages":
+		return pb.SBOMFormat_SBOM_FORMAT_PACKAGES, nil
+	case "spdx", "spdx-json":
+		return pb.SBOMFormat_SBOM_FORMAT_SPDX_JSON, nil
+	case "cyclonedx", "cyclonedx-json":
+		return pb.SBOMFormat_SBOM_FORMAT_CYCLONEDX_JSON, nil
+	default:
+		return pb.SBOMFormat_SBOM_FORMAT_UNSPECIFIED, fmt.Errorf("invalid sbom format '%s'. Valid formats: packages, spdx, cyclonedx", format)
+	}
+}
+
+// displaySBOMChunk displays one page of packages or package changes
+func displaySBOMChunk(chunk *pb.SoftwareBOMChunk) {
+	if chunk.GetPage() == 1 {
+		fmt.Fprintln(textOut, "SBOM Response: 200-")
+		fmt.Fprintf(textOut, "Revision: %s\n", chunk.GetRevision())
+		if chunk.GetCollectionTimestamp() != nil {
+			fmt.Fprintf(textOut, "Collection Timestamp: %s\n", chunk.GetCollectionTimestamp().AsTime().Format(time.RFC3339))
+		}
+		if chunk.GetCollectionMethod() != "" {
+			fmt.Fprintf(textOut, "Collection Method: %s\n", chunk.GetCollectionMethod())
+		}
+		fmt.Fprintf(textOut, "Total Packages: %d\n", chunk.GetTotalPackages())
+	}
+	if chunk.GetTotalPages() > 1 {
+		fmt.Fprintf(textOut, "\nPage %d of %d\n", chunk.GetPage(), chunk.GetTotalPages())
+	}
+
+	if chunk.GetDelta() {
+		if chunk.GetPage() == 1 && len(chunk.GetChanges()) == 0 {
+			fmt.Fprintln(textOut, "No changes")
+		}
+		for _, c := range chunk.GetChanges() {
+			p := c.GetPackage()
+			switch c.GetChangeType() {
+			case pb.SoftwarePackageChange_CHANGE_TYPE_ADDED:
+				fmt.Fprintf(textOut, "  + %s %s %s\n", p.GetName(), p.GetVersion(), p.GetArchitecture())
+			case pb.SoftwarePackageChange_CHANGE_TYPE_REMOVED:
+				fmt.Fprintf(textOut, "  - %s %s %s\n", p.GetName(), p.GetVersion(), p.GetArchitecture())
+			default:
+				fmt.Fprintf(textOut, "  ~ %s %s -> %s %s\n", p.GetName(), c.GetPreviousVersion(), p.GetVersion(), p.GetArchitecture())
+			}
+		}
+		return
+	}
+
+	for _, p := range chunk.GetPackages() {
+		fmt.Fprintf(textOut, "  - %s", p.GetName())
+		if p.GetVersion() != "" {
+			fmt.Fprintf(textOut, " (%s)", p.GetVersion())
+		}
+		if p.GetType() != "" {
+			fmt.Fprintf(textOut, " [%s]", p.GetType())
+		}
+		if p.GetArchitecture() != "" {
+			fmt.Fprintf(textOut, " (%s)", p.GetArchitecture())
+		}
+		fmt.Fprintln(textOut)
+	}
+}
@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func sbomDialer(client *MockInbServiceClient) func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+	return func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return client, &MockClientConn{}, nil
+	}
+}
+
+func TestSBOMCmd(t *testing.T) {
+	cmd := SBOMCmd()
+	assert.Equal(t, "sbom", cmd.Use)
+	for _, name := range []string{"socket", "page-size", "since", "format", "output-file"} {
+		assert.NotNil(t, cmd.Flag(name), name)
+	}
+	assert.Equal(t, "packages", cmd.Flag("format").DefValue)
+}
+
+func TestParseSBOMFormat(t *testing.T) {
+	format, err := parseSBOMFormat("SPDX")
+	require.NoError(t, err)
+	assert.Equal(t, pb.SBOMFormat_SBOM_FORMAT_SPDX_JSON, format)
+
+	format, err = parseSBOMFormat("cyclonedx")
+	require.NoError(t, err)
+	assert.Equal(t, pb.SBOMFormat_SBOM_FORMAT_CYCLONEDX_JSON, format)
+
+	_, err = parseSBOMFormat("xml")
+	assert.ErrorContains(t, err, "invalid sbom format")
+}
+
+func TestHandleSBOMCmd_Pages(t *testing.T) {
+	socket := "/tmp/test.sock"
+	pageSize := int32(1)
+	since := ""
+	format := "packages"
+	output := ""
+
+	stream := &MockSoftwareBOMStream{Chunks: []*pb.SoftwareBOMChunk{
+		{StatusCode: 200, Revision: "sha256:abc", Page: 1, TotalPages: 2, TotalPackages: 2,
+			Packages: []*pb.SoftwarePackage{{Name: "bash", Version: "5.1", Type: "deb"}}},
+		{StatusCode: 200, Revision: "sha256:abc", Page: 2, TotalPages: 2, TotalPackages: 2,
+			Packages: []*pb.SoftwarePackage{{Name: "vim", Version: "8.2", Type: "deb"}}},
+	}}
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("StreamSoftwareBOM", mock.Anything, &pb.StreamSoftwareBOMRequest{PageSize: 1, Format: pb.SBOMFormat_SBOM_FORMAT_PACKAGES}, mock.Anything).
+		Return(stream, nil)
+
+	err := handleSBOMCmd(&socket, &pageSize, &since, &format, &output, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+	assert.NoError(t, err)
+	assert.Empty(t, stream.Chunks)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleSBOMCmd_Delta(t *testing.T) {
+	socket := "/tmp/test.sock"
+	pageSize := int32(0)
+	since := "sha256:abc"
+	format := "packages"
+	output := ""
+
+	stream := &MockSoftwareBOMStream{Chunks: []*pb.SoftwareBOMChunk{
+		{StatusCode: 200, Revision: "sha256:def", Page: 1, TotalPages: 1, Delta: true, Changes: []*pb.SoftwarePackageChange{
+			{ChangeType: pb.SoftwarePackageChange_CHANGE_TYPE_CHANGED, Package: &pb.SoftwarePackage{Name: "vim", Version: "9.0"}, PreviousVersion: "8.2"},
+		}},
+	}}
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("StreamSoftwareBOM", mock.Anything, &pb.StreamSoftwareBOMRequest{SinceRevision: since, Format: pb.SBOMFormat_SBOM_FORMAT_PACKAGES}, mock.Anything).
+		Return(stream, nil)
+
+	err := handleSBOMCmd(&socket, &pageSize, &since, &format, &output, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleSBOMCmd_DocumentToFile(t *testing.T) {
+	socket := "/tmp/test.sock"
+	pageSize := int32(0)
+	since := ""
+	format := "spdx"
+	output := filepath.Join(t.TempDir(), "sbom.spdx.json")
+
+	stream := &MockSoftwareBOMStream{Chunks: []*pb.SoftwareBOMChunk{
+		{StatusCode: 200, Page: 1, TotalPages: 2, Document: []byte(`{"spdxVersion":`)},
+		{StatusCode: 200, Page: 2, TotalPages: 2, Document: []byte(`"SPDX-2.3"}`)},
+	}}
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("StreamSoftwareBOM", mock.Anything, &pb.StreamSoftwareBOMRequest{Format: pb.SBOMFormat_SBOM_FORMAT_SPDX_JSON}, mock.Anything).
+		Return(stream, nil)
+
+	err := handleSBOMCmd(&socket, &pageSize, &since, &format, &output, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+	require.NoError(t, err)
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"spdxVersion":"SPDX-2.3"}`, string(data))
+}
+
+func TestHandleSBOMCmd_ErrorChunk(t *testing.T) {
+	socket := "/tmp/test.sock"
+	pageSize := int32(0)
+	since := ""
+	format := "cyclonedx"
+	output := filepath.Join(t.TempDir(), "sbom.cdx.json")
+
+	stream := &MockSoftwareBOMStream{Chunks: []*pb.SoftwareBOMChunk{{StatusCode: 500, Error: "dpkg-query failed"}}}
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("StreamSoftwareBOM", mock.Anything, mock.Anything, mock.Anything).Return(stream, nil)
+
+	err := handleSBOMCmd(&socket, &pageSize, &since, &format, &output, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+	assert.EqualError(t, err, "sbom failed: 500-dpkg-query failed")
+	assert.Equal(t, ExitServerError, ExitCode(err))
+	assert.NoFileExists(t, output)
+}
+
+func TestHandleSBOMCmd_StreamError(t *testing.T) {
+	socket := "/tmp/test.sock"
+	pageSize := int32(0)
+	since := ""
+	format := "packages"
+	output := ""
+
+	stream := &MockSoftwareBOMStream{Err: errors.New("connection reset")}
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("StreamSoftwareBOM", mock.Anything, mock.Anything, mock.Anything).Return(stream, nil)
+
+	err := handleSBOMCmd(&socket, &pageSize, &since, &format, &output, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+	assert.ErrorContains(t, err, "error receiving sbom: connection reset")
+}
+
+func TestHandleSBOMCmd_InvalidFlags(t *testing.T) {
+	socket := "/tmp/test.sock"
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		t.Fatal("dialer must not be called")
+		return nil, nil, nil
+	}
+
+	tests := []struct {
+		name     string
+		pageSize int32
+		since    string
+		format   string
+		output   string
+		wantErr  string
+	}{
+		{"invalid format", 0, "", "xml", "", "invalid sbom format"},
+		{"page size too large", 1001, "", "packages", "", "page size must be between 0 and 1000"},
+		{"since with document", 0, "sha256:abc", "spdx", "", "--since can only be used with the packages format"},
+		{"output with packages", 0, "", "packages", "/tmp/sbom.json", "--output-file can only be used with the spdx or cyclonedx format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := handleSBOMCmd(&socket, &tt.pageSize, &tt.since, &tt.format, &tt.output, dialer)(&cobra.Command{}, []string{})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -9,6 +9,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
@@ -27,12 +28,14 @@ func SOTACmd() *cobra.Command {
 	var reboot bool
 	var packageList []string
 	var signature string
+	var provenanceURL string
+	var stagedID string
 
 	cmd := &cobra.Command{
 		Use:   "sota",
 		Short: "Performs System Software Update",
 		Long:  `Updates the system software on the device.`,
-		RunE:  handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &packageList, &signature, common.DetectOS, Dial),
+		RunE:  handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &packageList, &signature, &provenanceURL, &stagedID, common.DetectOS, Dial),
 	}
 
 	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
@@ -42,6 +45,8 @@ func SOTACmd() *cobra.Command {
 	cmd.Flags().BoolVar(&reboot, "reboot", true, "Whether to reboot after the software update attempt")
 	cmd.Flags().StringSliceVar(&packageList, "package-list", []string{}, "List of packages to install if whole package update isn't desired")
 	cmd.Flags().StringVar(&signature, "signature", "", "Signature of the package")
+	cmd.Flags().StringVar(&provenanceURL, "provenance-url", "", "URL of the provenance (Sigstore bundle, DSSE envelope or in-toto attestations) of the package")
+	cmd.Flags().StringVar(&stagedID, "staged-id", "", "ID of a staged image to install, from 'inbc staged list' (EMT, with --mode no-download)")
 
 	return cmd
 }
@@ -55,11 +60,13 @@ func handleSOTA(
 	reboot *bool,
 	packageList *[]string,
 	signature *string,
+	provenanceURL *string,
+	stagedID *string,
 	detectOS func() (string, error),
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("SOTA INBC Command was invoked.\n")
+		fmt.Fprintf(textOut, "SOTA INBC Command was invoked.\n")
 
 		// Validate and parse the release date
 		var releaseDateProto *timestamppb.Timestamp
@@ -92,14 +99,19 @@ func handleSOTA(
 		default:
 			return fmt.Errorf("invalid mode. Use one of full, no-download, download-only")
 		}
+		if *stagedID != "" && (*mode != "no-download" || *url != "") {
+			return fmt.Errorf("--staged-id installs a staged image: use it with --mode no-download and without --uri")
+		}
 
 		request := &pb.UpdateSystemSoftwareRequest{
-			Url:         *url,
-			ReleaseDate: releaseDateProto,
-			Mode:        pb.UpdateSystemSoftwareRequest_DownloadMode(downloadMode),
-			DoNotReboot: !*reboot,
-			PackageList: *packageList,
-			Signature:   *signature,
+			Url:              *url,
+			ReleaseDate:      releaseDateProto,
+			Mode:             pb.UpdateSystemSoftwareRequest_DownloadMode(downloadMode),
+			DoNotReboot:      !*reboot,
+			PackageList:      *packageList,
+			Signature:        *signature,
+			ProvenanceUrl:    *provenanceURL,
+			StagedArtifactId: *stagedID,
 		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeoutInSeconds*time.Second)
@@ -107,12 +119,12 @@ func handleSOTA(
 
 		client, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
@@ -132,16 +144,15 @@ func handleSOTA(
 
 		resp, err := client.UpdateSystemSoftware(ctx, request)
 		if err != nil {
-			return fmt.Errorf("error updating system software: %v", err)
+			return fmt.Errorf("error updating system software: %w", err)
 		}
 
-		fmt.Printf("SOTA Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
-
-		// Check if the operation failed based on status code
-		if resp.GetStatusCode() != 200 {
-			return fmt.Errorf("SOTA operation failed: %s", resp.GetError())
+		fmt.Fprintf(textOut, "SOTA Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		if resp.GetStagedArtifactId() != "" {
+			fmt.Fprintf(textOut, "Staged image ID: %s\n", resp.GetStagedArtifactId())
 		}
 
-		return nil
+		// Check if the operation failed based on status code
+		return finishResponse("SOTA operation", resp)
 	}
 }
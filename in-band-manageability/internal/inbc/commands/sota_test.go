@@ -7,6 +7,7 @@
 package commands
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
@@ -69,6 +70,8 @@ func TestHandleSOTA(t *testing.T) {
 	reboot := true
 	packageList := []string{"package1", "package2"}
 	signature := "signature"
+	provenanceURL := ""
+	stagedID := ""
 	cmd := &cobra.Command{}
 	args := []string{}
 
@@ -86,7 +89,7 @@ func TestHandleSOTA(t *testing.T) {
 			return "ubuntu", nil
 		}
 
-		err := handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &packageList, &signature, detectOS, dialer)(cmd, args)
+		err := handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &packageList, &signature, &provenanceURL, &stagedID, detectOS, dialer)(cmd, args)
 		assert.NoError(t, err, "handleSOTA should not return an error")
 
 		mockClient.AssertExpectations(t)
@@ -103,7 +106,7 @@ func TestHandleSOTA(t *testing.T) {
 		}
 
 		err := handleSOTA(&socket, &url, &invalidReleaseDate,
-			&mode, &reboot, &packageList, &signature, detectOS, dialer)(cmd, args)
+			&mode, &reboot, &packageList, &signature, &provenanceURL, &stagedID, detectOS, dialer)(cmd, args)
 		assert.Error(t, err, "error parsing release date: parsing time")
 	})
 
@@ -116,7 +119,7 @@ func TestHandleSOTA(t *testing.T) {
 		detectOS := func() (string, error) {
 			return "ubuntu", nil
 		}
-		err := handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &duplicatePackageList, &signature, detectOS, dialer)(cmd, args)
+		err := handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &duplicatePackageList, &signature, &provenanceURL, &stagedID, detectOS, dialer)(cmd, args)
 		assert.Error(t, err, "duplicate package in the package list: package1")
 	})
 
@@ -131,7 +134,7 @@ func TestHandleSOTA(t *testing.T) {
 		}
 
 		err := handleSOTA(&socket, &url, &releaseDate,
-			&invalidMode, &reboot, &packageList, &signature, detectOS, dialer)(cmd, args)
+			&invalidMode, &reboot, &packageList, &signature, &provenanceURL, &stagedID, detectOS, dialer)(cmd, args)
 		assert.Error(t, err, "invalid mode. Use one of full, no-download, download-only")
 	})
 
@@ -144,7 +147,7 @@ func TestHandleSOTA(t *testing.T) {
 			return "ubuntu", nil
 		}
 		err := handleSOTA(&socket, &url, &releaseDate, &mode,
-			&reboot, &packageList, &signature, detectOS, dialer)(cmd, args)
+			&reboot, &packageList, &signature, &provenanceURL, &stagedID, detectOS, dialer)(cmd, args)
 		assert.Error(t, err, "error setting up new gRPC client")
 	})
 
@@ -159,7 +162,7 @@ func TestHandleSOTA(t *testing.T) {
 			return "ubuntu", nil
 		}
 
-		err := handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &packageList, &signature, detectOS, dialer)(cmd, args)
+		err := handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &packageList, &signature, &provenanceURL, &stagedID, detectOS, dialer)(cmd, args)
 		assert.Error(t, err, "error updating system software")
 	})
 
@@ -177,7 +180,68 @@ func TestHandleSOTA(t *testing.T) {
 			return "ubuntu", nil
 		}
 
-		err := handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &packageList, &signature, detectOS, dialer)(cmd, args)
+		err := handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &packageList, &signature, &provenanceURL, &stagedID, detectOS, dialer)(cmd, args)
 		assert.NoError(t, err, "handleSOTA should not return an error even if Close fails")
 	})
+
+	t.Run("install a staged image", func(t *testing.T) {
+		var buf bytes.Buffer
+		original := textOut
+		textOut = &buf
+		defer func() { textOut = original }()
+
+		noURL, noDownload, id := "", "no-download", "0123456789abcdef"
+		mockClient := new(MockInbServiceClient)
+		mockClient.On("UpdateSystemSoftware", mock.Anything, mock.MatchedBy(func(req *pb.UpdateSystemSoftwareRequest) bool {
+			return req.StagedArtifactId == id && req.Mode == pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_NO_DOWNLOAD
+		}), mock.Anything).Return(&pb.UpdateResponse{StatusCode: 200, Error: "Success"}, nil)
+		dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+			return MockDialer(ctx, socket, mockClient, false)
+		}
+		detectOS := func() (string, error) {
+			return "EMT", nil
+		}
+
+		err := handleSOTA(&socket, &noURL, &releaseDate, &noDownload, &reboot, &packageList, &signature, &provenanceURL, &id, detectOS, dialer)(cmd, args)
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("staged image ID requires no-download without a URL", func(t *testing.T) {
+		id := "0123456789abcdef"
+		mockClient := new(MockInbServiceClient)
+		dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+			return MockDialer(ctx, socket, mockClient, false)
+		}
+		detectOS := func() (string, error) {
+			return "EMT", nil
+		}
+
+		err := handleSOTA(&socket, &url, &releaseDate, &mode, &reboot, &packageList, &signature, &provenanceURL, &id, detectOS, dialer)(cmd, args)
+		assert.ErrorContains(t, err, "--staged-id installs a staged image")
+		mockClient.AssertNotCalled(t, "UpdateSystemSoftware", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("download-only prints the staged image ID", func(t *testing.T) {
+		var buf bytes.Buffer
+		original := textOut
+		textOut = &buf
+		defer func() { textOut = original }()
+
+		downloadOnly := "download-only"
+		mockClient := new(MockInbServiceClient)
+		mockClient.On("UpdateSystemSoftware", mock.Anything, mock.Anything, mock.Anything).Return(&pb.UpdateResponse{
+			StatusCode: 200, Error: "Success", StagedArtifactId: "0123456789abcdef",
+		}, nil)
+		dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+			return MockDialer(ctx, socket, mockClient, false)
+		}
+		detectOS := func() (string, error) {
+			return "EMT", nil
+		}
+
+		err := handleSOTA(&socket, &url, &releaseDate, &downloadOnly, &reboot, &packageList, &signature, &provenanceURL, &stagedID, detectOS, dialer)(cmd, args)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Staged image ID: 0123456789abcdef")
+	})
 }
@@ -21,6 +21,7 @@ func SourceApplicationCmd() *cobra.Command {
 	// Add subcommands to Source Application command
 	cmd.AddCommand(AddApplicationSourceCmd())
 	cmd.AddCommand(RemoveApplicationSourceCmd())
+	cmd.AddCommand(ListApplicationSourcesCmd())
 
 	return cmd
 }
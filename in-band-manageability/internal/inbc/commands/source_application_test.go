@@ -20,13 +20,16 @@ func TestSourceApplicationCmd(t *testing.T) {
 	assert.Equal(t, `Source command is used to modify the application files used for performing application updates.`, cmd.Long, "command long description should match")
 
 	subcommands := cmd.Commands()
-	assert.Len(t, subcommands, 2, "there should be 2 subcommands")
+	assert.Len(t, subcommands, 3, "there should be 3 subcommands")
 
 	addCmd := subcommands[0]
 	assert.Equal(t, "add", addCmd.Use, "first subcommand should be 'add'")
 
-	removeCmd := subcommands[1]
-	assert.Equal(t, "remove", removeCmd.Use, "second subcommand should be 'remove'")
+	listCmd := subcommands[1]
+	assert.Equal(t, "list", listCmd.Use, "second subcommand should be 'list'")
+
+	removeCmd := subcommands[2]
+	assert.Equal(t, "remove", removeCmd.Use, "third subcommand should be 'remove'")
 }
 
 func TestAddApplicationSourceSubCmd(t *testing.T) {
@@ -0,0 +1,145 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// StagedCmd returns a cobra command for the staged image commands
+func StagedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staged",
+		Short: "Manages the staged EMT update images",
+		Long: `Staged command is used to list and delete the EMT update images downloaded with 'inbc sota --mode download-only'.
+Use 'inbc sota --mode no-download --staged-id <id>' to install a staged image.`,
+	}
+
+	cmd.AddCommand(StagedListCmd())
+	cmd.AddCommand(StagedDeleteCmd())
+
+	return cmd
+}
+
+// StagedListCmd returns the 'staged list' subcommand.
+func StagedListCmd() *cobra.Command {
+	var socket string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the staged update images",
+		RunE:  handleStagedListCmd(&socket, Dial),
+	}
+
+	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+
+	return cmd
+}
+
+// StagedDeleteCmd returns the 'staged delete' subcommand.
+func StagedDeleteCmd() *cobra.Command {
+	var socket string
+	var id string
+
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Delete a staged update image",
+		Example: `  inbc staged delete --id 3f2a9c4e1b7d6a05`,
+		RunE:    handleStagedDeleteCmd(&socket, &id, Dial),
+	}
+
+	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+	cmd.Flags().StringVar(&id, "id", "", "ID of the staged image")
+	must(cmd.MarkFlagRequired("id"))
+
+	return cmd
+}
+
+// handleStagedListCmd is a helper function to handle the StagedListCmd
+func handleStagedListCmd(
+	socket *string,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fmt.Fprintln(textOut, "STAGED LIST command invoked.")
+
+		client, closeConn, err := dialConfigClient(*socket, dialer)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		ctx, cancel := context.WithTimeout(context.Background(), sourceTimeoutInSeconds*time.Second)
+		defer cancel()
+
+		resp, err := client.ListStagedArtifacts(ctx, &pb.ListStagedArtifactsRequest{})
+		if err != nil {
+			return fmt.Errorf("error listing staged images: %w", err)
+		}
+
+		fmt.Fprintf(textOut, "STAGED LIST Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		displayStagedArtifacts(resp.GetArtifacts())
+
+		return finishResponse("staged list", resp)
+	}
+}
+
+// handleStagedDeleteCmd is a helper function to handle the StagedDeleteCmd
+func handleStagedDeleteCmd(
+	socket *string,
+	id *string,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fmt.Fprintln(textOut, "STAGED DELETE command invoked.")
+
+		if *id == "" {
+			return errors.New("id is required")
+		}
+
+		client, closeConn, err := dialConfigClient(*socket, dialer)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		ctx, cancel := context.WithTimeout(context.Background(), sourceTimeoutInSeconds*time.Second)
+		defer cancel()
+
+		resp, err := client.DeleteStagedArtifact(ctx, &pb.DeleteStagedArtifactRequest{Id: *id})
+		if err != nil {
+			return fmt.Errorf("error deleting staged image: %w", err)
+		}
+
+		fmt.Fprintf(textOut, "STAGED DELETE Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+
+		return finishResponse("staged delete", resp)
+	}
+}
+
+// displayStagedArtifacts displays the staged update images.
+func displayStagedArtifacts(artifacts []*pb.StagedArtifact) {
+	for _, a := range artifacts {
+		fmt.Fprintf(textOut, "  - %s: %s", a.GetId(), a.GetFileName())
+		if a.GetWritten() {
+			fmt.Fprint(textOut, " (written)")
+		}
+		fmt.Fprintln(textOut)
+		fmt.Fprintf(textOut, "    URL: %s\n", a.GetUrl())
+		fmt.Fprintf(textOut, "    SHA-256: %s\n", a.GetSha256())
+		fmt.Fprintf(textOut, "    Size: %d bytes\n", a.GetSizeBytes())
+		fmt.Fprintf(textOut, "    Signature: %s\n", a.GetSignatureStatus())
+		fmt.Fprintf(textOut, "    Staged: %s\n", a.GetStagedTime().AsTime().Local().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestStagedCmd(t *testing.T) {
+	cmd := StagedCmd()
+	assert.Equal(t, "staged", cmd.Use)
+	names := []string{}
+	for _, sub := range cmd.Commands() {
+		names = append(names, sub.Name())
+	}
+	assert.ElementsMatch(t, []string{"list", "delete"}, names)
+
+	id := StagedDeleteCmd().Flags().Lookup("id")
+	assert.NotNil(t, id)
+	assert.Equal(t, []string{"true"}, id.Annotations[cobra.BashCompOneRequiredFlag])
+}
+
+func TestHandleStagedListCmd(t *testing.T) {
+	socket := "/tmp/test.sock"
+
+	t.Run("success", func(t *testing.T) {
+		var buf bytes.Buffer
+		original := textOut
+		textOut = &buf
+		defer func() { textOut = original }()
+
+		mockClient := &MockInbServiceClient{}
+		mockClient.On("ListStagedArtifacts", mock.Anything, &pb.ListStagedArtifactsRequest{}, mock.Anything).
+			Return(&pb.ListStagedArtifactsResponse{StatusCode: 200, Error: "Success", Artifacts: []*pb.StagedArtifact{{
+				Id:              "3f2a9c4e1b7d6a05",
+				Url:             "https://example.com/emt.raw.gz",
+				FileName:        "emt.raw.gz",
+				Sha256:          "abc123",
+				SizeBytes:       1024,
+				SignatureStatus: "verified",
+				StagedTime:      timestamppb.New(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)),
+				Written:         true,
+			}}}, nil)
+
+		err := handleStagedListCmd(&socket, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "  - 3f2a9c4e1b7d6a05: emt.raw.gz (written)\n    URL: https://example.com/emt.raw.gz\n    SHA-256: abc123\n    Size: 1024 bytes\n    Signature: verified\n")
+	})
+
+	t.Run("gRPC error", func(t *testing.T) {
+		mockClient := &MockInbServiceClient{}
+		mockClient.On("ListStagedArtifacts", mock.Anything, mock.Anything, mock.Anything).
+			Return(&pb.ListStagedArtifactsResponse{}, errors.New("connection refused"))
+
+		err := handleStagedListCmd(&socket, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+		assert.ErrorContains(t, err, "error listing staged images")
+	})
+}
+
+func TestHandleStagedDeleteCmd(t *testing.T) {
+	socket := "/tmp/test.sock"
+
+	t.Run("success", func(t *testing.T) {
+		id := "3f2a9c4e1b7d6a05"
+		mockClient := &MockInbServiceClient{}
+		mockClient.On("DeleteStagedArtifact", mock.Anything, &pb.DeleteStagedArtifactRequest{Id: id}, mock.Anything).
+			Return(&pb.DeleteStagedArtifactResponse{StatusCode: 200, Error: "Success"}, nil)
+
+		err := handleStagedDeleteCmd(&socket, &id, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		id := "missing"
+		mockClient := &MockInbServiceClient{}
+		mockClient.On("DeleteStagedArtifact", mock.Anything, mock.Anything, mock.Anything).
+			Return(&pb.DeleteStagedArtifactResponse{StatusCode: 404, Error: "staged artifact not found: missing"}, nil)
+
+		err := handleStagedDeleteCmd(&socket, &id, sbomDialer(mockClient))(&cobra.Command{}, []string{})
+		assert.Equal(t, ExitNotFound, ExitCode(err))
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		id := ""
+		err := handleStagedDeleteCmd(&socket, &id, sbomDialer(&MockInbServiceClient{}))(&cobra.Command{}, []string{})
+		assert.EqualError(t, err, "id is required")
+	})
+}
@@ -9,6 +9,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
@@ -42,7 +43,7 @@ func handleUpdateOSSource(
 	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("SOURCE OS UPDATE INBC Command was invoked.\n")
+		fmt.Fprintf(textOut, "SOURCE OS UPDATE INBC Command was invoked.\n")
 
 		// Validate and parse the package list
 		sourcesSet := make(map[string]struct{})
@@ -62,12 +63,12 @@ func handleUpdateOSSource(
 
 		client, conn, err := dialer(ctx, *socket)
 		if err != nil {
-			return fmt.Errorf("error setting up new gRPC client: %v", err)
+			return fmt.Errorf("error setting up new gRPC client: %w", err)
 		}
 		defer func() {
 			if c, ok := conn.(*grpc.ClientConn); ok {
 				if err := c.Close(); err != nil {
-					fmt.Printf("Warning: failed to close gRPC connection: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Warning: failed to close gRPC connection: %v\n", err)
 				}
 			}
 		}()
@@ -77,11 +78,11 @@ func handleUpdateOSSource(
 
 		resp, err := client.UpdateOSSource(ctx, request)
 		if err != nil {
-			return fmt.Errorf("error updating OS sources: %v", err)
+			return fmt.Errorf("error updating OS sources: %w", err)
 		}
 
-		fmt.Printf("SOURCE OS UPDATE Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		fmt.Fprintf(textOut, "SOURCE OS UPDATE Command Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
 
-		return nil
+		return finishResponse("source os update", resp)
 	}
 }
@@ -0,0 +1,106 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// VulnDBCmd returns a cobra command for the vulnerability database commands
+func VulnDBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vulndb",
+		Short: "Manages the offline vulnerability database",
+		Long: `Vulndb command is used to load the offline vulnerability database that the installed packages are matched against.
+
+The database is a signed OSV dataset (.json or .zip) or an Ubuntu or Debian OVAL file (.xml), optionally compressed with bzip2 (.bz2).
+Use 'inbc query --option vulnerabilities' to list the installed packages affected by its advisories.`,
+	}
+
+	cmd.AddCommand(VulnDBLoadCmd())
+
+	return cmd
+}
+
+// VulnDBLoadCmd returns the 'vulndb load' subcommand.
+func VulnDBLoadCmd() *cobra.Command {
+	var socket string
+	var uri, signature, hashAlgorithm string
+	cmd := &cobra.Command{
+		Use:   "load",
+		Short: "Load a signed OSV or OVAL vulnerability dataset",
+		Example: `  inbc vulndb load --uri /var/cache/manageability/ubuntu-osv.zip --signature <signature>
+  inbc vulndb load -u /var/cache/manageability/com.ubuntu.jammy.usn.oval.xml.bz2 -s <signature> --hash_algorithm sha512`,
+		RunE: handleVulnDBLoadCmd(&socket, &uri, &signature, &hashAlgorithm, Dial),
+	}
+
+	cmd.Flags().StringVar(&socket, "socket", "/var/run/inbd.sock", "UNIX domain socket path")
+	cmd.Flags().StringVarP(&uri, "uri", "u", "", "Local path of the vulnerability dataset")
+	cmd.Flags().StringVarP(&signature, "signature", "s", "", "Signature for the vulnerability dataset")
+	cmd.Flags().StringVar(&hashAlgorithm, "hash_algorithm", "", "Hash algorithm to use for signature verification (sha256, sha384, sha512). Default is sha384.")
+	must(cmd.RegisterFlagCompletionFunc("hash_algorithm", hashAlgorithmCompletion))
+	must(cmd.MarkFlagRequired("uri"))
+	must(cmd.MarkFlagRequired("signature"))
+
+	return cmd
+}
+
+// handleVulnDBLoadCmd is a helper function to handle the VulnDBLoadCmd
+func handleVulnDBLoadCmd(
+	socket *string,
+	uri *string,
+	signature *string,
+	hashAlgorithm *string,
+	dialer func(context.Context, string) (pb.InbServiceClient, grpc.ClientConnInterface, error),
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fmt.Fprintln(textOut, "VULNDB LOAD command invoked.")
+
+		if *uri == "" {
+			return errors.New("uri is required")
+		}
+		if *signature == "" {
+			return errors.New("signature is required")
+		}
+
+		// Default to sha384 if not provided
+		finalHashAlgorithm := "sha384"
+		if *hashAlgorithm != "" {
+			finalHashAlgorithm = *hashAlgorithm
+		}
+
+		client, closeConn, err := dialConfigClient(*socket, dialer)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		ctx, cancel := context.WithTimeout(context.Background(), vulnDBTimeoutInSeconds*time.Second)
+		defer cancel()
+
+		resp, err := client.LoadVulnerabilityDatabase(ctx, &pb.LoadVulnerabilityDatabaseRequest{
+			Uri:           *uri,
+			Signature:     *signature,
+			HashAlgorithm: finalHashAlgorithm,
+		})
+		if err != nil {
+			return fmt.Errorf("error performing vulndb load: %w", err)
+		}
+		fmt.Fprintf(textOut, "VULNDB LOAD Response: %d-%s\n", resp.GetStatusCode(), resp.GetError())
+		if resp.GetStatusCode() == 200 {
+			fmt.Fprintf(textOut, "Loaded %d advisories from the %s dataset\n", resp.GetAdvisories(), resp.GetFormat())
+		}
+		return finishResponse("vulndb load", resp)
+	}
+}
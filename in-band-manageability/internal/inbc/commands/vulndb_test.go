@@ -0,0 +1,108 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package commands are the commands that are used by the INBC tool.
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+func TestVulnDBCmd_Subcommands(t *testing.T) {
+	cmd := VulnDBCmd()
+	names := []string{}
+	for _, c := range cmd.Commands() {
+		names = append(names, c.Name())
+	}
+	assert.ElementsMatch(t, []string{"load"}, names)
+}
+
+func TestVulnDBLoadCmd_RequiredFlags(t *testing.T) {
+	cmd := VulnDBLoadCmd()
+	for _, name := range []string{"uri", "signature"} {
+		flag := cmd.Flags().Lookup(name)
+		if assert.NotNil(t, flag, name) {
+			assert.Equal(t, []string{"true"}, flag.Annotations[cobra.BashCompOneRequiredFlag], name)
+		}
+	}
+}
+
+func TestHandleVulnDBLoadCmd_Success(t *testing.T) {
+	socket := "/tmp/test.sock"
+	uri := "/var/cache/manageability/ubuntu-osv.zip"
+	signature := "abc123"
+	hashAlgorithm := ""
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("LoadVulnerabilityDatabase", mock.Anything, &pb.LoadVulnerabilityDatabaseRequest{
+		Uri: uri, Signature: signature, HashAlgorithm: "sha384",
+	}, mock.Anything).Return(&pb.LoadVulnerabilityDatabaseResponse{StatusCode: 200, Success: true, Format: "osv", Advisories: 42}, nil)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return mockClient, &MockClientConn{}, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleVulnDBLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleVulnDBLoadCmd_MissingSignature(t *testing.T) {
+	socket := "/tmp/test.sock"
+	uri := "/var/cache/manageability/ubuntu-osv.zip"
+	signature := ""
+	hashAlgorithm := ""
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return nil, nil, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleVulnDBLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	assert.ErrorContains(t, err, "signature is required")
+}
+
+func TestHandleVulnDBLoadCmd_ServerError(t *testing.T) {
+	socket := "/tmp/test.sock"
+	uri := "/var/cache/manageability/ubuntu-osv.zip"
+	signature := "abc123"
+	hashAlgorithm := "sha512"
+
+	mockClient := &MockInbServiceClient{}
+	mockClient.On("LoadVulnerabilityDatabase", mock.Anything, mock.Anything, mock.Anything).
+		Return(&pb.LoadVulnerabilityDatabaseResponse{StatusCode: 500, Error: "signature verification failed"}, nil)
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return mockClient, &MockClientConn{}, nil
+	}
+
+	cmd := &cobra.Command{}
+	err := handleVulnDBLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	assert.ErrorContains(t, err, "vulndb load failed: 500-signature verification failed")
+}
+
+func TestHandleVulnDBLoadCmd_DialError(t *testing.T) {
+	socket := "/tmp/test.sock"
+	uri := "/var/cache/manageability/ubuntu-osv.zip"
+	signature := "abc123"
+	hashAlgorithm := ""
+
+	dialer := func(ctx context.Context, socket string) (pb.InbServiceClient, grpc.ClientConnInterface, error) {
+		return nil, nil, errors.New("connection refused")
+	}
+
+	cmd := &cobra.Command{}
+	err := handleVulnDBLoadCmd(&socket, &uri, &signature, &hashAlgorithm, dialer)(cmd, []string{})
+	assert.ErrorContains(t, err, "error setting up new gRPC client")
+}
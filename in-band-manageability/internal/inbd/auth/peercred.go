@@ -0,0 +1,121 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"syscall"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// PeerCredentials identifies the process on the other end of the UNIX socket.
+type PeerCredentials struct {
+	PID int32
+	UID uint32
+	GID uint32
+}
+
+// AuthInfo is the credentials.AuthInfo attached to every connection accepted with
+// the transport credentials returned by NewTransportCredentials.
+type AuthInfo struct {
+	credentials.CommonAuthInfo
+	Peer PeerCredentials
+}
+
+// AuthType returns the type of the AuthInfo.
+func (AuthInfo) AuthType() string {
+	return "peercred"
+}
+
+type peerCredTransport struct{}
+
+// NewTransportCredentials returns server transport credentials that read the SO_PEERCRED
+// credentials of every connection on a UNIX socket.  The connection itself is not encrypted.
+func NewTransportCredentials() credentials.TransportCredentials {
+	return peerCredTransport{}
+}
+
+func (peerCredTransport) ClientHandshake(_ context.Context, _ string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return conn, nil, fmt.Errorf("peer credentials are only supported on the server")
+}
+
+func (peerCredTransport) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		// Connections that are not on a UNIX socket carry no peer credentials and are
+		// denied by the authorization policy if one is configured.
+		return conn, nil, nil
+	}
+
+	peer, err := readPeerCredentials(unixConn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, AuthInfo{
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity},
+		Peer:           peer,
+	}, nil
+}
+
+func (peerCredTransport) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "peercred"}
+}
+
+func (p peerCredTransport) Clone() credentials.TransportCredentials {
+	return p
+}
+
+func (peerCredTransport) OverrideServerName(string) error {
+	return nil
+}
+
+// readPeerCredentials reads the SO_PEERCRED credentials of the connection.
+func readPeerCredentials(conn *net.UnixConn) (PeerCredentials, error) {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return PeerCredentials{}, fmt.Errorf("failed to get raw connection: %w", err)
+	}
+
+	var ucred *syscall.Ucred
+	var credErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		ucred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil {
+		return PeerCredentials{}, fmt.Errorf("failed to access socket: %w", err)
+	}
+	if credErr != nil {
+		return PeerCredentials{}, fmt.Errorf("failed to read peer credentials: %w", credErr)
+	}
+	return PeerCredentials{PID: ucred.Pid, UID: ucred.Uid, GID: ucred.Gid}, nil
+}
+
+// PeerFromContext returns the peer credentials of the connection an RPC was received on.
+func PeerFromContext(ctx context.Context) (PeerCredentials, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return PeerCredentials{}, false
+	}
+	info, ok := p.AuthInfo.(AuthInfo)
+	if !ok {
+		return PeerCredentials{}, false
+	}
+	return info.Peer, true
+}
+
+// CallerFromContext describes the caller of an RPC by its user ID, e.g. "uid 1000".
+// It returns "unknown" if the connection carries no peer credentials.
+func CallerFromContext(ctx context.Context) string {
+	creds, ok := PeerFromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	return fmt.Sprintf("uid %d", creds.UID)
+}
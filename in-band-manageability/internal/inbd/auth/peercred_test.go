@@ -0,0 +1,68 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package auth
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+)
+
+func TestServerHandshake_UnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "test.sock")
+	lis, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer lis.Close()
+
+	go func() {
+		conn, err := net.Dial("unix", socket)
+		if err == nil {
+			defer conn.Close()
+			buf := make([]byte, 1)
+			_, _ = conn.Read(buf)
+		}
+	}()
+
+	conn, err := lis.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, info, err := NewTransportCredentials().ServerHandshake(conn)
+	require.NoError(t, err)
+	authInfo, ok := info.(AuthInfo)
+	require.True(t, ok)
+	assert.Equal(t, uint32(os.Getuid()), authInfo.Peer.UID)
+	assert.Equal(t, uint32(os.Getgid()), authInfo.Peer.GID)
+	assert.Equal(t, int32(os.Getpid()), authInfo.Peer.PID)
+	assert.Equal(t, "peercred", authInfo.AuthType())
+}
+
+func TestServerHandshake_NotUnixSocket(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	conn, info, err := NewTransportCredentials().ServerHandshake(server)
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+	assert.Equal(t, server, conn)
+}
+
+func TestCallerFromContext(t *testing.T) {
+	assert.Equal(t, "unknown", CallerFromContext(context.Background()))
+	assert.Equal(t, "unknown", CallerFromContext(peer.NewContext(context.Background(), &peer.Peer{})))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: AuthInfo{Peer: PeerCredentials{PID: 42, UID: 1000, GID: 1000}},
+	})
+	assert.Equal(t, "uid 1000", CallerFromContext(ctx))
+}
@@ -0,0 +1,210 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package auth authorizes inbd RPCs based on the credentials of the caller on the UNIX socket.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os/user"
+	"slices"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc/peer"
+)
+
+// Built-in role names.
+const (
+	RoleViewer   = "viewer"
+	RoleOperator = "operator"
+	RoleAdmin    = "admin"
+)
+
+// Wildcard allows every RPC or query option.
+const Wildcard = "*"
+
+// Role lists the RPCs, by method name (e.g. Query), and the query options a role may use.
+// QueryOptions only applies to the Query RPC.
+type Role struct {
+	RPCs         []string `json:"rpcs"`
+	QueryOptions []string `json:"query_options,omitempty"`
+}
+
+// Binding assigns a role to a user ID or a group ID.
+type Binding struct {
+	UID  *uint32 `json:"uid,omitempty"`
+	GID  *uint32 `json:"gid,omitempty"`
+	Role string  `json:"role"`
+}
+
+// Config is the authorization section of the inbd configuration.
+type Config struct {
+	Roles    map[string]Role `json:"roles,omitempty"`
+	Bindings []Binding       `json:"bindings"`
+}
+
+// viewerQueryOptions are the query options that expose no command history.
+var viewerQueryOptions = []string{"hw", "fw", "fwcomponents", "os", "swbom", "version", "provenance", "vulnerabilities", "power", "all"}
+
+// DefaultRoles are available without being defined in the configuration.  A role of
+// the same name in the configuration replaces the default.
+var DefaultRoles = map[string]Role{
+	RoleViewer: {
+		RPCs:         []string{"Query", "StreamSoftwareBOM", "GetConfig", "GetConfigHistory", "DiffConfig", "ListApplicationSources", "ListStagedArtifacts"},
+		QueryOptions: viewerQueryOptions,
+	},
+	RoleOperator: {
+		RPCs:         []string{"Query", "StreamSoftwareBOM", "GetConfig", "GetConfigHistory", "DiffConfig", "ListApplicationSources", "ListStagedArtifacts", "UpdateSystemSoftware", "DeleteStagedArtifact", "UpdateFirmware", "SetPowerState", "CancelPowerAction"},
+		QueryOptions: viewerQueryOptions,
+	},
+	RoleAdmin: {
+		RPCs:         []string{Wildcard},
+		QueryOptions: []string{Wildcard},
+	},
+}
+
+// Policy maps callers to roles.  A nil Policy allows every caller.
+type Policy struct {
+	roles    map[string]Role
+	bindings []Binding
+}
+
+// NewPolicy validates the configuration and creates a policy.  A nil configuration
+// disables authorization and returns a nil policy.
+func NewPolicy(config *Config) (*Policy, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	roles := make(map[string]Role, len(DefaultRoles)+len(config.Roles))
+	for name, role := range DefaultRoles {
+		roles[name] = role
+	}
+	for name, role := range config.Roles {
+		roles[name] = role
+	}
+
+	for i, binding := range config.Bindings {
+		if (binding.UID == nil) == (binding.GID == nil) {
+			return nil, fmt.Errorf("authorization binding %d must have exactly one of uid or gid", i)
+		}
+		if _, ok := roles[binding.Role]; !ok {
+			return nil, fmt.Errorf("authorization binding %d uses unknown role %q", i, binding.Role)
+		}
+	}
+	return &Policy{roles: roles, bindings: config.Bindings}, nil
+}
+
+// Authorize checks if a caller may call the RPC method.  For the Query RPC, queryOption
+// must be allowed as well.  Root is always allowed so that a policy can not lock out
+// the administrator.
+func (p *Policy) Authorize(caller PeerCredentials, groups []uint32, method, queryOption string) error {
+	if p == nil || caller.UID == 0 {
+		return nil
+	}
+
+	roles := p.rolesFor(caller.UID, groups)
+	if len(roles) == 0 {
+		return fmt.Errorf("uid %d has no role", caller.UID)
+	}
+	for _, name := range roles {
+		role := p.roles[name]
+		if !allows(role.RPCs, method) {
+			continue
+		}
+		if method == "Query" && !allows(role.QueryOptions, queryOption) {
+			continue
+		}
+		return nil
+	}
+
+	if method == "Query" {
+		return fmt.Errorf("uid %d with roles %v may not query %q", caller.UID, roles, queryOption)
+	}
+	return fmt.Errorf("uid %d with roles %v may not call %s", caller.UID, roles, method)
+}
+
+// rolesFor returns the roles bound to the user or to any of the groups.
+func (p *Policy) rolesFor(uid uint32, groups []uint32) []string {
+	var roles []string
+	for _, binding := range p.bindings {
+		matched := (binding.UID != nil && *binding.UID == uid) ||
+			(binding.GID != nil && slices.Contains(groups, *binding.GID))
+		if matched && !slices.Contains(roles, binding.Role) {
+			roles = append(roles, binding.Role)
+		}
+	}
+	return roles
+}
+
+func allows(allowed []string, name string) bool {
+	return slices.Contains(allowed, Wildcard) || slices.Contains(allowed, name)
+}
+
+var (
+	policyMutex   sync.RWMutex
+	currentPolicy *Policy
+)
+
+// SetPolicy replaces the policy used by AuthorizeContext.
+func SetPolicy(policy *Policy) {
+	policyMutex.Lock()
+	defer policyMutex.Unlock()
+	currentPolicy = policy
+}
+
+// lookupGroupIDs returns the primary and supplementary groups of a user.
+// SO_PEERCRED only carries the primary group of the caller.
+var lookupGroupIDs = func(uid uint32) ([]uint32, error) {
+	u, err := user.LookupId(strconv.FormatUint(uint64(uid), 10))
+	if err != nil {
+		return nil, err
+	}
+	ids, err := u.GroupIds()
+	if err != nil {
+		return nil, err
+	}
+	var groups []uint32
+	for _, id := range ids {
+		gid, err := strconv.ParseUint(id, 10, 32)
+		if err != nil {
+			continue
+		}
+		groups = append(groups, uint32(gid))
+	}
+	return groups, nil
+}
+
+// AuthorizeContext checks the caller of an RPC against the current policy.
+func AuthorizeContext(ctx context.Context, method, queryOption string) error {
+	policyMutex.RLock()
+	policy := currentPolicy
+	policyMutex.RUnlock()
+
+	if policy == nil {
+		return nil
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return fmt.Errorf("no peer information")
+	}
+	info, ok := p.AuthInfo.(AuthInfo)
+	if !ok {
+		return fmt.Errorf("no peer credentials")
+	}
+
+	groups := []uint32{info.Peer.GID}
+	if supplementary, err := lookupGroupIDs(info.Peer.UID); err == nil {
+		for _, gid := range supplementary {
+			if !slices.Contains(groups, gid) {
+				groups = append(groups, gid)
+			}
+		}
+	}
+	return policy.Authorize(info.Peer, groups, method, queryOption)
+}
@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+)
+
+func uint32Ptr(v uint32) *uint32 {
+	return &v
+}
+
+func TestNewPolicy(t *testing.T) {
+	t.Run("nil config disables authorization", func(t *testing.T) {
+		policy, err := NewPolicy(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, policy)
+	})
+
+	t.Run("binding needs uid or gid", func(t *testing.T) {
+		_, err := NewPolicy(&Config{Bindings: []Binding{{Role: RoleViewer}}})
+		assert.ErrorContains(t, err, "exactly one of uid or gid")
+	})
+
+	t.Run("binding with uid and gid", func(t *testing.T) {
+		_, err := NewPolicy(&Config{Bindings: []Binding{{UID: uint32Ptr(1), GID: uint32Ptr(1), Role: RoleViewer}}})
+		assert.ErrorContains(t, err, "exactly one of uid or gid")
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		_, err := NewPolicy(&Config{Bindings: []Binding{{UID: uint32Ptr(1), Role: "superuser"}}})
+		assert.ErrorContains(t, err, "unknown role")
+	})
+
+	t.Run("custom role", func(t *testing.T) {
+		_, err := NewPolicy(&Config{
+			Roles:    map[string]Role{"auditor": {RPCs: []string{"Query"}, QueryOptions: []string{"auditlog"}}},
+			Bindings: []Binding{{UID: uint32Ptr(1), Role: "auditor"}},
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestPolicy_Authorize(t *testing.T) {
+	policy, err := NewPolicy(&Config{
+		Roles: map[string]Role{"auditor": {RPCs: []string{"Query"}, QueryOptions: []string{"auditlog"}}},
+		Bindings: []Binding{
+			{UID: uint32Ptr(1000), Role: RoleViewer},
+			{UID: uint32Ptr(1001), Role: RoleOperator},
+			{GID: uint32Ptr(2000), Role: RoleAdmin},
+			{UID: uint32Ptr(1002), Role: "auditor"},
+			{UID: uint32Ptr(1003), Role: RoleViewer},
+			{UID: uint32Ptr(1003), Role: "auditor"},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		uid         uint32
+		groups      []uint32
+		method      string
+		queryOption string
+		allowed     bool
+	}{
+		{"root is always allowed", 0, nil, "UpdateFirmware", "", true},
+		{"viewer may query hardware", 1000, nil, "Query", "hw", true},
+		{"viewer may get config", 1000, nil, "GetConfig", "", true},
+		{"viewer may read config history", 1000, nil, "GetConfigHistory", "", true},
+		{"viewer may stream software BOM", 1000, nil, "StreamSoftwareBOM", "", true},
+		{"viewer may query vulnerabilities", 1000, nil, "Query", "vulnerabilities", true},
+		{"operator may not load vulnerability database", 1001, nil, "LoadVulnerabilityDatabase", "", false},
+		{"operator may not roll back config", 1001, nil, "RollbackConfig", "", false},
+		{"viewer may not read audit log", 1000, nil, "Query", "auditlog", false},
+		{"viewer may not update firmware", 1000, nil, "UpdateFirmware", "", false},
+		{"operator may update firmware", 1001, nil, "UpdateFirmware", "", true},
+		{"viewer may query power actions", 1000, nil, "Query", "power", true},
+		{"viewer may not cancel power actions", 1000, nil, "CancelPowerAction", "", false},
+		{"operator may cancel power actions", 1001, nil, "CancelPowerAction", "", true},
+		{"viewer may list application sources", 1000, nil, "ListApplicationSources", "", true},
+		{"viewer may list staged artifacts", 1000, nil, "ListStagedArtifacts", "", true},
+		{"viewer may not delete staged artifacts", 1000, nil, "DeleteStagedArtifact", "", false},
+		{"operator may delete staged artifacts", 1001, nil, "DeleteStagedArtifact", "", true},
+		{"viewer may not add application sources", 1000, nil, "AddApplicationSource", "", false},
+		{"operator may not change OS sources", 1001, nil, "UpdateOSSource", "", false},
+		{"operator may not load config", 1001, nil, "LoadConfig", "", false},
+		{"group admin may load config", 1500, []uint32{2000}, "LoadConfig", "", true},
+		{"group admin may read audit log", 1500, []uint32{2000}, "Query", "auditlog", true},
+		{"custom role", 1002, nil, "Query", "auditlog", true},
+		{"custom role limits query options", 1002, nil, "Query", "hw", false},
+		{"roles are combined", 1003, nil, "Query", "auditlog", true},
+		{"unbound user", 1500, []uint32{1500}, "Query", "hw", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Authorize(PeerCredentials{UID: tt.uid}, tt.groups, tt.method, tt.queryOption)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	t.Run("nil policy allows everything", func(t *testing.T) {
+		var nilPolicy *Policy
+		assert.NoError(t, nilPolicy.Authorize(PeerCredentials{UID: 1500}, nil, "UpdateFirmware", ""))
+	})
+}
+
+func TestAuthorizeContext(t *testing.T) {
+	defer SetPolicy(nil)
+	originalLookup := lookupGroupIDs
+	defer func() { lookupGroupIDs = originalLookup }()
+	lookupGroupIDs = func(uid uint32) ([]uint32, error) {
+		return []uint32{2000}, nil
+	}
+
+	policy, err := NewPolicy(&Config{Bindings: []Binding{{GID: uint32Ptr(2000), Role: RoleViewer}}})
+	require.NoError(t, err)
+
+	peerCtx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: AuthInfo{Peer: PeerCredentials{UID: 1000, GID: 1000}},
+	})
+
+	t.Run("no policy", func(t *testing.T) {
+		SetPolicy(nil)
+		assert.NoError(t, AuthorizeContext(context.Background(), "UpdateFirmware", ""))
+	})
+
+	t.Run("supplementary group grants role", func(t *testing.T) {
+		SetPolicy(policy)
+		assert.NoError(t, AuthorizeContext(peerCtx, "Query", "hw"))
+		assert.Error(t, AuthorizeContext(peerCtx, "SetPowerState", ""))
+	})
+
+	t.Run("missing peer credentials", func(t *testing.T) {
+		SetPolicy(policy)
+		assert.ErrorContains(t, AuthorizeContext(context.Background(), "Query", "hw"), "no peer information")
+		noCreds := peer.NewContext(context.Background(), &peer.Peer{})
+		assert.ErrorContains(t, AuthorizeContext(noCreds, "Query", "hw"), "no peer credentials")
+	})
+}
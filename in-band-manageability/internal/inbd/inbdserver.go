@@ -15,38 +15,74 @@ import (
 	"net/url"
 	"os/exec"
 	"strings"
+	"sync"
+	"time"
 
 	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
 	fwUpdater "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/fw_updater"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/auth"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/power"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/provenance"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/staging"
 	telemetry "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/telemetry"
 	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/vulnerability"
 	osUpdater "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater"
 	appSource "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/ubuntu/app_source"
 	osSource "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/ubuntu/os_source"
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// maxPowerActionDelay is how far in the future a power action can be scheduled.
+const maxPowerActionDelay = 30 * 24 * time.Hour
+
+// stagingFs is the file system of the staged artifact registry.  It is a variable for testing.
+var stagingFs = afero.NewOsFs()
+
+// powerCapabilities detects the supported power actions.  It is a variable for testing.
+var powerCapabilities = telemetry.GetPowerCapabilities
+
 // PowerManager interface for power management operations
 type PowerManager interface {
-	Reboot() error
-	Shutdown() error
+	Reboot(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+	Suspend(ctx context.Context) error
+	Hibernate(ctx context.Context) error
+	SoftReboot(ctx context.Context) error
 }
 
 // DefaultPowerManager implements PowerManager using real system commands
 type DefaultPowerManager struct{}
 
-func (dpm *DefaultPowerManager) Reboot() error {
-	return utils.RebootSystem(common.NewExecutor(exec.Command, common.ExecuteAndReadOutput))
+func (dpm *DefaultPowerManager) Reboot(ctx context.Context) error {
+	return utils.RebootSystem(common.NewExecutorWithContext(ctx, exec.Command, common.ExecuteAndReadOutput))
+}
+
+func (dpm *DefaultPowerManager) Shutdown(ctx context.Context) error {
+	return utils.ShutdownSystem(common.NewExecutorWithContext(ctx, exec.Command, common.ExecuteAndReadOutput))
+}
+
+func (dpm *DefaultPowerManager) Suspend(ctx context.Context) error {
+	return utils.SuspendSystem(common.NewExecutorWithContext(ctx, exec.Command, common.ExecuteAndReadOutput))
+}
+
+func (dpm *DefaultPowerManager) Hibernate(ctx context.Context) error {
+	return utils.HibernateSystem(common.NewExecutorWithContext(ctx, exec.Command, common.ExecuteAndReadOutput))
 }
 
-func (dpm *DefaultPowerManager) Shutdown() error {
-	return utils.ShutdownSystem(common.NewExecutor(exec.Command, common.ExecuteAndReadOutput))
+func (dpm *DefaultPowerManager) SoftReboot(ctx context.Context) error {
+	return utils.SoftRebootSystem(common.NewExecutorWithContext(ctx, exec.Command, common.ExecuteAndReadOutput))
 }
 
 // InbdServer implements the InbServiceServer interface
 type InbdServer struct {
 	pb.UnimplementedInbServiceServer
 	powerManager PowerManager
+
+	powerSchedulerOnce sync.Once
+	powerScheduler     *power.Scheduler
 }
 
 // NewInbdServer creates a new InbdServer with default power manager
@@ -81,25 +117,136 @@ func validateURL(rawURL string) error {
 	return nil
 }
 
-// SetPowerState sets the power state of the device
+// SetPowerState sets the power state of the device, immediately or at a scheduled time
 func (s *InbdServer) SetPowerState(ctx context.Context, req *pb.SetPowerStateRequest) (*pb.SetPowerStateResponse, error) {
 	log.Printf("Received SetPowerState request")
 	if req.Action == pb.SetPowerStateRequest_POWER_ACTION_UNSPECIFIED {
 		return &pb.SetPowerStateResponse{StatusCode: 400, Error: "Power action is required"}, nil //nolint:nilerr // gRPC response pattern
 	}
+	if err := checkPowerActionSupported(req.Action); err != nil {
+		return &pb.SetPowerStateResponse{StatusCode: 400, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	at, err := powerActionTime(req, time.Now())
+	if err != nil {
+		return &pb.SetPowerStateResponse{StatusCode: 400, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
 
-	switch req.Action {
-	case pb.SetPowerStateRequest_POWER_ACTION_CYCLE:
-		if err := s.powerManager.Reboot(); err != nil {
+	if !at.IsZero() {
+		info, err := s.getPowerScheduler().Schedule(req.Action, at, auth.CallerFromContext(ctx))
+		if err != nil {
 			return &pb.SetPowerStateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
 		}
+		return &pb.SetPowerStateResponse{ //nolint:nilerr // gRPC response pattern
+			StatusCode:    200,
+			Error:         "SUCCESS",
+			ActionId:      info.ActionId,
+			ScheduledTime: info.ScheduledTime,
+		}, nil
+	}
+
+	if err := s.getPowerScheduler().Check(req.Action); err != nil {
+		return &pb.SetPowerStateResponse{StatusCode: 409, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	if err := s.performPowerAction(ctx, req.Action); err != nil {
+		return &pb.SetPowerStateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+
+	return &pb.SetPowerStateResponse{StatusCode: 200, Error: "SUCCESS"}, nil //nolint:nilerr // gRPC response pattern
+}
+
+// CancelPowerAction cancels a pending power action, or all of them if no action ID is given
+func (s *InbdServer) CancelPowerAction(ctx context.Context, req *pb.CancelPowerActionRequest) (*pb.CancelPowerActionResponse, error) {
+	log.Printf("Received CancelPowerAction request for %q", req.ActionId)
+	canceled, err := s.getPowerScheduler().Cancel(req.ActionId)
+	if errors.Is(err, power.ErrNotFound) {
+		return &pb.CancelPowerActionResponse{StatusCode: 404, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	if err != nil {
+		return &pb.CancelPowerActionResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	return &pb.CancelPowerActionResponse{StatusCode: 200, Canceled: canceled}, nil //nolint:nilerr // gRPC response pattern
+}
+
+// getPowerScheduler returns the scheduler of the power actions, which vetoes them while an update
+// is in progress.
+func (s *InbdServer) getPowerScheduler() *power.Scheduler {
+	s.powerSchedulerOnce.Do(func() {
+		s.powerScheduler = power.NewScheduler(func(action pb.SetPowerStateRequest_PowerAction) error {
+			// Scheduled actions run after the RPC has ended and are attributed to inbd.
+			return s.performPowerAction(context.Background(), action)
+		}, power.UpdateInProgress)
+	})
+	return s.powerScheduler
+}
+
+// performPowerAction performs a power action with the power manager.  The context carries the
+// caller the commands are attributed to in the audit log.
+func (s *InbdServer) performPowerAction(ctx context.Context, action pb.SetPowerStateRequest_PowerAction) error {
+	switch action {
+	case pb.SetPowerStateRequest_POWER_ACTION_CYCLE:
+		return s.powerManager.Reboot(ctx)
 	case pb.SetPowerStateRequest_POWER_ACTION_OFF:
-		if err := s.powerManager.Shutdown(); err != nil {
-			return &pb.SetPowerStateResponse{StatusCode: 500, Error: fmt.Sprintf("shutdown failed: %s", err)}, nil //nolint:nilerr // gRPC response pattern
+		if err := s.powerManager.Shutdown(ctx); err != nil {
+			return fmt.Errorf("shutdown failed: %s", err)
 		}
+		return nil
+	case pb.SetPowerStateRequest_POWER_ACTION_SUSPEND:
+		return s.powerManager.Suspend(ctx)
+	case pb.SetPowerStateRequest_POWER_ACTION_HIBERNATE:
+		return s.powerManager.Hibernate(ctx)
+	case pb.SetPowerStateRequest_POWER_ACTION_SOFT_REBOOT:
+		return s.powerManager.SoftReboot(ctx)
+	default:
+		return fmt.Errorf("unsupported power action %s", action)
 	}
+}
 
-	return &pb.SetPowerStateResponse{StatusCode: 200, Error: "SUCCESS"}, nil //nolint:nilerr // gRPC response pattern
+// checkPowerActionSupported checks that the device supports suspend and hibernate.  Power off and
+// restart are always supported.
+func checkPowerActionSupported(action pb.SetPowerStateRequest_PowerAction) error {
+	if action != pb.SetPowerStateRequest_POWER_ACTION_SUSPEND && action != pb.SetPowerStateRequest_POWER_ACTION_HIBERNATE {
+		return nil
+	}
+	capabilities, err := powerCapabilities()
+	if err != nil {
+		return fmt.Errorf("failed to detect power capabilities: %w", err)
+	}
+	if action == pb.SetPowerStateRequest_POWER_ACTION_SUSPEND && !capabilities.Suspend {
+		return errors.New("suspend is not supported on this device")
+	}
+	if action == pb.SetPowerStateRequest_POWER_ACTION_HIBERNATE && !capabilities.Hibernate {
+		return errors.New("hibernate is not supported on this device")
+	}
+	return nil
+}
+
+// powerActionTime returns when a requested power action is to be performed, or the zero time if
+// it is to be performed immediately.
+func powerActionTime(req *pb.SetPowerStateRequest, now time.Time) (time.Time, error) {
+	if req.DelaySeconds > 0 && req.ScheduledTime != nil {
+		return time.Time{}, errors.New("delay_seconds and scheduled_time can not be combined")
+	}
+	if req.DelaySeconds > 0 {
+		delay := time.Duration(req.DelaySeconds) * time.Second
+		if delay > maxPowerActionDelay {
+			return time.Time{}, fmt.Errorf("delay_seconds must be at most %d", int(maxPowerActionDelay.Seconds()))
+		}
+		return now.Add(delay), nil
+	}
+	if req.ScheduledTime == nil {
+		return time.Time{}, nil
+	}
+	if err := req.ScheduledTime.CheckValid(); err != nil {
+		return time.Time{}, fmt.Errorf("invalid scheduled_time: %w", err)
+	}
+	at := req.ScheduledTime.AsTime()
+	if !at.After(now) {
+		return time.Time{}, errors.New("scheduled_time must be in the future")
+	}
+	if at.Sub(now) > maxPowerActionDelay {
+		return time.Time{}, errors.New("scheduled_time must be at most 30 days in the future")
+	}
+	return at, nil
 }
 
 // UpdateFirmware updates the firmware
@@ -131,12 +278,71 @@ func (s *InbdServer) UpdateFirmware(ctx context.Context, req *pb.UpdateFirmwareR
 	}
 	req.HashAlgorithm = finalHashAlgorithm
 
-	resp, err := fwUpdater.NewFWUpdater(req).UpdateFirmware()
+	resp, err := fwUpdater.NewFWUpdater(req).WithContext(ctx).UpdateFirmware()
 	if err != nil {
 		return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
 	}
 
-	return &pb.UpdateResponse{StatusCode: resp.StatusCode, Error: resp.Error}, nil //nolint:nilerr // gRPC response pattern
+	return &pb.UpdateResponse{StatusCode: resp.StatusCode, Error: resp.Error, StagedArtifactId: resp.StagedArtifactId}, nil //nolint:nilerr // gRPC response pattern
+}
+
+// validateStagedInstall checks a request that installs a staged artifact, returning the status
+// code to reject it with.
+func validateStagedInstall(os string, req *pb.UpdateSystemSoftwareRequest) (int32, error) {
+	if os != "EMT" {
+		return 415, errors.New("staged artifacts are only supported on EMT")
+	}
+	if req.Mode != pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_NO_DOWNLOAD {
+		return 400, errors.New("a staged artifact can only be installed with mode no-download")
+	}
+	if req.Url != "" {
+		return 400, errors.New("a staged artifact is installed without a URL")
+	}
+	if _, err := staging.Get(stagingFs, req.StagedArtifactId); err != nil {
+		if errors.Is(err, staging.ErrNotFound) {
+			return 404, err
+		}
+		return 500, err
+	}
+	return 0, nil
+}
+
+// ListStagedArtifacts lists the update images that were downloaded and not yet installed.
+func (s *InbdServer) ListStagedArtifacts(ctx context.Context, req *pb.ListStagedArtifactsRequest) (*pb.ListStagedArtifactsResponse, error) {
+	artifacts, err := staging.List(stagingFs)
+	if err != nil {
+		return &pb.ListStagedArtifactsResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	resp := &pb.ListStagedArtifactsResponse{StatusCode: 200, Error: "Success"}
+	for _, artifact := range artifacts {
+		resp.Artifacts = append(resp.Artifacts, &pb.StagedArtifact{
+			Id:              artifact.ID,
+			Url:             artifact.URL,
+			FileName:        artifact.FileName,
+			Sha256:          artifact.SHA256,
+			SizeBytes:       artifact.SizeBytes,
+			SignatureStatus: artifact.SignatureStatus,
+			StagedTime:      timestamppb.New(artifact.StagedTime),
+			Written:         artifact.Written,
+		})
+	}
+	return resp, nil
+}
+
+// DeleteStagedArtifact deletes a staged update image.
+func (s *InbdServer) DeleteStagedArtifact(ctx context.Context, req *pb.DeleteStagedArtifactRequest) (*pb.DeleteStagedArtifactResponse, error) {
+	log.Printf("Received DeleteStagedArtifact request for %q", req.Id)
+	if req.Id == "" {
+		return &pb.DeleteStagedArtifactResponse{StatusCode: 400, Error: "id is required"}, nil //nolint:nilerr // gRPC response pattern
+	}
+	err := staging.Delete(stagingFs, req.Id)
+	if errors.Is(err, staging.ErrNotFound) {
+		return &pb.DeleteStagedArtifactResponse{StatusCode: 404, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	if err != nil {
+		return &pb.DeleteStagedArtifactResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	return &pb.DeleteStagedArtifactResponse{StatusCode: 200, Error: "Success"}, nil //nolint:nilerr // gRPC response pattern
 }
 
 // UpdateSystemSoftware updates the system software
@@ -152,13 +358,18 @@ func (s *InbdServer) UpdateSystemSoftware(ctx context.Context, req *pb.UpdateSys
 			return &pb.UpdateResponse{StatusCode: 400, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
 		}
 	}
+	if req.StagedArtifactId != "" {
+		if code, err := validateStagedInstall(os, req); err != nil {
+			return &pb.UpdateResponse{StatusCode: code, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+		}
+	}
 
 	sotaFactory, err := osUpdater.GetOSUpdaterFactory(os)
 	if err != nil {
 		return &pb.UpdateResponse{StatusCode: 415, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
 	}
 
-	resp, err := osUpdater.NewOSUpdater(req).UpdateOS(sotaFactory)
+	resp, err := osUpdater.NewOSUpdater(req).WithContext(ctx).UpdateOS(sotaFactory)
 	if err != nil {
 		return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
 	}
@@ -189,6 +400,8 @@ func (s *InbdServer) UpdateOSSource(ctx context.Context, req *pb.UpdateOSSourceR
 
 // AddApplicationSource adds the source file under /etc/apt/sources.list.d/.
 // It optionally adds the GPG key under /usr/share/keyrings/ if the GPG key name is provided.
+// A structured source is added as a deb822 file with a pinned Signed-By keyring, after the
+// Release files of the repository have been verified.
 func (s *InbdServer) AddApplicationSource(ctx context.Context, req *pb.AddApplicationSourceRequest) (*pb.UpdateResponse, error) {
 	os, err := common.DetectOS()
 	if err != nil {
@@ -202,13 +415,22 @@ func (s *InbdServer) AddApplicationSource(ctx context.Context, req *pb.AddApplic
 			return &pb.UpdateResponse{StatusCode: 400, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
 		}
 	}
-	if req.Filename == "" {
-		return &pb.UpdateResponse{StatusCode: 400, Error: "Filename is empty"}, nil //nolint:nilerr // gRPC response pattern
-	}
-	if len(req.Source) == 0 {
-		return &pb.UpdateResponse{StatusCode: 400, Error: "Source list is empty"}, nil //nolint:nilerr // gRPC response pattern
+	if req.ApplicationSource != nil {
+		if req.Filename != "" || len(req.Source) > 0 || req.GpgKeyName != "" {
+			return &pb.UpdateResponse{StatusCode: 400, Error: "Source, filename and GPG key name must be empty for a structured application source"}, nil //nolint:nilerr // gRPC response pattern
+		}
+		if req.GpgKeyUri == "" {
+			return &pb.UpdateResponse{StatusCode: 400, Error: "GPG key URI is required for a structured application source"}, nil //nolint:nilerr // gRPC response pattern
+		}
+	} else {
+		if req.Filename == "" {
+			return &pb.UpdateResponse{StatusCode: 400, Error: "Filename is empty"}, nil //nolint:nilerr // gRPC response pattern
+		}
+		if len(req.Source) == 0 {
+			return &pb.UpdateResponse{StatusCode: 400, Error: "Source list is empty"}, nil //nolint:nilerr // gRPC response pattern
+		}
 	}
-	err = appSource.NewAdder().Add(req)
+	err = appSource.NewAdderWithContext(ctx).Add(req)
 	if err != nil {
 		return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
 	}
@@ -236,13 +458,29 @@ func (s *InbdServer) RemoveApplicationSource(ctx context.Context, req *pb.Remove
 
 }
 
+// ListApplicationSources lists the sources of the files under /etc/apt/sources.list.d/.
+func (s *InbdServer) ListApplicationSources(ctx context.Context, req *pb.ListApplicationSourcesRequest) (*pb.ListApplicationSourcesResponse, error) {
+	os, err := common.DetectOS()
+	if err != nil {
+		return &pb.ListApplicationSourcesResponse{StatusCode: 415, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	if os != "Ubuntu" {
+		return &pb.ListApplicationSourcesResponse{StatusCode: 415, Error: "Unsupported OS.  List Application Sources is only for Ubuntu."}, nil //nolint:nilerr // gRPC response pattern
+	}
+	sources, err := appSource.NewLister().List()
+	if err != nil {
+		return &pb.ListApplicationSourcesResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	return &pb.ListApplicationSourcesResponse{StatusCode: 200, Error: "Success", Sources: sources}, nil //nolint:nilerr // gRPC response pattern
+}
+
 // LoadConfig loads the configuration from the specified URI.
 func (s *InbdServer) LoadConfig(ctx context.Context, req *pb.LoadConfigRequest) (*pb.ConfigResponse, error) {
 	log.Printf("Received LoadConfig request")
 	if req.Uri == "" {
 		return &pb.ConfigResponse{StatusCode: 400, Error: "uri is required", Success: false}, nil //nolint:nilerr // gRPC response pattern
 	}
-	op := &utils.ConfigOperation{}
+	op := &utils.ConfigOperation{Caller: auth.CallerFromContext(ctx)}
 
 	// TODO: Validate signature against expected format
 	// TODO: Add unittest test case for invalid signature format
@@ -262,6 +500,12 @@ func (s *InbdServer) LoadConfig(ctx context.Context, req *pb.LoadConfigRequest)
 		}
 	}
 
+	// Provenance is checked against the policy of the current configuration.
+	verifier := provenance.NewVerifier(afero.NewOsFs())
+	if _, err := verifier.VerifyPackage(provenance.PackageTypeConfig, req.Uri, req.ProvenanceUri); err != nil {
+		return &pb.ConfigResponse{StatusCode: 400, Error: err.Error(), Success: false}, nil //nolint:nilerr // gRPC response pattern
+	}
+
 	err := op.LoadConfigCommand(req.Uri, req.Signature, finalHashAlgorithm)
 	if err != nil {
 		return &pb.ConfigResponse{StatusCode: 500, Error: err.Error(), Success: false}, nil //nolint:nilerr // gRPC response pattern
@@ -306,26 +550,138 @@ func (s *InbdServer) handleConfigOperation(operationName, path string, operation
 }
 
 func (s *InbdServer) SetConfig(ctx context.Context, req *pb.SetConfigRequest) (*pb.ConfigResponse, error) {
+	if strings.TrimSpace(req.JsonPatch) != "" {
+		if strings.TrimSpace(req.Path) != "" {
+			return &pb.ConfigResponse{StatusCode: 400, Error: "path and json_patch are mutually exclusive", Success: false}, nil //nolint:nilerr // gRPC response pattern
+		}
+		return s.handleConfigOperation("SetConfig", req.JsonPatch, func(patch string) error {
+			op := &utils.ConfigOperation{Caller: auth.CallerFromContext(ctx)}
+			return op.PatchConfigCommand(patch)
+		})
+	}
 	return s.handleConfigOperation("SetConfig", req.Path, func(path string) error {
-		op := &utils.ConfigOperation{}
+		op := &utils.ConfigOperation{Caller: auth.CallerFromContext(ctx)}
 		return op.SetConfigCommand(path)
 	})
 }
 
 func (s *InbdServer) AppendConfig(ctx context.Context, req *pb.AppendConfigRequest) (*pb.ConfigResponse, error) {
 	return s.handleConfigOperation("AppendConfig", req.Path, func(path string) error {
-		op := &utils.ConfigOperation{}
+		op := &utils.ConfigOperation{Caller: auth.CallerFromContext(ctx)}
 		return op.AppendConfigCommand(path)
 	})
 }
 
 func (s *InbdServer) RemoveConfig(ctx context.Context, req *pb.RemoveConfigRequest) (*pb.ConfigResponse, error) {
 	return s.handleConfigOperation("RemoveConfig", req.Path, func(path string) error {
-		op := &utils.ConfigOperation{}
+		op := &utils.ConfigOperation{Caller: auth.CallerFromContext(ctx)}
 		return op.RemoveConfigCommand(path)
 	})
 }
 
+// StreamSoftwareBOM streams the software BOM in pages, the changes since an earlier revision of
+// it, or an SPDX or CycloneDX document of it
+func (s *InbdServer) StreamSoftwareBOM(req *pb.StreamSoftwareBOMRequest, stream pb.InbService_StreamSoftwareBOMServer) error {
+	log.Printf("Received StreamSoftwareBOM request: format=%v, since_revision=%q", req.GetFormat(), req.GetSinceRevision())
+	err := telemetry.StreamSoftwareBOM(afero.NewOsFs(), req, stream.Send)
+	if err == nil {
+		return nil
+	}
+	statusCode := int32(500)
+	if errors.Is(err, telemetry.ErrInvalidSoftwareBOMRequest) {
+		statusCode = 400
+	}
+	return stream.Send(&pb.SoftwareBOMChunk{StatusCode: statusCode, Error: err.Error()})
+}
+
+// LoadVulnerabilityDatabase verifies a signed OSV or OVAL dataset and loads it as the vulnerability
+// database that the installed packages are matched against
+func (s *InbdServer) LoadVulnerabilityDatabase(ctx context.Context, req *pb.LoadVulnerabilityDatabaseRequest) (*pb.LoadVulnerabilityDatabaseResponse, error) {
+	log.Printf("Received LoadVulnerabilityDatabase request")
+	if req.Uri == "" {
+		return &pb.LoadVulnerabilityDatabaseResponse{StatusCode: 400, Error: "uri is required"}, nil //nolint:nilerr // gRPC response pattern
+	}
+	if req.Signature == "" {
+		return &pb.LoadVulnerabilityDatabaseResponse{StatusCode: 400, Error: "signature is required"}, nil //nolint:nilerr // gRPC response pattern
+	}
+	switch strings.ToLower(req.HashAlgorithm) {
+	case "", "sha256", "sha384", "sha512":
+	default:
+		return &pb.LoadVulnerabilityDatabaseResponse{
+			StatusCode: 400,
+			Error:      "invalid hash algorithm: must be 'sha256', 'sha384', or 'sha512'",
+		}, nil //nolint:nilerr // gRPC response pattern
+	}
+
+	db, err := vulnerability.LoadDatabase(afero.NewOsFs(), req.Uri, req.Signature, utils.ParseHashAlgorithm(req.HashAlgorithm))
+	if err != nil {
+		return &pb.LoadVulnerabilityDatabaseResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+	return &pb.LoadVulnerabilityDatabaseResponse{
+		StatusCode: 200,
+		Success:    true,
+		Format:     db.Format,
+		Advisories: int32(len(db.Advisories)),
+	}, nil //nolint:nilerr // gRPC response pattern
+}
+
+// GetConfigHistory returns the most recent revisions of the configuration file
+func (s *InbdServer) GetConfigHistory(ctx context.Context, req *pb.GetConfigHistoryRequest) (*pb.GetConfigHistoryResponse, error) {
+	log.Printf("Received GetConfigHistory request")
+	if req.Limit < 0 {
+		return &pb.GetConfigHistoryResponse{StatusCode: 400, Error: "limit must not be negative"}, nil //nolint:nilerr // gRPC response pattern
+	}
+	op := &utils.ConfigOperation{}
+	revisions, err := op.ConfigHistoryCommand(int(req.Limit))
+	if err != nil {
+		return &pb.GetConfigHistoryResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+
+	resp := &pb.GetConfigHistoryResponse{StatusCode: 200}
+	for _, r := range revisions {
+		resp.Revisions = append(resp.Revisions, &pb.ConfigRevision{
+			Revision:  int32(r.Revision),
+			Time:      timestamppb.New(r.Time),
+			Caller:    r.Caller,
+			Operation: r.Operation,
+			Summary:   r.Summary,
+		})
+	}
+	return resp, nil
+}
+
+// DiffConfig returns the values that differ between two revisions of the configuration file
+func (s *InbdServer) DiffConfig(ctx context.Context, req *pb.DiffConfigRequest) (*pb.DiffConfigResponse, error) {
+	log.Printf("Received DiffConfig request")
+	if req.FromRevision < 0 || req.ToRevision < 0 {
+		return &pb.DiffConfigResponse{StatusCode: 400, Error: "revisions must not be negative"}, nil //nolint:nilerr // gRPC response pattern
+	}
+	op := &utils.ConfigOperation{}
+	from, to, changes, err := op.DiffConfigCommand(int(req.FromRevision), int(req.ToRevision))
+	if err != nil {
+		return &pb.DiffConfigResponse{StatusCode: 404, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+
+	resp := &pb.DiffConfigResponse{StatusCode: 200, FromRevision: int32(from), ToRevision: int32(to)}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, &pb.ConfigChange{Path: c.Path, OldValue: c.OldValue, NewValue: c.NewValue})
+	}
+	return resp, nil
+}
+
+// RollbackConfig restores the configuration file of a previous revision
+func (s *InbdServer) RollbackConfig(ctx context.Context, req *pb.RollbackConfigRequest) (*pb.ConfigResponse, error) {
+	log.Printf("Received RollbackConfig request for revision %d", req.Revision)
+	if req.Revision <= 0 {
+		return &pb.ConfigResponse{StatusCode: 400, Error: "revision is required", Success: false}, nil //nolint:nilerr // gRPC response pattern
+	}
+	op := &utils.ConfigOperation{Caller: auth.CallerFromContext(ctx)}
+	if err := op.RollbackConfigCommand(int(req.Revision)); err != nil {
+		return &pb.ConfigResponse{StatusCode: 500, Error: err.Error(), Success: false}, nil //nolint:nilerr // gRPC response pattern
+	}
+	return &pb.ConfigResponse{StatusCode: 200, Error: "", Success: true}, nil //nolint:nilerr // gRPC response pattern
+}
+
 // Query returns system information based on the query option
 func (s *InbdServer) Query(ctx context.Context, req *pb.QueryRequest) (*pb.QueryResponse, error) {
 
@@ -352,8 +708,15 @@ func (s *InbdServer) Query(ctx context.Context, req *pb.QueryRequest) (*pb.Query
 	// Convert enum to string
 	optionStr := convertQueryOptionToString(req.Option)
 
-	queryHandler := telemetry.NewQueryHandler()
-	data, err := queryHandler.HandleQuery(optionStr)
+	var data *pb.QueryData
+	var err error
+	if optionStr == "power" {
+		// The pending power actions are held by the server, not collected by telemetry.
+		data, err = s.queryPower()
+	} else {
+		queryHandler := telemetry.NewQueryHandler()
+		data, err = queryHandler.HandleQuery(optionStr)
+	}
 	if err != nil {
 		return &pb.QueryResponse{ //nolint:nilerr // gRPC response pattern
 			StatusCode: 500,
@@ -371,6 +734,22 @@ func (s *InbdServer) Query(ctx context.Context, req *pb.QueryRequest) (*pb.Query
 	}, nil //nolint:nilerr // gRPC response pattern
 }
 
+// queryPower returns the power capabilities and the pending power actions.
+func (s *InbdServer) queryPower() (*pb.QueryData, error) {
+	capabilities, err := powerCapabilities()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.QueryData{
+		Type:      "power",
+		Timestamp: timestamppb.Now(),
+		Values: &pb.QueryData_Power{Power: &pb.PowerInfo{
+			Capabilities:   capabilities,
+			PendingActions: s.getPowerScheduler().Pending(),
+		}},
+	}, nil
+}
+
 // convertQueryOptionToString converts QueryOption enum to string
 func convertQueryOptionToString(option pb.QueryOption) string {
 	switch option {
@@ -386,6 +765,16 @@ func convertQueryOptionToString(option pb.QueryOption) string {
 		return "version"
 	case pb.QueryOption_QUERY_OPTION_ALL:
 		return "all"
+	case pb.QueryOption_QUERY_OPTION_FIRMWARE_COMPONENTS:
+		return "fwcomponents"
+	case pb.QueryOption_QUERY_OPTION_AUDIT_LOG:
+		return "auditlog"
+	case pb.QueryOption_QUERY_OPTION_PROVENANCE:
+		return "provenance"
+	case pb.QueryOption_QUERY_OPTION_VULNERABILITIES:
+		return "vulnerabilities"
+	case pb.QueryOption_QUERY_OPTION_POWER:
+		return "power"
 	default:
 		return "all" // Default to "all" for unknown options
 	}
@@ -697,6 +697,11 @@ func TestConvertQueryOptionToString(t *testing.T) {
 		{pb.QueryOption_QUERY_OPTION_SWBOM, "swbom"},
 		{pb.QueryOption_QUERY_OPTION_VERSION, "version"},
 		{pb.QueryOption_QUERY_OPTION_ALL, "all"},
+		{pb.QueryOption_QUERY_OPTION_FIRMWARE_COMPONENTS, "fwcomponents"},
+		{pb.QueryOption_QUERY_OPTION_AUDIT_LOG, "auditlog"},
+		{pb.QueryOption_QUERY_OPTION_PROVENANCE, "provenance"},
+		{pb.QueryOption_QUERY_OPTION_VULNERABILITIES, "vulnerabilities"},
+		{pb.QueryOption_QUERY_OPTION_POWER, "power"},
 		{pb.QueryOption_QUERY_OPTION_UNSPECIFIED, "all"},
 	}
 
@@ -801,3 +806,110 @@ func TestInbdServer_LoadConfig(t *testing.T) {
 		}
 	})
 }
+
+func TestInbdServer_ConfigHistory_InvalidArguments(t *testing.T) {
+	server := &InbdServer{}
+	ctx := context.Background()
+
+	t.Run("negative history limit", func(t *testing.T) {
+		resp, err := server.GetConfigHistory(ctx, &pb.GetConfigHistoryRequest{Limit: -1})
+		if err != nil {
+			t.Errorf("GetConfigHistory() returned unexpected error: %v", err)
+		}
+		if resp.StatusCode != 400 {
+			t.Errorf("GetConfigHistory() StatusCode = %v, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("negative diff revision", func(t *testing.T) {
+		resp, err := server.DiffConfig(ctx, &pb.DiffConfigRequest{FromRevision: -1})
+		if err != nil {
+			t.Errorf("DiffConfig() returned unexpected error: %v", err)
+		}
+		if resp.StatusCode != 400 {
+			t.Errorf("DiffConfig() StatusCode = %v, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("missing rollback revision", func(t *testing.T) {
+		resp, err := server.RollbackConfig(ctx, &pb.RollbackConfigRequest{})
+		if err != nil {
+			t.Errorf("RollbackConfig() returned unexpected error: %v", err)
+		}
+		if resp.StatusCode != 400 || resp.Success {
+			t.Errorf("RollbackConfig() StatusCode = %v, Success = %v, want 400 and false", resp.StatusCode, resp.Success)
+		}
+		if !strings.Contains(resp.Error, "revision is required") {
+			t.Errorf("RollbackConfig() Error = %v, want containing 'revision is required'", resp.Error)
+		}
+	})
+}
+
+func TestInbdServer_SetConfig_PathAndJSONPatch(t *testing.T) {
+	server := &InbdServer{}
+	resp, err := server.SetConfig(context.Background(), &pb.SetConfigRequest{
+		Path:      "os_updater.proceedWithoutRollback:true",
+		JsonPatch: `[{"op":"replace","path":"/os_updater/proceedWithoutRollback","value":true}]`,
+	})
+	if err != nil {
+		t.Errorf("SetConfig() returned unexpected error: %v", err)
+	}
+	if resp.StatusCode != 400 {
+		t.Errorf("SetConfig() StatusCode = %v, want 400", resp.StatusCode)
+	}
+	if !strings.Contains(resp.Error, "mutually exclusive") {
+		t.Errorf("SetConfig() Error = %v, want containing 'mutually exclusive'", resp.Error)
+	}
+}
+
+// softwareBOMStream records the chunks sent by StreamSoftwareBOM.
+type softwareBOMStream struct {
+	pb.InbService_StreamSoftwareBOMServer
+	chunks []*pb.SoftwareBOMChunk
+}
+
+func (s *softwareBOMStream) Send(chunk *pb.SoftwareBOMChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestInbdServer_StreamSoftwareBOM_InvalidRequest(t *testing.T) {
+	server := &InbdServer{}
+	stream := &softwareBOMStream{}
+	err := server.StreamSoftwareBOM(&pb.StreamSoftwareBOMRequest{SinceRevision: "not-a-revision"}, stream)
+	if err != nil {
+		t.Errorf("StreamSoftwareBOM() returned unexpected error: %v", err)
+	}
+	if len(stream.chunks) != 1 {
+		t.Fatalf("StreamSoftwareBOM() sent %d chunks, want 1", len(stream.chunks))
+	}
+	if stream.chunks[0].StatusCode != 400 {
+		t.Errorf("StreamSoftwareBOM() StatusCode = %v, want 400", stream.chunks[0].StatusCode)
+	}
+}
+
+func TestInbdServer_LoadVulnerabilityDatabase_InvalidRequest(t *testing.T) {
+	server := &InbdServer{}
+	tests := []struct {
+		name string
+		req  *pb.LoadVulnerabilityDatabaseRequest
+	}{
+		{"missing uri", &pb.LoadVulnerabilityDatabaseRequest{Signature: "abc"}},
+		{"missing signature", &pb.LoadVulnerabilityDatabaseRequest{Uri: "/var/cache/manageability/osv.zip"}},
+		{"invalid hash algorithm", &pb.LoadVulnerabilityDatabaseRequest{Uri: "/var/cache/manageability/osv.zip", Signature: "abc", HashAlgorithm: "md5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.LoadVulnerabilityDatabase(context.Background(), tt.req)
+			if err != nil {
+				t.Errorf("LoadVulnerabilityDatabase() returned unexpected error: %v", err)
+			}
+			if resp.StatusCode != 400 {
+				t.Errorf("LoadVulnerabilityDatabase() StatusCode = %v, want 400", resp.StatusCode)
+			}
+			if resp.Success {
+				t.Error("LoadVulnerabilityDatabase() Success = true, want false")
+			}
+		})
+	}
+}
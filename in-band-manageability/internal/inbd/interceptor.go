@@ -0,0 +1,85 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package inbd
+
+import (
+	"context"
+	"log"
+	"path"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/auth"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/power"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+// authorizeContext checks the caller against the authorization policy.  It is a variable for testing.
+var authorizeContext = auth.AuthorizeContext
+
+// authorizationUnaryInterceptor rejects RPCs, and query options of the Query RPC, that the
+// caller's role does not allow.
+func authorizationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method := path.Base(info.FullMethod)
+	queryOption := ""
+	if query, ok := req.(*pb.QueryRequest); ok {
+		queryOption = convertQueryOptionToString(query.GetOption())
+	}
+
+	if err := authorizeContext(ctx, method, queryOption); err != nil {
+		log.Printf("[Authorization] Denied %s: %v", info.FullMethod, err)
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+	}
+	return handler(ctx, req)
+}
+
+// auditUnaryInterceptor records the RPC being handled, and passes it with the peer's user ID
+// in the context so that the commands it executes are attributed to it in the command audit log.
+func auditUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	end := power.BeginRPC(info.FullMethod)
+	defer end()
+	return handler(withAuditCaller(ctx, info.FullMethod), req)
+}
+
+// withAuditCaller returns the context with the RPC and the user ID of its peer as the caller
+// of the commands it executes.
+func withAuditCaller(ctx context.Context, method string) context.Context {
+	caller := common.Caller{Method: method}
+	if creds, ok := auth.PeerFromContext(ctx); ok {
+		caller.UID = &creds.UID
+	}
+	return common.WithCaller(ctx, caller)
+}
+
+// authorizationStreamInterceptor rejects streaming RPCs that the caller's role does not allow.
+func authorizationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := authorizeContext(ss.Context(), path.Base(info.FullMethod), ""); err != nil {
+		log.Printf("[Authorization] Denied %s: %v", info.FullMethod, err)
+		return status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+	}
+	return handler(srv, ss)
+}
+
+// auditStreamInterceptor records the streaming RPC being handled, and passes it with the peer's
+// user ID in the stream's context for the command audit log.
+func auditStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	end := power.BeginRPC(info.FullMethod)
+	defer end()
+	return handler(srv, &auditServerStream{ServerStream: ss, ctx: withAuditCaller(ss.Context(), info.FullMethod)})
+}
+
+// auditServerStream is a server stream whose context carries the caller for the command audit log.
+type auditServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+func (s *auditServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,173 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package inbd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/auth"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+func TestAuthorizationUnaryInterceptor(t *testing.T) {
+	original := authorizeContext
+	defer func() { authorizeContext = original }()
+
+	var gotMethod, gotOption string
+	authorizeContext = func(_ context.Context, method, queryOption string) error {
+		gotMethod, gotOption = method, queryOption
+		if queryOption == "auditlog" {
+			return errors.New("denied")
+		}
+		return nil
+	}
+
+	handlerCalled := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCalled = true
+		return "ok", nil
+	}
+
+	resp, err := authorizationUnaryInterceptor(context.Background(),
+		&pb.QueryRequest{Option: pb.QueryOption_QUERY_OPTION_HARDWARE},
+		&grpc.UnaryServerInfo{FullMethod: "/inbd.v1.InbService/Query"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.True(t, handlerCalled)
+	assert.Equal(t, "Query", gotMethod)
+	assert.Equal(t, "hw", gotOption)
+
+	handlerCalled = false
+	_, err = authorizationUnaryInterceptor(context.Background(),
+		&pb.QueryRequest{Option: pb.QueryOption_QUERY_OPTION_AUDIT_LOG},
+		&grpc.UnaryServerInfo{FullMethod: "/inbd.v1.InbService/Query"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.False(t, handlerCalled)
+
+	_, err = authorizationUnaryInterceptor(context.Background(), &pb.SetPowerStateRequest{},
+		&grpc.UnaryServerInfo{FullMethod: "/inbd.v1.InbService/SetPowerState"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "SetPowerState", gotMethod)
+	assert.Equal(t, "", gotOption)
+}
+
+// testServerStream is a grpc.ServerStream with a context.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
+func TestAuthorizationStreamInterceptor(t *testing.T) {
+	original := authorizeContext
+	defer func() { authorizeContext = original }()
+
+	var gotMethod string
+	authorizeContext = func(_ context.Context, method, _ string) error {
+		gotMethod = method
+		if method == "StreamSoftwareBOM" {
+			return errors.New("denied")
+		}
+		return nil
+	}
+
+	handlerCalled := false
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		handlerCalled = true
+		return nil
+	}
+	stream := &testServerStream{ctx: context.Background()}
+
+	err := authorizationStreamInterceptor(nil, stream,
+		&grpc.StreamServerInfo{FullMethod: "/inbd.v1.InbService/StreamSoftwareBOM"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "StreamSoftwareBOM", gotMethod)
+	assert.False(t, handlerCalled)
+
+	err = authorizationStreamInterceptor(nil, stream,
+		&grpc.StreamServerInfo{FullMethod: "/inbd.v1.InbService/OtherStream"}, handler)
+	assert.NoError(t, err)
+	assert.True(t, handlerCalled)
+}
+
+func TestAuditInterceptors_PassCaller(t *testing.T) {
+	uid := uint32(1000)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: auth.AuthInfo{Peer: auth.PeerCredentials{UID: uid}}})
+
+	var got common.Caller
+	_, err := auditUnaryInterceptor(ctx, &pb.QueryRequest{},
+		&grpc.UnaryServerInfo{FullMethod: "/inbd.v1.InbService/Query"},
+		func(ctx context.Context, _ interface{}) (interface{}, error) {
+			got = common.CallerFromContext(ctx)
+			return nil, nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "/inbd.v1.InbService/Query (uid 1000)", got.String())
+
+	err = auditStreamInterceptor(nil, &testServerStream{ctx: context.Background()},
+		&grpc.StreamServerInfo{FullMethod: "/inbd.v1.InbService/StreamSoftwareBOM"},
+		func(_ interface{}, stream grpc.ServerStream) error {
+			got = common.CallerFromContext(stream.Context())
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "/inbd.v1.InbService/StreamSoftwareBOM", got.String())
+}
+
+// TestAuthorization_UnixSocket checks that the peer credentials of an inbc client on the
+// UNIX socket reach the authorization policy.
+func TestAuthorization_UnixSocket(t *testing.T) {
+	defer auth.SetPolicy(nil)
+	uid := uint32(os.Getuid())
+	policy, err := auth.NewPolicy(&auth.Config{
+		Bindings: []auth.Binding{{UID: &uid, Role: auth.RoleViewer}},
+	})
+	require.NoError(t, err)
+	auth.SetPolicy(policy)
+
+	socket := filepath.Join(t.TempDir(), "inbd.sock")
+	lis, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	server := grpc.NewServer(
+		grpc.Creds(auth.NewTransportCredentials()),
+		grpc.ChainUnaryInterceptor(authorizationUnaryInterceptor, auditUnaryInterceptor),
+	)
+	pb.RegisterInbServiceServer(server, &pb.UnimplementedInbServiceServer{})
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pb.NewInbServiceClient(conn)
+
+	// Viewers may query hardware; the stub service answers Unimplemented.
+	_, err = client.Query(context.Background(), &pb.QueryRequest{Option: pb.QueryOption_QUERY_OPTION_HARDWARE})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// Root is always allowed.
+	_, err = client.SetPowerState(context.Background(), &pb.SetPowerStateRequest{})
+	if uid == 0 {
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	} else {
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package power
+
+import (
+	"fmt"
+	"path"
+	"sync"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+// updateRPCs are the RPCs during which the device must not change its power state, as an
+// interrupted OS update or firmware flash can leave it unbootable.
+var updateRPCs = []string{
+	"/inbd.v1.InbService/UpdateSystemSoftware",
+	"/inbd.v1.InbService/UpdateFirmware",
+}
+
+var (
+	activeRPCsMutex sync.Mutex
+	activeRPCs      = map[string]int{}
+)
+
+// BeginRPC marks an RPC as in flight so that power actions can be vetoed while it is being
+// handled.  The returned function must be called when the RPC ends.
+func BeginRPC(method string) func() {
+	activeRPCsMutex.Lock()
+	activeRPCs[method]++
+	activeRPCsMutex.Unlock()
+
+	return func() {
+		activeRPCsMutex.Lock()
+		defer activeRPCsMutex.Unlock()
+		if activeRPCs[method]--; activeRPCs[method] <= 0 {
+			delete(activeRPCs, method)
+		}
+	}
+}
+
+// isRPCActive reports whether an RPC with the full method name, e.g.
+// /inbd.v1.InbService/UpdateFirmware, is being handled.
+func isRPCActive(method string) bool {
+	activeRPCsMutex.Lock()
+	defer activeRPCsMutex.Unlock()
+	return activeRPCs[method] > 0
+}
+
+// UpdateInProgress vetoes power actions while inbd is updating the OS or the firmware.
+func UpdateInProgress(action pb.SetPowerStateRequest_PowerAction) error {
+	for _, method := range updateRPCs {
+		if isRPCActive(method) {
+			return fmt.Errorf("%s is in progress", path.Base(method))
+		}
+	}
+	return nil
+}
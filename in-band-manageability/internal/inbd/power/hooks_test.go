@@ -0,0 +1,35 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package power
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateInProgress(t *testing.T) {
+	assert.NoError(t, UpdateInProgress(actionOff))
+
+	end := BeginRPC("/inbd.v1.InbService/UpdateFirmware")
+	assert.EqualError(t, UpdateInProgress(actionOff), "UpdateFirmware is in progress")
+	end()
+
+	end = BeginRPC("/inbd.v1.InbService/Query")
+	assert.NoError(t, UpdateInProgress(actionCycle))
+	end()
+}
+
+func TestBeginRPC(t *testing.T) {
+	endA := BeginRPC("/a")
+	endB := BeginRPC("/a")
+	assert.True(t, isRPCActive("/a"))
+	assert.False(t, isRPCActive("/b"))
+	endA()
+	assert.True(t, isRPCActive("/a"))
+	endB()
+	assert.False(t, isRPCActive("/a"))
+}
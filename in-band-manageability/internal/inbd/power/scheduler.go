@@ -0,0 +1,192 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package power schedules the power actions of inbd.
+//
+// A power action may be performed immediately or at a later time.  Before an action is
+// performed, the pre-action hooks are called; any of them can veto the action, for example while
+// an update is in progress.  A vetoed scheduled action is postponed until no hook vetoes it or it
+// is canceled.  Pending actions are kept in memory and do not survive a restart of inbd.
+package power
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultRetryInterval is how long a vetoed action is postponed.
+const DefaultRetryInterval = time.Minute
+
+// ErrNotFound is returned when canceling an action that is not pending.
+var ErrNotFound = errors.New("power action not found")
+
+// ErrVetoed is returned when a pre-action hook vetoes an action.
+var ErrVetoed = errors.New("power action vetoed")
+
+// Hook is called before a power action is performed.  It returns an error to veto the action.
+type Hook func(action pb.SetPowerStateRequest_PowerAction) error
+
+// Scheduler performs power actions, immediately or at a scheduled time.
+type Scheduler struct {
+	perform       func(pb.SetPowerStateRequest_PowerAction) error
+	hooks         []Hook
+	retryInterval time.Duration
+
+	mutex   sync.Mutex
+	pending map[string]*pendingAction
+}
+
+// pendingAction is a scheduled action and the timer that performs it.
+type pendingAction struct {
+	info  *pb.PowerActionInfo
+	timer *time.Timer
+}
+
+// NewScheduler creates a scheduler that calls perform to carry out the actions that none of the
+// hooks veto.
+func NewScheduler(perform func(pb.SetPowerStateRequest_PowerAction) error, hooks ...Hook) *Scheduler {
+	return &Scheduler{
+		perform:       perform,
+		hooks:         hooks,
+		retryInterval: DefaultRetryInterval,
+		pending:       map[string]*pendingAction{},
+	}
+}
+
+// Check calls the pre-action hooks and returns an error wrapping ErrVetoed if any of them vetoes
+// the action.
+func (s *Scheduler) Check(action pb.SetPowerStateRequest_PowerAction) error {
+	for _, hook := range s.hooks {
+		if err := hook(action); err != nil {
+			return fmt.Errorf("%w: %v", ErrVetoed, err)
+		}
+	}
+	return nil
+}
+
+// Schedule schedules an action at a time and returns the pending action.  requestedBy identifies
+// the caller, e.g. uid 0.
+func (s *Scheduler) Schedule(action pb.SetPowerStateRequest_PowerAction, at time.Time, requestedBy string) (*pb.PowerActionInfo, error) {
+	id, err := newActionID()
+	if err != nil {
+		return nil, err
+	}
+	info := &pb.PowerActionInfo{
+		ActionId:      id,
+		Action:        action,
+		ScheduledTime: timestamppb.New(at),
+		RequestedTime: timestamppb.Now(),
+		RequestedBy:   requestedBy,
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.pending[id] = &pendingAction{
+		info:  info,
+		timer: time.AfterFunc(time.Until(at), func() { s.run(id) }),
+	}
+	log.Printf("Scheduled power action %s (%s) at %s", id, action, at.Format(time.RFC3339))
+	return proto.Clone(info).(*pb.PowerActionInfo), nil
+}
+
+// Cancel cancels a pending action, or all pending actions if id is empty, and returns the
+// canceled actions.
+func (s *Scheduler) Cancel(id string) ([]*pb.PowerActionInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var ids []string
+	if id == "" {
+		for pendingID := range s.pending {
+			ids = append(ids, pendingID)
+		}
+	} else if _, ok := s.pending[id]; ok {
+		ids = []string{id}
+	} else {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+
+	canceled := []*pb.PowerActionInfo{}
+	for _, pendingID := range ids {
+		p := s.pending[pendingID]
+		p.timer.Stop()
+		delete(s.pending, pendingID)
+		canceled = append(canceled, proto.Clone(p.info).(*pb.PowerActionInfo))
+		log.Printf("Canceled power action %s (%s)", pendingID, p.info.Action)
+	}
+	sortByScheduledTime(canceled)
+	return canceled, nil
+}
+
+// Pending returns the pending actions, earliest first.
+func (s *Scheduler) Pending() []*pb.PowerActionInfo {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	actions := make([]*pb.PowerActionInfo, 0, len(s.pending))
+	for _, p := range s.pending {
+		actions = append(actions, proto.Clone(p.info).(*pb.PowerActionInfo))
+	}
+	sortByScheduledTime(actions)
+	return actions
+}
+
+// run performs a pending action when it is due, or postpones it if a hook vetoes it.
+func (s *Scheduler) run(id string) {
+	s.mutex.Lock()
+	p, ok := s.pending[id]
+	if !ok {
+		// Canceled while the timer fired.
+		s.mutex.Unlock()
+		return
+	}
+	action := p.info.Action
+	if err := s.Check(action); err != nil {
+		at := time.Now().Add(s.retryInterval)
+		p.info.VetoedReason = err.Error()
+		p.info.ScheduledTime = timestamppb.New(at)
+		p.timer = time.AfterFunc(s.retryInterval, func() { s.run(id) })
+		s.mutex.Unlock()
+		log.Printf("Postponed power action %s (%s) to %s: %v", id, action, at.Format(time.RFC3339), err)
+		return
+	}
+	delete(s.pending, id)
+	s.mutex.Unlock()
+
+	log.Printf("Performing power action %s (%s)", id, action)
+	if err := s.perform(action); err != nil {
+		log.Printf("Power action %s (%s) failed: %v", id, action, err)
+	}
+}
+
+// sortByScheduledTime sorts actions by scheduled time, then by ID.
+func sortByScheduledTime(actions []*pb.PowerActionInfo) {
+	sort.Slice(actions, func(i, j int) bool {
+		ti, tj := actions[i].ScheduledTime.AsTime(), actions[j].ScheduledTime.AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return actions[i].ActionId < actions[j].ActionId
+	})
+}
+
+// newActionID returns a random ID for a pending action.
+func newActionID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate power action ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
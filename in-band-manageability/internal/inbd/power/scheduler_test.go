@@ -0,0 +1,161 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package power
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	actionOff     = pb.SetPowerStateRequest_POWER_ACTION_OFF
+	actionCycle   = pb.SetPowerStateRequest_POWER_ACTION_CYCLE
+	actionSuspend = pb.SetPowerStateRequest_POWER_ACTION_SUSPEND
+)
+
+// recorder records the performed actions.
+type recorder struct {
+	mutex     sync.Mutex
+	performed []pb.SetPowerStateRequest_PowerAction
+	done      chan struct{}
+}
+
+func newRecorder() *recorder {
+	return &recorder{done: make(chan struct{}, 10)}
+}
+
+func (r *recorder) perform(action pb.SetPowerStateRequest_PowerAction) error {
+	r.mutex.Lock()
+	r.performed = append(r.performed, action)
+	r.mutex.Unlock()
+	r.done <- struct{}{}
+	return nil
+}
+
+func (r *recorder) wait(t *testing.T) {
+	t.Helper()
+	select {
+	case <-r.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("power action was not performed")
+	}
+}
+
+func TestScheduler_Check(t *testing.T) {
+	s := NewScheduler(newRecorder().perform,
+		func(pb.SetPowerStateRequest_PowerAction) error { return nil },
+		func(action pb.SetPowerStateRequest_PowerAction) error {
+			if action == actionSuspend {
+				return errors.New("on battery")
+			}
+			return nil
+		})
+
+	assert.NoError(t, s.Check(actionOff))
+	err := s.Check(actionSuspend)
+	assert.ErrorIs(t, err, ErrVetoed)
+	assert.EqualError(t, err, "power action vetoed: on battery")
+}
+
+func TestScheduler_Schedule(t *testing.T) {
+	r := newRecorder()
+	s := NewScheduler(r.perform)
+
+	at := time.Now().Add(20 * time.Millisecond)
+	info, err := s.Schedule(actionCycle, at, "uid 0")
+	require.NoError(t, err)
+	assert.Len(t, info.ActionId, 16)
+	assert.Equal(t, actionCycle, info.Action)
+	assert.True(t, info.ScheduledTime.AsTime().Equal(at))
+	assert.Equal(t, "uid 0", info.RequestedBy)
+	assert.Len(t, s.Pending(), 1)
+
+	r.wait(t)
+	assert.Equal(t, []pb.SetPowerStateRequest_PowerAction{actionCycle}, r.performed)
+	assert.Empty(t, s.Pending())
+}
+
+func TestScheduler_PostponesVetoedAction(t *testing.T) {
+	r := newRecorder()
+	var busy atomic.Bool
+	busy.Store(true)
+	s := NewScheduler(r.perform, func(pb.SetPowerStateRequest_PowerAction) error {
+		if busy.Load() {
+			return errors.New("UpdateFirmware is in progress")
+		}
+		return nil
+	})
+	s.retryInterval = 20 * time.Millisecond
+
+	_, err := s.Schedule(actionOff, time.Now(), "uid 0")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		pending := s.Pending()
+		return len(pending) == 1 && pending[0].VetoedReason != ""
+	}, 5*time.Second, 5*time.Millisecond)
+	assert.Equal(t, "power action vetoed: UpdateFirmware is in progress", s.Pending()[0].VetoedReason)
+
+	busy.Store(false)
+	r.wait(t)
+	assert.Empty(t, s.Pending())
+}
+
+func TestScheduler_Cancel(t *testing.T) {
+	r := newRecorder()
+	s := NewScheduler(r.perform)
+
+	later, err := s.Schedule(actionOff, time.Now().Add(2*time.Hour), "uid 0")
+	require.NoError(t, err)
+	sooner, err := s.Schedule(actionCycle, time.Now().Add(time.Hour), "uid 1000")
+	require.NoError(t, err)
+
+	pending := s.Pending()
+	require.Len(t, pending, 2)
+	assert.Equal(t, sooner.ActionId, pending[0].ActionId)
+	assert.Equal(t, later.ActionId, pending[1].ActionId)
+
+	canceled, err := s.Cancel(sooner.ActionId)
+	require.NoError(t, err)
+	require.Len(t, canceled, 1)
+	assert.Equal(t, sooner.ActionId, canceled[0].ActionId)
+
+	_, err = s.Cancel(sooner.ActionId)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	canceled, err = s.Cancel("")
+	require.NoError(t, err)
+	require.Len(t, canceled, 1)
+	assert.Equal(t, later.ActionId, canceled[0].ActionId)
+	assert.Empty(t, s.Pending())
+
+	canceled, err = s.Cancel("")
+	require.NoError(t, err)
+	assert.Empty(t, canceled)
+	assert.Empty(t, r.performed)
+}
+
+func TestScheduler_CanceledActionIsNotPerformed(t *testing.T) {
+	r := newRecorder()
+	s := NewScheduler(r.perform)
+
+	info, err := s.Schedule(actionOff, time.Now().Add(50*time.Millisecond), "uid 0")
+	require.NoError(t, err)
+	_, err = s.Cancel(info.ActionId)
+	require.NoError(t, err)
+
+	// run is also a no-op if the timer fired while the action was being canceled.
+	s.run(info.ActionId)
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, r.performed)
+}
@@ -0,0 +1,145 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provenance
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+)
+
+// Package types in ProvenanceConfig.RequiredFor.
+const (
+	PackageTypeSOTA   = "sota"
+	PackageTypeFOTA   = "fota"
+	PackageTypeConfig = "config"
+)
+
+// Verifier verifies the provenance of packages according to the configured policy and
+// records the results.
+type Verifier struct {
+	fs             afero.Fs
+	loadConfigFunc func(afero.Fs, string) (*utils.Configurations, error)
+	downloadFunc   func(afero.Fs, string, string, *http.Client,
+		func(string, string, io.Reader) (*http.Request, error),
+		func(afero.Fs, string, func(string) (bool, error)) (string, error),
+		func(string) (bool, error)) error
+	now func() time.Time
+}
+
+// NewVerifier creates a Verifier that reads the policy from the inbd configuration file.
+func NewVerifier(fs afero.Fs) *Verifier {
+	return &Verifier{
+		fs:             fs,
+		loadConfigFunc: utils.LoadConfig,
+		downloadFunc:   utils.DownloadFile,
+		now:            time.Now,
+	}
+}
+
+// VerifyPackage verifies the provenance of a package of the given type.  If provenancePath is
+// empty, it only fails when the policy requires provenance for the package type.  A nil Result
+// with a nil error means provenance was neither supplied nor required.  If the policy can not be
+// loaded, only a configuration package without provenance is accepted.
+func (v *Verifier) VerifyPackage(packageType, artifactPath, provenancePath string) (*Result, error) {
+	config, err := v.loadConfigFunc(v.fs, utils.ConfigFilePath)
+	if err != nil {
+		if provenancePath == "" && packageType == PackageTypeConfig {
+			// This keeps a missing or broken configuration file replaceable with LoadConfig.
+			log.Printf("[Warning] Provenance policy not loaded: %v", err)
+			return nil, nil
+		}
+		err = fmt.Errorf("error loading config: %w", err)
+		v.record(packageType, artifactPath, nil, err)
+		return nil, err
+	}
+	policy := config.Provenance
+
+	if provenancePath == "" {
+		if policy == nil || !slices.Contains(policy.RequiredFor, packageType) {
+			return nil, nil
+		}
+		err := errors.New("provenance is required but was not supplied")
+		v.record(packageType, artifactPath, nil, err)
+		return nil, err
+	}
+
+	if policy == nil || policy.TrustRoot == "" {
+		err := errors.New("provenance was supplied but no provenance trust root is configured")
+		v.record(packageType, artifactPath, nil, err)
+		return nil, err
+	}
+
+	root, err := LoadTrustRoot(v.fs, policy.TrustRoot)
+	if err != nil {
+		v.record(packageType, artifactPath, nil, err)
+		return nil, err
+	}
+
+	result, err := Verify(v.fs, artifactPath, provenancePath, root, policy)
+	v.record(packageType, artifactPath, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("provenance verification failed: %w", err)
+	}
+	log.Printf("Provenance verified: format %s, builder %q, source %q, signer %s",
+		result.Format, result.BuilderID, result.SourceRepo, result.Signer)
+	return result, nil
+}
+
+func (v *Verifier) record(packageType, artifactPath string, result *Result, verifyErr error) {
+	record := Record{
+		Time:        v.now(),
+		PackageType: packageType,
+		Artifact:    filepath.Base(artifactPath),
+		Verified:    verifyErr == nil,
+	}
+	if result != nil {
+		record.Format = result.Format
+		record.PredicateType = result.PredicateType
+		record.BuilderID = result.BuilderID
+		record.SourceRepo = result.SourceRepo
+		record.Signer = result.Signer
+		record.ArtifactDigest = result.ArtifactDigest
+	}
+	if verifyErr != nil {
+		record.Error = verifyErr.Error()
+	}
+	if err := addRecord(v.fs, record); err != nil {
+		log.Printf("[Warning] %v", err)
+	}
+}
+
+// Fetch downloads the provenance file at rawURL into destinationDir and returns its path.
+// Like update packages, provenance is only downloaded from trusted repositories.
+func (v *Verifier) Fetch(rawURL, destinationDir string) (string, error) {
+	config, err := v.loadConfigFunc(v.fs, utils.ConfigFilePath)
+	if err != nil {
+		return "", fmt.Errorf("error loading config: %w", err)
+	}
+	if !utils.IsTrustedRepository(rawURL, config) {
+		return "", fmt.Errorf("provenance URL '%s' is not in the list of trusted repositories", rawURL)
+	}
+
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid provenance URL: %w", err)
+	}
+	if err := v.downloadFunc(v.fs, rawURL, destinationDir, utils.LoadNetworkProfile(v.fs).HTTPClient(5*time.Minute),
+		http.NewRequest, utils.ReadJWTToken, utils.IsTokenExpired); err != nil {
+		return "", fmt.Errorf("error downloading provenance: %w", err)
+	}
+	return filepath.Join(destinationDir, path.Base(parsedURL.Path)), nil
+}
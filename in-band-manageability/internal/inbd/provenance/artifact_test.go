@@ -0,0 +1,179 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provenance
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTrustRootPath = "/etc/intel-manageability/provenance_trust_root.pem"
+
+func newTestVerifier(fs afero.Fs, config *utils.Configurations) *Verifier {
+	return &Verifier{
+		fs: fs,
+		loadConfigFunc: func(afero.Fs, string) (*utils.Configurations, error) {
+			return config, nil
+		},
+		now: func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) },
+	}
+}
+
+func TestVerifyPackage(t *testing.T) {
+	key, spki := generateKey(t)
+	env := signEnvelope(t, key, "", slsaStatement(t, testArtifact, testBuilder, testSourceRepo))
+	policy := &utils.ProvenanceConfig{
+		RequiredFor:     []string{PackageTypeFOTA},
+		TrustRoot:       testTrustRootPath,
+		AllowedBuilders: []string{"https://github.com/slsa-framework/"},
+	}
+
+	newFs := func(t *testing.T) afero.Fs {
+		fs := writeTestFiles(t, marshal(t, env))
+		require.NoError(t, afero.WriteFile(fs, testTrustRootPath, publicKeyPEM(spki), 0644))
+		return fs
+	}
+
+	t.Run("verified", func(t *testing.T) {
+		fs := newFs(t)
+		v := newTestVerifier(fs, &utils.Configurations{Provenance: policy})
+		result, err := v.VerifyPackage(PackageTypeFOTA, testArtifactPath, testProvenancePath)
+		require.NoError(t, err)
+		assert.Equal(t, testBuilder, result.BuilderID)
+
+		records, err := ReadRecords(fs)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.True(t, records[0].Verified)
+		assert.Equal(t, "firmware.bin", records[0].Artifact)
+		assert.Equal(t, PackageTypeFOTA, records[0].PackageType)
+		assert.Equal(t, FormatDSSE, records[0].Format)
+	})
+
+	t.Run("not supplied and not required", func(t *testing.T) {
+		fs := newFs(t)
+		v := newTestVerifier(fs, &utils.Configurations{Provenance: policy})
+		result, err := v.VerifyPackage(PackageTypeSOTA, testArtifactPath, "")
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+
+		records, err := ReadRecords(fs)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("required but not supplied", func(t *testing.T) {
+		fs := newFs(t)
+		v := newTestVerifier(fs, &utils.Configurations{Provenance: policy})
+		_, err := v.VerifyPackage(PackageTypeFOTA, testArtifactPath, "")
+		assert.ErrorContains(t, err, "required but was not supplied")
+
+		records, err := ReadRecords(fs)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.False(t, records[0].Verified)
+	})
+
+	t.Run("supplied without trust root", func(t *testing.T) {
+		v := newTestVerifier(newFs(t), &utils.Configurations{})
+		_, err := v.VerifyPackage(PackageTypeConfig, testArtifactPath, testProvenancePath)
+		assert.ErrorContains(t, err, "no provenance trust root is configured")
+	})
+
+	t.Run("policy violation", func(t *testing.T) {
+		fs := newFs(t)
+		v := newTestVerifier(fs, &utils.Configurations{Provenance: &utils.ProvenanceConfig{
+			TrustRoot:       testTrustRootPath,
+			AllowedBuilders: []string{"https://builder.example.com/"},
+		}})
+		_, err := v.VerifyPackage(PackageTypeSOTA, testArtifactPath, testProvenancePath)
+		assert.ErrorContains(t, err, "provenance verification failed")
+
+		records, err := ReadRecords(fs)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Contains(t, records[0].Error, "is not allowed")
+	})
+}
+
+func TestVerifyPackage_ConfigNotLoaded(t *testing.T) {
+	v := newTestVerifier(afero.NewMemMapFs(), nil)
+	v.loadConfigFunc = func(afero.Fs, string) (*utils.Configurations, error) {
+		return nil, errors.New("file not found")
+	}
+
+	result, err := v.VerifyPackage(PackageTypeConfig, testArtifactPath, "")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	_, err = v.VerifyPackage(PackageTypeConfig, testArtifactPath, testProvenancePath)
+	assert.ErrorContains(t, err, "error loading config")
+
+	// OS and firmware updates are not installed without the policy.
+	for _, packageType := range []string{PackageTypeSOTA, PackageTypeFOTA} {
+		_, err = v.VerifyPackage(packageType, testArtifactPath, "")
+		assert.ErrorContains(t, err, "error loading config")
+	}
+}
+
+func TestAddRecord_KeepsMostRecent(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for i := 0; i < maxRecords+5; i++ {
+		require.NoError(t, addRecord(fs, Record{Time: time.Unix(int64(i), 0), PackageType: PackageTypeSOTA}))
+	}
+	records, err := ReadRecords(fs)
+	require.NoError(t, err)
+	require.Len(t, records, maxRecords)
+	assert.Equal(t, int64(5), records[0].Time.Unix())
+	assert.Equal(t, int64(maxRecords+4), records[maxRecords-1].Time.Unix())
+}
+
+func TestFetch(t *testing.T) {
+	config := &utils.Configurations{}
+	config.OSUpdater.TrustedRepositories = []string{"https://example.com/"}
+
+	t.Run("untrusted repository", func(t *testing.T) {
+		v := newTestVerifier(afero.NewMemMapFs(), config)
+		_, err := v.Fetch("https://evil.example.org/firmware.bin.intoto.jsonl", "/tmp")
+		assert.ErrorContains(t, err, "not in the list of trusted repositories")
+	})
+
+	t.Run("downloads to destination", func(t *testing.T) {
+		v := newTestVerifier(afero.NewMemMapFs(), config)
+		var downloaded string
+		v.downloadFunc = func(_ afero.Fs, url, _ string, _ *http.Client,
+			_ func(string, string, io.Reader) (*http.Request, error),
+			_ func(afero.Fs, string, func(string) (bool, error)) (string, error),
+			_ func(string) (bool, error)) error {
+			downloaded = url
+			return nil
+		}
+		path, err := v.Fetch("https://example.com/fw/firmware.bin.intoto.jsonl", "/tmp")
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/fw/firmware.bin.intoto.jsonl", downloaded)
+		assert.Equal(t, "/tmp/firmware.bin.intoto.jsonl", path)
+	})
+
+	t.Run("download error", func(t *testing.T) {
+		v := newTestVerifier(afero.NewMemMapFs(), config)
+		v.downloadFunc = func(afero.Fs, string, string, *http.Client,
+			func(string, string, io.Reader) (*http.Request, error),
+			func(afero.Fs, string, func(string) (bool, error)) (string, error),
+			func(string) (bool, error)) error {
+			return errors.New("connection refused")
+		}
+		_, err := v.Fetch("https://example.com/fw/firmware.bin.intoto.jsonl", "/tmp")
+		assert.ErrorContains(t, err, "connection refused")
+	})
+}
@@ -0,0 +1,218 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provenance
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BundleMediaTypePrefix is the media type prefix of Sigstore bundles.
+const BundleMediaTypePrefix = "application/vnd.dev.sigstore.bundle"
+
+// bundle is a Sigstore bundle as written by cosign.  It holds either a DSSE envelope with an
+// attestation or a plain signature over the artifact digest.
+type bundle struct {
+	MediaType            string `json:"mediaType"`
+	VerificationMaterial struct {
+		PublicKey *struct {
+			Hint string `json:"hint"`
+		} `json:"publicKey"`
+		Certificate *struct {
+			RawBytes string `json:"rawBytes"`
+		} `json:"certificate"`
+		X509CertificateChain *struct {
+			Certificates []struct {
+				RawBytes string `json:"rawBytes"`
+			} `json:"certificates"`
+		} `json:"x509CertificateChain"`
+		TlogEntries []tlogEntry `json:"tlogEntries"`
+	} `json:"verificationMaterial"`
+	DSSEEnvelope     *Envelope `json:"dsseEnvelope"`
+	MessageSignature *struct {
+		MessageDigest struct {
+			Algorithm string `json:"algorithm"`
+			Digest    string `json:"digest"`
+		} `json:"messageDigest"`
+		Signature string `json:"signature"`
+	} `json:"messageSignature"`
+}
+
+// tlogEntry is a Rekor transparency log entry of a Sigstore bundle.
+type tlogEntry struct {
+	LogIndex string `json:"logIndex"`
+	LogID    struct {
+		KeyID string `json:"keyId"`
+	} `json:"logId"`
+	IntegratedTime   string `json:"integratedTime"`
+	InclusionPromise *struct {
+		SignedEntryTimestamp string `json:"signedEntryTimestamp"`
+	} `json:"inclusionPromise"`
+	CanonicalizedBody string `json:"canonicalizedBody"`
+}
+
+// signingTime returns the time at which the certificate was used, which is the integrated time
+// of a log entry about the certificate whose signed entry timestamp verifies with a key of the
+// trust root.  Without such an entry, it returns the current time, so that an expired
+// certificate is rejected.
+func (b *bundle) signingTime(root *TrustRoot, leaf *x509.Certificate) time.Time {
+	for _, entry := range b.VerificationMaterial.TlogEntries {
+		integrated, err := entry.verify(root, leaf)
+		if err == nil {
+			return integrated
+		}
+		log.Printf("[Warning] Transparency log entry %s not used: %v", entry.LogIndex, err)
+	}
+	return time.Now()
+}
+
+// verify checks the signed entry timestamp of the entry, which the log signs over the canonical
+// JSON of the body, the integrated time, the log ID and the log index, and that the entry is about
+// the leaf certificate.  It returns the integrated time.
+func (e *tlogEntry) verify(root *TrustRoot, leaf *x509.Certificate) (time.Time, error) {
+	if e.InclusionPromise == nil {
+		return time.Time{}, errors.New("entry has no signed entry timestamp")
+	}
+	logID, err := decodeBase64(e.LogID.KeyID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid log ID: %w", err)
+	}
+	key, ok := root.keys[hex.EncodeToString(logID)]
+	if !ok {
+		return time.Time{}, errors.New("the key of the log is not in the trust root")
+	}
+	integratedTime, err := strconv.ParseInt(e.IntegratedTime, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid integrated time: %w", err)
+	}
+	logIndex, err := strconv.ParseInt(e.LogIndex, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid log index: %w", err)
+	}
+	payload, err := json.Marshal(struct {
+		Body           string `json:"body"`
+		IntegratedTime int64  `json:"integratedTime"`
+		LogID          string `json:"logID"`
+		LogIndex       int64  `json:"logIndex"`
+	}{e.CanonicalizedBody, integratedTime, hex.EncodeToString(logID), logIndex})
+	if err != nil {
+		return time.Time{}, err
+	}
+	timestamp, err := decodeBase64(e.InclusionPromise.SignedEntryTimestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid signed entry timestamp: %w", err)
+	}
+	if err := verifySignature(key, payload, timestamp); err != nil {
+		return time.Time{}, fmt.Errorf("signed entry timestamp: %w", err)
+	}
+
+	// Rekor records the signing certificate, PEM encoded, in the body of the entry.
+	body, err := decodeBase64(e.CanonicalizedBody)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid entry body: %w", err)
+	}
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})
+	if !bytes.Contains(body, []byte(base64.StdEncoding.EncodeToString(certificate))) {
+		return time.Time{}, errors.New("entry is not about the signing certificate")
+	}
+	return time.Unix(integratedTime, 0), nil
+}
+
+// certificateChain returns the certificates of the verification material, leaf first.
+func (b *bundle) certificateChain() ([]*x509.Certificate, error) {
+	var encoded []string
+	material := b.VerificationMaterial
+	if material.Certificate != nil {
+		encoded = append(encoded, material.Certificate.RawBytes)
+	}
+	if material.X509CertificateChain != nil {
+		for _, cert := range material.X509CertificateChain.Certificates {
+			encoded = append(encoded, cert.RawBytes)
+		}
+	}
+
+	var chain []*x509.Certificate
+	for _, e := range encoded {
+		der, err := decodeBase64(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate encoding: %w", err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate: %w", err)
+		}
+		chain = append(chain, cert)
+	}
+	return chain, nil
+}
+
+// keyHint returns the public key hint of the verification material.
+func (b *bundle) keyHint() string {
+	if b.VerificationMaterial.PublicKey != nil {
+		return b.VerificationMaterial.PublicKey.Hint
+	}
+	return ""
+}
+
+// verifyBundle verifies a Sigstore bundle.  For a DSSE envelope it returns the verified
+// statement payload; for a message signature the payload is nil.
+func verifyBundle(b *bundle, root *TrustRoot, digests artifactDigests) ([]byte, string, error) {
+	if !strings.HasPrefix(b.MediaType, BundleMediaTypePrefix) {
+		return nil, "", fmt.Errorf("unsupported bundle media type %q", b.MediaType)
+	}
+	chain, err := b.certificateChain()
+	if err != nil {
+		return nil, "", err
+	}
+	if len(chain) > 0 {
+		if err := root.verifyCertificate(chain, b.signingTime(root, chain[0])); err != nil {
+			return nil, "", err
+		}
+	}
+
+	switch {
+	case b.DSSEEnvelope != nil:
+		env := *b.DSSEEnvelope
+		if hint := b.keyHint(); hint != "" {
+			for i := range env.Signatures {
+				if env.Signatures[i].KeyID == "" {
+					env.Signatures[i].KeyID = hint
+				}
+			}
+		}
+		return verifyEnvelope(&env, root, chain)
+	case b.MessageSignature != nil:
+		sig := b.MessageSignature
+		if sig.MessageDigest.Algorithm != "SHA2_256" {
+			return nil, "", fmt.Errorf("unsupported message digest algorithm %q", sig.MessageDigest.Algorithm)
+		}
+		digest, err := decodeBase64(sig.MessageDigest.Digest)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid message digest: %w", err)
+		}
+		if !bytes.Equal(digest, digests.sha256) {
+			return nil, "", errors.New("artifact digest does not match the signed digest")
+		}
+		signature, err := decodeBase64(sig.Signature)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid signature encoding: %w", err)
+		}
+		signer, err := verifyDigestSignature(root, chain, b.keyHint(), digest, signature)
+		return nil, signer, err
+	default:
+		return nil, "", errors.New("bundle has no envelope or signature")
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provenance
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// InTotoPayloadType is the DSSE payload type of in-toto statements.
+const InTotoPayloadType = "application/vnd.in-toto+json"
+
+// Envelope is a DSSE envelope.
+type Envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     string      `json:"payload"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+// Signature is a signature of a DSSE envelope.
+type Signature struct {
+	KeyID string `json:"keyid"`
+	Sig   string `json:"sig"`
+}
+
+// pae returns the DSSE pre-authentication encoding of the payload, which is what is signed.
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// verifyEnvelope checks that one signature of the envelope is valid, either with a key from the
+// trust root or, if chain is not empty, with the leaf certificate.  It returns the decoded payload
+// and a description of the signer.
+func verifyEnvelope(env *Envelope, root *TrustRoot, chain []*x509.Certificate) ([]byte, string, error) {
+	if env.PayloadType != InTotoPayloadType {
+		return nil, "", fmt.Errorf("unsupported payload type %q", env.PayloadType)
+	}
+	payload, err := decodeBase64(env.Payload)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid envelope payload: %w", err)
+	}
+	if len(env.Signatures) == 0 {
+		return nil, "", errors.New("envelope is not signed")
+	}
+
+	message := pae(env.PayloadType, payload)
+	var lastErr error
+	for _, sig := range env.Signatures {
+		signature, err := decodeBase64(sig.Sig)
+		if err != nil {
+			lastErr = fmt.Errorf("invalid signature encoding: %w", err)
+			continue
+		}
+		signer, err := verifyMessage(root, chain, sig.KeyID, message, signature)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return payload, signer, nil
+	}
+	return nil, "", lastErr
+}
+
+// verifyMessage verifies a signature over message with the certificate chain if there is one,
+// otherwise with the trusted keys.
+func verifyMessage(root *TrustRoot, chain []*x509.Certificate, keyID string, message, signature []byte) (string, error) {
+	return verifySigner(root, chain, keyID, func(key crypto.PublicKey) error {
+		return verifySignature(key, message, signature)
+	})
+}
+
+// verifyDigestSignature verifies a signature over a SHA-256 digest like verifyMessage.
+func verifyDigestSignature(root *TrustRoot, chain []*x509.Certificate, keyID string, digest, signature []byte) (string, error) {
+	return verifySigner(root, chain, keyID, func(key crypto.PublicKey) error {
+		return verifyDigest(key, digest, signature)
+	})
+}
+
+// verifySigner verifies with the leaf certificate if there is a chain, which the caller has
+// verified against the trust root, otherwise with the trusted keys.
+func verifySigner(root *TrustRoot, chain []*x509.Certificate, keyID string, verify func(crypto.PublicKey) error) (string, error) {
+	if len(chain) > 0 {
+		if err := verify(chain[0].PublicKey); err != nil {
+			return "", err
+		}
+		return certificateIdentity(chain[0]), nil
+	}
+	id, err := root.verifyWithKeys(keyID, verify)
+	if err != nil {
+		return "", err
+	}
+	return "key " + id, nil
+}
+
+// certificateIdentity describes the signer of a certificate, preferring the SAN that Sigstore
+// uses for identities.
+func certificateIdentity(cert *x509.Certificate) string {
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	if len(cert.EmailAddresses) > 0 {
+		return cert.EmailAddresses[0]
+	}
+	return cert.Subject.String()
+}
+
+// certificateSANs returns the URI and email subject alternative names of a certificate.
+func certificateSANs(cert *x509.Certificate) []string {
+	var sans []string
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	return append(sans, cert.EmailAddresses...)
+}
+
+// OIDs of the OIDC issuer extensions of Sigstore's Fulcio certificates.
+var (
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+	oidIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+)
+
+// oidcIssuer returns the OIDC issuer recorded in a Fulcio certificate, or "" if there is none.
+// The current extension holds a DER UTF8String; the deprecated one holds the raw string.
+func oidcIssuer(cert *x509.Certificate) string {
+	issuer := ""
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var value string
+			if rest, err := asn1.Unmarshal(ext.Value, &value); err == nil && len(rest) == 0 {
+				return value
+			}
+		case ext.Id.Equal(oidIssuerV1):
+			issuer = string(ext.Value)
+		}
+	}
+	return issuer
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	if data, err := base64.StdEncoding.DecodeString(s); err == nil {
+		return data, nil
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
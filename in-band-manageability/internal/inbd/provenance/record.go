@@ -0,0 +1,87 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provenance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+)
+
+// RecordsPath is the file holding the results of the most recent provenance verifications.
+const RecordsPath = "/var/intel-manageability/provenance_records.json"
+
+// maxRecords is the number of verification results kept.
+const maxRecords = 20
+
+// Record is the result of a provenance verification of one package.
+type Record struct {
+	Time           time.Time `json:"time"`
+	PackageType    string    `json:"package_type"`
+	Artifact       string    `json:"artifact"`
+	Verified       bool      `json:"verified"`
+	Format         string    `json:"format,omitempty"`
+	PredicateType  string    `json:"predicate_type,omitempty"`
+	BuilderID      string    `json:"builder_id,omitempty"`
+	SourceRepo     string    `json:"source_repo,omitempty"`
+	Signer         string    `json:"signer,omitempty"`
+	ArtifactDigest string    `json:"artifact_digest,omitempty"`
+	Error          string    `json:"error,omitempty"`
+}
+
+var recordsMutex sync.Mutex
+
+// ReadRecords returns the stored verification results, oldest first.
+func ReadRecords(fs afero.Fs) ([]Record, error) {
+	recordsMutex.Lock()
+	defer recordsMutex.Unlock()
+	return readRecords(fs)
+}
+
+func readRecords(fs afero.Fs) ([]Record, error) {
+	data, err := afero.ReadFile(fs, RecordsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Record{}, nil
+		}
+		return nil, fmt.Errorf("failed to read provenance records: %w", err)
+	}
+	var records []Record
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse provenance records: %w", err)
+	}
+	return records, nil
+}
+
+// addRecord appends a record and drops the oldest records beyond maxRecords.
+func addRecord(fs afero.Fs, record Record) error {
+	recordsMutex.Lock()
+	defer recordsMutex.Unlock()
+
+	records, err := readRecords(fs)
+	if err != nil {
+		// Start over rather than losing the new record to a corrupt file.
+		records = nil
+	}
+	records = append(records, record)
+	if len(records) > maxRecords {
+		records = records[len(records)-maxRecords:]
+	}
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize provenance records: %w", err)
+	}
+	if err := utils.WriteFile(fs, RecordsPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write provenance records: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provenance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SLSA provenance predicate types.
+const (
+	SLSAProvenanceV1  = "https://slsa.dev/provenance/v1"
+	SLSAProvenanceV02 = "https://slsa.dev/provenance/v0.2"
+)
+
+// statement is an in-toto attestation statement (v0.1 or v1).
+type statement struct {
+	Type          string          `json:"_type"`
+	Subject       []subject       `json:"subject"`
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+type subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// slsaV1Predicate holds the fields of SLSA v1 provenance used by the policy.
+type slsaV1Predicate struct {
+	BuildDefinition struct {
+		ExternalParameters struct {
+			Workflow struct {
+				Repository string `json:"repository"`
+			} `json:"workflow"`
+			Source struct {
+				URI string `json:"uri"`
+			} `json:"source"`
+		} `json:"externalParameters"`
+		ResolvedDependencies []struct {
+			URI string `json:"uri"`
+		} `json:"resolvedDependencies"`
+	} `json:"buildDefinition"`
+	RunDetails struct {
+		Builder struct {
+			ID string `json:"id"`
+		} `json:"builder"`
+	} `json:"runDetails"`
+}
+
+// slsaV02Predicate holds the fields of SLSA v0.2 provenance used by the policy.
+type slsaV02Predicate struct {
+	Builder struct {
+		ID string `json:"id"`
+	} `json:"builder"`
+	Invocation struct {
+		ConfigSource struct {
+			URI string `json:"uri"`
+		} `json:"configSource"`
+	} `json:"invocation"`
+	Materials []struct {
+		URI string `json:"uri"`
+	} `json:"materials"`
+}
+
+// parseStatement parses an in-toto statement and checks that one of its subjects is the artifact.
+func parseStatement(payload []byte, digests artifactDigests) (*statement, error) {
+	var st statement
+	if err := json.Unmarshal(payload, &st); err != nil {
+		return nil, fmt.Errorf("invalid in-toto statement: %w", err)
+	}
+	if !strings.HasPrefix(st.Type, "https://in-toto.io/Statement/") {
+		return nil, fmt.Errorf("unsupported statement type %q", st.Type)
+	}
+	for _, s := range st.Subject {
+		if digests.matches(s.Digest) {
+			return &st, nil
+		}
+	}
+	return nil, errors.New("artifact digest does not match any subject of the statement")
+}
+
+// builderAndSources returns the builder ID and the source repositories named in SLSA provenance.
+// The first source is the one the build was started from.
+func (st *statement) builderAndSources() (string, []string, error) {
+	switch st.PredicateType {
+	case SLSAProvenanceV1:
+		var p slsaV1Predicate
+		if err := json.Unmarshal(st.Predicate, &p); err != nil {
+			return "", nil, fmt.Errorf("invalid SLSA v1 provenance: %w", err)
+		}
+		sources := nonEmpty(p.BuildDefinition.ExternalParameters.Workflow.Repository,
+			p.BuildDefinition.ExternalParameters.Source.URI)
+		for _, dep := range p.BuildDefinition.ResolvedDependencies {
+			sources = append(sources, nonEmpty(dep.URI)...)
+		}
+		return p.RunDetails.Builder.ID, sources, nil
+	case SLSAProvenanceV02:
+		var p slsaV02Predicate
+		if err := json.Unmarshal(st.Predicate, &p); err != nil {
+			return "", nil, fmt.Errorf("invalid SLSA v0.2 provenance: %w", err)
+		}
+		sources := nonEmpty(p.Invocation.ConfigSource.URI)
+		for _, material := range p.Materials {
+			sources = append(sources, nonEmpty(material.URI)...)
+		}
+		return p.Builder.ID, sources, nil
+	default:
+		return "", nil, nil
+	}
+}
+
+func nonEmpty(values ...string) []string {
+	var result []string
+	for _, v := range values {
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provenance
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+)
+
+// TrustRoot holds the public keys and CA certificates that may sign provenance.
+type TrustRoot struct {
+	keys  map[string]crypto.PublicKey
+	roots *x509.CertPool
+}
+
+// LoadTrustRoot reads PUBLIC KEY and CERTIFICATE blocks from a PEM file.
+func LoadTrustRoot(fs afero.Fs, path string) (*TrustRoot, error) {
+	data, err := utils.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read provenance trust root: %w", err)
+	}
+	return ParseTrustRoot(data)
+}
+
+// ParseTrustRoot parses PUBLIC KEY and CERTIFICATE blocks from PEM data.
+func ParseTrustRoot(data []byte) (*TrustRoot, error) {
+	root := &TrustRoot{keys: map[string]crypto.PublicKey{}, roots: x509.NewCertPool()}
+	certificates := 0
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid public key in trust root: %w", err)
+			}
+			root.keys[KeyID(block.Bytes)] = key
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid certificate in trust root: %w", err)
+			}
+			root.roots.AddCert(cert)
+			certificates++
+		}
+	}
+	if len(root.keys) == 0 && certificates == 0 {
+		return nil, errors.New("trust root contains no public keys or certificates")
+	}
+	return root, nil
+}
+
+// KeyID returns the ID of a public key: the hex SHA-256 of its DER SubjectPublicKeyInfo.
+func KeyID(spki []byte) string {
+	sum := sha256.Sum256(spki)
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyWithKeys runs verify with the key matching keyID, or with every trusted key if keyID
+// is empty or unknown.  It returns the ID of the key that verified the signature.
+func (r *TrustRoot) verifyWithKeys(keyID string, verify func(crypto.PublicKey) error) (string, error) {
+	if key, ok := r.keys[keyID]; ok {
+		if err := verify(key); err != nil {
+			return "", err
+		}
+		return keyID, nil
+	}
+	for id, key := range r.keys {
+		if verify(key) == nil {
+			return id, nil
+		}
+	}
+	return "", errors.New("signature does not match any trusted key")
+}
+
+// verifyCertificate checks that the leaf certificate chains to a trusted CA and may sign code
+// at the time the signature was made.
+func (r *TrustRoot) verifyCertificate(chain []*x509.Certificate, signedAt time.Time) error {
+	if len(chain) == 0 {
+		return errors.New("no certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         r.roots,
+		Intermediates: intermediates,
+		CurrentTime:   signedAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return fmt.Errorf("certificate is not trusted: %w", err)
+	}
+	return nil
+}
+
+// verifySignature verifies a signature over message with an ECDSA, RSA or Ed25519 key.
+func verifySignature(key crypto.PublicKey, message, signature []byte) error {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		digest := digestForKey(k, message)
+		if !ecdsa.VerifyASN1(k, digest, signature) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(message)
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature); err != nil {
+			if pssErr := rsa.VerifyPSS(k, crypto.SHA256, digest[:], signature, nil); pssErr != nil {
+				return errors.New("invalid RSA signature")
+			}
+		}
+		return nil
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, message, signature) {
+			return errors.New("invalid Ed25519 signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+// digestForKey hashes the message with the hash matching the curve size, as cosign does.
+func digestForKey(key *ecdsa.PublicKey, message []byte) []byte {
+	switch key.Curve.Params().BitSize {
+	case 384:
+		sum := sha512.Sum384(message)
+		return sum[:]
+	case 521:
+		sum := sha512.Sum512(message)
+		return sum[:]
+	default:
+		sum := sha256.Sum256(message)
+		return sum[:]
+	}
+}
+
+// verifyDigest verifies a signature over a SHA-256 digest with an ECDSA or RSA key.
+func verifyDigest(key crypto.PublicKey, digest, signature []byte) error {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest, signature) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest, signature); err != nil {
+			return errors.New("invalid RSA signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %T for digest signatures", key)
+	}
+}
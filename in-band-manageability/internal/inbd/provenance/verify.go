@@ -0,0 +1,264 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package provenance verifies the supply-chain provenance of update and configuration packages.
+//
+// Supported formats are Sigstore bundles as written by cosign, DSSE envelopes with in-toto
+// attestations, and in-toto JSON lines files with one DSSE envelope per line as written by the
+// SLSA GitHub generators.  Signatures are checked against a locally configured trust root, and
+// SLSA provenance is checked against the allowed builders and source repositories.
+package provenance
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+)
+
+// Provenance formats reported in Result.Format.
+const (
+	FormatSigstoreBundle = "sigstore-bundle"
+	FormatDSSE           = "dsse"
+	FormatInTotoJSONL    = "in-toto-jsonl"
+)
+
+// maxProvenanceSize is the largest provenance file that is read.
+const maxProvenanceSize = 10 * 1024 * 1024
+
+// Result is the outcome of a successful provenance verification.
+type Result struct {
+	Format         string
+	PredicateType  string
+	BuilderID      string
+	SourceRepo     string
+	Signer         string
+	ArtifactDigest string
+	// SignerIssuer is the OIDC issuer of the signing certificate; it is empty for key signatures.
+	SignerIssuer string
+
+	// signerSANs are the subject alternative names of the signing certificate.
+	signerSANs []string
+	// certificateSigned is set if the provenance was signed with a certificate rather than a trusted key.
+	certificateSigned bool
+}
+
+// artifactDigests are the digests of the artifact the provenance is checked against.
+type artifactDigests struct {
+	sha256 []byte
+	sha512 []byte
+}
+
+func (d artifactDigests) matches(digest map[string]string) bool {
+	if v, ok := digest["sha256"]; ok && strings.EqualFold(v, hex.EncodeToString(d.sha256)) {
+		return true
+	}
+	if v, ok := digest["sha512"]; ok && strings.EqualFold(v, hex.EncodeToString(d.sha512)) {
+		return true
+	}
+	return false
+}
+
+func computeDigests(fs afero.Fs, path string) (artifactDigests, error) {
+	file, err := utils.Open(fs, path)
+	if err != nil {
+		return artifactDigests{}, fmt.Errorf("failed to open artifact: %w", err)
+	}
+	defer file.Close()
+
+	h256, h512 := sha256.New(), sha512.New()
+	if _, err := io.Copy(io.MultiWriter(h256, h512), file); err != nil {
+		return artifactDigests{}, fmt.Errorf("failed to read artifact: %w", err)
+	}
+	return artifactDigests{sha256: h256.Sum(nil), sha512: h512.Sum(nil)}, nil
+}
+
+// Verify checks the provenance file of the artifact against the trust root and the policy.
+func Verify(fs afero.Fs, artifactPath, provenancePath string, root *TrustRoot, policy *utils.ProvenanceConfig) (*Result, error) {
+	digests, err := computeDigests(fs, artifactPath)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := utils.ReadFile(fs, provenancePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read provenance: %w", err)
+	}
+	if len(data) > maxProvenanceSize {
+		return nil, fmt.Errorf("provenance file is larger than %d bytes", maxProvenanceSize)
+	}
+
+	var probe struct {
+		MediaType   string `json:"mediaType"`
+		PayloadType string `json:"payloadType"`
+	}
+	if err := json.Unmarshal(data, &probe); err == nil {
+		switch {
+		case probe.MediaType != "":
+			return verifyBundleDocument(data, root, policy, digests)
+		case probe.PayloadType != "":
+			return verifyEnvelopeDocument(data, FormatDSSE, root, policy, digests)
+		}
+		return nil, errors.New("unrecognized provenance format")
+	}
+
+	// Not a single JSON document: try in-toto JSON lines.  Any verified line is enough.
+	var lastErr error = errors.New("provenance file is empty")
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxProvenanceSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		result, err := verifyEnvelopeDocument(line, FormatInTotoJSONL, root, policy, digests)
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read provenance: %w", err)
+	}
+	return nil, lastErr
+}
+
+func verifyBundleDocument(data []byte, root *TrustRoot, policy *utils.ProvenanceConfig, digests artifactDigests) (*Result, error) {
+	var b bundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("invalid Sigstore bundle: %w", err)
+	}
+	payload, signer, err := verifyBundle(&b, root, digests)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Format: FormatSigstoreBundle, Signer: signer, ArtifactDigest: "sha256:" + hex.EncodeToString(digests.sha256)}
+	if chain, err := b.certificateChain(); err == nil && len(chain) > 0 {
+		result.certificateSigned = true
+		result.signerSANs = certificateSANs(chain[0])
+		result.SignerIssuer = oidcIssuer(chain[0])
+	}
+	if payload == nil {
+		// A plain signature carries no provenance to check against the policy.
+		if err := checkPolicy(policy, result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	return checkStatement(payload, result, policy, digests)
+}
+
+func verifyEnvelopeDocument(data []byte, format string, root *TrustRoot, policy *utils.ProvenanceConfig, digests artifactDigests) (*Result, error) {
+	var env Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("invalid DSSE envelope: %w", err)
+	}
+	payload, signer, err := verifyEnvelope(&env, root, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Format: format, Signer: signer, ArtifactDigest: "sha256:" + hex.EncodeToString(digests.sha256)}
+	return checkStatement(payload, result, policy, digests)
+}
+
+// checkStatement checks that the signed statement is about the artifact and that its
+// provenance satisfies the policy.
+func checkStatement(payload []byte, result *Result, policy *utils.ProvenanceConfig, digests artifactDigests) (*Result, error) {
+	st, err := parseStatement(payload, digests)
+	if err != nil {
+		return nil, err
+	}
+	builder, sources, err := st.builderAndSources()
+	if err != nil {
+		return nil, err
+	}
+	result.PredicateType = st.PredicateType
+	result.BuilderID = builder
+
+	if policy != nil && len(policy.AllowedSourceRepos) > 0 {
+		for _, source := range sources {
+			if hasAllowedPrefix(source, policy.AllowedSourceRepos) {
+				result.SourceRepo = source
+				break
+			}
+		}
+	} else if len(sources) > 0 {
+		result.SourceRepo = sources[0]
+	}
+
+	if err := checkPolicy(policy, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkPolicy checks the builder and source of the result against the allowed prefixes, and the
+// signing certificate against the allowed signers.  Once the policy requires provenance or
+// restricts builders or sources, only SLSA provenance is accepted; a plain signature only proves
+// who signed the artifact, not how it was built.
+func checkPolicy(policy *utils.ProvenanceConfig, result *Result) error {
+	if policy == nil {
+		return checkSigner(nil, result)
+	}
+	if len(policy.AllowedBuilders) > 0 && !hasAllowedPrefix(result.BuilderID, policy.AllowedBuilders) {
+		if result.BuilderID == "" {
+			return errors.New("provenance does not name a builder")
+		}
+		return fmt.Errorf("builder %q is not allowed", result.BuilderID)
+	}
+	if len(policy.AllowedSourceRepos) > 0 && result.SourceRepo == "" {
+		return errors.New("provenance does not name an allowed source repository")
+	}
+	if len(policy.RequiredFor) > 0 || len(policy.AllowedBuilders) > 0 || len(policy.AllowedSourceRepos) > 0 {
+		if result.PredicateType != SLSAProvenanceV1 && result.PredicateType != SLSAProvenanceV02 {
+			return errors.New("an in-toto statement with SLSA provenance is required")
+		}
+	}
+	return checkSigner(policy.AllowedSigners, result)
+}
+
+// checkSigner checks that a certificate signer is one of the allowed signers, and that the
+// signer may attest to the builder.  A CA such as Sigstore's Fulcio issues certificates to any
+// identity, so certificate signatures are rejected when no signers are allowed.
+func checkSigner(allowed []utils.ProvenanceSigner, result *Result) error {
+	if !result.certificateSigned {
+		return nil
+	}
+	if len(allowed) == 0 {
+		return fmt.Errorf("signer %q is not allowed: certificate signatures require allowed_signers in the provenance policy", result.Signer)
+	}
+	for _, signer := range allowed {
+		if signer.OIDCIssuer != result.SignerIssuer || !slices.Contains(result.signerSANs, signer.SAN) {
+			continue
+		}
+		if len(signer.Builders) > 0 && !hasAllowedPrefix(result.BuilderID, signer.Builders) {
+			return fmt.Errorf("signer %q may not attest to builder %q", signer.SAN, result.BuilderID)
+		}
+		return nil
+	}
+	return fmt.Errorf("signer %q with OIDC issuer %q is not allowed", result.Signer, result.SignerIssuer)
+}
+
+func hasAllowedPrefix(value string, allowed []string) bool {
+	if value == "" {
+		return false
+	}
+	for _, prefix := range allowed {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
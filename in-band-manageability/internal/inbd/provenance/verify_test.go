@@ -0,0 +1,471 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provenance
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testArtifactPath   = "/tmp/firmware.bin"
+	testProvenancePath = "/tmp/firmware.bin.intoto.jsonl"
+	testBuilder        = "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_generic_slsa3.yml@refs/tags/v2.0.0"
+	testSourceRepo     = "git+https://github.com/example/firmware@refs/heads/main"
+	testSignerSAN      = "https://github.com/example/firmware/.github/workflows/release.yml@refs/heads/main"
+	testSignerIssuer   = "https://token.actions.githubusercontent.com"
+)
+
+var testArtifact = []byte("firmware image")
+
+func generateKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	spki, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return key, spki
+}
+
+func publicKeyPEM(spki []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: spki})
+}
+
+// slsaStatement returns an in-toto statement with SLSA v1 provenance for the artifact.
+func slsaStatement(t *testing.T, artifact []byte, builder, source string) []byte {
+	t.Helper()
+	sum := sha256.Sum256(artifact)
+	st := map[string]any{
+		"_type":         "https://in-toto.io/Statement/v1",
+		"subject":       []any{map[string]any{"name": "firmware.bin", "digest": map[string]string{"sha256": hex.EncodeToString(sum[:])}}},
+		"predicateType": SLSAProvenanceV1,
+		"predicate": map[string]any{
+			"buildDefinition": map[string]any{
+				"externalParameters": map[string]any{
+					"workflow": map[string]any{"repository": source},
+				},
+			},
+			"runDetails": map[string]any{
+				"builder": map[string]any{"id": builder},
+			},
+		},
+	}
+	payload, err := json.Marshal(st)
+	require.NoError(t, err)
+	return payload
+}
+
+func signEnvelope(t *testing.T, key *ecdsa.PrivateKey, keyID string, payload []byte) *Envelope {
+	t.Helper()
+	digest := sha256.Sum256(pae(InTotoPayloadType, payload))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	return &Envelope{
+		PayloadType: InTotoPayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []Signature{{KeyID: keyID, Sig: base64.StdEncoding.EncodeToString(sig)}},
+	}
+}
+
+func marshal(t *testing.T, v any) []byte {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
+
+func writeTestFiles(t *testing.T, provenance []byte) afero.Fs {
+	t.Helper()
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, testArtifactPath, testArtifact, 0644))
+	require.NoError(t, afero.WriteFile(fs, testProvenancePath, provenance, 0644))
+	return fs
+}
+
+// codeSigningChain returns a CA certificate and a leaf certificate issued by it for key.
+func codeSigningChain(t *testing.T, key *ecdsa.PrivateKey) (*x509.Certificate, *x509.Certificate) {
+	t.Helper()
+	return codeSigningChainAt(t, key, time.Now().Add(-time.Minute))
+}
+
+// codeSigningChainAt returns a CA certificate and a leaf certificate for key that is valid for
+// ten minutes from notBefore.
+func codeSigningChainAt(t *testing.T, key *ecdsa.PrivateKey, notBefore time.Time) (*x509.Certificate, *x509.Certificate) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	identity, err := url.Parse(testSignerSAN)
+	require.NoError(t, err)
+	issuer, err := asn1.Marshal(testSignerIssuer)
+	require.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       notBefore,
+		NotAfter:        notBefore.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		URIs:            []*url.URL{identity},
+		ExtraExtensions: []pkix.Extension{{Id: oidIssuerV2, Value: issuer}},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(leafDER)
+	require.NoError(t, err)
+	return ca, leaf
+}
+
+func TestParseTrustRoot(t *testing.T) {
+	_, spki := generateKey(t)
+	root, err := ParseTrustRoot(publicKeyPEM(spki))
+	require.NoError(t, err)
+	assert.Contains(t, root.keys, KeyID(spki))
+
+	_, err = ParseTrustRoot([]byte("not pem"))
+	assert.ErrorContains(t, err, "no public keys or certificates")
+}
+
+func TestVerify_DSSEEnvelope(t *testing.T) {
+	key, spki := generateKey(t)
+	root, err := ParseTrustRoot(publicKeyPEM(spki))
+	require.NoError(t, err)
+	env := signEnvelope(t, key, "", slsaStatement(t, testArtifact, testBuilder, testSourceRepo))
+	fs := writeTestFiles(t, marshal(t, env))
+
+	result, err := Verify(fs, testArtifactPath, testProvenancePath, root, &utils.ProvenanceConfig{
+		AllowedBuilders:    []string{"https://github.com/slsa-framework/slsa-github-generator/"},
+		AllowedSourceRepos: []string{"git+https://github.com/example/"},
+	})
+	require.NoError(t, err)
+	sum := sha256.Sum256(testArtifact)
+	assert.Equal(t, FormatDSSE, result.Format)
+	assert.Equal(t, SLSAProvenanceV1, result.PredicateType)
+	assert.Equal(t, testBuilder, result.BuilderID)
+	assert.Equal(t, testSourceRepo, result.SourceRepo)
+	assert.Equal(t, "key "+KeyID(spki), result.Signer)
+	assert.Equal(t, "sha256:"+hex.EncodeToString(sum[:]), result.ArtifactDigest)
+}
+
+func TestVerify_InTotoJSONLines(t *testing.T) {
+	key, spki := generateKey(t)
+	otherKey, _ := generateKey(t)
+	root, err := ParseTrustRoot(publicKeyPEM(spki))
+	require.NoError(t, err)
+
+	payload := slsaStatement(t, testArtifact, testBuilder, testSourceRepo)
+	lines := append(marshal(t, signEnvelope(t, otherKey, "", payload)), '\n')
+	lines = append(lines, marshal(t, signEnvelope(t, key, KeyID(spki), payload))...)
+	fs := writeTestFiles(t, lines)
+
+	result, err := Verify(fs, testArtifactPath, testProvenancePath, root, nil)
+	require.NoError(t, err)
+	assert.Equal(t, FormatInTotoJSONL, result.Format)
+	assert.Equal(t, testBuilder, result.BuilderID)
+}
+
+func TestVerify_SigstoreBundleWithCertificate(t *testing.T) {
+	key, _ := generateKey(t)
+	ca, leaf := codeSigningChain(t, key)
+	root, err := ParseTrustRoot(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}))
+	require.NoError(t, err)
+
+	env := signEnvelope(t, key, "", slsaStatement(t, testArtifact, testBuilder, testSourceRepo))
+	b := map[string]any{
+		"mediaType": BundleMediaTypePrefix + ".v0.3+json",
+		"verificationMaterial": map[string]any{
+			"certificate": map[string]string{"rawBytes": base64.StdEncoding.EncodeToString(leaf.Raw)},
+		},
+		"dsseEnvelope": env,
+	}
+	fs := writeTestFiles(t, marshal(t, b))
+
+	result, err := Verify(fs, testArtifactPath, testProvenancePath, root,
+		&utils.ProvenanceConfig{AllowedSigners: []utils.ProvenanceSigner{{SAN: testSignerSAN, OIDCIssuer: testSignerIssuer}}})
+	require.NoError(t, err)
+	assert.Equal(t, FormatSigstoreBundle, result.Format)
+	assert.Equal(t, testSignerSAN, result.Signer)
+	assert.Equal(t, testSignerIssuer, result.SignerIssuer)
+}
+
+func TestVerify_AllowedSigners(t *testing.T) {
+	key, _ := generateKey(t)
+	ca, leaf := codeSigningChain(t, key)
+	root, err := ParseTrustRoot(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}))
+	require.NoError(t, err)
+
+	env := signEnvelope(t, key, "", slsaStatement(t, testArtifact, testBuilder, testSourceRepo))
+	b := map[string]any{
+		"mediaType": BundleMediaTypePrefix + ".v0.3+json",
+		"verificationMaterial": map[string]any{
+			"certificate": map[string]string{"rawBytes": base64.StdEncoding.EncodeToString(leaf.Raw)},
+		},
+		"dsseEnvelope": env,
+	}
+
+	tests := []struct {
+		name    string
+		signers []utils.ProvenanceSigner
+		wantErr string
+	}{
+		{
+			name:    "allowed signer",
+			signers: []utils.ProvenanceSigner{{SAN: testSignerSAN, OIDCIssuer: testSignerIssuer}},
+		},
+		{
+			name: "allowed signer and builder",
+			signers: []utils.ProvenanceSigner{{SAN: testSignerSAN, OIDCIssuer: testSignerIssuer,
+				Builders: []string{"https://github.com/slsa-framework/"}}},
+		},
+		{
+			name:    "no allowed signers",
+			wantErr: "require allowed_signers",
+		},
+		{
+			name:    "other issuer",
+			signers: []utils.ProvenanceSigner{{SAN: testSignerSAN, OIDCIssuer: "https://accounts.google.com"}},
+			wantErr: "is not allowed",
+		},
+		{
+			name:    "other SAN",
+			signers: []utils.ProvenanceSigner{{SAN: "release@example.com", OIDCIssuer: testSignerIssuer}},
+			wantErr: "is not allowed",
+		},
+		{
+			name: "builder not bound to the signer",
+			signers: []utils.ProvenanceSigner{{SAN: testSignerSAN, OIDCIssuer: testSignerIssuer,
+				Builders: []string{"https://builder.example.com/"}}},
+			wantErr: "may not attest to builder",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(writeTestFiles(t, marshal(t, b)), testArtifactPath, testProvenancePath, root,
+				&utils.ProvenanceConfig{AllowedSigners: tt.signers})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+	// Without a policy, certificate signatures are rejected as well.
+	_, err = Verify(writeTestFiles(t, marshal(t, b)), testArtifactPath, testProvenancePath, root, nil)
+	assert.ErrorContains(t, err, "require allowed_signers")
+}
+
+// tlogEntryFor returns a transparency log entry about the leaf certificate, integrated at
+// integratedTime and signed by logKey.
+func tlogEntryFor(t *testing.T, logKey *ecdsa.PrivateKey, logSPKI []byte, leaf *x509.Certificate, integratedTime time.Time) map[string]any {
+	t.Helper()
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})
+	body := base64.StdEncoding.EncodeToString(marshal(t, map[string]any{
+		"kind": "dsse",
+		"spec": map[string]any{"signatures": []any{map[string]string{"verifier": base64.StdEncoding.EncodeToString(certificate)}}},
+	}))
+	logID := sha256.Sum256(logSPKI)
+	payload := marshal(t, map[string]any{
+		"body":           body,
+		"integratedTime": integratedTime.Unix(),
+		"logID":          hex.EncodeToString(logID[:]),
+		"logIndex":       42,
+	})
+	digest := sha256.Sum256(payload)
+	set, err := ecdsa.SignASN1(rand.Reader, logKey, digest[:])
+	require.NoError(t, err)
+	return map[string]any{
+		"logIndex":          "42",
+		"logId":             map[string]string{"keyId": base64.StdEncoding.EncodeToString(logID[:])},
+		"integratedTime":    strconv.FormatInt(integratedTime.Unix(), 10),
+		"inclusionPromise":  map[string]string{"signedEntryTimestamp": base64.StdEncoding.EncodeToString(set)},
+		"canonicalizedBody": body,
+	}
+}
+
+func TestVerify_CertificateSigningTime(t *testing.T) {
+	key, _ := generateKey(t)
+	logKey, logSPKI := generateKey(t)
+	otherLogKey, _ := generateKey(t)
+	issued := time.Now().Add(-2 * time.Hour)
+	ca, leaf := codeSigningChainAt(t, key, issued)
+	root, err := ParseTrustRoot(append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}), publicKeyPEM(logSPKI)...))
+	require.NoError(t, err)
+	policy := &utils.ProvenanceConfig{AllowedSigners: []utils.ProvenanceSigner{{SAN: testSignerSAN, OIDCIssuer: testSignerIssuer}}}
+	env := signEnvelope(t, key, "", slsaStatement(t, testArtifact, testBuilder, testSourceRepo))
+
+	bundleWith := func(entries ...any) []byte {
+		material := map[string]any{"certificate": map[string]string{"rawBytes": base64.StdEncoding.EncodeToString(leaf.Raw)}}
+		if len(entries) > 0 {
+			material["tlogEntries"] = entries
+		}
+		return marshal(t, map[string]any{
+			"mediaType":            BundleMediaTypePrefix + ".v0.3+json",
+			"verificationMaterial": material,
+			"dsseEnvelope":         env,
+		})
+	}
+
+	tests := []struct {
+		name       string
+		provenance []byte
+		wantErr    string
+	}{
+		{
+			name:       "expired certificate without log entry",
+			provenance: bundleWith(),
+			wantErr:    "certificate is not trusted",
+		},
+		{
+			name:       "expired certificate used while valid",
+			provenance: bundleWith(tlogEntryFor(t, logKey, logSPKI, leaf, issued.Add(time.Minute))),
+		},
+		{
+			name:       "log entry integrated after the certificate expired",
+			provenance: bundleWith(tlogEntryFor(t, logKey, logSPKI, leaf, issued.Add(time.Hour))),
+			wantErr:    "certificate is not trusted",
+		},
+		{
+			name:       "log entry signed by an untrusted key",
+			provenance: bundleWith(tlogEntryFor(t, otherLogKey, logSPKI, leaf, issued.Add(time.Minute))),
+			wantErr:    "certificate is not trusted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(writeTestFiles(t, tt.provenance), testArtifactPath, testProvenancePath, root, policy)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerify_SigstoreBundleWithMessageSignature(t *testing.T) {
+	key, spki := generateKey(t)
+	root, err := ParseTrustRoot(publicKeyPEM(spki))
+	require.NoError(t, err)
+
+	digest := sha256.Sum256(testArtifact)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	b := map[string]any{
+		"mediaType":            BundleMediaTypePrefix + "+json;version=0.2",
+		"verificationMaterial": map[string]any{"publicKey": map[string]string{"hint": KeyID(spki)}},
+		"messageSignature": map[string]any{
+			"messageDigest": map[string]string{"algorithm": "SHA2_256", "digest": base64.StdEncoding.EncodeToString(digest[:])},
+			"signature":     base64.StdEncoding.EncodeToString(sig),
+		},
+	}
+
+	result, err := Verify(writeTestFiles(t, marshal(t, b)), testArtifactPath, testProvenancePath, root, nil)
+	require.NoError(t, err)
+	assert.Equal(t, FormatSigstoreBundle, result.Format)
+	assert.Empty(t, result.BuilderID)
+
+	// A plain signature names no builder, so it does not satisfy a builder policy.
+	_, err = Verify(writeTestFiles(t, marshal(t, b)), testArtifactPath, testProvenancePath, root,
+		&utils.ProvenanceConfig{AllowedBuilders: []string{"https://github.com/"}})
+	assert.ErrorContains(t, err, "does not name a builder")
+
+	// Nor does it satisfy a policy that requires provenance.
+	_, err = Verify(writeTestFiles(t, marshal(t, b)), testArtifactPath, testProvenancePath, root,
+		&utils.ProvenanceConfig{RequiredFor: []string{PackageTypeSOTA}})
+	assert.ErrorContains(t, err, "SLSA provenance is required")
+}
+
+func TestVerify_Failures(t *testing.T) {
+	key, spki := generateKey(t)
+	untrustedKey, _ := generateKey(t)
+	root, err := ParseTrustRoot(publicKeyPEM(spki))
+	require.NoError(t, err)
+	payload := slsaStatement(t, testArtifact, testBuilder, testSourceRepo)
+
+	tests := []struct {
+		name       string
+		provenance []byte
+		policy     *utils.ProvenanceConfig
+		wantErr    string
+	}{
+		{
+			name:       "untrusted key",
+			provenance: marshal(t, signEnvelope(t, untrustedKey, "", payload)),
+			wantErr:    "does not match any trusted key",
+		},
+		{
+			name:       "other artifact",
+			provenance: marshal(t, signEnvelope(t, key, "", slsaStatement(t, []byte("other"), testBuilder, testSourceRepo))),
+			wantErr:    "does not match any subject",
+		},
+		{
+			name:       "builder not allowed",
+			provenance: marshal(t, signEnvelope(t, key, "", payload)),
+			policy:     &utils.ProvenanceConfig{AllowedBuilders: []string{"https://builder.example.com/"}},
+			wantErr:    "is not allowed",
+		},
+		{
+			name:       "source not allowed",
+			provenance: marshal(t, signEnvelope(t, key, "", payload)),
+			policy:     &utils.ProvenanceConfig{AllowedSourceRepos: []string{"git+https://github.com/other/"}},
+			wantErr:    "does not name an allowed source repository",
+		},
+		{
+			name:       "unrecognized format",
+			provenance: []byte(`{"foo": "bar"}`),
+			wantErr:    "unrecognized provenance format",
+		},
+		{
+			name: "tampered payload",
+			provenance: func() []byte {
+				env := signEnvelope(t, key, "", payload)
+				env.Payload = base64.StdEncoding.EncodeToString(slsaStatement(t, testArtifact, "https://evil.example.com", testSourceRepo))
+				return marshal(t, env)
+			}(),
+			wantErr: "does not match any trusted key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(writeTestFiles(t, tt.provenance), testArtifactPath, testProvenancePath, root, tt.policy)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
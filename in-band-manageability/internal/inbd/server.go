@@ -6,6 +6,7 @@ package inbd
 
 import (
 	"fmt"
+	"log"
 	"net"
 	"os"
 	"os/user"
@@ -14,6 +15,9 @@ import (
 	"github.com/spf13/afero"
 	"google.golang.org/grpc"
 
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/auth"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/staging"
+	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
 	osUpdater "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater"
 )
 
@@ -34,6 +38,8 @@ type ServerDeps struct {
 	GetInbcGroupID  func() (int, error)
 	Chown           func(string, int, int) error    // os.Chown
 	Chmod           func(string, os.FileMode) error // os.Chmod
+	// VerifyUpdateAfterReboot verifies the update after a reboot.  Defaults to osUpdater.VerifyUpdateAfterReboot.
+	VerifyUpdateAfterReboot func(afero.Fs) error
 }
 
 // RunServer implements the core logic of the server:
@@ -74,7 +80,11 @@ func RunServer(deps ServerDeps) error {
 
 	deps.Umask(oldUmask)
 
-	grpcServer := deps.NewGRPCServer()
+	grpcServer := deps.NewGRPCServer(
+		grpc.Creds(auth.NewTransportCredentials()),
+		grpc.ChainUnaryInterceptor(authorizationUnaryInterceptor, auditUnaryInterceptor),
+		grpc.ChainStreamInterceptor(authorizationStreamInterceptor, auditStreamInterceptor),
+	)
 	deps.RegisterService(grpcServer)
 
 	// VerifyUpdateAfterReboot verifies the update after reboot.
@@ -83,9 +93,19 @@ func RunServer(deps ServerDeps) error {
 
 	fs := afero.NewOsFs()
 
-	err = osUpdater.VerifyUpdateAfterReboot(fs)
-	if err != nil {
-		return fmt.Errorf("[Post verification failed] error verifying update after reboot: %w", err)
+	verifyUpdateAfterReboot := deps.VerifyUpdateAfterReboot
+	if verifyUpdateAfterReboot == nil {
+		verifyUpdateAfterReboot = osUpdater.VerifyUpdateAfterReboot
+	}
+	// The verifier records the outcome of the update and rolls it back if it can.  inbd keeps
+	// serving either way, so that the result can be queried and the node can still be managed.
+	if err := verifyUpdateAfterReboot(fs); err != nil {
+		log.Printf("[Post verification failed] error verifying update after reboot: %v", err)
+	}
+	// inbd can not tell whether the update partition changed while it was not running, so the
+	// staged images are written again before they are installed.
+	if err := staging.ClearWritten(fs); err != nil {
+		log.Printf("[Warning] Error clearing the written staged artifact: %v", err)
 	}
 
 	isValidConfig, err := deps.IsValidJSON(afero.Afero{Fs: fs}, schemaFilePath, configFilePath)
@@ -95,9 +115,39 @@ func RunServer(deps ServerDeps) error {
 	if !isValidConfig {
 		return fmt.Errorf("INBD Configuration file is not valid")
 	}
+
+	applyProtectedConfig(fs)
 	return deps.ServeFunc(grpcServer, lis)
 }
 
+// applyProtectedConfig loads the command allowlist and the authorization policy.  They are only
+// loaded if no one but root can write the configuration file, as a signed load is the only other
+// way to change them.
+func applyProtectedConfig(fs afero.Fs) {
+	// If the authorization policy can not be loaded, only root is allowed.
+	onlyRoot := func() {
+		policy, _ := auth.NewPolicy(&auth.Config{})
+		auth.SetPolicy(policy)
+	}
+
+	if err := utils.CheckRootOnly(fs, configFilePath); err != nil {
+		log.Printf("[Warning] Not loading the command allowlist and the authorization policy, only root is allowed: %v", err)
+		onlyRoot()
+		return
+	}
+
+	// Commands in the configured allowlist are only available once the configuration is loaded.
+	// Built-in commands keep working if it can not be loaded.
+	if err := utils.ApplyCommandAllowlist(fs, configFilePath); err != nil {
+		log.Printf("[Warning] Error loading command allowlist: %v", err)
+	}
+
+	if err := utils.ApplyAuthorization(fs, configFilePath); err != nil {
+		log.Printf("[Warning] Error loading authorization policy, only root is allowed: %v", err)
+		onlyRoot()
+	}
+}
+
 // GetInbcGroupID retrieves the GID of the 'inbc' group.
 func GetInbcGroupID() (int, error) {
 	grp, err := user.LookupGroup("inbc")
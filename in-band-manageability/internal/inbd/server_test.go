@@ -13,29 +13,51 @@ import (
 	"testing"
 	"time"
 
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/power"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/staging"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
 	"github.com/spf13/afero"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MockPowerManager implements PowerManager for fast testing without delays
 type MockPowerManager struct {
-	RebootError    error
-	ShutdownError  error
-	RebootCalled   bool
-	ShutdownCalled bool
+	RebootError      error
+	ShutdownError    error
+	RebootCalled     bool
+	ShutdownCalled   bool
+	SuspendCalled    bool
+	HibernateCalled  bool
+	SoftRebootCalled bool
 }
 
-func (m *MockPowerManager) Reboot() error {
+func (m *MockPowerManager) Reboot(_ context.Context) error {
 	m.RebootCalled = true
 	return m.RebootError
 }
 
-func (m *MockPowerManager) Shutdown() error {
+func (m *MockPowerManager) Shutdown(_ context.Context) error {
 	m.ShutdownCalled = true
 	return m.ShutdownError
 }
 
+func (m *MockPowerManager) Suspend(_ context.Context) error {
+	m.SuspendCalled = true
+	return nil
+}
+
+func (m *MockPowerManager) Hibernate(_ context.Context) error {
+	m.HibernateCalled = true
+	return nil
+}
+
+func (m *MockPowerManager) SoftReboot(_ context.Context) error {
+	m.SoftRebootCalled = true
+	return nil
+}
+
 // dummyFileInfo implements os.FileInfo for testing.
 type dummyFileInfo struct{}
 
@@ -130,6 +152,54 @@ func TestRunServer_Success(t *testing.T) {
 	}
 }
 
+// TestRunServer_PostVerificationFails verifies that a failed post-reboot verification is left to
+// the verifier and the server still serves.
+func TestRunServer_PostVerificationFails(t *testing.T) {
+	served := false
+	deps := ServerDeps{
+		Socket: "dummy.sock",
+		Stat: func(name string) (os.FileInfo, error) {
+			return nil, os.ErrNotExist
+		},
+		Remove: os.Remove,
+		NetListen: func(network, address string) (net.Listener, error) {
+			return &fakeListener{}, nil
+		},
+		Umask: func(mask int) int { return 0 },
+		NewGRPCServer: func(opts ...grpc.ServerOption) *grpc.Server {
+			return grpc.NewServer(opts...)
+		},
+		RegisterService: func(gs *grpc.Server) {},
+		ServeFunc: func(gs *grpc.Server, lis net.Listener) error {
+			served = true
+			return nil
+		},
+		IsValidJSON: func(afero.Afero, string, string) (bool, error) {
+			return true, nil
+		},
+		GetInbcGroupID: func() (int, error) {
+			return 1000, nil
+		},
+		Chown: func(path string, uid, gid int) error {
+			return nil
+		},
+		Chmod: func(path string, mode os.FileMode) error {
+			return nil
+		},
+		VerifyUpdateAfterReboot: func(afero.Fs) error {
+			return errors.New("no network connection detected after the update")
+		},
+	}
+
+	err := RunServer(deps)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !served {
+		t.Errorf("Server should serve after a failed post-reboot verification")
+	}
+}
+
 func TestRunServer_ConfigValidationFails(t *testing.T) {
 	fl := &fakeListener{}
 
@@ -590,6 +660,145 @@ func TestSetPowerState_OffActionError(t *testing.T) {
 	}
 }
 
+// stubPowerCapabilities replaces the detected power capabilities for a test.
+func stubPowerCapabilities(t *testing.T, capabilities *pb.PowerCapabilitiesInfo) {
+	t.Helper()
+	original := powerCapabilities
+	t.Cleanup(func() { powerCapabilities = original })
+	powerCapabilities = func() (*pb.PowerCapabilitiesInfo, error) { return capabilities, nil }
+}
+
+// TestSetPowerState_SystemctlActions tests the suspend, hibernate and soft reboot actions
+func TestSetPowerState_SystemctlActions(t *testing.T) {
+	stubPowerCapabilities(t, &pb.PowerCapabilitiesInfo{Shutdown: true, Reboot: true, Suspend: true})
+	mockPowerManager := &MockPowerManager{}
+	server := NewInbdServerWithPowerManager(mockPowerManager)
+	ctx := context.Background()
+
+	resp, _ := server.SetPowerState(ctx, &pb.SetPowerStateRequest{Action: pb.SetPowerStateRequest_POWER_ACTION_SUSPEND})
+	if resp.StatusCode != 200 || !mockPowerManager.SuspendCalled {
+		t.Errorf("Expected suspend to succeed, got %d-%s", resp.StatusCode, resp.Error)
+	}
+
+	resp, _ = server.SetPowerState(ctx, &pb.SetPowerStateRequest{Action: pb.SetPowerStateRequest_POWER_ACTION_SOFT_REBOOT})
+	if resp.StatusCode != 200 || !mockPowerManager.SoftRebootCalled {
+		t.Errorf("Expected soft reboot to succeed, got %d-%s", resp.StatusCode, resp.Error)
+	}
+
+	resp, _ = server.SetPowerState(ctx, &pb.SetPowerStateRequest{Action: pb.SetPowerStateRequest_POWER_ACTION_HIBERNATE})
+	if resp.StatusCode != 400 || resp.Error != "hibernate is not supported on this device" {
+		t.Errorf("Expected hibernate to be rejected, got %d-%s", resp.StatusCode, resp.Error)
+	}
+	if mockPowerManager.HibernateCalled {
+		t.Errorf("Expected Hibernate not to be called")
+	}
+}
+
+// TestSetPowerState_VetoedDuringUpdate tests that an immediate action is rejected while the firmware is updated
+func TestSetPowerState_VetoedDuringUpdate(t *testing.T) {
+	mockPowerManager := &MockPowerManager{}
+	server := NewInbdServerWithPowerManager(mockPowerManager)
+
+	end := power.BeginRPC("/inbd.v1.InbService/UpdateFirmware")
+	defer end()
+
+	resp, err := server.SetPowerState(context.Background(), &pb.SetPowerStateRequest{Action: pb.SetPowerStateRequest_POWER_ACTION_CYCLE})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.StatusCode != 409 {
+		t.Errorf("Expected status code 409, got %d", resp.StatusCode)
+	}
+	if resp.Error != "power action vetoed: UpdateFirmware is in progress" {
+		t.Errorf("Unexpected error %s", resp.Error)
+	}
+	if mockPowerManager.RebootCalled {
+		t.Errorf("Expected Reboot not to be called")
+	}
+}
+
+// TestSetPowerState_ScheduleQueryAndCancel tests scheduling, listing and canceling a power action
+func TestSetPowerState_ScheduleQueryAndCancel(t *testing.T) {
+	stubPowerCapabilities(t, &pb.PowerCapabilitiesInfo{Shutdown: true, Reboot: true})
+	mockPowerManager := &MockPowerManager{}
+	server := NewInbdServerWithPowerManager(mockPowerManager)
+	ctx := context.Background()
+
+	resp, err := server.SetPowerState(ctx, &pb.SetPowerStateRequest{
+		Action:       pb.SetPowerStateRequest_POWER_ACTION_OFF,
+		DelaySeconds: 3600,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.StatusCode != 200 || resp.ActionId == "" {
+		t.Fatalf("Expected the action to be scheduled, got %d-%s", resp.StatusCode, resp.Error)
+	}
+	if delay := time.Until(resp.ScheduledTime.AsTime()); delay < 59*time.Minute || delay > time.Hour {
+		t.Errorf("Expected the action to be scheduled in an hour, got %v", delay)
+	}
+	if mockPowerManager.ShutdownCalled {
+		t.Errorf("Expected Shutdown not to be called yet")
+	}
+
+	queryResp, _ := server.Query(ctx, &pb.QueryRequest{Option: pb.QueryOption_QUERY_OPTION_POWER})
+	if queryResp.StatusCode != 200 {
+		t.Fatalf("Expected query to succeed, got %d-%s", queryResp.StatusCode, queryResp.Error)
+	}
+	pending := queryResp.Data.GetPower().GetPendingActions()
+	if len(pending) != 1 || pending[0].ActionId != resp.ActionId || pending[0].Action != pb.SetPowerStateRequest_POWER_ACTION_OFF {
+		t.Errorf("Unexpected pending actions %v", pending)
+	}
+	if !queryResp.Data.GetPower().GetCapabilities().Reboot {
+		t.Errorf("Expected the power capabilities in the query response")
+	}
+
+	cancelResp, _ := server.CancelPowerAction(ctx, &pb.CancelPowerActionRequest{ActionId: resp.ActionId})
+	if cancelResp.StatusCode != 200 || len(cancelResp.Canceled) != 1 {
+		t.Errorf("Expected the action to be canceled, got %d-%s", cancelResp.StatusCode, cancelResp.Error)
+	}
+	cancelResp, _ = server.CancelPowerAction(ctx, &pb.CancelPowerActionRequest{ActionId: resp.ActionId})
+	if cancelResp.StatusCode != 404 {
+		t.Errorf("Expected status code 404, got %d", cancelResp.StatusCode)
+	}
+}
+
+// TestPowerActionTime tests the validation of the delay and the scheduled time of a power action
+func TestPowerActionTime(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		req     *pb.SetPowerStateRequest
+		want    time.Time
+		wantErr string
+	}{
+		{"immediate", &pb.SetPowerStateRequest{}, time.Time{}, ""},
+		{"delay", &pb.SetPowerStateRequest{DelaySeconds: 600}, now.Add(10 * time.Minute), ""},
+		{"scheduled", &pb.SetPowerStateRequest{ScheduledTime: timestamppb.New(now.Add(time.Hour))}, now.Add(time.Hour), ""},
+		{"both", &pb.SetPowerStateRequest{DelaySeconds: 600, ScheduledTime: timestamppb.New(now.Add(time.Hour))}, time.Time{}, "delay_seconds and scheduled_time can not be combined"},
+		{"delay too long", &pb.SetPowerStateRequest{DelaySeconds: 31 * 24 * 3600}, time.Time{}, "delay_seconds must be at most 2592000"},
+		{"in the past", &pb.SetPowerStateRequest{ScheduledTime: timestamppb.New(now.Add(-time.Minute))}, time.Time{}, "scheduled_time must be in the future"},
+		{"too far", &pb.SetPowerStateRequest{ScheduledTime: timestamppb.New(now.Add(31 * 24 * time.Hour))}, time.Time{}, "scheduled_time must be at most 30 days in the future"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := powerActionTime(tt.req, now)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("powerActionTime() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("powerActionTime() returned unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("powerActionTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // TestUpdateFirmware_EmptyURL tests UpdateFirmware with empty URL
 func TestUpdateFirmware_EmptyURL(t *testing.T) {
 	server := &InbdServer{}
@@ -709,3 +918,88 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+// stubStagingFs replaces the file system of the staged artifact registry for a test.
+func stubStagingFs(t *testing.T) afero.Fs {
+	t.Helper()
+	original := stagingFs
+	t.Cleanup(func() { stagingFs = original })
+	stagingFs = afero.NewMemMapFs()
+	return stagingFs
+}
+
+// TestStagedArtifacts tests listing and deleting staged artifacts
+func TestStagedArtifacts(t *testing.T) {
+	fs := stubStagingFs(t)
+	server := &InbdServer{}
+	ctx := context.Background()
+
+	imagePath := utils.SOTADownloadDir + "/emt.raw.gz"
+	if err := afero.WriteFile(fs, imagePath, []byte("image"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	artifact, err := staging.Stage(fs, "https://example.com/emt.raw.gz", imagePath, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listResp, _ := server.ListStagedArtifacts(ctx, &pb.ListStagedArtifactsRequest{})
+	if listResp.StatusCode != 200 || len(listResp.Artifacts) != 1 {
+		t.Fatalf("Expected one staged artifact, got %d-%s %v", listResp.StatusCode, listResp.Error, listResp.Artifacts)
+	}
+	if got := listResp.Artifacts[0]; got.Id != artifact.ID || got.Url != artifact.URL || got.SizeBytes != 5 || !got.Written {
+		t.Errorf("Unexpected staged artifact %v", got)
+	}
+
+	deleteResp, _ := server.DeleteStagedArtifact(ctx, &pb.DeleteStagedArtifactRequest{Id: artifact.ID})
+	if deleteResp.StatusCode != 200 {
+		t.Errorf("Expected delete to succeed, got %d-%s", deleteResp.StatusCode, deleteResp.Error)
+	}
+	deleteResp, _ = server.DeleteStagedArtifact(ctx, &pb.DeleteStagedArtifactRequest{Id: artifact.ID})
+	if deleteResp.StatusCode != 404 {
+		t.Errorf("Expected status code 404, got %d-%s", deleteResp.StatusCode, deleteResp.Error)
+	}
+	deleteResp, _ = server.DeleteStagedArtifact(ctx, &pb.DeleteStagedArtifactRequest{})
+	if deleteResp.StatusCode != 400 {
+		t.Errorf("Expected status code 400, got %d-%s", deleteResp.StatusCode, deleteResp.Error)
+	}
+}
+
+// TestValidateStagedInstall tests the checks of a request that installs a staged artifact
+func TestValidateStagedInstall(t *testing.T) {
+	fs := stubStagingFs(t)
+	imagePath := utils.SOTADownloadDir + "/emt.raw.gz"
+	if err := afero.WriteFile(fs, imagePath, []byte("image"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	artifact, err := staging.Stage(fs, "https://example.com/emt.raw.gz", imagePath, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	noDownload := pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_NO_DOWNLOAD
+	tests := []struct {
+		name         string
+		os           string
+		req          *pb.UpdateSystemSoftwareRequest
+		expectedCode int32
+	}{
+		{"valid", "EMT", &pb.UpdateSystemSoftwareRequest{Mode: noDownload, StagedArtifactId: artifact.ID}, 0},
+		{"not EMT", "Ubuntu", &pb.UpdateSystemSoftwareRequest{Mode: noDownload, StagedArtifactId: artifact.ID}, 415},
+		{"full mode", "EMT", &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL, StagedArtifactId: artifact.ID}, 400},
+		{"with URL", "EMT", &pb.UpdateSystemSoftwareRequest{Mode: noDownload, Url: "https://example.com/emt.raw.gz", StagedArtifactId: artifact.ID}, 400},
+		{"unknown ID", "EMT", &pb.UpdateSystemSoftwareRequest{Mode: noDownload, StagedArtifactId: "missing"}, 404},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := validateStagedInstall(tt.os, tt.req)
+			if code != tt.expectedCode {
+				t.Errorf("Expected status code %d, got %d (%v)", tt.expectedCode, code, err)
+			}
+			if (err == nil) != (tt.expectedCode == 0) {
+				t.Errorf("Unexpected error %v", err)
+			}
+		})
+	}
+}
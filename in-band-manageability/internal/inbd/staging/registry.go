@@ -0,0 +1,366 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package staging keeps the registry of the update images that were downloaded with
+// DOWNLOAD_MODE_DOWNLOAD_ONLY and not yet installed.
+//
+// A staged image is moved out of the SOTA download directory, which is emptied after every
+// update, into a directory of its own, and is recorded with the URL it came from, its SHA-256,
+// its size and the result of its signature check.  A later install references the image by ID
+// instead of repeating the URL.
+package staging
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+	"golang.org/x/sys/unix"
+)
+
+// RegistryPath is the file holding the staged images.
+const RegistryPath = "/var/intel-manageability/staged_artifacts.json"
+
+// Dir is the directory the staged images are kept in, one subdirectory per image.
+const Dir = utils.IntelManageabilityCachePathPrefix + "/staged"
+
+// Signature status of a staged image.
+const (
+	// SignatureVerified means the SHA-256 of the image matches the signature of the request.
+	SignatureVerified = "verified"
+	// SignatureFailed means the image no longer matches the SHA-256 it was staged with.
+	SignatureFailed = "failed"
+)
+
+// Retention limits of the staging directory.  When an image is staged beyond them, the oldest
+// images that are not written to the update partition are deleted.  They are variables for
+// testing.
+var (
+	// MaxArtifacts is the number of images that are kept.
+	MaxArtifacts = 3
+	// MaxBytes is the total size of the images that are kept.
+	MaxBytes int64 = 10 << 30
+)
+
+// freeDiskSpace returns the free space of the file system of a path.  It is a variable for
+// testing.
+var freeDiskSpace = func(path string) (uint64, error) {
+	return utils.GetFreeDiskSpaceInBytes(path, unix.Statfs)
+}
+
+// ErrNotFound is returned for an ID that is not in the registry.
+var ErrNotFound = errors.New("staged artifact not found")
+
+// Artifact is a staged update image.
+type Artifact struct {
+	ID              string    `json:"id"`
+	URL             string    `json:"url"`
+	FileName        string    `json:"file_name"`
+	SHA256          string    `json:"sha256"`
+	SizeBytes       int64     `json:"size_bytes"`
+	SignatureStatus string    `json:"signature_status"`
+	StagedTime      time.Time `json:"staged_time"`
+	// Written is set for the image that was last written to the update partition.  Only one
+	// image can be written at a time; the others are written again before they are installed.
+	// It is cleared whenever the update partition may have changed since, see ClearWritten.
+	Written bool `json:"written"`
+}
+
+// Path returns the path of the staged image.
+func (a *Artifact) Path() string {
+	return filepath.Join(Dir, a.ID, a.FileName)
+}
+
+var registryMutex sync.Mutex
+
+// Stage moves a downloaded image into the staging directory and records it.  signature is the
+// SHA-256 the image was verified against; written tells whether the image was written to the
+// update partition.
+func Stage(fs afero.Fs, url, imagePath, signature string, written bool) (*Artifact, error) {
+	digest, size, err := hashFile(fs, imagePath)
+	if err != nil {
+		return nil, err
+	}
+	status := SignatureVerified
+	if !strings.EqualFold(digest, signature) {
+		status = SignatureFailed
+	}
+	id, err := newArtifactID()
+	if err != nil {
+		return nil, err
+	}
+	artifact := Artifact{
+		ID:              id,
+		URL:             url,
+		FileName:        path.Base(imagePath),
+		SHA256:          digest,
+		SizeBytes:       size,
+		SignatureStatus: status,
+		StagedTime:      time.Now().UTC(),
+		Written:         written,
+	}
+
+	if err := fs.MkdirAll(filepath.Join(Dir, id), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	// A staged image keeps the disk space of its download, so there must be room left for the
+	// next download of an image of the same size.
+	if free, err := freeDiskSpace(Dir); err != nil {
+		log.Printf("[Warning] Could not determine the free disk space of %s, staging anyway: %v", Dir, err)
+	} else if free < uint64(size) {
+		if removeErr := fs.RemoveAll(filepath.Join(Dir, id)); removeErr != nil {
+			log.Printf("[Warning] Error removing %s: %v", filepath.Join(Dir, id), removeErr)
+		}
+		return nil, fmt.Errorf("insufficient disk space to stage %s: %d bytes free, %d bytes required", artifact.FileName, free, size)
+	}
+	if err := fs.Rename(imagePath, artifact.Path()); err != nil {
+		return nil, fmt.Errorf("failed to move image to the staging directory: %w", err)
+	}
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	artifacts, err := readRegistry(fs)
+	if err != nil {
+		// Start over rather than losing the image that was just staged.
+		log.Printf("[Warning] %v; starting a new registry", err)
+		artifacts = nil
+	}
+	if written {
+		for i := range artifacts {
+			artifacts[i].Written = false
+		}
+	}
+	artifacts = append(artifacts, artifact)
+	artifacts = evict(fs, artifacts, id)
+	if err := writeRegistry(fs, artifacts); err != nil {
+		return nil, err
+	}
+	log.Printf("Staged %s as %s (%d bytes, signature %s)", artifact.FileName, id, size, status)
+	return &artifact, nil
+}
+
+// List returns the staged images, oldest first.
+func List(fs afero.Fs) ([]Artifact, error) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	return readRegistry(fs)
+}
+
+// Get returns a staged image.
+func Get(fs afero.Fs, id string) (*Artifact, error) {
+	artifacts, err := List(fs)
+	if err != nil {
+		return nil, err
+	}
+	for _, artifact := range artifacts {
+		if artifact.ID == id {
+			return &artifact, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+}
+
+// Verify checks that a staged image still has the SHA-256 it was staged with, and records the
+// result as its signature status.
+func Verify(fs afero.Fs, id string) (*Artifact, error) {
+	artifact, err := Get(fs, id)
+	if err != nil {
+		return nil, err
+	}
+	digest, _, hashErr := hashFile(fs, artifact.Path())
+	status := SignatureVerified
+	if hashErr != nil || digest != artifact.SHA256 {
+		status = SignatureFailed
+	}
+	if err := update(fs, id, func(a *Artifact) { a.SignatureStatus = status }); err != nil {
+		return nil, err
+	}
+	artifact.SignatureStatus = status
+	if hashErr != nil {
+		return artifact, hashErr
+	}
+	if status != SignatureVerified {
+		return artifact, fmt.Errorf("staged image %s does not match its SHA-256: expected %s, got %s", id, artifact.SHA256, digest)
+	}
+	return artifact, nil
+}
+
+// MarkWritten records that a staged image was written to the update partition, replacing the
+// image that was written before.
+func MarkWritten(fs afero.Fs, id string) error {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	artifacts, err := readRegistry(fs)
+	if err != nil {
+		return err
+	}
+	found := false
+	for i := range artifacts {
+		artifacts[i].Written = artifacts[i].ID == id
+		found = found || artifacts[i].Written
+	}
+	if !found {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return writeRegistry(fs, artifacts)
+}
+
+// ClearWritten records that none of the staged images is known to be on the update partition,
+// so that the image is written again before it is installed.  It is called whenever the update
+// partition is applied, committed or may otherwise have changed.
+func ClearWritten(fs afero.Fs) error {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	artifacts, err := readRegistry(fs)
+	if err != nil {
+		return err
+	}
+	changed := false
+	for i := range artifacts {
+		changed = changed || artifacts[i].Written
+		artifacts[i].Written = false
+	}
+	if !changed {
+		return nil
+	}
+	return writeRegistry(fs, artifacts)
+}
+
+// Delete removes a staged image and its registry entry.
+func Delete(fs afero.Fs, id string) error {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	artifacts, err := readRegistry(fs)
+	if err != nil {
+		return err
+	}
+	index := -1
+	for i, artifact := range artifacts {
+		if artifact.ID == id {
+			index = i
+		}
+	}
+	if index < 0 {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if err := fs.RemoveAll(filepath.Join(Dir, id)); err != nil {
+		return fmt.Errorf("failed to remove staged image: %w", err)
+	}
+	artifacts = append(artifacts[:index], artifacts[index+1:]...)
+	log.Printf("Deleted staged image %s", id)
+	return writeRegistry(fs, artifacts)
+}
+
+// evict deletes the oldest images that are not written to the update partition, other than the
+// image with ID keep, until the images are within the retention limits, and returns the images
+// that are left.
+func evict(fs afero.Fs, artifacts []Artifact, keep string) []Artifact {
+	var total int64
+	for _, artifact := range artifacts {
+		total += artifact.SizeBytes
+	}
+	count := len(artifacts)
+	kept := make([]Artifact, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		over := count > MaxArtifacts || total > MaxBytes
+		if !over || artifact.Written || artifact.ID == keep {
+			kept = append(kept, artifact)
+			continue
+		}
+		if err := fs.RemoveAll(filepath.Join(Dir, artifact.ID)); err != nil {
+			log.Printf("[Warning] Error removing staged image %s: %v", artifact.ID, err)
+			kept = append(kept, artifact)
+			continue
+		}
+		count--
+		total -= artifact.SizeBytes
+		log.Printf("Deleted staged image %s to stay within the staging limits", artifact.ID)
+	}
+	return kept
+}
+
+func update(fs afero.Fs, id string, change func(*Artifact)) error {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	artifacts, err := readRegistry(fs)
+	if err != nil {
+		return err
+	}
+	for i := range artifacts {
+		if artifacts[i].ID == id {
+			change(&artifacts[i])
+			return writeRegistry(fs, artifacts)
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrNotFound, id)
+}
+
+func readRegistry(fs afero.Fs) ([]Artifact, error) {
+	data, err := afero.ReadFile(fs, RegistryPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Artifact{}, nil
+		}
+		return nil, fmt.Errorf("failed to read staged artifacts: %w", err)
+	}
+	var artifacts []Artifact
+	if err := json.Unmarshal(data, &artifacts); err != nil {
+		return nil, fmt.Errorf("failed to parse staged artifacts: %w", err)
+	}
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		return artifacts[i].StagedTime.Before(artifacts[j].StagedTime)
+	})
+	return artifacts, nil
+}
+
+func writeRegistry(fs afero.Fs, artifacts []Artifact) error {
+	data, err := json.MarshalIndent(artifacts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize staged artifacts: %w", err)
+	}
+	if err := fs.MkdirAll(path.Dir(RegistryPath), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", path.Dir(RegistryPath), err)
+	}
+	if err := utils.WriteFile(fs, RegistryPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write staged artifacts: %w", err)
+	}
+	return nil
+}
+
+func hashFile(fs afero.Fs, filePath string) (string, int64, error) {
+	file, err := fs.Open(filePath)
+	if err != nil {
+		return "", 0, fmt.Errorf("error opening image: %w", err)
+	}
+	defer file.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return "", 0, fmt.Errorf("error reading image: %w", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+func newArtifactID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate staged artifact ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
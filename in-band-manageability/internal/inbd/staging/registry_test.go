@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package staging
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	freeDiskSpace = func(string) (uint64, error) { return 1 << 40, nil }
+	os.Exit(m.Run())
+}
+
+func downloadImage(t *testing.T, fs afero.Fs, name, content string) (string, string) {
+	t.Helper()
+	imagePath := utils.SOTADownloadDir + "/" + name
+	require.NoError(t, afero.WriteFile(fs, imagePath, []byte(content), 0644))
+	sum := sha256.Sum256([]byte(content))
+	return imagePath, hex.EncodeToString(sum[:])
+}
+
+func TestStage(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	imagePath, digest := downloadImage(t, fs, "emt.raw.gz", "image")
+
+	artifact, err := Stage(fs, "https://example.com/emt.raw.gz", imagePath, digest, true)
+	require.NoError(t, err)
+	assert.Len(t, artifact.ID, 16)
+	assert.Equal(t, "emt.raw.gz", artifact.FileName)
+	assert.Equal(t, digest, artifact.SHA256)
+	assert.Equal(t, int64(5), artifact.SizeBytes)
+	assert.Equal(t, SignatureVerified, artifact.SignatureStatus)
+	assert.True(t, artifact.Written)
+
+	exists, err := afero.Exists(fs, imagePath)
+	require.NoError(t, err)
+	assert.False(t, exists, "the image is moved out of the download directory")
+	content, err := afero.ReadFile(fs, artifact.Path())
+	require.NoError(t, err)
+	assert.Equal(t, "image", string(content))
+
+	artifacts, err := List(fs)
+	require.NoError(t, err)
+	assert.Equal(t, []Artifact{*artifact}, artifacts)
+}
+
+func TestStageSignatureMismatch(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	imagePath, _ := downloadImage(t, fs, "emt.raw.gz", "image")
+
+	artifact, err := Stage(fs, "https://example.com/emt.raw.gz", imagePath, "0000", false)
+	require.NoError(t, err)
+	assert.Equal(t, SignatureFailed, artifact.SignatureStatus)
+}
+
+func TestStageMissingImage(t *testing.T) {
+	_, err := Stage(afero.NewMemMapFs(), "https://example.com/emt.raw.gz", utils.SOTADownloadDir+"/missing", "", true)
+	assert.ErrorContains(t, err, "error opening image")
+}
+
+func TestStageInsufficientDiskSpace(t *testing.T) {
+	defer func(f func(string) (uint64, error)) { freeDiskSpace = f }(freeDiskSpace)
+	freeDiskSpace = func(string) (uint64, error) { return 4, nil }
+	fs := afero.NewMemMapFs()
+	imagePath, digest := downloadImage(t, fs, "emt.raw.gz", "image")
+
+	_, err := Stage(fs, "https://example.com/emt.raw.gz", imagePath, digest, true)
+	assert.ErrorContains(t, err, "insufficient disk space to stage emt.raw.gz")
+	artifacts, err := List(fs)
+	require.NoError(t, err)
+	assert.Empty(t, artifacts)
+	entries, err := afero.ReadDir(fs, Dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "the staging directory of the image is removed")
+}
+
+func TestStageRetention(t *testing.T) {
+	defer func(count int, size int64) { MaxArtifacts, MaxBytes = count, size }(MaxArtifacts, MaxBytes)
+	stage := func(t *testing.T, fs afero.Fs, name string, written bool) *Artifact {
+		imagePath, digest := downloadImage(t, fs, name, name)
+		artifact, err := Stage(fs, "https://example.com/"+name, imagePath, digest, written)
+		require.NoError(t, err)
+		return artifact
+	}
+	ids := func(t *testing.T, fs afero.Fs) []string {
+		artifacts, err := List(fs)
+		require.NoError(t, err)
+		var ids []string
+		for _, artifact := range artifacts {
+			ids = append(ids, artifact.ID)
+		}
+		return ids
+	}
+
+	t.Run("count", func(t *testing.T) {
+		MaxArtifacts, MaxBytes = 2, 1<<20
+		fs := afero.NewMemMapFs()
+		written := stage(t, fs, "first", true)
+		second := stage(t, fs, "second", false)
+		third := stage(t, fs, "third", false)
+		fourth := stage(t, fs, "fourth", false)
+
+		assert.Equal(t, []string{written.ID, fourth.ID}, ids(t, fs), "the oldest images that are not written are deleted")
+		for _, evicted := range []*Artifact{second, third} {
+			exists, err := afero.Exists(fs, evicted.Path())
+			require.NoError(t, err)
+			assert.False(t, exists)
+		}
+	})
+
+	t.Run("size", func(t *testing.T) {
+		MaxArtifacts, MaxBytes = 10, 10
+		fs := afero.NewMemMapFs()
+		first := stage(t, fs, "first", false)
+		second := stage(t, fs, "second", false)
+		assert.Equal(t, []string{second.ID}, ids(t, fs))
+		assert.NotContains(t, ids(t, fs), first.ID)
+
+		third := stage(t, fs, "third-is-larger", false)
+		assert.Equal(t, []string{third.ID}, ids(t, fs), "the new image is kept even if it is over the limit")
+	})
+}
+
+func TestMarkWritten(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	firstPath, firstDigest := downloadImage(t, fs, "first.raw.gz", "first")
+	first, err := Stage(fs, "https://example.com/first.raw.gz", firstPath, firstDigest, true)
+	require.NoError(t, err)
+	secondPath, secondDigest := downloadImage(t, fs, "second.raw.gz", "second")
+	second, err := Stage(fs, "https://example.com/second.raw.gz", secondPath, secondDigest, true)
+	require.NoError(t, err)
+
+	got, err := Get(fs, first.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Written, "writing an image replaces the one written before")
+
+	require.NoError(t, MarkWritten(fs, first.ID))
+	got, err = Get(fs, first.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Written)
+	got, err = Get(fs, second.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Written)
+
+	assert.ErrorIs(t, MarkWritten(fs, "missing"), ErrNotFound)
+}
+
+func TestClearWritten(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	imagePath, digest := downloadImage(t, fs, "emt.raw.gz", "image")
+	artifact, err := Stage(fs, "https://example.com/emt.raw.gz", imagePath, digest, true)
+	require.NoError(t, err)
+
+	require.NoError(t, ClearWritten(fs))
+	got, err := Get(fs, artifact.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Written)
+
+	require.NoError(t, ClearWritten(afero.NewMemMapFs()), "an empty registry has nothing to clear")
+}
+
+func TestVerify(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	imagePath, digest := downloadImage(t, fs, "emt.raw.gz", "image")
+	artifact, err := Stage(fs, "https://example.com/emt.raw.gz", imagePath, digest, true)
+	require.NoError(t, err)
+
+	verified, err := Verify(fs, artifact.ID)
+	require.NoError(t, err)
+	assert.Equal(t, SignatureVerified, verified.SignatureStatus)
+
+	require.NoError(t, afero.WriteFile(fs, artifact.Path(), []byte("tampered"), 0644))
+	_, err = Verify(fs, artifact.ID)
+	assert.ErrorContains(t, err, "does not match its SHA-256")
+	got, err := Get(fs, artifact.ID)
+	require.NoError(t, err)
+	assert.Equal(t, SignatureFailed, got.SignatureStatus, "the failure is recorded")
+
+	_, err = Verify(fs, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDelete(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	imagePath, digest := downloadImage(t, fs, "emt.raw.gz", "image")
+	artifact, err := Stage(fs, "https://example.com/emt.raw.gz", imagePath, digest, true)
+	require.NoError(t, err)
+
+	require.NoError(t, Delete(fs, artifact.ID))
+	exists, err := afero.Exists(fs, artifact.Path())
+	require.NoError(t, err)
+	assert.False(t, exists)
+	artifacts, err := List(fs)
+	require.NoError(t, err)
+	assert.Empty(t, artifacts)
+
+	assert.ErrorIs(t, Delete(fs, artifact.ID), ErrNotFound)
+	_, err = Get(fs, artifact.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestListCorruptRegistry(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, RegistryPath, []byte("not json"), 0644))
+	_, err := List(fs)
+	assert.ErrorContains(t, err, "failed to parse staged artifacts")
+}
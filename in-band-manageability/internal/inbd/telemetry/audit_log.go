@@ -0,0 +1,42 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// auditLogQueryLimit is the number of most recent audit log entries returned by a query.
+const auditLogQueryLimit = 100
+
+// GetAuditLog retrieves the most recent entries of the command audit log.
+func GetAuditLog() (*pb.AuditLogInfo, error) {
+	entries, err := common.ReadAuditLog(auditLogQueryLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditLogInfo{Entries: convertAuditEntries(entries)}, nil
+}
+
+// convertAuditEntries converts audit log entries into their protobuf representation.
+func convertAuditEntries(entries []common.AuditEntry) []*pb.AuditLogEntry {
+	result := make([]*pb.AuditLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, &pb.AuditLogEntry{
+			Time:       timestamppb.New(entry.Time),
+			Caller:     entry.Caller,
+			Command:    entry.Command,
+			Args:       entry.Args,
+			ExitCode:   int32(entry.ExitCode),
+			DurationMs: entry.DurationMs,
+			Allowed:    entry.Allowed,
+			Error:      entry.Error,
+		})
+	}
+	return result
+}
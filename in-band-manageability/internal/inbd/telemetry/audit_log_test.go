@@ -0,0 +1,46 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAuditEntries(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	entries := convertAuditEntries([]common.AuditEntry{
+		{
+			Time:       now,
+			Caller:     "/inbd.v1.InbService/SetPowerState",
+			Command:    common.RebootCmd,
+			Args:       []string{"now"},
+			DurationMs: 12,
+			Allowed:    true,
+		},
+		{
+			Time:     now,
+			Caller:   "inbd",
+			Command:  "/usr/bin/uptime",
+			ExitCode: -1,
+			Error:    "command is not allowed",
+		},
+	})
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, now, entries[0].Time.AsTime())
+	assert.Equal(t, "/inbd.v1.InbService/SetPowerState", entries[0].Caller)
+	assert.Equal(t, []string{"now"}, entries[0].Args)
+	assert.Equal(t, int64(12), entries[0].DurationMs)
+	assert.True(t, entries[0].Allowed)
+	assert.False(t, entries[1].Allowed)
+	assert.Equal(t, int32(-1), entries[1].ExitCode)
+	assert.Equal(t, "command is not allowed", entries[1].Error)
+}
@@ -0,0 +1,157 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+)
+
+// ESRT_ENTRIES_PATH is the sysfs directory exposing the UEFI EFI System Resource Table entries.
+const ESRT_ENTRIES_PATH = "/sys/firmware/efi/esrt/entries"
+
+// ESRT firmware types as defined by the UEFI specification.
+var esrtFwTypes = map[uint64]string{
+	0: "unknown",
+	1: "system",
+	2: "device",
+	3: "uefi_driver",
+}
+
+// ESRT last attempt status values as defined by the UEFI specification.
+var esrtLastAttemptStatus = map[uint64]string{
+	0: "success",
+	1: "unsuccessful",
+	2: "insufficient resources",
+	3: "incorrect version",
+	4: "invalid image format",
+	5: "authentication error",
+	6: "power event: AC not connected",
+	7: "power event: insufficient battery",
+	8: "unsatisfied dependencies",
+}
+
+// IsESRTAvailable checks if the UEFI ESRT is exposed by the kernel.
+func IsESRTAvailable(fs afero.Fs) bool {
+	exists, err := afero.DirExists(fs, ESRT_ENTRIES_PATH)
+	return err == nil && exists
+}
+
+// GetFirmwareComponents retrieves the updatable firmware components from the UEFI ESRT.
+func GetFirmwareComponents() (*pb.FirmwareComponentsInfo, error) {
+	components, err := ReadESRTEntries(afero.NewOsFs(), ESRT_ENTRIES_PATH)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FirmwareComponentsInfo{
+		Components: components,
+		Source:     "esrt",
+	}, nil
+}
+
+// ReadESRTEntries reads every entryN directory under entriesPath and converts it
+// into a FirmwareComponent.
+func ReadESRTEntries(fs afero.Fs, entriesPath string) ([]*pb.FirmwareComponent, error) {
+	entries, err := afero.ReadDir(fs, entriesPath)
+	if err != nil {
+		return nil, fmt.Errorf("UEFI ESRT is not available: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), "entry") {
+			names = append(names, entry.Name())
+		}
+	}
+	// Keep the kernel enumeration order (entry0, entry1, ..., entry10).
+	sort.Slice(names, func(i, j int) bool {
+		ni, _ := strconv.Atoi(strings.TrimPrefix(names[i], "entry"))
+		nj, _ := strconv.Atoi(strings.TrimPrefix(names[j], "entry"))
+		return ni < nj
+	})
+
+	components := make([]*pb.FirmwareComponent, 0, len(names))
+	for _, name := range names {
+		component, err := readESRTEntry(fs, filepath.Join(entriesPath, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ESRT %s: %w", name, err)
+		}
+		components = append(components, component)
+	}
+	return components, nil
+}
+
+// readESRTEntry reads the attributes of a single ESRT entry directory.
+func readESRTEntry(fs afero.Fs, entryPath string) (*pb.FirmwareComponent, error) {
+	fwClass, err := readESRTAttribute(fs, entryPath, "fw_class")
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]uint64{}
+	for _, attr := range []string{
+		"fw_type",
+		"fw_version",
+		"lowest_supported_fw_version",
+		"capsule_flags",
+		"last_attempt_version",
+		"last_attempt_status",
+	} {
+		raw, err := readESRTAttribute(fs, entryPath, attr)
+		if err != nil {
+			return nil, err
+		}
+		// Base 0 accepts both the decimal and the 0x-prefixed values the kernel exports.
+		value, err := strconv.ParseUint(raw, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %w", attr, err)
+		}
+		values[attr] = value
+	}
+
+	fwType, ok := esrtFwTypes[values["fw_type"]]
+	if !ok {
+		fwType = "unknown"
+	}
+
+	return &pb.FirmwareComponent{
+		FwClass:                      strings.ToLower(fwClass),
+		FwType:                       fwType,
+		FwVersion:                    uint32(values["fw_version"]),
+		LowestSupportedFwVersion:     uint32(values["lowest_supported_fw_version"]),
+		CapsuleFlags:                 uint32(values["capsule_flags"]),
+		LastAttemptVersion:           uint32(values["last_attempt_version"]),
+		LastAttemptStatus:            uint32(values["last_attempt_status"]),
+		LastAttemptStatusDescription: describeESRTLastAttemptStatus(values["last_attempt_status"]),
+	}, nil
+}
+
+// readESRTAttribute reads a single sysfs attribute of an ESRT entry.
+func readESRTAttribute(fs afero.Fs, entryPath, attr string) (string, error) {
+	data, err := afero.ReadFile(fs, filepath.Join(entryPath, attr))
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", attr, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// describeESRTLastAttemptStatus converts an ESRT last attempt status into a readable string.
+// Values from 0x1000 to 0x4000 are reserved for vendor specific errors.
+func describeESRTLastAttemptStatus(status uint64) string {
+	if description, ok := esrtLastAttemptStatus[status]; ok {
+		return description
+	}
+	if status >= 0x1000 && status <= 0x4000 {
+		return fmt.Sprintf("vendor specific error (0x%x)", status)
+	}
+	return fmt.Sprintf("unknown (%d)", status)
+}
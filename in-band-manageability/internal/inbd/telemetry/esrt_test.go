@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeESRTEntry(t *testing.T, fs afero.Fs, name string, attrs map[string]string) {
+	t.Helper()
+	for attr, value := range attrs {
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(ESRT_ENTRIES_PATH, name, attr), []byte(value+"\n"), 0644))
+	}
+}
+
+func validESRTAttrs(fwClass string) map[string]string {
+	return map[string]string{
+		"fw_class":                    fwClass,
+		"fw_type":                     "1",
+		"fw_version":                  "65602",
+		"lowest_supported_fw_version": "65536",
+		"capsule_flags":               "0x1",
+		"last_attempt_version":        "65602",
+		"last_attempt_status":         "0",
+	}
+}
+
+func TestIsESRTAvailable(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	assert.False(t, IsESRTAvailable(fs))
+
+	require.NoError(t, fs.MkdirAll(ESRT_ENTRIES_PATH, 0755))
+	assert.True(t, IsESRTAvailable(fs))
+}
+
+func TestReadESRTEntries(t *testing.T) {
+	t.Run("reads entries in kernel order", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		for i, name := range []string{"entry0", "entry1", "entry10", "entry2"} {
+			attrs := validESRTAttrs("A5B2F1A8-0000-4000-8000-00000000000" + string(rune('0'+i)))
+			writeESRTEntry(t, fs, name, attrs)
+		}
+
+		components, err := ReadESRTEntries(fs, ESRT_ENTRIES_PATH)
+		require.NoError(t, err)
+		require.Len(t, components, 4)
+		assert.Equal(t, "a5b2f1a8-0000-4000-8000-000000000000", components[0].FwClass)
+		assert.Equal(t, "a5b2f1a8-0000-4000-8000-000000000001", components[1].FwClass)
+		assert.Equal(t, "a5b2f1a8-0000-4000-8000-000000000003", components[2].FwClass)
+		assert.Equal(t, "a5b2f1a8-0000-4000-8000-000000000002", components[3].FwClass)
+	})
+
+	t.Run("parses entry attributes", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		attrs := validESRTAttrs("a5b2f1a8-0000-4000-8000-000000000000")
+		attrs["fw_type"] = "2"
+		attrs["last_attempt_status"] = "5"
+		writeESRTEntry(t, fs, "entry0", attrs)
+
+		components, err := ReadESRTEntries(fs, ESRT_ENTRIES_PATH)
+		require.NoError(t, err)
+		require.Len(t, components, 1)
+		c := components[0]
+		assert.Equal(t, "device", c.FwType)
+		assert.Equal(t, uint32(65602), c.FwVersion)
+		assert.Equal(t, uint32(65536), c.LowestSupportedFwVersion)
+		assert.Equal(t, uint32(1), c.CapsuleFlags)
+		assert.Equal(t, uint32(65602), c.LastAttemptVersion)
+		assert.Equal(t, uint32(5), c.LastAttemptStatus)
+		assert.Equal(t, "authentication error", c.LastAttemptStatusDescription)
+	})
+
+	t.Run("missing ESRT directory", func(t *testing.T) {
+		_, err := ReadESRTEntries(afero.NewMemMapFs(), ESRT_ENTRIES_PATH)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "UEFI ESRT is not available")
+	})
+
+	t.Run("missing attribute", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		attrs := validESRTAttrs("a5b2f1a8-0000-4000-8000-000000000000")
+		delete(attrs, "fw_version")
+		writeESRTEntry(t, fs, "entry0", attrs)
+
+		_, err := ReadESRTEntries(fs, ESRT_ENTRIES_PATH)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "fw_version")
+	})
+
+	t.Run("invalid attribute value", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		attrs := validESRTAttrs("a5b2f1a8-0000-4000-8000-000000000000")
+		attrs["fw_version"] = "not-a-number"
+		writeESRTEntry(t, fs, "entry0", attrs)
+
+		_, err := ReadESRTEntries(fs, ESRT_ENTRIES_PATH)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid value for fw_version")
+	})
+}
+
+func TestDescribeESRTLastAttemptStatus(t *testing.T) {
+	assert.Equal(t, "success", describeESRTLastAttemptStatus(0))
+	assert.Equal(t, "incorrect version", describeESRTLastAttemptStatus(3))
+	assert.Equal(t, "unsatisfied dependencies", describeESRTLastAttemptStatus(8))
+	assert.Equal(t, "vendor specific error (0x1001)", describeESRTLastAttemptStatus(0x1001))
+	assert.Equal(t, "unknown (42)", describeESRTLastAttemptStatus(42))
+}
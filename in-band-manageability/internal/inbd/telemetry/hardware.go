@@ -54,11 +54,33 @@ func GetHardwareInfo() (*pb.HardwareInfo, error) {
 		} else {
 			log.Printf("Failed to read DiskInformation: %v", err)
 		}
+
+		readHardwareDetails(afero.NewOsFs(), hw)
 	}
 
 	return hw, nil
 }
 
+// readHardwareDetails reads the disks, network interfaces, PCI devices, TPM, Secure Boot state
+// and memory modules from sysfs.  Parts that can not be read are left empty.
+func readHardwareDetails(fs afero.Fs, hw *pb.HardwareInfo) {
+	var err error
+	if hw.Disks, err = ReadDisks(fs); err != nil {
+		log.Printf("Failed to read Disks: %v", err)
+	}
+	if hw.NetworkInterfaces, err = ReadNetworkInterfaces(fs); err != nil {
+		log.Printf("Failed to read NetworkInterfaces: %v", err)
+	}
+	if hw.PciDevices, err = ReadPCIDevices(fs); err != nil {
+		log.Printf("Failed to read PciDevices: %v", err)
+	}
+	hw.Tpm = ReadTPM(fs)
+	hw.SecureBoot = ReadSecureBootState(fs)
+	if hw.MemoryModules, err = ReadMemoryModules(fs); err != nil {
+		log.Printf("Failed to read MemoryModules: %v", err)
+	}
+}
+
 func readDMIInfo(path string) (string, error) {
 	var fs = afero.NewOsFs()
 	data, err := utils.ReadFile(fs, path)
@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"bufio"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+)
+
+// sysfs directories of network interfaces and PCI devices.
+const (
+	SYS_CLASS_NET_PATH = "/sys/class/net"
+	PCI_DEVICES_PATH   = "/sys/bus/pci/devices"
+)
+
+// Names of the PCI base classes.
+var pciBaseClasses = map[string]string{
+	"00": "unclassified device",
+	"01": "mass storage controller",
+	"02": "network controller",
+	"03": "display controller",
+	"04": "multimedia controller",
+	"05": "memory controller",
+	"06": "bridge",
+	"07": "communication controller",
+	"08": "generic system peripheral",
+	"09": "input device controller",
+	"0a": "docking station",
+	"0b": "processor",
+	"0c": "serial bus controller",
+	"0d": "wireless controller",
+	"0e": "intelligent controller",
+	"0f": "satellite communications controller",
+	"10": "encryption controller",
+	"11": "signal processing controller",
+	"12": "processing accelerator",
+	"13": "non-essential instrumentation",
+	"40": "coprocessor",
+	"ff": "unassigned class",
+}
+
+// ReadNetworkInterfaces reads the physical network interfaces under /sys/class/net.  Virtual
+// interfaces such as lo, bridges and veth pairs have no device and are not listed.
+func ReadNetworkInterfaces(fs afero.Fs) ([]*pb.NetworkInterface, error) {
+	entries, err := afero.ReadDir(fs, SYS_CLASS_NET_PATH)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list network interfaces: %w", err)
+	}
+
+	var nics []*pb.NetworkInterface
+	for _, entry := range entries {
+		dir := filepath.Join(SYS_CLASS_NET_PATH, entry.Name())
+		if exists, _ := afero.Exists(fs, filepath.Join(dir, "device")); !exists {
+			continue
+		}
+
+		uevent := readUevent(fs, filepath.Join(dir, "device"))
+		nic := &pb.NetworkInterface{
+			Name:       entry.Name(),
+			MacAddress: readSysfsAttribute(fs, dir, "address"),
+			Operstate:  readSysfsAttribute(fs, dir, "operstate"),
+			Driver:     uevent["DRIVER"],
+			PciAddress: uevent["PCI_SLOT_NAME"],
+		}
+		// Reading the speed fails while the link is down, and it is -1 if the driver can not tell.
+		if speed, err := strconv.Atoi(readSysfsAttribute(fs, dir, "speed")); err == nil && speed > 0 {
+			nic.SpeedMbps = int32(speed)
+		}
+		if mtu, err := strconv.Atoi(readSysfsAttribute(fs, dir, "mtu")); err == nil {
+			nic.Mtu = int32(mtu)
+		}
+		nics = append(nics, nic)
+	}
+	return nics, nil
+}
+
+// ReadPCIDevices reads the PCI devices under /sys/bus/pci/devices, ordered by address.
+func ReadPCIDevices(fs afero.Fs) ([]*pb.PCIDevice, error) {
+	entries, err := afero.ReadDir(fs, PCI_DEVICES_PATH)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PCI devices: %w", err)
+	}
+
+	devices := make([]*pb.PCIDevice, 0, len(entries))
+	for _, entry := range entries {
+		dir := filepath.Join(PCI_DEVICES_PATH, entry.Name())
+		classCode := trimHexPrefix(readSysfsAttribute(fs, dir, "class"))
+		device := &pb.PCIDevice{
+			Address:           entry.Name(),
+			VendorId:          trimHexPrefix(readSysfsAttribute(fs, dir, "vendor")),
+			DeviceId:          trimHexPrefix(readSysfsAttribute(fs, dir, "device")),
+			SubsystemVendorId: trimHexPrefix(readSysfsAttribute(fs, dir, "subsystem_vendor")),
+			SubsystemDeviceId: trimHexPrefix(readSysfsAttribute(fs, dir, "subsystem_device")),
+			ClassCode:         classCode,
+			Driver:            readUevent(fs, dir)["DRIVER"],
+			NumaNode:          -1,
+		}
+		if len(classCode) >= 2 {
+			device.ClassName = pciBaseClasses[classCode[:2]]
+		}
+		if node, err := strconv.Atoi(readSysfsAttribute(fs, dir, "numa_node")); err == nil {
+			device.NumaNode = int32(node)
+		}
+		devices = append(devices, device)
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].Address < devices[j].Address })
+	return devices, nil
+}
+
+// readUevent reads the KEY=value pairs of the uevent attribute of a device.
+func readUevent(fs afero.Fs, dir string) map[string]string {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(readSysfsAttribute(fs, dir, "uevent")))
+	for scanner.Scan() {
+		if key, value, found := strings.Cut(scanner.Text(), "="); found {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// trimHexPrefix converts a sysfs hex value such as 0x8086 to lower case without its prefix.
+func trimHexPrefix(value string) string {
+	return strings.TrimPrefix(strings.ToLower(value), "0x")
+}
@@ -0,0 +1,185 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+)
+
+// DMI_ENTRIES_PATH is the sysfs directory with the raw SMBIOS structures, one per directory
+// named <type>-<instance>.
+const DMI_ENTRIES_PATH = "/sys/firmware/dmi/entries"
+
+// smbiosMemoryDevice is the SMBIOS structure type of a memory device.
+const smbiosMemoryDevice = 17
+
+// Memory types of SMBIOS memory devices.
+var smbiosMemoryTypes = map[byte]string{
+	0x03: "DRAM",
+	0x0f: "SDRAM",
+	0x12: "DDR",
+	0x13: "DDR2",
+	0x14: "DDR2 FB-DIMM",
+	0x18: "DDR3",
+	0x1a: "DDR4",
+	0x1b: "LPDDR",
+	0x1c: "LPDDR2",
+	0x1d: "LPDDR3",
+	0x1e: "LPDDR4",
+	0x1f: "Logical non-volatile device",
+	0x20: "HBM",
+	0x21: "HBM2",
+	0x22: "DDR5",
+	0x23: "LPDDR5",
+	0x24: "HBM3",
+}
+
+// Form factors of SMBIOS memory devices.
+var smbiosFormFactors = map[byte]string{
+	0x05: "Chip",
+	0x06: "DIP",
+	0x09: "DIMM",
+	0x0b: "Row of chips",
+	0x0c: "RIMM",
+	0x0d: "SODIMM",
+	0x0f: "FB-DIMM",
+	0x10: "Die",
+	0x11: "CAMM",
+}
+
+// ReadMemoryModules reads the installed memory modules from the SMBIOS memory device structures.
+// Empty slots are not listed.
+func ReadMemoryModules(fs afero.Fs) ([]*pb.MemoryModule, error) {
+	entries, err := afero.ReadDir(fs, DMI_ENTRIES_PATH)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SMBIOS entries: %w", err)
+	}
+
+	prefix := strconv.Itoa(smbiosMemoryDevice) + "-"
+	var instances []int
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		if instance, err := strconv.Atoi(strings.TrimPrefix(entry.Name(), prefix)); err == nil {
+			instances = append(instances, instance)
+		}
+	}
+	sort.Ints(instances)
+
+	var modules []*pb.MemoryModule
+	for _, instance := range instances {
+		raw, err := afero.ReadFile(fs, filepath.Join(DMI_ENTRIES_PATH, prefix+strconv.Itoa(instance), "raw"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SMBIOS memory device %d: %w", instance, err)
+		}
+		module, err := parseSMBIOSMemoryDevice(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SMBIOS memory device %d: %w", instance, err)
+		}
+		if module != nil {
+			modules = append(modules, module)
+		}
+	}
+	return modules, nil
+}
+
+// parseSMBIOSMemoryDevice parses a raw SMBIOS memory device structure as defined by the SMBIOS
+// specification (DSP0134).  It returns nil for an empty slot.
+func parseSMBIOSMemoryDevice(raw []byte) (*pb.MemoryModule, error) {
+	if len(raw) < 0x15 || raw[0] != smbiosMemoryDevice {
+		return nil, fmt.Errorf("not a memory device structure")
+	}
+	length := int(raw[1])
+	if length < 0x15 || length > len(raw) {
+		return nil, fmt.Errorf("invalid structure length %d", length)
+	}
+	formatted := raw[:length]
+	strs := smbiosStrings(raw[length:])
+	str := func(offset int) string {
+		if offset >= length {
+			return ""
+		}
+		return smbiosString(strs, formatted[offset])
+	}
+	word := func(offset int) uint16 {
+		if offset+2 > length {
+			return 0
+		}
+		return binary.LittleEndian.Uint16(formatted[offset:])
+	}
+
+	if word(0x0c) == 0 {
+		return nil, nil
+	}
+	module := &pb.MemoryModule{
+		Locator:      str(0x10),
+		BankLocator:  str(0x11),
+		SizeBytes:    smbiosMemorySize(word(0x0c), formatted),
+		Type:         smbiosMemoryTypes[formatted[0x12]],
+		FormFactor:   smbiosFormFactors[formatted[0x0e]],
+		Manufacturer: str(0x17),
+		Serial:       str(0x18),
+		PartNumber:   str(0x1a),
+	}
+	// 0xffff means the speed is in the 32-bit extended speed fields of SMBIOS 3.3.
+	if speed := word(0x15); speed != 0xffff {
+		module.SpeedMts = uint32(speed)
+	} else if length >= 0x58 {
+		module.SpeedMts = binary.LittleEndian.Uint32(formatted[0x54:]) & 0x7fffffff
+	}
+	if speed := word(0x20); speed != 0xffff {
+		module.ConfiguredSpeedMts = uint32(speed)
+	} else if length >= 0x5c {
+		module.ConfiguredSpeedMts = binary.LittleEndian.Uint32(formatted[0x58:]) & 0x7fffffff
+	}
+	return module, nil
+}
+
+// smbiosMemorySize converts the size field of an installed memory device into bytes, or 0 if the
+// size is unknown.
+func smbiosMemorySize(size uint16, formatted []byte) uint64 {
+	switch {
+	case size == 0xffff:
+		return 0
+	case size == 0x7fff && len(formatted) >= 0x20:
+		// Modules of 32 GiB and more have their size in MiB in the extended size field.
+		return uint64(binary.LittleEndian.Uint32(formatted[0x1c:])&0x7fffffff) << 20
+	case size&0x8000 != 0:
+		return uint64(size&0x7fff) << 10
+	default:
+		return uint64(size) << 20
+	}
+}
+
+// smbiosStrings splits the string set that follows the formatted area of a structure.
+func smbiosStrings(data []byte) []string {
+	end := bytes.Index(data, []byte{0, 0})
+	if end < 0 {
+		end = len(data)
+	}
+	if end == 0 {
+		return nil
+	}
+	return strings.Split(string(data[:end]), "\x00")
+}
+
+// smbiosString returns the string with a 1-based index, or "" for index 0.
+func smbiosString(strs []string, index byte) string {
+	if index == 0 || int(index) > len(strs) {
+		return ""
+	}
+	return strings.TrimSpace(strs[index-1])
+}
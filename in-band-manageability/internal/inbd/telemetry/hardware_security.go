@@ -0,0 +1,98 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+)
+
+// sysfs paths of the TPM devices and the UEFI variables.
+const (
+	TPM_CLASS_PATH     = "/sys/class/tpm"
+	TPMRM_CLASS_PATH   = "/sys/class/tpmrm"
+	EFI_PATH           = "/sys/firmware/efi"
+	EFIVARS_PATH       = EFI_PATH + "/efivars"
+	SECURE_BOOT_EFIVAR = EFIVARS_PATH + "/SecureBoot-8be4df61-93ca-11d2-aa0d-00e098032b8c"
+	SETUP_MODE_EFIVAR  = EFIVARS_PATH + "/SetupMode-8be4df61-93ca-11d2-aa0d-00e098032b8c"
+)
+
+// Secure Boot states.
+const (
+	SecureBootEnabled     = "enabled"
+	SecureBootDisabled    = "disabled"
+	SecureBootSetupMode   = "setup_mode"
+	SecureBootUnsupported = "unsupported"
+	SecureBootUnknown     = "unknown"
+)
+
+// ReadTPM reads the first TPM under /sys/class/tpm.
+func ReadTPM(fs afero.Fs) *pb.TPMInfo {
+	entries, err := afero.ReadDir(fs, TPM_CLASS_PATH)
+	if err != nil {
+		return &pb.TPMInfo{}
+	}
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "tpm") {
+			names = append(names, entry.Name())
+		}
+	}
+	if len(names) == 0 {
+		return &pb.TPMInfo{}
+	}
+	sort.Strings(names)
+
+	dir := filepath.Join(TPM_CLASS_PATH, names[0])
+	tpm := &pb.TPMInfo{Present: true, Device: names[0]}
+	switch readSysfsAttribute(fs, dir, "tpm_version_major") {
+	case "2":
+		tpm.Version = "2.0"
+	case "1":
+		tpm.Version = "1.2"
+	default:
+		// Kernels before 5.6 do not export the version.  Only TPM 1.2 devices have caps, and
+		// only TPM 2.0 devices have a resource manager.
+		if exists, _ := afero.Exists(fs, filepath.Join(dir, "device/caps")); exists {
+			tpm.Version = "1.2"
+		} else if exists, _ := afero.Exists(fs, filepath.Join(TPMRM_CLASS_PATH, "tpmrm"+strings.TrimPrefix(names[0], "tpm"))); exists {
+			tpm.Version = "2.0"
+		}
+	}
+	return tpm
+}
+
+// ReadSecureBootState reads the Secure Boot state from the UEFI variables.
+func ReadSecureBootState(fs afero.Fs) string {
+	if exists, _ := afero.DirExists(fs, EFI_PATH); !exists {
+		return SecureBootUnsupported
+	}
+	secureBoot, ok := readEFIVariableByte(fs, SECURE_BOOT_EFIVAR)
+	if !ok {
+		return SecureBootUnknown
+	}
+	if secureBoot == 1 {
+		return SecureBootEnabled
+	}
+	if setupMode, ok := readEFIVariableByte(fs, SETUP_MODE_EFIVAR); ok && setupMode == 1 {
+		return SecureBootSetupMode
+	}
+	return SecureBootDisabled
+}
+
+// readEFIVariableByte reads a one-byte UEFI variable.  efivarfs files start with the 4-byte
+// attributes of the variable.
+func readEFIVariableByte(fs afero.Fs, path string) (byte, bool) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil || len(data) < 5 {
+		return 0, false
+	}
+	return data[4], true
+}
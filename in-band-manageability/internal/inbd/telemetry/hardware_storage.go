@@ -0,0 +1,142 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+)
+
+// SYS_BLOCK_PATH is the sysfs directory with an entry per block device.
+const SYS_BLOCK_PATH = "/sys/block"
+
+// Block devices that are not disks: loop, RAM and compressed RAM disks, device mapper and MD
+// volumes, optical and floppy drives, and network block devices.
+var nonDiskPrefixes = []string{"loop", "ram", "zram", "dm-", "md", "sr", "fd", "nbd"}
+
+// smartctlTimeout bounds a smartctl run, as a failing disk can stall it for minutes.
+const smartctlTimeout = 30 * time.Second
+
+// getDiskHealth returns the SMART overall health of a disk.  It is a variable for testing.
+var getDiskHealth = func(name string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), smartctlTimeout)
+	defer cancel()
+	createCmd := func(name string, args ...string) *exec.Cmd {
+		return exec.CommandContext(ctx, name, args...)
+	}
+	return readSMARTHealth(common.NewExecutor(createCmd, common.ExecuteAndReadOutput), name)
+}
+
+// ReadDisks reads the disks under /sys/block.  Partitions and logical volumes are not listed.
+func ReadDisks(fs afero.Fs) ([]*pb.DiskInfo, error) {
+	entries, err := afero.ReadDir(fs, SYS_BLOCK_PATH)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list block devices: %w", err)
+	}
+
+	var disks []*pb.DiskInfo
+	for _, entry := range entries {
+		name := entry.Name()
+		if isNonDisk(name) {
+			continue
+		}
+		dir := filepath.Join(SYS_BLOCK_PATH, name)
+		sectors, _ := strconv.ParseUint(readSysfsAttribute(fs, dir, "size"), 10, 64)
+		if sectors == 0 {
+			// Empty card readers and similar devices without media.
+			continue
+		}
+
+		disk := &pb.DiskInfo{
+			Name:             name,
+			Model:            readSysfsAttribute(fs, dir, "device/model"),
+			Serial:           readDiskSerial(fs, dir),
+			SizeBytes:        sectors * 512, // sysfs always counts 512-byte sectors
+			Rotational:       readSysfsAttribute(fs, dir, "queue/rotational") == "1",
+			Removable:        readSysfsAttribute(fs, dir, "removable") == "1",
+			FirmwareRevision: readSysfsAttribute(fs, dir, "device/firmware_rev"),
+		}
+		if disk.FirmwareRevision == "" {
+			disk.FirmwareRevision = readSysfsAttribute(fs, dir, "device/rev")
+		}
+		disk.SmartHealth = getDiskHealth(name)
+		disks = append(disks, disk)
+	}
+	return disks, nil
+}
+
+func isNonDisk(name string) bool {
+	for _, prefix := range nonDiskPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// readDiskSerial returns the serial number of a disk.  NVMe controllers export it as serial,
+// virtio disks as the serial of the block device, and SCSI and SATA disks only in the unit
+// serial number VPD page.
+func readDiskSerial(fs afero.Fs, dir string) string {
+	if serial := readSysfsAttribute(fs, dir, "device/serial"); serial != "" {
+		return serial
+	}
+	if serial := readSysfsAttribute(fs, dir, "serial"); serial != "" {
+		return serial
+	}
+	page, err := afero.ReadFile(fs, filepath.Join(dir, "device/vpd_pg80"))
+	if err != nil || len(page) <= 4 {
+		return ""
+	}
+	// The page has a 4-byte header, with the length of the serial number in bytes 2 and 3.
+	length := int(page[2])<<8 | int(page[3])
+	if length > len(page)-4 {
+		length = len(page) - 4
+	}
+	return strings.TrimSpace(strings.Trim(string(page[4:4+length]), "\x00"))
+}
+
+// readSMARTHealth returns passed or failed from the SMART overall health self-assessment of a
+// disk, or "" if smartctl is not installed or the disk does not support SMART.
+func readSMARTHealth(executor common.Executor, name string) string {
+	// smartctl sets bits of its exit status for failing disks, so the output is parsed regardless.
+	output, _, _ := executor.Execute([]string{common.SmartctlCmd, "--health", "--json", "/dev/" + name})
+	if len(output) == 0 {
+		return ""
+	}
+	var result struct {
+		SmartStatus *struct {
+			Passed bool `json:"passed"`
+		} `json:"smart_status"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil || result.SmartStatus == nil {
+		return ""
+	}
+	if result.SmartStatus.Passed {
+		return "passed"
+	}
+	return "failed"
+}
+
+// readSysfsAttribute reads a sysfs attribute relative to a device directory, or returns "" if it
+// does not exist or can not be read.
+func readSysfsAttribute(fs afero.Fs, dir, attr string) string {
+	data, err := afero.ReadFile(fs, filepath.Join(dir, attr))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
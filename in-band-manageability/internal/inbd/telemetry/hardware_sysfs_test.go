@@ -0,0 +1,241 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtureFs returns the fake sysfs tree in testdata/hardware.
+func fixtureFs(t *testing.T) afero.Fs {
+	t.Helper()
+	original := getDiskHealth
+	t.Cleanup(func() { getDiskHealth = original })
+	getDiskHealth = func(name string) string {
+		if name == "sda" {
+			return "failed"
+		}
+		return "passed"
+	}
+	return afero.NewReadOnlyFs(afero.NewBasePathFs(afero.NewOsFs(), "testdata/hardware"))
+}
+
+func TestReadDisks(t *testing.T) {
+	disks, err := ReadDisks(fixtureFs(t))
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.DiskInfo{
+		{
+			Name:             "nvme0n1",
+			Model:            "Samsung SSD 980 PRO 1TB",
+			Serial:           "S5GXNF0R123456",
+			SizeBytes:        1953525168 * 512,
+			FirmwareRevision: "5B2QGXA7",
+			SmartHealth:      "passed",
+		},
+		{
+			Name:             "sda",
+			Model:            "ST500LM012 HN-M5",
+			Serial:           "S2Z9J9BD123456",
+			SizeBytes:        976773168 * 512,
+			Rotational:       true,
+			FirmwareRevision: "2AR1",
+			SmartHealth:      "failed",
+		},
+	}, disks)
+}
+
+func TestReadNetworkInterfaces(t *testing.T) {
+	nics, err := ReadNetworkInterfaces(fixtureFs(t))
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.NetworkInterface{
+		{Name: "eno1", MacAddress: "3c:ec:ef:01:02:03", SpeedMbps: 1000, Operstate: "up", Driver: "e1000e", PciAddress: "0000:00:1f.6", Mtu: 1500},
+		{Name: "wlp2s0", MacAddress: "a0:b1:c2:d3:e4:f5", Operstate: "down", Driver: "iwlwifi", PciAddress: "0000:02:00.0", Mtu: 1500},
+	}, nics)
+}
+
+func TestReadPCIDevices(t *testing.T) {
+	devices, err := ReadPCIDevices(fixtureFs(t))
+	require.NoError(t, err)
+	require.Len(t, devices, 4)
+
+	addresses := []string{}
+	for _, d := range devices {
+		addresses = append(addresses, d.Address)
+	}
+	assert.Equal(t, []string{"0000:00:02.0", "0000:00:1f.0", "0000:00:1f.6", "0000:02:00.0"}, addresses)
+	assert.Equal(t, &pb.PCIDevice{
+		Address:           "0000:00:1f.6",
+		VendorId:          "8086",
+		DeviceId:          "15fa",
+		SubsystemVendorId: "8086",
+		SubsystemDeviceId: "2084",
+		ClassCode:         "020000",
+		ClassName:         "network controller",
+		Driver:            "e1000e",
+		NumaNode:          -1,
+	}, devices[2])
+	assert.Equal(t, "display controller", devices[0].ClassName)
+	assert.Equal(t, "bridge", devices[1].ClassName)
+	assert.Empty(t, devices[1].Driver)
+}
+
+func TestReadTPM(t *testing.T) {
+	assert.Equal(t, &pb.TPMInfo{Present: true, Version: "2.0", Device: "tpm0"}, ReadTPM(fixtureFs(t)))
+
+	t.Run("no TPM", func(t *testing.T) {
+		assert.False(t, ReadTPM(afero.NewMemMapFs()).Present)
+	})
+
+	t.Run("TPM 1.2 on older kernels", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, TPM_CLASS_PATH+"/tpm0/device/caps", []byte("Manufacturer: 0x49465800\n"), 0444))
+		assert.Equal(t, &pb.TPMInfo{Present: true, Version: "1.2", Device: "tpm0"}, ReadTPM(fs))
+	})
+
+	t.Run("TPM 2.0 on older kernels", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, fs.MkdirAll(TPM_CLASS_PATH+"/tpm0", 0755))
+		require.NoError(t, fs.MkdirAll(TPMRM_CLASS_PATH+"/tpmrm0", 0755))
+		assert.Equal(t, "2.0", ReadTPM(fs).Version)
+	})
+}
+
+func TestReadSecureBootState(t *testing.T) {
+	assert.Equal(t, SecureBootEnabled, ReadSecureBootState(fixtureFs(t)))
+
+	tests := []struct {
+		name       string
+		secureBoot []byte
+		setupMode  []byte
+		want       string
+	}{
+		{"disabled", []byte{6, 0, 0, 0, 0}, []byte{6, 0, 0, 0, 0}, SecureBootDisabled},
+		{"setup mode", []byte{6, 0, 0, 0, 0}, []byte{6, 0, 0, 0, 1}, SecureBootSetupMode},
+		{"variable missing", nil, nil, SecureBootUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll(EFIVARS_PATH, 0755))
+			if tt.secureBoot != nil {
+				require.NoError(t, afero.WriteFile(fs, SECURE_BOOT_EFIVAR, tt.secureBoot, 0444))
+				require.NoError(t, afero.WriteFile(fs, SETUP_MODE_EFIVAR, tt.setupMode, 0444))
+			}
+			assert.Equal(t, tt.want, ReadSecureBootState(fs))
+		})
+	}
+
+	t.Run("legacy BIOS boot", func(t *testing.T) {
+		assert.Equal(t, SecureBootUnsupported, ReadSecureBootState(afero.NewMemMapFs()))
+	})
+}
+
+func TestReadMemoryModules(t *testing.T) {
+	modules, err := ReadMemoryModules(fixtureFs(t))
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.MemoryModule{{
+		Locator:            "Controller0-ChannelA-DIMM0",
+		BankLocator:        "BANK 0",
+		SizeBytes:          8 << 30,
+		Type:               "DDR4",
+		FormFactor:         "SODIMM",
+		SpeedMts:           3200,
+		ConfiguredSpeedMts: 2933,
+		Manufacturer:       "Samsung",
+		Serial:             "12345678",
+		PartNumber:         "M471A1K43DB1-CWE",
+	}}, modules)
+}
+
+func TestParseSMBIOSMemoryDevice(t *testing.T) {
+	t.Run("extended size", func(t *testing.T) {
+		raw := make([]byte, 0x28)
+		raw[0], raw[1] = smbiosMemoryDevice, 0x28
+		binary.LittleEndian.PutUint16(raw[0x0c:], 0x7fff)
+		binary.LittleEndian.PutUint32(raw[0x1c:], 65536)
+		raw[0x12] = 0x22
+		raw = append(raw, 0, 0)
+
+		module, err := parseSMBIOSMemoryDevice(raw)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(64<<30), module.SizeBytes)
+		assert.Equal(t, "DDR5", module.Type)
+		assert.Empty(t, module.Locator)
+	})
+
+	t.Run("size in KiB", func(t *testing.T) {
+		raw := make([]byte, 0x15)
+		raw[0], raw[1] = smbiosMemoryDevice, 0x15
+		binary.LittleEndian.PutUint16(raw[0x0c:], 0x8000|512)
+		raw = append(raw, 0, 0)
+
+		module, err := parseSMBIOSMemoryDevice(raw)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(512<<10), module.SizeBytes)
+	})
+
+	t.Run("not a memory device", func(t *testing.T) {
+		_, err := parseSMBIOSMemoryDevice([]byte{16, 0x17, 0, 0})
+		assert.Error(t, err)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		raw := make([]byte, 0x15)
+		raw[0], raw[1] = smbiosMemoryDevice, 0x28
+		_, err := parseSMBIOSMemoryDevice(raw)
+		assert.ErrorContains(t, err, "invalid structure length")
+	})
+}
+
+func TestReadHardwareDetails(t *testing.T) {
+	hw := &pb.HardwareInfo{}
+	readHardwareDetails(fixtureFs(t), hw)
+	assert.Len(t, hw.Disks, 2)
+	assert.Len(t, hw.NetworkInterfaces, 2)
+	assert.Len(t, hw.PciDevices, 4)
+	assert.True(t, hw.Tpm.Present)
+	assert.Equal(t, SecureBootEnabled, hw.SecureBoot)
+	assert.Len(t, hw.MemoryModules, 1)
+
+	empty := &pb.HardwareInfo{}
+	readHardwareDetails(afero.NewMemMapFs(), empty)
+	assert.Empty(t, empty.Disks)
+	assert.False(t, empty.Tpm.Present)
+	assert.Equal(t, SecureBootUnsupported, empty.SecureBoot)
+}
+
+// smartctlStub is a common.Executor that answers smartctl with fixed output.
+type smartctlStub struct {
+	output []byte
+	err    error
+	args   []string
+}
+
+func (s *smartctlStub) Execute(args []string) ([]byte, []byte, error) {
+	s.args = args
+	return s.output, nil, s.err
+}
+
+func TestReadSMARTHealth(t *testing.T) {
+	passed := &smartctlStub{output: []byte(`{"smart_status":{"passed":true}}`)}
+	assert.Equal(t, "passed", readSMARTHealth(passed, "sda"))
+	assert.Equal(t, []string{common.SmartctlCmd, "--health", "--json", "/dev/sda"}, passed.args)
+
+	// smartctl exits with status bits set for a failing disk.
+	failed := &smartctlStub{output: []byte(`{"smart_status":{"passed":false}}`), err: errors.New("exit status 8")}
+	assert.Equal(t, "failed", readSMARTHealth(failed, "sda"))
+
+	assert.Equal(t, "", readSMARTHealth(&smartctlStub{err: errors.New("command is not allowed")}, "sda"))
+	assert.Equal(t, "", readSMARTHealth(&smartctlStub{output: []byte(`{"device":{}}`)}, "sda"))
+}
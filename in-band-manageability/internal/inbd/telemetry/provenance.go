@@ -0,0 +1,43 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/provenance"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetProvenance retrieves the results of the most recent provenance verifications.
+func GetProvenance() (*pb.ProvenanceInfo, error) {
+	records, err := provenance.ReadRecords(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProvenanceInfo{Records: convertProvenanceRecords(records)}, nil
+}
+
+// convertProvenanceRecords converts provenance records into their protobuf representation.
+func convertProvenanceRecords(records []provenance.Record) []*pb.ProvenanceRecord {
+	result := make([]*pb.ProvenanceRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, &pb.ProvenanceRecord{
+			Time:           timestamppb.New(record.Time),
+			PackageType:    record.PackageType,
+			Artifact:       record.Artifact,
+			Verified:       record.Verified,
+			Format:         record.Format,
+			PredicateType:  record.PredicateType,
+			BuilderId:      record.BuilderID,
+			SourceRepo:     record.SourceRepo,
+			Signer:         record.Signer,
+			ArtifactDigest: record.ArtifactDigest,
+			Error:          record.Error,
+		})
+	}
+	return result
+}
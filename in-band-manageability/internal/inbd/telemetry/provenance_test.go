@@ -0,0 +1,50 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/provenance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertProvenanceRecords(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	records := convertProvenanceRecords([]provenance.Record{
+		{
+			Time:           now,
+			PackageType:    provenance.PackageTypeFOTA,
+			Artifact:       "bios.cap",
+			Verified:       true,
+			Format:         provenance.FormatDSSE,
+			PredicateType:  provenance.SLSAProvenanceV1,
+			BuilderID:      "https://github.com/actions/runner",
+			SourceRepo:     "https://github.com/example/firmware",
+			Signer:         "key:0123",
+			ArtifactDigest: "sha256:abcd",
+		},
+		{
+			Time:        now,
+			PackageType: provenance.PackageTypeSOTA,
+			Artifact:    "image.raw.gz",
+			Error:       "provenance is required but was not supplied",
+		},
+	})
+
+	require.Len(t, records, 2)
+	assert.Equal(t, now, records[0].Time.AsTime())
+	assert.True(t, records[0].Verified)
+	assert.Equal(t, "dsse", records[0].Format)
+	assert.Equal(t, "https://github.com/actions/runner", records[0].BuilderId)
+	assert.Equal(t, "https://github.com/example/firmware", records[0].SourceRepo)
+	assert.Equal(t, "sha256:abcd", records[0].ArtifactDigest)
+	assert.False(t, records[1].Verified)
+	assert.Equal(t, "sota", records[1].PackageType)
+	assert.Equal(t, "provenance is required but was not supplied", records[1].Error)
+}
@@ -53,8 +53,10 @@ func getSoftwareBOMList() ([]*pb.SoftwarePackage, error) {
 	var packages []*pb.SoftwarePackage
 
 	switch osType {
-	case "Ubuntu", "Deby":
+	case "Ubuntu", "Debian", "Deby":
 		packages, err = getDebianPackages()
+	case "RHEL":
+		packages, err = getRPMPackages()
 	case "YoctoX86_64", "YoctoARM":
 		packages, err = getRPMPackages()
 		// Add mender version for Yocto systems
@@ -74,7 +76,7 @@ func getSoftwareBOMList() ([]*pb.SoftwarePackage, error) {
 
 // getDebianPackages gets packages using dpkg-query (Ubuntu/Debian)
 func getDebianPackages() ([]*pb.SoftwarePackage, error) {
-	cmd := exec.Command("dpkg-query", "-f", "${Package} ${Version}\n", "-W")
+	cmd := exec.Command("dpkg-query", "-f", "${Package} ${Version} ${Architecture} ${source:Package}\n", "-W")
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("failed to run dpkg-query: %w", err)
@@ -94,7 +96,7 @@ func getRPMPackages() ([]*pb.SoftwarePackage, error) {
 	return parseRPMOutput(string(output)), nil
 }
 
-// parsePackageOutput parses dpkg-query output format "package version"
+// parsePackageOutput parses dpkg-query output format "package version [architecture [source]]"
 func parsePackageOutput(output string) []*pb.SoftwarePackage {
 	lines := strings.Split(strings.TrimSpace(output), "\n")
 	packages := make([]*pb.SoftwarePackage, 0)
@@ -107,11 +109,19 @@ func parsePackageOutput(output string) []*pb.SoftwarePackage {
 
 		parts := strings.Fields(line)
 		if len(parts) >= 2 {
-			packages = append(packages, &pb.SoftwarePackage{
+			pkg := &pb.SoftwarePackage{
 				Name:    parts[0],
 				Version: parts[1],
 				Type:    "deb",
-			})
+			}
+			if len(parts) >= 3 {
+				pkg.Architecture = parts[2]
+			}
+			// Vulnerability advisories of Debian and Ubuntu name the source package.
+			if len(parts) >= 4 && parts[3] != parts[0] {
+				pkg.SourceName = parts[3]
+			}
+			packages = append(packages, pkg)
 		}
 	}
 
@@ -217,9 +227,9 @@ func getCollectionMethod() string {
 	}
 
 	switch osType {
-	case "Ubuntu", "Deby":
+	case "Ubuntu", "Debian", "Deby":
 		return "dpkg-query"
-	case "YoctoX86_64", "YoctoARM":
+	case "RHEL", "YoctoX86_64", "YoctoARM":
 		return "rpm"
 	default:
 		return "unknown"
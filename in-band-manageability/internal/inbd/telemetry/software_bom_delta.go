@@ -0,0 +1,202 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+// sbomRevisionDir holds the software BOMs that were returned to clients, so that later
+// queries can return the changes since one of them.
+var sbomRevisionDir = "/var/intel-manageability/sbom_revisions"
+
+// maxSBOMRevisions is the number of software BOM revisions kept.
+const maxSBOMRevisions = 10
+
+const sbomRevisionPrefix = "sha256:"
+
+// SoftwareBOMRevision returns the revision of a package list.  It does not depend on the
+// order of the packages or on when they were collected.
+func SoftwareBOMRevision(packages []*pb.SoftwarePackage) string {
+	lines := make([]string, 0, len(packages))
+	for _, p := range packages {
+		lines = append(lines, strings.Join([]string{p.GetType(), p.GetName(), p.GetArchitecture(), p.GetVersion()}, "\x00"))
+	}
+	sort.Strings(lines)
+
+	h := sha256.New()
+	for _, line := range lines {
+		h.Write([]byte(line + "\n"))
+	}
+	return sbomRevisionPrefix + hex.EncodeToString(h.Sum(nil))
+}
+
+// StoreSoftwareBOMRevision keeps the software BOM so that it can be used as the base of a delta.
+// The oldest revisions are removed.
+func StoreSoftwareBOMRevision(fs afero.Fs, revision string, swbom *pb.SWBOMInfo) error {
+	path, err := sbomRevisionPath(revision)
+	if err != nil {
+		return err
+	}
+	if utils.IsFileExist(fs, path) {
+		// Refresh the modification time so that the revision is kept.
+		now := time.Now()
+		return fs.Chtimes(path, now, now)
+	}
+
+	data, err := protojson.Marshal(&pb.SWBOMInfo{Packages: swbom.GetPackages()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal software BOM: %w", err)
+	}
+	if err := utils.WriteFile(fs, path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write software BOM revision: %w", err)
+	}
+	return pruneSoftwareBOMRevisions(fs)
+}
+
+// LoadSoftwareBOMRevision returns the packages of a stored revision.  It returns false if the
+// revision is not stored.
+func LoadSoftwareBOMRevision(fs afero.Fs, revision string) ([]*pb.SoftwarePackage, bool, error) {
+	path, err := sbomRevisionPath(revision)
+	if err != nil {
+		return nil, false, err
+	}
+	if !utils.IsFileExist(fs, path) {
+		return nil, false, nil
+	}
+	data, err := utils.ReadFile(fs, path)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read software BOM revision: %w", err)
+	}
+	var swbom pb.SWBOMInfo
+	if err := protojson.Unmarshal(data, &swbom); err != nil {
+		return nil, false, fmt.Errorf("invalid software BOM revision: %w", err)
+	}
+	return swbom.GetPackages(), true, nil
+}
+
+// DiffSoftwareBOM returns the packages added, removed or changed between two package lists,
+// sorted by name.  Packages are identified by type, name and architecture.  Packages that are
+// installed in several versions at once, such as kernels or gpg-pubkey on RPM systems, are also
+// identified by version, so each of their versions is reported as added or removed.
+func DiffSoftwareBOM(previous, current []*pb.SoftwarePackage) []*pb.SoftwarePackageChange {
+	nameKey := func(p *pb.SoftwarePackage) string {
+		return p.GetType() + "\x00" + p.GetName() + "\x00" + p.GetArchitecture()
+	}
+	repeated := map[string]bool{}
+	for _, packages := range [][]*pb.SoftwarePackage{previous, current} {
+		count := make(map[string]int, len(packages))
+		for _, p := range packages {
+			k := nameKey(p)
+			if count[k]++; count[k] > 1 {
+				repeated[k] = true
+			}
+		}
+	}
+	key := func(p *pb.SoftwarePackage) string {
+		k := nameKey(p)
+		if repeated[k] {
+			k += "\x00" + p.GetVersion()
+		}
+		return k
+	}
+	before := make(map[string]*pb.SoftwarePackage, len(previous))
+	for _, p := range previous {
+		before[key(p)] = p
+	}
+
+	var changes []*pb.SoftwarePackageChange
+	seen := make(map[string]bool, len(current))
+	for _, p := range current {
+		k := key(p)
+		seen[k] = true
+		old, ok := before[k]
+		switch {
+		case !ok:
+			changes = append(changes, &pb.SoftwarePackageChange{
+				ChangeType: pb.SoftwarePackageChange_CHANGE_TYPE_ADDED,
+				Package:    p,
+			})
+		case old.GetVersion() != p.GetVersion():
+			changes = append(changes, &pb.SoftwarePackageChange{
+				ChangeType:      pb.SoftwarePackageChange_CHANGE_TYPE_CHANGED,
+				Package:         p,
+				PreviousVersion: old.GetVersion(),
+			})
+		}
+	}
+	for _, p := range previous {
+		if !seen[key(p)] {
+			changes = append(changes, &pb.SoftwarePackageChange{
+				ChangeType: pb.SoftwarePackageChange_CHANGE_TYPE_REMOVED,
+				Package:    p,
+			})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i].GetPackage(), changes[j].GetPackage()
+		if a.GetName() != b.GetName() {
+			return a.GetName() < b.GetName()
+		}
+		if a.GetArchitecture() != b.GetArchitecture() {
+			return a.GetArchitecture() < b.GetArchitecture()
+		}
+		return a.GetVersion() < b.GetVersion()
+	})
+	return changes
+}
+
+func sbomRevisionPath(revision string) (string, error) {
+	digest, ok := strings.CutPrefix(revision, sbomRevisionPrefix)
+	if !ok || len(digest) != sha256.Size*2 {
+		return "", fmt.Errorf("invalid software BOM revision: %q", revision)
+	}
+	if _, err := hex.DecodeString(digest); err != nil {
+		return "", fmt.Errorf("invalid software BOM revision: %q", revision)
+	}
+	return filepath.Join(sbomRevisionDir, digest+".json"), nil
+}
+
+func pruneSoftwareBOMRevisions(fs afero.Fs) error {
+	entries, err := afero.ReadDir(fs, sbomRevisionDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read software BOM revisions: %w", err)
+	}
+	var files []os.FileInfo
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			files = append(files, entry)
+		}
+	}
+	if len(files) <= maxSBOMRevisions {
+		return nil
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].ModTime().Before(files[j].ModTime()) })
+	for _, f := range files[:len(files)-maxSBOMRevisions] {
+		if err := utils.RemoveFile(fs, filepath.Join(sbomRevisionDir, f.Name())); err != nil {
+			log.Printf("[Warning] Failed to remove software BOM revision %s: %v", f.Name(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+func TestSoftwareBOMRevision(t *testing.T) {
+	a := []*pb.SoftwarePackage{
+		{Name: "bash", Version: "5.1-6", Type: "deb", Architecture: "amd64"},
+		{Name: "vim", Version: "8.2", Type: "deb", Architecture: "amd64"},
+	}
+	b := []*pb.SoftwarePackage{a[1], a[0]}
+
+	revision := SoftwareBOMRevision(a)
+	assert.Regexp(t, `^sha256:[0-9a-f]{64}$`, revision)
+	assert.Equal(t, revision, SoftwareBOMRevision(b), "the revision must not depend on the package order")
+
+	changed := []*pb.SoftwarePackage{a[0], {Name: "vim", Version: "9.0", Type: "deb", Architecture: "amd64"}}
+	assert.NotEqual(t, revision, SoftwareBOMRevision(changed))
+}
+
+func TestDiffSoftwareBOM(t *testing.T) {
+	previous := []*pb.SoftwarePackage{
+		{Name: "bash", Version: "5.1-6", Type: "deb", Architecture: "amd64"},
+		{Name: "libc6", Version: "2.35", Type: "deb", Architecture: "amd64"},
+		{Name: "libc6", Version: "2.35", Type: "deb", Architecture: "i386"},
+		{Name: "telnet", Version: "0.17", Type: "deb", Architecture: "amd64"},
+	}
+	current := []*pb.SoftwarePackage{
+		{Name: "bash", Version: "5.1-6", Type: "deb", Architecture: "amd64"},
+		{Name: "curl", Version: "7.81", Type: "deb", Architecture: "amd64"},
+		{Name: "libc6", Version: "2.36", Type: "deb", Architecture: "amd64"},
+		{Name: "libc6", Version: "2.35", Type: "deb", Architecture: "i386"},
+	}
+
+	changes := DiffSoftwareBOM(previous, current)
+	require.Len(t, changes, 3)
+	assert.Equal(t, pb.SoftwarePackageChange_CHANGE_TYPE_ADDED, changes[0].GetChangeType())
+	assert.Equal(t, "curl", changes[0].GetPackage().GetName())
+	assert.Equal(t, pb.SoftwarePackageChange_CHANGE_TYPE_CHANGED, changes[1].GetChangeType())
+	assert.Equal(t, "libc6", changes[1].GetPackage().GetName())
+	assert.Equal(t, "2.36", changes[1].GetPackage().GetVersion())
+	assert.Equal(t, "2.35", changes[1].GetPreviousVersion())
+	assert.Equal(t, pb.SoftwarePackageChange_CHANGE_TYPE_REMOVED, changes[2].GetChangeType())
+	assert.Equal(t, "telnet", changes[2].GetPackage().GetName())
+
+	assert.Empty(t, DiffSoftwareBOM(current, current))
+}
+
+func TestDiffSoftwareBOM_MultipleVersions(t *testing.T) {
+	previous := []*pb.SoftwarePackage{
+		{Name: "kernel", Version: "5.14.0-427", Type: "rpm", Architecture: "x86_64"},
+		{Name: "kernel", Version: "5.14.0-503", Type: "rpm", Architecture: "x86_64"},
+		{Name: "gpg-pubkey", Version: "fd431d51", Type: "rpm", Architecture: ""},
+	}
+	current := []*pb.SoftwarePackage{
+		{Name: "kernel", Version: "5.14.0-503", Type: "rpm", Architecture: "x86_64"},
+		{Name: "kernel", Version: "5.14.0-570", Type: "rpm", Architecture: "x86_64"},
+		{Name: "gpg-pubkey", Version: "fd431d51", Type: "rpm", Architecture: ""},
+		{Name: "gpg-pubkey", Version: "5a6340b3", Type: "rpm", Architecture: ""},
+	}
+
+	changes := DiffSoftwareBOM(previous, current)
+	require.Len(t, changes, 3)
+	assert.Equal(t, pb.SoftwarePackageChange_CHANGE_TYPE_ADDED, changes[0].GetChangeType())
+	assert.Equal(t, "gpg-pubkey", changes[0].GetPackage().GetName())
+	assert.Equal(t, "5a6340b3", changes[0].GetPackage().GetVersion())
+	assert.Equal(t, pb.SoftwarePackageChange_CHANGE_TYPE_REMOVED, changes[1].GetChangeType())
+	assert.Equal(t, "5.14.0-427", changes[1].GetPackage().GetVersion())
+	assert.Equal(t, pb.SoftwarePackageChange_CHANGE_TYPE_ADDED, changes[2].GetChangeType())
+	assert.Equal(t, "5.14.0-570", changes[2].GetPackage().GetVersion())
+
+	assert.Empty(t, DiffSoftwareBOM(current, current))
+}
+
+func TestStoreAndLoadSoftwareBOMRevision(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	packages := []*pb.SoftwarePackage{{Name: "bash", Version: "5.1-6", Type: "deb", Architecture: "amd64"}}
+	revision := SoftwareBOMRevision(packages)
+
+	_, found, err := LoadSoftwareBOMRevision(fs, revision)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, StoreSoftwareBOMRevision(fs, revision, &pb.SWBOMInfo{Packages: packages}))
+	loaded, found, err := LoadSoftwareBOMRevision(fs, revision)
+	require.NoError(t, err)
+	assert.True(t, found)
+	require.Len(t, loaded, 1)
+	assert.Equal(t, "bash", loaded[0].GetName())
+	assert.Equal(t, "amd64", loaded[0].GetArchitecture())
+
+	_, _, err = LoadSoftwareBOMRevision(fs, "sha256:../../etc/passwd")
+	assert.ErrorContains(t, err, "invalid software BOM revision")
+}
+
+func TestStoreSoftwareBOMRevision_KeepsMostRecent(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	var revisions []string
+	for i := 0; i < maxSBOMRevisions+2; i++ {
+		packages := []*pb.SoftwarePackage{{Name: "pkg", Version: fmt.Sprint(i), Type: "deb"}}
+		revision := SoftwareBOMRevision(packages)
+		require.NoError(t, StoreSoftwareBOMRevision(fs, revision, &pb.SWBOMInfo{Packages: packages}))
+		path, err := sbomRevisionPath(revision)
+		require.NoError(t, err)
+		stamp := time.Unix(int64(1000+i), 0)
+		require.NoError(t, fs.Chtimes(path, stamp, stamp))
+		revisions = append(revisions, revision)
+	}
+	// The next store prunes the oldest revisions.
+	packages := []*pb.SoftwarePackage{{Name: "pkg", Version: "last", Type: "deb"}}
+	require.NoError(t, StoreSoftwareBOMRevision(fs, SoftwareBOMRevision(packages), &pb.SWBOMInfo{Packages: packages}))
+
+	entries, err := afero.ReadDir(fs, sbomRevisionDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, maxSBOMRevisions)
+	for _, revision := range revisions[:3] {
+		_, found, err := LoadSoftwareBOMRevision(fs, revision)
+		require.NoError(t, err)
+		assert.False(t, found, revision)
+	}
+}
@@ -0,0 +1,266 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+const noAssertion = "NOASSERTION"
+
+// sbomDocumentInfo describes the system a software BOM document is about.
+type sbomDocumentInfo struct {
+	Hostname string
+	Distro   string // purl namespace of the OS packages, e.g. ubuntu
+	Revision string
+	Created  time.Time
+}
+
+// ExportSoftwareBOM returns the software BOM as an SPDX 2.3 or CycloneDX 1.5 JSON document.
+func ExportSoftwareBOM(swbom *pb.SWBOMInfo, revision string, format pb.SBOMFormat) ([]byte, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	info := sbomDocumentInfo{
+		Hostname: hostname,
+		Distro:   purlNamespace(getOSType()),
+		Revision: revision,
+		Created:  swbom.GetCollectionTimestamp().AsTime(),
+	}
+
+	switch format {
+	case pb.SBOMFormat_SBOM_FORMAT_SPDX_JSON:
+		return json.MarshalIndent(buildSPDXDocument(swbom.GetPackages(), info), "", "  ")
+	case pb.SBOMFormat_SBOM_FORMAT_CYCLONEDX_JSON:
+		return json.MarshalIndent(buildCycloneDXDocument(swbom.GetPackages(), info), "", "  ")
+	default:
+		return nil, fmt.Errorf("unsupported software BOM document format: %v", format)
+	}
+}
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	Supplier         string            `json:"supplier"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	CopyrightText    string            `json:"copyrightText"`
+	Description      string            `json:"description,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func buildSPDXDocument(packages []*pb.SoftwarePackage, info sbomDocumentInfo) spdxDocument {
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              info.Hostname + "-software-bom",
+		DocumentNamespace: "https://spdx.org/spdxdocs/inbd/" + url.PathEscape(info.Hostname) + "/" + strings.TrimPrefix(info.Revision, sbomRevisionPrefix),
+		CreationInfo: spdxCreationInfo{
+			Created:  info.Created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: inbd"},
+		},
+		Packages:      make([]spdxPackage, 0, len(packages)),
+		Relationships: make([]spdxRelationship, 0, len(packages)),
+	}
+
+	for i, p := range packages {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i+1)
+		supplier := noAssertion
+		if p.GetVendor() != "" {
+			supplier = "Organization: " + p.GetVendor()
+		}
+		license := noAssertion
+		if p.GetLicense() != "" {
+			license = p.GetLicense()
+		}
+		pkg := spdxPackage{
+			Name:             p.GetName(),
+			SPDXID:           id,
+			VersionInfo:      p.GetVersion(),
+			Supplier:         supplier,
+			DownloadLocation: noAssertion,
+			FilesAnalyzed:    false,
+			LicenseConcluded: noAssertion,
+			LicenseDeclared:  license,
+			CopyrightText:    noAssertion,
+			Description:      p.GetDescription(),
+		}
+		if purl := packageURL(p, info.Distro); purl != "" {
+			pkg.ExternalRefs = []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  purl,
+			}}
+		}
+		doc.Packages = append(doc.Packages, pkg)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: id,
+		})
+	}
+	return doc
+}
+
+type cycloneDXDocument struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     cycloneDXTools     `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	Type        string             `json:"type"`
+	BOMRef      string             `json:"bom-ref,omitempty"`
+	Name        string             `json:"name"`
+	Version     string             `json:"version,omitempty"`
+	Publisher   string             `json:"publisher,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Licenses    []cycloneDXLicense `json:"licenses,omitempty"`
+	PURL        string             `json:"purl,omitempty"`
+}
+
+type cycloneDXLicense struct {
+	License cycloneDXLicenseName `json:"license"`
+}
+
+type cycloneDXLicenseName struct {
+	Name string `json:"name"`
+}
+
+func buildCycloneDXDocument(packages []*pb.SoftwarePackage, info sbomDocumentInfo) cycloneDXDocument {
+	doc := cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + revisionUUID(info.Revision),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: info.Created.UTC().Format(time.RFC3339),
+			Tools:     cycloneDXTools{Components: []cycloneDXComponent{{Type: "application", Name: "inbd"}}},
+			Component: cycloneDXComponent{Type: "operating-system", Name: info.Hostname},
+		},
+		Components: make([]cycloneDXComponent, 0, len(packages)),
+	}
+
+	for i, p := range packages {
+		purl := packageURL(p, info.Distro)
+		component := cycloneDXComponent{
+			Type:        "library",
+			BOMRef:      purl,
+			Name:        p.GetName(),
+			Version:     p.GetVersion(),
+			Publisher:   p.GetVendor(),
+			Description: p.GetDescription(),
+			PURL:        purl,
+		}
+		if component.BOMRef == "" {
+			component.BOMRef = fmt.Sprintf("package-%d", i+1)
+		}
+		if p.GetLicense() != "" {
+			component.Licenses = []cycloneDXLicense{{License: cycloneDXLicenseName{Name: p.GetLicense()}}}
+		}
+		doc.Components = append(doc.Components, component)
+	}
+	return doc
+}
+
+// packageURL returns the package URL (purl) of a package, e.g. pkg:deb/ubuntu/bash@5.1-6?arch=amd64.
+func packageURL(p *pb.SoftwarePackage, distro string) string {
+	if p.GetName() == "" {
+		return ""
+	}
+	var purl string
+	switch p.GetType() {
+	case "deb", "rpm":
+		purl = "pkg:" + p.GetType() + "/" + url.PathEscape(distro) + "/" + url.PathEscape(p.GetName())
+	default:
+		purl = "pkg:generic/" + url.PathEscape(p.GetName())
+	}
+	if p.GetVersion() != "" {
+		purl += "@" + url.PathEscape(p.GetVersion())
+	}
+	if p.GetArchitecture() != "" {
+		purl += "?arch=" + url.QueryEscape(p.GetArchitecture())
+	}
+	return purl
+}
+
+// purlNamespace returns the purl namespace of the packages of an OS type.
+func purlNamespace(osType string) string {
+	switch {
+	case strings.HasPrefix(osType, "Yocto"):
+		return "yocto"
+	case osType == "RHEL":
+		return "redhat"
+	case osType == "" || osType == "linux":
+		return "linux"
+	default:
+		return strings.ToLower(osType)
+	}
+}
+
+// revisionUUID derives a version 4 style UUID from the revision, so that the same software
+// BOM always gets the same serial number.
+func revisionUUID(revision string) string {
+	sum := sha256.Sum256([]byte(revision))
+	b := sum[:16]
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
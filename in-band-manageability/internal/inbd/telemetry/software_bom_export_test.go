@@ -0,0 +1,95 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+var testExportPackages = []*pb.SoftwarePackage{
+	{Name: "bash", Version: "5.1-6ubuntu1", Type: "deb", Architecture: "amd64", License: "GPL-3.0"},
+	{Name: "mender", Version: "3.4.0", Type: "mender", Vendor: "Mender"},
+}
+
+var testExportInfo = sbomDocumentInfo{
+	Hostname: "edge-01",
+	Distro:   "ubuntu",
+	Revision: "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	Created:  time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+}
+
+func TestBuildSPDXDocument(t *testing.T) {
+	data, err := json.Marshal(buildSPDXDocument(testExportPackages, testExportInfo))
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, "SPDX-2.3", doc["spdxVersion"])
+	assert.Equal(t, "SPDXRef-DOCUMENT", doc["SPDXID"])
+	assert.Equal(t, "https://spdx.org/spdxdocs/inbd/edge-01/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", doc["documentNamespace"])
+	assert.Equal(t, "2025-06-01T12:00:00Z", doc["creationInfo"].(map[string]interface{})["created"])
+
+	packages := doc["packages"].([]interface{})
+	require.Len(t, packages, 2)
+	bash := packages[0].(map[string]interface{})
+	assert.Equal(t, "SPDXRef-Package-1", bash["SPDXID"])
+	assert.Equal(t, "GPL-3.0", bash["licenseDeclared"])
+	assert.Equal(t, "NOASSERTION", bash["supplier"])
+	ref := bash["externalRefs"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "purl", ref["referenceType"])
+	assert.Equal(t, "pkg:deb/ubuntu/bash@5.1-6ubuntu1?arch=amd64", ref["referenceLocator"])
+	assert.Equal(t, "Organization: Mender", packages[1].(map[string]interface{})["supplier"])
+
+	relationships := doc["relationships"].([]interface{})
+	require.Len(t, relationships, 2)
+	assert.Equal(t, "DESCRIBES", relationships[1].(map[string]interface{})["relationshipType"])
+	assert.Equal(t, "SPDXRef-Package-2", relationships[1].(map[string]interface{})["relatedSpdxElement"])
+}
+
+func TestBuildCycloneDXDocument(t *testing.T) {
+	data, err := json.Marshal(buildCycloneDXDocument(testExportPackages, testExportInfo))
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, "CycloneDX", doc["bomFormat"])
+	assert.Equal(t, "1.5", doc["specVersion"])
+	assert.Regexp(t, `^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, doc["serialNumber"])
+
+	components := doc["components"].([]interface{})
+	require.Len(t, components, 2)
+	bash := components[0].(map[string]interface{})
+	assert.Equal(t, "pkg:deb/ubuntu/bash@5.1-6ubuntu1?arch=amd64", bash["purl"])
+	assert.Equal(t, bash["purl"], bash["bom-ref"])
+	assert.Equal(t, "GPL-3.0", bash["licenses"].([]interface{})[0].(map[string]interface{})["license"].(map[string]interface{})["name"])
+	mender := components[1].(map[string]interface{})
+	assert.Equal(t, "pkg:generic/mender@3.4.0", mender["purl"])
+	assert.Equal(t, "Mender", mender["publisher"])
+
+	// The serial number is stable for a revision.
+	again := buildCycloneDXDocument(testExportPackages, testExportInfo)
+	assert.Equal(t, doc["serialNumber"], again.SerialNumber)
+}
+
+func TestExportSoftwareBOM_UnsupportedFormat(t *testing.T) {
+	_, err := ExportSoftwareBOM(&pb.SWBOMInfo{}, "", pb.SBOMFormat_SBOM_FORMAT_PACKAGES)
+	assert.ErrorContains(t, err, "unsupported")
+}
+
+func TestPurlNamespace(t *testing.T) {
+	assert.Equal(t, "ubuntu", purlNamespace("Ubuntu"))
+	assert.Equal(t, "debian", purlNamespace("Debian"))
+	assert.Equal(t, "redhat", purlNamespace("RHEL"))
+	assert.Equal(t, "yocto", purlNamespace("YoctoX86_64"))
+	assert.Equal(t, "linux", purlNamespace("linux"))
+}
@@ -0,0 +1,125 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/spf13/afero"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+// sbomDocumentChunkSize is the number of bytes of an SPDX or CycloneDX document sent per chunk.
+const sbomDocumentChunkSize = 64 * 1024
+
+// maxSBOMPageSize is the largest number of packages a client may request per chunk.
+const maxSBOMPageSize = 1000
+
+// ErrInvalidSoftwareBOMRequest is returned for software BOM stream requests that can not be served.
+var ErrInvalidSoftwareBOMRequest = errors.New("invalid software BOM request")
+
+// getSoftwareBOM collects the software BOM.  It is a variable for testing.
+var getSoftwareBOM = GetSoftwareBOM
+
+// StreamSoftwareBOM collects the software BOM and passes it to send in pages.  With a since
+// revision that is still stored, only the changes since that revision are sent; otherwise all
+// packages are sent and the chunks are not marked as a delta.
+func StreamSoftwareBOM(fs afero.Fs, req *pb.StreamSoftwareBOMRequest, send func(*pb.SoftwareBOMChunk) error) error {
+	pageSize := int(req.GetPageSize())
+	if pageSize < 0 || pageSize > maxSBOMPageSize {
+		return fmt.Errorf("%w: page size must be between 0 and %d", ErrInvalidSoftwareBOMRequest, maxSBOMPageSize)
+	}
+	if pageSize == 0 {
+		pageSize = maxPackagesPerChunk
+	}
+	format := req.GetFormat()
+	document := format == pb.SBOMFormat_SBOM_FORMAT_SPDX_JSON || format == pb.SBOMFormat_SBOM_FORMAT_CYCLONEDX_JSON
+	if !document && format != pb.SBOMFormat_SBOM_FORMAT_UNSPECIFIED && format != pb.SBOMFormat_SBOM_FORMAT_PACKAGES {
+		return fmt.Errorf("%w: unknown format %v", ErrInvalidSoftwareBOMRequest, format)
+	}
+	if req.GetSinceRevision() != "" {
+		if document {
+			return fmt.Errorf("%w: a since revision can not be used with an SPDX or CycloneDX document", ErrInvalidSoftwareBOMRequest)
+		}
+		if _, err := sbomRevisionPath(req.GetSinceRevision()); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidSoftwareBOMRequest, err)
+		}
+	}
+
+	swbom, err := getSoftwareBOM()
+	if err != nil {
+		return err
+	}
+	revision := SoftwareBOMRevision(swbom.GetPackages())
+	if err := StoreSoftwareBOMRevision(fs, revision, swbom); err != nil {
+		log.Printf("[Warning] Failed to store software BOM revision: %v", err)
+	}
+
+	header := func(page, totalPages int) *pb.SoftwareBOMChunk {
+		return &pb.SoftwareBOMChunk{
+			StatusCode:          200,
+			Revision:            revision,
+			Page:                int32(page),
+			TotalPages:          int32(totalPages),
+			TotalPackages:       int32(len(swbom.GetPackages())),
+			CollectionTimestamp: swbom.GetCollectionTimestamp(),
+			CollectionMethod:    swbom.GetCollectionMethod(),
+		}
+	}
+
+	if document {
+		data, err := ExportSoftwareBOM(swbom, revision, format)
+		if err != nil {
+			return err
+		}
+		totalPages := max(1, (len(data)+sbomDocumentChunkSize-1)/sbomDocumentChunkSize)
+		for page := 1; page <= totalPages; page++ {
+			chunk := header(page, totalPages)
+			chunk.Document = data[(page-1)*sbomDocumentChunkSize : min(len(data), page*sbomDocumentChunkSize)]
+			if err := send(chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if req.GetSinceRevision() != "" {
+		previous, found, err := LoadSoftwareBOMRevision(fs, req.GetSinceRevision())
+		if err != nil {
+			log.Printf("[Warning] Failed to load software BOM revision %s: %v", req.GetSinceRevision(), err)
+		}
+		if found {
+			changes := DiffSoftwareBOM(previous, swbom.GetPackages())
+			totalPages := max(1, (len(changes)+pageSize-1)/pageSize)
+			for page := 1; page <= totalPages; page++ {
+				chunk := header(page, totalPages)
+				chunk.Delta = true
+				chunk.Changes = changes[min(len(changes), (page-1)*pageSize):min(len(changes), page*pageSize)]
+				if err := send(chunk); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		log.Printf("Software BOM revision %s is not stored; sending all packages", req.GetSinceRevision())
+	}
+
+	pages := ChunkSoftwareBOM(swbom.GetPackages(), pageSize)
+	if len(pages) == 0 {
+		pages = [][]*pb.SoftwarePackage{{}}
+	}
+	for i, packages := range pages {
+		chunk := header(i+1, len(pages))
+		chunk.Packages = packages
+		if err := send(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+func withSoftwareBOM(t *testing.T, packages []*pb.SoftwarePackage) {
+	original := getSoftwareBOM
+	t.Cleanup(func() { getSoftwareBOM = original })
+	getSoftwareBOM = func() (*pb.SWBOMInfo, error) {
+		return &pb.SWBOMInfo{
+			Packages:            packages,
+			CollectionTimestamp: timestamppb.Now(),
+			CollectionMethod:    "dpkg-query",
+		}, nil
+	}
+}
+
+func testPackages(n int) []*pb.SoftwarePackage {
+	packages := make([]*pb.SoftwarePackage, 0, n)
+	for i := 0; i < n; i++ {
+		packages = append(packages, &pb.SoftwarePackage{Name: fmt.Sprintf("pkg%03d", i), Version: "1.0", Type: "deb", Architecture: "amd64"})
+	}
+	return packages
+}
+
+func collectChunks(t *testing.T, fs afero.Fs, req *pb.StreamSoftwareBOMRequest) []*pb.SoftwareBOMChunk {
+	var chunks []*pb.SoftwareBOMChunk
+	require.NoError(t, StreamSoftwareBOM(fs, req, func(chunk *pb.SoftwareBOMChunk) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}))
+	return chunks
+}
+
+func TestStreamSoftwareBOM_Pages(t *testing.T) {
+	packages := testPackages(25)
+	withSoftwareBOM(t, packages)
+
+	chunks := collectChunks(t, afero.NewMemMapFs(), &pb.StreamSoftwareBOMRequest{PageSize: 10})
+	require.Len(t, chunks, 3)
+	var names []string
+	for i, chunk := range chunks {
+		assert.Equal(t, int32(200), chunk.GetStatusCode())
+		assert.Equal(t, int32(i+1), chunk.GetPage())
+		assert.Equal(t, int32(3), chunk.GetTotalPages())
+		assert.Equal(t, int32(25), chunk.GetTotalPackages())
+		assert.Equal(t, SoftwareBOMRevision(packages), chunk.GetRevision())
+		assert.False(t, chunk.GetDelta())
+		for _, p := range chunk.GetPackages() {
+			names = append(names, p.GetName())
+		}
+	}
+	assert.Len(t, chunks[2].GetPackages(), 5)
+	assert.Len(t, names, 25)
+}
+
+func TestStreamSoftwareBOM_EmptyList(t *testing.T) {
+	withSoftwareBOM(t, nil)
+	chunks := collectChunks(t, afero.NewMemMapFs(), &pb.StreamSoftwareBOMRequest{})
+	require.Len(t, chunks, 1)
+	assert.Equal(t, int32(1), chunks[0].GetTotalPages())
+	assert.Empty(t, chunks[0].GetPackages())
+}
+
+func TestStreamSoftwareBOM_Delta(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	before := testPackages(3)
+	withSoftwareBOM(t, before)
+	first := collectChunks(t, fs, &pb.StreamSoftwareBOMRequest{})
+	revision := first[0].GetRevision()
+
+	after := []*pb.SoftwarePackage{
+		before[0],
+		{Name: "pkg001", Version: "2.0", Type: "deb", Architecture: "amd64"},
+		{Name: "pkg100", Version: "1.0", Type: "deb", Architecture: "amd64"},
+	}
+	withSoftwareBOM(t, after)
+
+	chunks := collectChunks(t, fs, &pb.StreamSoftwareBOMRequest{SinceRevision: revision})
+	require.Len(t, chunks, 1)
+	assert.True(t, chunks[0].GetDelta())
+	assert.Equal(t, SoftwareBOMRevision(after), chunks[0].GetRevision())
+	assert.Empty(t, chunks[0].GetPackages())
+	changes := chunks[0].GetChanges()
+	require.Len(t, changes, 3)
+	assert.Equal(t, pb.SoftwarePackageChange_CHANGE_TYPE_CHANGED, changes[0].GetChangeType())
+	assert.Equal(t, pb.SoftwarePackageChange_CHANGE_TYPE_REMOVED, changes[1].GetChangeType())
+	assert.Equal(t, pb.SoftwarePackageChange_CHANGE_TYPE_ADDED, changes[2].GetChangeType())
+
+	// Nothing changed since the current revision.
+	chunks = collectChunks(t, fs, &pb.StreamSoftwareBOMRequest{SinceRevision: chunks[0].GetRevision()})
+	require.Len(t, chunks, 1)
+	assert.True(t, chunks[0].GetDelta())
+	assert.Empty(t, chunks[0].GetChanges())
+}
+
+func TestStreamSoftwareBOM_UnknownRevisionSendsAllPackages(t *testing.T) {
+	withSoftwareBOM(t, testPackages(2))
+	chunks := collectChunks(t, afero.NewMemMapFs(), &pb.StreamSoftwareBOMRequest{
+		SinceRevision: SoftwareBOMRevision(testPackages(5)),
+	})
+	require.Len(t, chunks, 1)
+	assert.False(t, chunks[0].GetDelta())
+	assert.Len(t, chunks[0].GetPackages(), 2)
+}
+
+func TestStreamSoftwareBOM_Document(t *testing.T) {
+	withSoftwareBOM(t, testPackages(2000))
+
+	chunks := collectChunks(t, afero.NewMemMapFs(), &pb.StreamSoftwareBOMRequest{Format: pb.SBOMFormat_SBOM_FORMAT_CYCLONEDX_JSON})
+	require.Greater(t, len(chunks), 1)
+	var buf bytes.Buffer
+	for _, chunk := range chunks {
+		assert.Empty(t, chunk.GetPackages())
+		assert.LessOrEqual(t, len(chunk.GetDocument()), sbomDocumentChunkSize)
+		buf.Write(chunk.GetDocument())
+	}
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "CycloneDX", doc["bomFormat"])
+	assert.Len(t, doc["components"], 2000)
+}
+
+func TestStreamSoftwareBOM_InvalidRequests(t *testing.T) {
+	withSoftwareBOM(t, testPackages(1))
+	revision := SoftwareBOMRevision(testPackages(1))
+
+	for _, req := range []*pb.StreamSoftwareBOMRequest{
+		{PageSize: -1},
+		{PageSize: maxSBOMPageSize + 1},
+		{SinceRevision: "abc"},
+		{SinceRevision: revision, Format: pb.SBOMFormat_SBOM_FORMAT_SPDX_JSON},
+		{Format: pb.SBOMFormat(42)},
+	} {
+		err := StreamSoftwareBOM(afero.NewMemMapFs(), req, func(*pb.SoftwareBOMChunk) error { return nil })
+		assert.ErrorIs(t, err, ErrInvalidSoftwareBOMRequest, req.String())
+	}
+}
+
+func TestStreamSoftwareBOM_CollectionError(t *testing.T) {
+	original := getSoftwareBOM
+	defer func() { getSoftwareBOM = original }()
+	getSoftwareBOM = func() (*pb.SWBOMInfo, error) { return nil, errors.New("dpkg-query failed") }
+
+	err := StreamSoftwareBOM(afero.NewMemMapFs(), &pb.StreamSoftwareBOMRequest{}, func(*pb.SoftwareBOMChunk) error { return nil })
+	assert.ErrorContains(t, err, "dpkg-query failed")
+	assert.NotErrorIs(t, err, ErrInvalidSoftwareBOMRequest)
+}
@@ -74,6 +74,14 @@ func TestParsePackageOutput(t *testing.T) {
 				{Name: "vim", Version: "8.2.0716", Type: "deb"},
 			},
 		},
+		{
+			name:  "architecture and source package",
+			input: "libssl3 3.0.2-0ubuntu1.15 amd64 openssl\nopenssl 3.0.2-0ubuntu1.15 amd64 openssl",
+			expected: []*pb.SoftwarePackage{
+				{Name: "libssl3", Version: "3.0.2-0ubuntu1.15", Type: "deb", Architecture: "amd64", SourceName: "openssl"},
+				{Name: "openssl", Version: "3.0.2-0ubuntu1.15", Type: "deb", Architecture: "amd64"},
+			},
+		},
 		{
 			name:     "empty input",
 			input:    "",
@@ -48,6 +48,50 @@ func (q *QueryHandler) HandleQuery(option string) (*pb.QueryData, error) {
 			Values:    &pb.QueryData_Firmware{Firmware: fw},
 		}, nil
 
+	case "fwcomponents", "firmware-components":
+		components, err := GetFirmwareComponents()
+		if err != nil {
+			return nil, err
+		}
+		return &pb.QueryData{
+			Type:      "firmware_components",
+			Timestamp: timestamp,
+			Values:    &pb.QueryData_FirmwareComponents{FirmwareComponents: components},
+		}, nil
+
+	case "auditlog", "audit-log":
+		auditLog, err := GetAuditLog()
+		if err != nil {
+			return nil, err
+		}
+		return &pb.QueryData{
+			Type:      "audit_log",
+			Timestamp: timestamp,
+			Values:    &pb.QueryData_AuditLog{AuditLog: auditLog},
+		}, nil
+
+	case "provenance":
+		provenanceInfo, err := GetProvenance()
+		if err != nil {
+			return nil, err
+		}
+		return &pb.QueryData{
+			Type:      "provenance",
+			Timestamp: timestamp,
+			Values:    &pb.QueryData_Provenance{Provenance: provenanceInfo},
+		}, nil
+
+	case "vulnerabilities":
+		vulnerabilities, err := GetVulnerabilities()
+		if err != nil {
+			return nil, err
+		}
+		return &pb.QueryData{
+			Type:      "vulnerabilities",
+			Timestamp: timestamp,
+			Values:    &pb.QueryData_Vulnerabilities{Vulnerabilities: vulnerabilities},
+		}, nil
+
 	case "os":
 		osInfo, err := GetOSInfo()
 		if err != nil {
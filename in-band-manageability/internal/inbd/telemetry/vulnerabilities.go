@@ -0,0 +1,57 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/vulnerability"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetVulnerabilities matches the software BOM against the loaded vulnerability database.
+func GetVulnerabilities() (*pb.VulnerabilitiesInfo, error) {
+	return getVulnerabilities(afero.NewOsFs())
+}
+
+func getVulnerabilities(fs afero.Fs) (*pb.VulnerabilitiesInfo, error) {
+	db, err := vulnerability.ReadDatabase(fs)
+	if err != nil {
+		return nil, err
+	}
+	swbom, err := getSoftwareBOM()
+	if err != nil {
+		return nil, err
+	}
+
+	findings := vulnerability.Match(db, swbom.GetPackages(), vulnerability.ReadOSRelease(fs))
+	return &pb.VulnerabilitiesInfo{
+		DatabaseFormat: db.Format,
+		DatabaseSource: db.Source,
+		DatabaseLoaded: timestamppb.New(db.LoadedAt),
+		Advisories:     int32(len(db.Advisories)),
+		Findings:       convertVulnerabilityFindings(findings),
+	}, nil
+}
+
+// convertVulnerabilityFindings converts findings into their protobuf representation.
+func convertVulnerabilityFindings(findings []vulnerability.Finding) []*pb.VulnerabilityFinding {
+	result := make([]*pb.VulnerabilityFinding, 0, len(findings))
+	for _, f := range findings {
+		result = append(result, &pb.VulnerabilityFinding{
+			Id:               f.ID,
+			Aliases:          f.Aliases,
+			Package:          f.Package,
+			Architecture:     f.Architecture,
+			InstalledVersion: f.InstalledVersion,
+			FixedVersion:     f.FixedVersion,
+			Severity:         f.Severity,
+			CvssScore:        f.CVSSScore,
+			Summary:          f.Summary,
+		})
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package telemetry
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/vulnerability"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+func TestGetVulnerabilities(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	loaded := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	db := vulnerability.Database{
+		Format:   vulnerability.FormatOSV,
+		Source:   "ubuntu-osv.zip",
+		LoadedAt: loaded,
+		Advisories: []vulnerability.Advisory{{
+			ID:        "UBUNTU-CVE-2024-0001",
+			Aliases:   []string{"CVE-2024-0001"},
+			Summary:   "openssl: buffer overflow",
+			Severity:  vulnerability.SeverityHigh,
+			CVSSScore: 9.8,
+			Affected: []vulnerability.AffectedPackage{{
+				Ecosystem: "Ubuntu:22.04:LTS",
+				Name:      "openssl",
+				Ranges:    []vulnerability.VersionRange{{Introduced: "0", Fixed: "3.0.2-0ubuntu1.15"}},
+			}},
+		}},
+	}
+	data, err := json.Marshal(db)
+	require.NoError(t, err)
+	require.NoError(t, afero.WriteFile(fs, vulnerability.DatabasePath, data, 0644))
+	require.NoError(t, afero.WriteFile(fs, "/etc/os-release", []byte("ID=ubuntu\nVERSION_ID=\"22.04\"\n"), 0644))
+	withSoftwareBOM(t, []*pb.SoftwarePackage{
+		{Name: "openssl", Version: "3.0.2-0ubuntu1.14", Architecture: "amd64", Type: "deb"},
+		{Name: "bash", Version: "5.1-6ubuntu1", Architecture: "amd64", Type: "deb"},
+	})
+
+	info, err := getVulnerabilities(fs)
+	require.NoError(t, err)
+	assert.Equal(t, "osv", info.GetDatabaseFormat())
+	assert.Equal(t, "ubuntu-osv.zip", info.GetDatabaseSource())
+	assert.Equal(t, loaded, info.GetDatabaseLoaded().AsTime())
+	assert.Equal(t, int32(1), info.GetAdvisories())
+	require.Len(t, info.GetFindings(), 1)
+	finding := info.GetFindings()[0]
+	assert.Equal(t, "UBUNTU-CVE-2024-0001", finding.GetId())
+	assert.Equal(t, []string{"CVE-2024-0001"}, finding.GetAliases())
+	assert.Equal(t, "openssl", finding.GetPackage())
+	assert.Equal(t, "3.0.2-0ubuntu1.14", finding.GetInstalledVersion())
+	assert.Equal(t, "3.0.2-0ubuntu1.15", finding.GetFixedVersion())
+	assert.Equal(t, "high", finding.GetSeverity())
+	assert.InDelta(t, 9.8, finding.GetCvssScore(), 0.001)
+}
+
+func TestGetVulnerabilities_NoDatabase(t *testing.T) {
+	_, err := getVulnerabilities(afero.NewMemMapFs())
+	assert.ErrorIs(t, err, vulnerability.ErrNoDatabase)
+}
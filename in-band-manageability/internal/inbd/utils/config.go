@@ -11,7 +11,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"syscall"
 
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/auth"
 	"github.com/spf13/afero"
 )
 
@@ -21,6 +24,37 @@ type Configurations struct {
 		TrustedRepositories    []string `json:"trustedRepositories"`
 		ProceedWithoutRollback bool     `json:"proceedWithoutRollback"`
 	} `json:"os_updater"`
+	CommandAllowlist []common.CommandRule `json:"command_allowlist,omitempty"`
+	Authorization    *auth.Config         `json:"authorization,omitempty"`
+	Provenance       *ProvenanceConfig    `json:"provenance,omitempty"`
+	Network          *NetworkConfig       `json:"network,omitempty"`
+}
+
+// ProvenanceConfig is the supply-chain provenance policy for update and configuration packages.
+type ProvenanceConfig struct {
+	// RequiredFor lists the package types (sota, fota, config) that must come with provenance.
+	// Provenance supplied for other package types is still verified.
+	RequiredFor []string `json:"required_for"`
+	// TrustRoot is a PEM file with the public keys and CA certificates that may sign provenance.
+	TrustRoot string `json:"trust_root"`
+	// AllowedBuilders are prefixes of the builder IDs accepted in SLSA provenance.  Empty allows any builder.
+	AllowedBuilders []string `json:"allowed_builders,omitempty"`
+	// AllowedSourceRepos are prefixes of the source repositories accepted in SLSA provenance.  Empty allows any source.
+	AllowedSourceRepos []string `json:"allowed_source_repos,omitempty"`
+	// AllowedSigners are the certificate identities accepted as signers of provenance.  Empty accepts
+	// any certificate that chains to the trust root.  Keys in the trust root are not affected.
+	AllowedSigners []ProvenanceSigner `json:"allowed_signers,omitempty"`
+}
+
+// ProvenanceSigner is a certificate identity, as issued by Sigstore, that may sign provenance.
+type ProvenanceSigner struct {
+	// SAN is the subject alternative name (URI or email address) of the signing certificate.
+	SAN string `json:"san"`
+	// OIDCIssuer is the OIDC issuer recorded in the signing certificate.
+	OIDCIssuer string `json:"oidc_issuer"`
+	// Builders are prefixes of the builder IDs the signer may attest to.  Empty allows any builder
+	// that AllowedBuilders allows.
+	Builders []string `json:"builders,omitempty"`
 }
 
 // LoadConfig loads the XML configuration file
@@ -66,3 +100,53 @@ func IsProceedWithoutRollback(config *Configurations) bool {
 	log.Printf("Checking if rollback is allowed")
 	return config.OSUpdater.ProceedWithoutRollback
 }
+
+// ApplyCommandAllowlist loads the configuration file and passes its command allowlist to the executor.
+func ApplyCommandAllowlist(fs afero.Fs, filePath string) error {
+	config, err := LoadConfig(fs, filePath)
+	if err != nil {
+		return err
+	}
+	if err := common.SetCommandAllowlist(config.CommandAllowlist); err != nil {
+		return fmt.Errorf("invalid command allowlist: %w", err)
+	}
+	log.Printf("Loaded %d command allowlist rules from configuration", len(config.CommandAllowlist))
+	return nil
+}
+
+// CheckRootOnly checks that only root can write the configuration file.  The command allowlist
+// and the authorization policy are only changed through a signed load, so the file is only
+// trusted when it is owned by root and is not writable by group or others.
+func CheckRootOnly(fs afero.Fs, filePath string) error {
+	info, err := fs.Stat(filePath)
+	if err != nil {
+		return fmt.Errorf("error reading configuration file: %w", err)
+	}
+	if info.Mode().Perm()&0022 != 0 {
+		return fmt.Errorf("%s is writable by users other than root (mode %v)", filePath, info.Mode().Perm())
+	}
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok && stat.Uid != 0 {
+		return fmt.Errorf("%s is owned by uid %d, not root", filePath, stat.Uid)
+	}
+	return nil
+}
+
+// ApplyAuthorization loads the configuration file and sets the RPC authorization policy.
+// Without an authorization section, every caller that can reach the socket is allowed.
+func ApplyAuthorization(fs afero.Fs, filePath string) error {
+	config, err := LoadConfig(fs, filePath)
+	if err != nil {
+		return err
+	}
+	policy, err := auth.NewPolicy(config.Authorization)
+	if err != nil {
+		return fmt.Errorf("invalid authorization policy: %w", err)
+	}
+	auth.SetPolicy(policy)
+	if policy == nil {
+		log.Println("No authorization policy configured; all callers on the socket are allowed")
+	} else {
+		log.Printf("Loaded authorization policy with %d bindings", len(config.Authorization.Bindings))
+	}
+	return nil
+}
@@ -8,15 +8,19 @@ package utils
 
 import (
 	"archive/tar"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
-	"strconv"
+	"reflect"
+	"slices"
 	"strings"
 	"sync"
 
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/auth"
 	"github.com/spf13/afero"
 )
 
@@ -26,8 +30,16 @@ var (
 	schemaFilePath                     = "/usr/share/inbd_schema.json"
 )
 
+// protectedConfigKeys are the top-level configuration keys that control what inbd executes,
+// who may call it, which packages it trusts and where it downloads them from.  They can only be
+// changed by a signed configuration.
+var protectedConfigKeys = []string{"command_allowlist", "authorization", "provenance", "network"}
+
+// ConfigOperation reads and changes the configuration file.  Every change is recorded in the
+// configuration history with the Caller.
 type ConfigOperation struct {
-	mu sync.RWMutex
+	mu     sync.RWMutex
+	Caller string
 }
 
 // LoadConfigCommand copies the file at uri to /etc/intel_manageability.conf
@@ -58,43 +70,32 @@ func (c *ConfigOperation) LoadConfigCommand(uri, signature, hashAlgorithm string
 	// Determine if the input is a tar file
 	isTar := strings.HasSuffix(strings.ToLower(uri), ".tar")
 
-	var input []byte
-
+	// The configuration is read once, so that the bytes that are verified are the bytes that are
+	// written.
+	raw, err := ReadFile(fs.Fs, uri)
+	if err != nil {
+		return fmt.Errorf("failed to read new config: %w", err)
+	}
+	input := raw
 	if isTar {
-		// Extract intel_manageability.conf from tar to a temp file
-		tarFile, err := fs.Open(uri)
+		input, err = readConfigFromTar(raw)
 		if err != nil {
-			return fmt.Errorf("failed to open tar file: %w", err)
+			return err
 		}
-		defer tarFile.Close()
+	}
 
-		tr := tar.NewReader(tarFile)
-		found := false
-		for {
-			hdr, err := tr.Next()
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				return fmt.Errorf("failed to read tar file: %w", err)
-			}
-			if filepath.Base(hdr.Name) == "intel_manageability.conf" {
-				input, err = io.ReadAll(tr)
-				if err != nil {
-					return fmt.Errorf("failed to read config from tar: %w", err)
-				}
-				found = true
-				break
-			}
-		}
-		if !found {
-			return fmt.Errorf("intel_manageability.conf not found in tar archive")
+	// The command allowlist, the authorization policy, the provenance policy and the network
+	// profile can only be changed by a configuration signed with the certificate of the device.
+	protectedChanged, err := protectedConfigChanged(fs.Fs, input)
+	if err != nil {
+		return err
+	}
+	if protectedChanged {
+		if signature == "" {
+			return errors.New("a signature is required to change the command allowlist, the authorization policy, the provenance policy or the network profile")
 		}
-	} else {
-		var err error
-		input, err = ReadFile(fs.Fs, uri)
-		if err != nil {
-			return fmt.Errorf("failed to read new config: %w", err)
+		if err := verifyProtectedConfigSignature(fs.Fs, signature, raw, isTar, ParseHashAlgorithm(finalHashAlgorithm)); err != nil {
+			return fmt.Errorf("signature verification failed: %w", err)
 		}
 	}
 
@@ -126,12 +127,66 @@ func (c *ConfigOperation) LoadConfigCommand(uri, signature, hashAlgorithm string
 	}
 
 	// Only write if valid
+	previous, _ := ReadFile(fs.Fs, configFilePath)
 	if err := WriteFile(fs.Fs, configFilePath, input, 0644); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
+	c.recordRevision(fs.Fs, ConfigOperationLoad, "load "+uri, previous, input)
+
+	if protectedChanged {
+		if err := ApplyCommandAllowlist(fs.Fs, configFilePath); err != nil {
+			return err
+		}
+		if err := ApplyAuthorization(fs.Fs, configFilePath); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// readConfigFromTar returns intel_manageability.conf from a configuration package.
+func readConfigFromTar(raw []byte) ([]byte, error) {
+	tr := tar.NewReader(bytes.NewReader(raw))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("intel_manageability.conf not found in tar archive")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tar file: %w", err)
+		}
+		if filepath.Base(hdr.Name) == "intel_manageability.conf" {
+			input, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read config from tar: %w", err)
+			}
+			return input, nil
+		}
+	}
+}
+
+// verifyProtectedConfigSignature verifies the signature of a configuration that changes protected
+// keys against the certificate of the device only.  A package that carries a certificate of its
+// own is rejected, as it could be signed by anyone.
+func verifyProtectedConfigSignature(fs afero.Fs, signature string, raw []byte, isTar bool, hashAlgorithm *HashAlgorithm) error {
+	if isTar {
+		tr := tar.NewReader(bytes.NewReader(raw))
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read tar file: %w", err)
+			}
+			if isPEMFile(filepath.Base(hdr.Name)) {
+				return fmt.Errorf("a configuration that changes protected keys must be signed with the device certificate, not with the certificate %s in the package", hdr.Name)
+			}
+		}
+	}
+	return verifyTrustedSignature(fs, signature, raw, hashAlgorithm)
+}
+
 // GetConfigCommand returns the value for a key in /etc/intel_manageability.conf
 func (c *ConfigOperation) GetConfigCommand(key string) (string, string, error) {
 	c.mu.RLock() // Use read lock instead of exclusive lock
@@ -181,7 +236,8 @@ func (c *ConfigOperation) GetConfigCommand(key string) (string, string, error) {
 	return strings.Join(results, ";"), errorStr, nil
 }
 
-// SetConfigCommand expects keyValue(s) in "key:value;key2:value2" format.
+// SetConfigCommand expects keyValue(s) in "key:value;key2:value2" format.  Values are converted
+// to the type the schema gives the key.
 func (c *ConfigOperation) SetConfigCommand(keyValues string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -195,6 +251,7 @@ func (c *ConfigOperation) SetConfigCommand(keyValues string) error {
 	if err := json.Unmarshal(data, &m); err != nil {
 		return fmt.Errorf("invalid config JSON: %w", err)
 	}
+	schema := loadConfigSchema(fs.Fs)
 
 	pairs := strings.Split(keyValues, ";")
 	for _, keyValue := range pairs {
@@ -207,13 +264,15 @@ func (c *ConfigOperation) SetConfigCommand(keyValues string) error {
 			return errors.New("invalid format, expected key:value")
 		}
 		key, value := parts[0], parts[1]
+		if isProtectedConfigPath(key) {
+			return fmt.Errorf("%s can only be changed with a signed LoadConfig", key)
+		}
 
-		// Try to parse value as int, bool, or leave as string
-		var v interface{} = value
-		if i, err := strconv.Atoi(value); err == nil {
-			v = i
-		} else if b, err := strconv.ParseBool(value); err == nil {
-			v = b
+		// The type of the value comes from the schema
+		current, getErr := getJSONPath(m, key)
+		v, err := coerceConfigValue(schema, key, value, current, getErr == nil)
+		if err != nil {
+			return err
 		}
 
 		if err := setJSONPath(m, key, v); err != nil {
@@ -231,6 +290,7 @@ func (c *ConfigOperation) SetConfigCommand(keyValues string) error {
 	if err := WriteFile(fs.Fs, configFilePath, out, 0644); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
+	c.recordRevision(fs.Fs, ConfigOperationSet, ConfigOperationSet+" "+keyValues, data, out)
 	return nil
 }
 
@@ -248,6 +308,7 @@ func (c *ConfigOperation) AppendConfigCommand(keyValues string) error {
 	if err := json.Unmarshal(data, &m); err != nil {
 		return fmt.Errorf("invalid config JSON: %w", err)
 	}
+	schema := loadConfigSchema(fs.Fs)
 
 	pairs := strings.Split(keyValues, ";")
 	for _, keyValue := range pairs {
@@ -271,7 +332,11 @@ func (c *ConfigOperation) AppendConfigCommand(keyValues string) error {
 		if !ok {
 			return errors.New("target is not a list")
 		}
-		list = append(list, value)
+		item, err := coerceConfigItem(schema, key, value)
+		if err != nil {
+			return err
+		}
+		list = append(list, item)
 		if err := setJSONPath(m, key, list); err != nil {
 			return err
 		}
@@ -287,6 +352,7 @@ func (c *ConfigOperation) AppendConfigCommand(keyValues string) error {
 	if err := WriteFile(fs.Fs, configFilePath, out, 0644); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
+	c.recordRevision(fs.Fs, ConfigOperationAppend, ConfigOperationAppend+" "+keyValues, data, out)
 	return nil
 }
 
@@ -304,6 +370,7 @@ func (c *ConfigOperation) RemoveConfigCommand(keyValues string) error {
 	if err := json.Unmarshal(data, &m); err != nil {
 		return fmt.Errorf("invalid config JSON: %w", err)
 	}
+	schema := loadConfigSchema(fs.Fs)
 
 	pairs := strings.Split(keyValues, ";")
 	for _, keyValue := range pairs {
@@ -327,9 +394,13 @@ func (c *ConfigOperation) RemoveConfigCommand(keyValues string) error {
 		if !ok {
 			return errors.New("target is not a list")
 		}
+		item, err := coerceConfigItem(schema, key, value)
+		if err != nil {
+			return err
+		}
 		newList := make([]interface{}, 0)
 		for _, v := range list {
-			if v != value {
+			if !reflect.DeepEqual(v, item) {
 				newList = append(newList, v)
 			}
 		}
@@ -348,11 +419,49 @@ func (c *ConfigOperation) RemoveConfigCommand(keyValues string) error {
 	if err := WriteFile(fs.Fs, configFilePath, out, 0644); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
+	c.recordRevision(fs.Fs, ConfigOperationRemove, ConfigOperationRemove+" "+keyValues, data, out)
 	return nil
 }
 
 // --- Helpers ---
 
+func isProtectedConfigPath(path string) bool {
+	first := strings.TrimSpace(strings.Split(path, ".")[0])
+	return slices.Contains(protectedConfigKeys, first)
+}
+
+// protectedConfigChanged checks if the new configuration changes the command allowlist, the
+// authorization policy, the provenance policy or the network profile of the current configuration.  The allowlist
+// and the authorization policy are validated as well, so that an invalid configuration is rejected before it is written.
+func protectedConfigChanged(fs afero.Fs, input []byte) (bool, error) {
+	var newConfig Configurations
+	if err := json.Unmarshal(input, &newConfig); err != nil {
+		return false, fmt.Errorf("invalid config JSON: %w", err)
+	}
+	if err := common.ValidateCommandAllowlist(newConfig.CommandAllowlist); err != nil {
+		return false, fmt.Errorf("invalid command allowlist: %w", err)
+	}
+	if _, err := auth.NewPolicy(newConfig.Authorization); err != nil {
+		return false, fmt.Errorf("invalid authorization policy: %w", err)
+	}
+
+	var current Configurations
+	if currentConfig, err := LoadConfig(fs, configFilePath); err == nil {
+		current = *currentConfig
+	}
+	return !reflect.DeepEqual(normalizeRules(current.CommandAllowlist), normalizeRules(newConfig.CommandAllowlist)) ||
+		!reflect.DeepEqual(current.Authorization, newConfig.Authorization) ||
+		!reflect.DeepEqual(current.Provenance, newConfig.Provenance) ||
+		!reflect.DeepEqual(current.Network, newConfig.Network), nil
+}
+
+func normalizeRules(rules []common.CommandRule) []common.CommandRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	return rules
+}
+
 func isAppendRemovePathAllowed(path string) bool {
 	parts := strings.Split(path, ".")
 	last := strings.TrimSpace(parts[len(parts)-1])
@@ -716,3 +716,179 @@ func TestLoadConfigCommand_HashAlgorithmHandling(t *testing.T) {
 		}
 	})
 }
+
+func TestLoadConfigCommand_CommandAllowlistRequiresSignature(t *testing.T) {
+	fs := afero.NewOsFs()
+
+	tmpConfig, err := CreateTempFile(fs, "/tmp", "test_config_*.json")
+	assert.NoError(t, err)
+	tmpConfig.Close()
+	defer os.Remove(tmpConfig.Name())
+	configFilePath = tmpConfig.Name()
+	writeTestConfig(t, fs, configFilePath, map[string]interface{}{"os_updater": map[string]interface{}{}})
+
+	tmpSchema, err := CreateTempFile(fs, "/tmp", "test_schema_*.json")
+	assert.NoError(t, err)
+	tmpSchema.Close()
+	defer os.Remove(tmpSchema.Name())
+	schemaFilePath = tmpSchema.Name()
+	writeTestSchema(t, fs, schemaFilePath)
+
+	newConfig, err := CreateTempFile(fs, "/tmp", "allowlist_*.json")
+	assert.NoError(t, err)
+	newConfig.Close()
+	defer os.Remove(newConfig.Name())
+
+	op := &ConfigOperation{}
+
+	t.Run("unsigned allowlist change is rejected", func(t *testing.T) {
+		writeTestConfig(t, fs, newConfig.Name(), map[string]interface{}{
+			"os_updater": map[string]interface{}{},
+			"command_allowlist": []interface{}{
+				map[string]interface{}{"command": "/usr/bin/ls", "args": []interface{}{"-l"}},
+			},
+		})
+		err := op.LoadConfigCommand(newConfig.Name(), "", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "signature is required")
+
+		data, err := ReadFile(fs, configFilePath)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "command_allowlist")
+	})
+
+	t.Run("unsigned authorization change is rejected", func(t *testing.T) {
+		writeTestConfig(t, fs, newConfig.Name(), map[string]interface{}{
+			"os_updater": map[string]interface{}{},
+			"authorization": map[string]interface{}{
+				"bindings": []interface{}{map[string]interface{}{"gid": 1000, "role": "admin"}},
+			},
+		})
+		err := op.LoadConfigCommand(newConfig.Name(), "", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "signature is required")
+	})
+
+	t.Run("unsigned network change is rejected", func(t *testing.T) {
+		writeTestConfig(t, fs, newConfig.Name(), map[string]interface{}{
+			"os_updater": map[string]interface{}{},
+			"network": map[string]interface{}{
+				"mirror_rewrites": []interface{}{map[string]interface{}{"prefix": "https://archive.ubuntu.com/", "mirror": "http://mirror.example.com/"}},
+			},
+		})
+		err := op.LoadConfigCommand(newConfig.Name(), "", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "signature is required")
+	})
+
+	t.Run("invalid authorization is rejected", func(t *testing.T) {
+		writeTestConfig(t, fs, newConfig.Name(), map[string]interface{}{
+			"os_updater": map[string]interface{}{},
+			"authorization": map[string]interface{}{
+				"bindings": []interface{}{map[string]interface{}{"gid": 1000, "role": "superuser"}},
+			},
+		})
+		err := op.LoadConfigCommand(newConfig.Name(), "", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid authorization policy")
+	})
+
+	t.Run("invalid pattern is rejected", func(t *testing.T) {
+		writeTestConfig(t, fs, newConfig.Name(), map[string]interface{}{
+			"os_updater": map[string]interface{}{},
+			"command_allowlist": []interface{}{
+				map[string]interface{}{"command": "/usr/bin/ls", "args": []interface{}{"(["}},
+			},
+		})
+		err := op.LoadConfigCommand(newConfig.Name(), "", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid command allowlist")
+	})
+
+	t.Run("unsigned config without allowlist change is accepted", func(t *testing.T) {
+		writeTestConfig(t, fs, newConfig.Name(), map[string]interface{}{
+			"os_updater": map[string]interface{}{"proceedWithoutRollback": true},
+		})
+		assert.NoError(t, op.LoadConfigCommand(newConfig.Name(), "", ""))
+	})
+}
+
+func TestSetConfigCommand_RejectsCommandAllowlist(t *testing.T) {
+	fs := afero.NewOsFs()
+	tmpConfig, err := CreateTempFile(fs, "/tmp", "test_config_*.json")
+	assert.NoError(t, err)
+	tmpConfig.Close()
+	defer os.Remove(tmpConfig.Name())
+	configFilePath = tmpConfig.Name()
+	writeTestConfig(t, fs, configFilePath, map[string]interface{}{})
+
+	op := &ConfigOperation{}
+	err = op.SetConfigCommand("command_allowlist:/usr/bin/ls")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "signed LoadConfig")
+
+	err = op.SetConfigCommand("authorization.bindings:[]")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "signed LoadConfig")
+
+	err = op.SetConfigCommand("network.https_proxy:http://proxy.example.com:3128")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "signed LoadConfig")
+}
+
+func TestVerifyProtectedConfigSignature(t *testing.T) {
+	devicePriv, deviceCert := generateTestCertAndKey(t)
+	packagePriv, packageCert := generateTestCertAndKey(t)
+
+	fs := afero.NewMemMapFs()
+	assert.NoError(t, fs.MkdirAll("/etc/intel-manageability/public", 0755))
+	assert.NoError(t, afero.WriteFile(fs, OTAPackageCertPath, deviceCert, 0644))
+
+	config := []byte(`{"command_allowlist":[{"command":"/bin/sh","args":[".*"]}]}`)
+	alg := ParseHashAlgorithm("sha384")
+
+	t.Run("tar signed with its own certificate is rejected", func(t *testing.T) {
+		assert.NoError(t, createTestTarFile(fs, "/tmp/config.tar", map[string][]byte{
+			"intel_manageability.conf": config,
+			"package_cert.pem":         packageCert,
+		}))
+		raw, err := afero.ReadFile(fs, "/tmp/config.tar")
+		assert.NoError(t, err)
+
+		err = verifyProtectedConfigSignature(fs, generateSignature(t, packagePriv, raw), raw, true, alg)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "device certificate")
+	})
+
+	t.Run("tar with a certificate is rejected even when signed by the device", func(t *testing.T) {
+		assert.NoError(t, createTestTarFile(fs, "/tmp/config.tar", map[string][]byte{
+			"intel_manageability.conf": config,
+			"device_cert.pem":          deviceCert,
+		}))
+		raw, err := afero.ReadFile(fs, "/tmp/config.tar")
+		assert.NoError(t, err)
+
+		err = verifyProtectedConfigSignature(fs, generateSignature(t, devicePriv, raw), raw, true, alg)
+		assert.Error(t, err)
+	})
+
+	t.Run("config signed with another key is rejected", func(t *testing.T) {
+		err := verifyProtectedConfigSignature(fs, generateSignature(t, packagePriv, config), config, false, alg)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "signature check failed")
+	})
+
+	t.Run("config signed by the device is accepted", func(t *testing.T) {
+		assert.NoError(t, verifyProtectedConfigSignature(fs, generateSignature(t, devicePriv, config), config, false, alg))
+	})
+
+	t.Run("tar signed by the device is accepted", func(t *testing.T) {
+		assert.NoError(t, createTestTarFile(fs, "/tmp/config.tar", map[string][]byte{
+			"intel_manageability.conf": config,
+		}))
+		raw, err := afero.ReadFile(fs, "/tmp/config.tar")
+		assert.NoError(t, err)
+
+		assert.NoError(t, verifyProtectedConfigSignature(fs, generateSignature(t, devicePriv, raw), raw, true, alg))
+	})
+}
@@ -0,0 +1,364 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+// configHistoryDir holds one file per revision of the configuration file.
+var configHistoryDir = "/var/intel-manageability/config_history"
+
+// configHistoryMutex serializes adding revisions, as every ConfigOperation has its own lock and
+// two changes must not be given the same revision number.
+var configHistoryMutex sync.Mutex
+
+// maxConfigRevisions is the number of revisions kept.  Older revisions are removed.
+const maxConfigRevisions = 50
+
+// Operations recorded in the configuration history.
+const (
+	ConfigOperationInitial  = "initial"
+	ConfigOperationLoad     = "load"
+	ConfigOperationSet      = "set"
+	ConfigOperationAppend   = "append"
+	ConfigOperationRemove   = "remove"
+	ConfigOperationRollback = "rollback"
+)
+
+// ConfigRevision is a stored revision of the configuration file.
+type ConfigRevision struct {
+	Revision  int             `json:"revision"`
+	Time      time.Time       `json:"time"`
+	Caller    string          `json:"caller"`
+	Operation string          `json:"operation"`
+	Summary   string          `json:"summary"`
+	Config    json.RawMessage `json:"config"`
+}
+
+// ConfigChange is a value that differs between two revisions.  Values are JSON; an empty
+// value means the path does not exist in that revision.
+type ConfigChange struct {
+	Path     string
+	OldValue string
+	NewValue string
+}
+
+// ConfigHistoryCommand returns the most recent revisions, newest first.  A limit of 0
+// returns all revisions.
+func (c *ConfigOperation) ConfigHistoryCommand(limit int) ([]ConfigRevision, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	revisions, err := readConfigRevisions(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(revisions)
+	if limit > 0 && len(revisions) > limit {
+		revisions = revisions[:limit]
+	}
+	return revisions, nil
+}
+
+// DiffConfigCommand compares two revisions.  A to revision of 0 is the latest revision, and a
+// from revision of 0 is the revision before to.  It returns the revisions that were compared.
+func (c *ConfigOperation) DiffConfigCommand(from, to int) (int, int, []ConfigChange, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	revisions, err := readConfigRevisions(afero.NewOsFs())
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if len(revisions) == 0 {
+		return 0, 0, nil, errors.New("no configuration history")
+	}
+
+	toIndex := len(revisions) - 1
+	if to != 0 {
+		if toIndex = findRevision(revisions, to); toIndex < 0 {
+			return 0, 0, nil, fmt.Errorf("revision %d not found", to)
+		}
+	}
+	fromIndex := toIndex - 1
+	if from != 0 {
+		if fromIndex = findRevision(revisions, from); fromIndex < 0 {
+			return 0, 0, nil, fmt.Errorf("revision %d not found", from)
+		}
+	} else if fromIndex < 0 {
+		return 0, 0, nil, fmt.Errorf("revision %d has no earlier revision", revisions[toIndex].Revision)
+	}
+
+	changes, err := diffConfigs(revisions[fromIndex].Config, revisions[toIndex].Config)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return revisions[fromIndex].Revision, revisions[toIndex].Revision, changes, nil
+}
+
+// RollbackConfigCommand restores the configuration of a revision.  The restored configuration
+// is validated against the schema, and it may not change the settings that require a signed
+// configuration.
+func (c *ConfigOperation) RollbackConfigCommand(revision int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fs := afero.NewOsFs()
+	revisions, err := readConfigRevisions(fs)
+	if err != nil {
+		return err
+	}
+	index := findRevision(revisions, revision)
+	if index < 0 {
+		return fmt.Errorf("revision %d not found", revision)
+	}
+	target := revisions[index].Config
+
+	protectedChanged, err := protectedConfigChanged(fs, target)
+	if err != nil {
+		return err
+	}
+	if protectedChanged {
+		return fmt.Errorf("revision %d has a different command allowlist, authorization policy, provenance policy or network profile; restore it with a signed LoadConfig", revision)
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(target, &m); err != nil {
+		return fmt.Errorf("invalid config JSON in revision %d: %w", revision, err)
+	}
+	if err := validateConfigMapWithSchema(m); err != nil {
+		return fmt.Errorf("config validation failed: %w", err)
+	}
+	out, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	previous, _ := ReadFile(fs, configFilePath)
+	if err := WriteFile(fs, configFilePath, out, 0644); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	c.recordRevision(fs, ConfigOperationRollback, fmt.Sprintf("rollback to revision %d", revision), previous, out)
+	return nil
+}
+
+// recordRevision stores the new configuration as a revision.  When there is no history yet, the
+// previous configuration is stored first, so that the first change can be rolled back.  Failures
+// are logged, as the configuration has already been written.
+func (c *ConfigOperation) recordRevision(fs afero.Fs, operation, summary string, previous, current []byte) {
+	caller := c.Caller
+	if caller == "" {
+		caller = "inbd"
+	}
+	if err := addConfigRevision(fs, caller, operation, summary, previous, current, time.Now()); err != nil {
+		log.Printf("[Warning] Failed to record configuration revision: %v", err)
+	}
+}
+
+func addConfigRevision(fs afero.Fs, caller, operation, summary string, previous, current []byte, now time.Time) error {
+	configHistoryMutex.Lock()
+	defer configHistoryMutex.Unlock()
+
+	revisions, err := readConfigRevisions(fs)
+	if err != nil {
+		return err
+	}
+
+	next := 1
+	if len(revisions) > 0 {
+		next = revisions[len(revisions)-1].Revision + 1
+	} else if json.Valid(previous) {
+		initial := ConfigRevision{
+			Revision:  next,
+			Time:      now,
+			Caller:    "inbd",
+			Operation: ConfigOperationInitial,
+			Summary:   "configuration before the first recorded change",
+			Config:    compactJSON(previous),
+		}
+		if err := writeConfigRevision(fs, initial); err != nil {
+			return err
+		}
+		revisions = append(revisions, initial)
+		next++
+	}
+
+	revision := ConfigRevision{
+		Revision:  next,
+		Time:      now,
+		Caller:    caller,
+		Operation: operation,
+		Summary:   summary,
+		Config:    compactJSON(current),
+	}
+	if err := writeConfigRevision(fs, revision); err != nil {
+		return err
+	}
+	revisions = append(revisions, revision)
+
+	for len(revisions) > maxConfigRevisions {
+		if err := RemoveFile(fs, configRevisionPath(revisions[0].Revision)); err != nil {
+			return fmt.Errorf("failed to remove old revision: %w", err)
+		}
+		revisions = revisions[1:]
+	}
+	return nil
+}
+
+func configRevisionPath(revision int) string {
+	return filepath.Join(configHistoryDir, fmt.Sprintf("revision-%06d.json", revision))
+}
+
+func writeConfigRevision(fs afero.Fs, revision ConfigRevision) error {
+	data, err := json.MarshalIndent(revision, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal revision: %w", err)
+	}
+	if err := WriteFile(fs, configRevisionPath(revision.Revision), data, 0600); err != nil {
+		return fmt.Errorf("failed to write revision: %w", err)
+	}
+	return nil
+}
+
+// readConfigRevisions returns the stored revisions, oldest first.
+func readConfigRevisions(fs afero.Fs) ([]ConfigRevision, error) {
+	entries, err := afero.ReadDir(fs, configHistoryDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []ConfigRevision{}, nil
+		}
+		return nil, fmt.Errorf("failed to read configuration history: %w", err)
+	}
+
+	revisions := []ConfigRevision{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, "revision-") || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		if _, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "revision-"), ".json")); err != nil {
+			continue
+		}
+		data, err := ReadFile(fs, filepath.Join(configHistoryDir, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read revision %s: %w", name, err)
+		}
+		var revision ConfigRevision
+		if err := json.Unmarshal(data, &revision); err != nil {
+			log.Printf("[Warning] Skipping invalid configuration revision %s: %v", name, err)
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i].Revision < revisions[j].Revision })
+	return revisions, nil
+}
+
+func findRevision(revisions []ConfigRevision, revision int) int {
+	for i, r := range revisions {
+		if r.Revision == revision {
+			return i
+		}
+	}
+	return -1
+}
+
+// diffConfigs returns the changed values between two configurations, sorted by path.  Objects
+// are compared key by key; lists and other values are compared as a whole.
+func diffConfigs(from, to []byte) ([]ConfigChange, error) {
+	oldValues, err := flattenConfig(from)
+	if err != nil {
+		return nil, err
+	}
+	newValues, err := flattenConfig(to)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string]struct{})
+	for p := range oldValues {
+		paths[p] = struct{}{}
+	}
+	for p := range newValues {
+		paths[p] = struct{}{}
+	}
+
+	var changes []ConfigChange
+	for p := range paths {
+		oldValue, inOld := oldValues[p]
+		newValue, inNew := newValues[p]
+		if inOld && inNew && reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		change := ConfigChange{Path: p}
+		if inOld {
+			change.OldValue = marshalValue(oldValue)
+		}
+		if inNew {
+			change.NewValue = marshalValue(newValue)
+		}
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes, nil
+}
+
+func flattenConfig(data []byte) (map[string]interface{}, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid config JSON: %w", err)
+	}
+	values := make(map[string]interface{})
+	flattenValue("", m, values)
+	return values, nil
+}
+
+func flattenValue(prefix string, value interface{}, values map[string]interface{}) {
+	m, ok := value.(map[string]interface{})
+	if !ok || (len(m) == 0 && prefix != "") {
+		values[prefix] = value
+		return
+	}
+	for key, v := range m {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		flattenValue(path, v, values)
+	}
+}
+
+func marshalValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+func compactJSON(data []byte) json.RawMessage {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return json.RawMessage("null")
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain keeps the configuration history of the config command tests out of
+// /var/intel-manageability.
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("/tmp", "inbd_config_history_*")
+	if err != nil {
+		fmt.Printf("failed to create config history dir: %v\n", err)
+		os.Exit(1)
+	}
+	configHistoryDir = dir
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// setupConfigHistoryTest points the config, schema and history paths at temporary files.
+func setupConfigHistoryTest(t *testing.T, config map[string]interface{}) afero.Fs {
+	fs := afero.NewOsFs()
+
+	tmpConfig, err := CreateTempFile(fs, "/tmp", "test_config_*.json")
+	require.NoError(t, err)
+	tmpConfig.Close()
+	t.Cleanup(func() { os.Remove(tmpConfig.Name()) })
+	configFilePath = tmpConfig.Name()
+	writeTestConfig(t, fs, configFilePath, config)
+
+	tmpSchema, err := CreateTempFile(fs, "/tmp", "test_schema_*.json")
+	require.NoError(t, err)
+	tmpSchema.Close()
+	t.Cleanup(func() { os.Remove(tmpSchema.Name()) })
+	schemaFilePath = tmpSchema.Name()
+	writeTestSchema(t, fs, schemaFilePath)
+
+	previousDir := configHistoryDir
+	configHistoryDir = t.TempDir()
+	t.Cleanup(func() { configHistoryDir = previousDir })
+	return fs
+}
+
+func readTestConfig(t *testing.T, fs afero.Fs) map[string]interface{} {
+	data, err := ReadFile(fs, configFilePath)
+	require.NoError(t, err)
+	var m map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &m))
+	return m
+}
+
+func TestConfigHistory_RecordsEveryChange(t *testing.T) {
+	setupConfigHistoryTest(t, map[string]interface{}{
+		"os_updater": map[string]interface{}{"maxCacheSize": 10, "trustedRepositories": []interface{}{}},
+	})
+
+	op := &ConfigOperation{Caller: "uid 1000"}
+	require.NoError(t, op.SetConfigCommand("os_updater.maxCacheSize:20"))
+	require.NoError(t, op.AppendConfigCommand("os_updater.trustedRepositories:https://example.com"))
+	require.NoError(t, op.RemoveConfigCommand("os_updater.trustedRepositories:https://example.com"))
+
+	revisions, err := op.ConfigHistoryCommand(0)
+	require.NoError(t, err)
+	require.Len(t, revisions, 4)
+
+	// Newest first; the configuration before the first change is kept as revision 1.
+	assert.Equal(t, 4, revisions[0].Revision)
+	assert.Equal(t, ConfigOperationRemove, revisions[0].Operation)
+	assert.Equal(t, "remove os_updater.trustedRepositories:https://example.com", revisions[0].Summary)
+	assert.Equal(t, "uid 1000", revisions[0].Caller)
+	assert.Equal(t, ConfigOperationSet, revisions[2].Operation)
+	assert.Equal(t, 1, revisions[3].Revision)
+	assert.Equal(t, ConfigOperationInitial, revisions[3].Operation)
+
+	limited, err := op.ConfigHistoryCommand(2)
+	require.NoError(t, err)
+	require.Len(t, limited, 2)
+	assert.Equal(t, 4, limited[0].Revision)
+}
+
+func TestConfigHistory_LoadConfigRecorded(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{"os_updater": map[string]interface{}{"maxCacheSize": 10}})
+
+	newConfig, err := CreateTempFile(fs, "/tmp", "new_config_*.json")
+	require.NoError(t, err)
+	newConfig.Close()
+	defer os.Remove(newConfig.Name())
+	writeTestConfig(t, fs, newConfig.Name(), map[string]interface{}{"os_updater": map[string]interface{}{"maxCacheSize": 30}})
+
+	op := &ConfigOperation{}
+	require.NoError(t, op.LoadConfigCommand(newConfig.Name(), "", ""))
+
+	revisions, err := op.ConfigHistoryCommand(0)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, ConfigOperationLoad, revisions[0].Operation)
+	assert.Equal(t, "inbd", revisions[0].Caller)
+	assert.Equal(t, "load "+newConfig.Name(), revisions[0].Summary)
+}
+
+func TestConfigHistory_FailedChangeNotRecorded(t *testing.T) {
+	setupConfigHistoryTest(t, map[string]interface{}{"os_updater": map[string]interface{}{"maxCacheSize": 10}})
+
+	op := &ConfigOperation{}
+	assert.Error(t, op.SetConfigCommand("invalid"))
+
+	revisions, err := op.ConfigHistoryCommand(0)
+	require.NoError(t, err)
+	assert.Empty(t, revisions)
+}
+
+func TestDiffConfigCommand(t *testing.T) {
+	setupConfigHistoryTest(t, map[string]interface{}{
+		"os_updater": map[string]interface{}{"maxCacheSize": 10, "trustedRepositories": []interface{}{}},
+	})
+
+	op := &ConfigOperation{}
+	_, _, _, err := op.DiffConfigCommand(0, 0)
+	assert.ErrorContains(t, err, "no configuration history")
+
+	require.NoError(t, op.SetConfigCommand("os_updater.maxCacheSize:20"))
+	require.NoError(t, op.SetConfigCommand("os_updater.proceedWithoutRollback:true"))
+
+	from, to, changes, err := op.DiffConfigCommand(0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, from)
+	assert.Equal(t, 3, to)
+	assert.Equal(t, []ConfigChange{{Path: "os_updater.proceedWithoutRollback", NewValue: `"true"`}}, changes)
+
+	from, to, changes, err = op.DiffConfigCommand(1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, from)
+	assert.Equal(t, 3, to)
+	assert.Equal(t, []ConfigChange{
+		{Path: "os_updater.maxCacheSize", OldValue: "10", NewValue: "20"},
+		{Path: "os_updater.proceedWithoutRollback", NewValue: `"true"`},
+	}, changes)
+
+	_, _, _, err = op.DiffConfigCommand(0, 1)
+	assert.ErrorContains(t, err, "has no earlier revision")
+	_, _, _, err = op.DiffConfigCommand(7, 0)
+	assert.ErrorContains(t, err, "revision 7 not found")
+}
+
+func TestRollbackConfigCommand(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{"os_updater": map[string]interface{}{"maxCacheSize": 10}})
+
+	op := &ConfigOperation{Caller: "uid 0"}
+	require.NoError(t, op.SetConfigCommand("os_updater.maxCacheSize:20"))
+	require.NoError(t, op.RollbackConfigCommand(1))
+
+	m := readTestConfig(t, fs)
+	assert.Equal(t, float64(10), m["os_updater"].(map[string]interface{})["maxCacheSize"])
+
+	revisions, err := op.ConfigHistoryCommand(1)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	assert.Equal(t, 3, revisions[0].Revision)
+	assert.Equal(t, ConfigOperationRollback, revisions[0].Operation)
+	assert.Equal(t, "rollback to revision 1", revisions[0].Summary)
+
+	assert.ErrorContains(t, op.RollbackConfigCommand(42), "revision 42 not found")
+}
+
+func TestRollbackConfigCommand_SchemaValidationFail(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{"os_updater": map[string]interface{}{"maxCacheSize": 10}})
+
+	op := &ConfigOperation{}
+	require.NoError(t, op.SetConfigCommand("os_updater.maxCacheSize:20"))
+
+	// The schema changed since revision 1 was written.
+	require.NoError(t, WriteFile(fs, schemaFilePath, []byte(`{
+		"type": "object",
+		"properties": {"os_updater": {"type": "object", "properties": {"maxCacheSize": {"minimum": 15}}}}
+	}`), 0644))
+
+	assert.ErrorContains(t, op.RollbackConfigCommand(1), "config validation failed")
+	m := readTestConfig(t, fs)
+	assert.Equal(t, float64(20), m["os_updater"].(map[string]interface{})["maxCacheSize"])
+}
+
+func TestRollbackConfigCommand_RejectsProtectedChange(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{"os_updater": map[string]interface{}{"maxCacheSize": 10}})
+
+	// Revision 1 has no allowlist; the current configuration was loaded with a signed allowlist.
+	require.NoError(t, addConfigRevision(fs, "inbd", ConfigOperationSet, "set", nil,
+		[]byte(`{"os_updater":{"maxCacheSize":10}}`), time.Now()))
+	writeTestConfig(t, fs, configFilePath, map[string]interface{}{
+		"os_updater":        map[string]interface{}{"maxCacheSize": 10},
+		"command_allowlist": []interface{}{map[string]interface{}{"command": "/usr/bin/uptime"}},
+	})
+
+	op := &ConfigOperation{}
+	assert.ErrorContains(t, op.RollbackConfigCommand(1), "signed LoadConfig")
+}
+
+func TestAddConfigRevision_Concurrent(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, addConfigRevision(fs, "inbd", ConfigOperationSet, "set", nil, []byte(`{}`), time.Now()))
+		}()
+	}
+	wg.Wait()
+
+	revisions, err := readConfigRevisions(fs)
+	require.NoError(t, err)
+	require.Len(t, revisions, 10)
+	for i, revision := range revisions {
+		assert.Equal(t, i+1, revision.Revision)
+	}
+}
+
+func TestAddConfigRevision_KeepsMostRecent(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{})
+
+	for i := 0; i < maxConfigRevisions+3; i++ {
+		require.NoError(t, addConfigRevision(fs, "inbd", ConfigOperationSet, "set", nil, []byte(`{}`), time.Now()))
+	}
+	revisions, err := readConfigRevisions(fs)
+	require.NoError(t, err)
+	require.Len(t, revisions, maxConfigRevisions)
+	assert.Equal(t, 4, revisions[0].Revision)
+	assert.Equal(t, maxConfigRevisions+3, revisions[len(revisions)-1].Revision)
+}
@@ -0,0 +1,359 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// ConfigOperationPatch is recorded in the configuration history for a JSON Patch.
+const ConfigOperationPatch = "patch"
+
+// jsonPatchOperation is an operation of an RFC 6902 JSON Patch document.
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  *string         `json:"path"`
+	From  *string         `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// PatchConfigCommand applies an RFC 6902 JSON Patch document to /etc/intel_manageability.conf.
+// The configuration is only written if every operation succeeds and the result is valid
+// according to the schema.
+func (c *ConfigOperation) PatchConfigCommand(patch string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ops, err := parseJSONPatch(patch)
+	if err != nil {
+		return err
+	}
+	for i, op := range ops {
+		for _, p := range []*string{op.Path, op.From} {
+			if p == nil {
+				continue
+			}
+			tokens, err := parseJSONPointer(*p)
+			if err != nil {
+				return fmt.Errorf("operation %d: %w", i, err)
+			}
+			if len(tokens) == 0 || isProtectedConfigPath(tokens[0]) {
+				return fmt.Errorf("operation %d: %s can only be changed with a signed LoadConfig", i, *p)
+			}
+		}
+	}
+
+	fs := afero.Afero{Fs: afero.NewOsFs()}
+	data, err := ReadFile(fs.Fs, configFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("invalid config JSON: %w", err)
+	}
+
+	doc, err = applyJSONPatch(doc, ops)
+	if err != nil {
+		return err
+	}
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return errors.New("patched configuration is not an object")
+	}
+
+	if err := validateConfigMapWithSchema(m); err != nil {
+		return fmt.Errorf("config validation failed: %w", err)
+	}
+	out, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := WriteFile(fs.Fs, configFilePath, out, 0644); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	c.recordRevision(fs.Fs, ConfigOperationPatch, patchSummary(ops), data, out)
+	return nil
+}
+
+func parseJSONPatch(patch string) ([]jsonPatchOperation, error) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(patch)))
+	dec.DisallowUnknownFields()
+	var ops []jsonPatchOperation
+	if err := dec.Decode(&ops); err != nil {
+		return nil, fmt.Errorf("invalid JSON Patch: %w", err)
+	}
+	if len(ops) == 0 {
+		return nil, errors.New("invalid JSON Patch: no operations")
+	}
+	for i, op := range ops {
+		if op.Path == nil {
+			return nil, fmt.Errorf("invalid JSON Patch: operation %d has no path", i)
+		}
+		switch op.Op {
+		case "add", "replace", "test":
+			if op.Value == nil {
+				return nil, fmt.Errorf("invalid JSON Patch: operation %d (%s) has no value", i, op.Op)
+			}
+		case "move", "copy":
+			if op.From == nil {
+				return nil, fmt.Errorf("invalid JSON Patch: operation %d (%s) has no from", i, op.Op)
+			}
+		case "remove":
+		default:
+			return nil, fmt.Errorf("invalid JSON Patch: operation %d has unknown op %q", i, op.Op)
+		}
+	}
+	return ops, nil
+}
+
+func patchSummary(ops []jsonPatchOperation) string {
+	parts := make([]string, 0, len(ops))
+	for _, op := range ops {
+		parts = append(parts, op.Op+" "+*op.Path)
+	}
+	return ConfigOperationPatch + " " + strings.Join(parts, ", ")
+}
+
+// applyJSONPatch applies the operations in order and returns the patched document.  The
+// document is changed in place, so it must be discarded if an error is returned.
+func applyJSONPatch(doc interface{}, ops []jsonPatchOperation) (interface{}, error) {
+	for i, op := range ops {
+		path, err := parseJSONPointer(*op.Path)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+
+		switch op.Op {
+		case "add":
+			var value interface{}
+			if err := json.Unmarshal(op.Value, &value); err != nil {
+				return nil, fmt.Errorf("operation %d: invalid value: %w", i, err)
+			}
+			doc, err = pointerAdd(doc, path, value)
+		case "remove":
+			doc, _, err = pointerRemove(doc, path)
+		case "replace":
+			var value interface{}
+			if err = json.Unmarshal(op.Value, &value); err != nil {
+				return nil, fmt.Errorf("operation %d: invalid value: %w", i, err)
+			}
+			if _, err = pointerGet(doc, path); err == nil {
+				doc, _, err = pointerRemove(doc, path)
+			}
+			if err == nil {
+				doc, err = pointerAdd(doc, path, value)
+			}
+		case "move", "copy":
+			var from []string
+			if from, err = parseJSONPointer(*op.From); err != nil {
+				break
+			}
+			var value interface{}
+			if op.Op == "move" {
+				if *op.Path != *op.From && strings.HasPrefix(*op.Path, *op.From+"/") {
+					err = errors.New("a value can not be moved into one of its children")
+					break
+				}
+				if doc, value, err = pointerRemove(doc, from); err != nil {
+					break
+				}
+			} else {
+				if value, err = pointerGet(doc, from); err != nil {
+					break
+				}
+				value = deepCopyJSON(value)
+			}
+			doc, err = pointerAdd(doc, path, value)
+		case "test":
+			var expected, actual interface{}
+			if err = json.Unmarshal(op.Value, &expected); err != nil {
+				return nil, fmt.Errorf("operation %d: invalid value: %w", i, err)
+			}
+			if actual, err = pointerGet(doc, path); err == nil && !reflect.DeepEqual(expected, actual) {
+				err = fmt.Errorf("test failed: %s is %s", *op.Path, marshalValue(actual))
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, op.Op, *op.Path, err)
+		}
+	}
+	return doc, nil
+}
+
+// parseJSONPointer splits an RFC 6901 JSON Pointer into its unescaped reference tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON Pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func pointerGet(doc interface{}, path []string) (interface{}, error) {
+	cur := doc
+	for _, token := range path {
+		switch node := cur.(type) {
+		case map[string]interface{}:
+			next, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("path not found: %s", token)
+			}
+			cur = next
+		case []interface{}:
+			i, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			cur = node[i]
+		default:
+			return nil, fmt.Errorf("path not found: %s", token)
+		}
+	}
+	return cur, nil
+}
+
+// pointerAdd adds or replaces the value at path.  Array elements are inserted, and "-"
+// appends to an array.  It returns the document, which is new if the root is replaced.
+func pointerAdd(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	token := path[0]
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			node[token] = value
+			return node, nil
+		}
+		child, ok := node[token]
+		if !ok {
+			return nil, fmt.Errorf("path not found: %s", token)
+		}
+		updated, err := pointerAdd(child, path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		node[token] = updated
+		return node, nil
+	case []interface{}:
+		if len(path) == 1 {
+			i := len(node)
+			if token != "-" {
+				var err error
+				if i, err = arrayIndex(token, len(node)); err != nil {
+					return nil, err
+				}
+			}
+			node = append(node, nil)
+			copy(node[i+1:], node[i:])
+			node[i] = value
+			return node, nil
+		}
+		i, err := arrayIndex(token, len(node)-1)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := pointerAdd(node[i], path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		node[i] = updated
+		return node, nil
+	default:
+		return nil, fmt.Errorf("path not found: %s", token)
+	}
+}
+
+// pointerRemove removes the value at path.  It returns the document and the removed value.
+func pointerRemove(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, errors.New("the whole configuration can not be removed")
+	}
+	token := path[0]
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		child, ok := node[token]
+		if !ok {
+			return nil, nil, fmt.Errorf("path not found: %s", token)
+		}
+		if len(path) == 1 {
+			delete(node, token)
+			return node, child, nil
+		}
+		updated, removed, err := pointerRemove(child, path[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		node[token] = updated
+		return node, removed, nil
+	case []interface{}:
+		i, err := arrayIndex(token, len(node)-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(path) == 1 {
+			removed := node[i]
+			return append(node[:i], node[i+1:]...), removed, nil
+		}
+		updated, removed, err := pointerRemove(node[i], path[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		node[i] = updated
+		return node, removed, nil
+	default:
+		return nil, nil, fmt.Errorf("path not found: %s", token)
+	}
+}
+
+// arrayIndex parses an array index token that must not be greater than max.
+func arrayIndex(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if i > max {
+		return 0, fmt.Errorf("array index %d out of range", i)
+	}
+	return i, nil
+}
+
+func deepCopyJSON(v interface{}) interface{} {
+	switch n := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(n))
+		for k, child := range n {
+			m[k] = deepCopyJSON(child)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(n))
+		for i, child := range n {
+			a[i] = deepCopyJSON(child)
+		}
+		return a
+	default:
+		return v
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyJSONPatch(t *testing.T) {
+	base := `{"a":{"b":1,"c":[1,2,3]},"d":"x","e~f":{"g/h":true}}`
+
+	tests := []struct {
+		name     string
+		patch    string
+		expected string
+		err      string
+	}{
+		{"add member", `[{"op":"add","path":"/a/z","value":"new"}]`, `{"a":{"b":1,"c":[1,2,3],"z":"new"},"d":"x","e~f":{"g/h":true}}`, ""},
+		{"add null", `[{"op":"add","path":"/n","value":null}]`, `{"a":{"b":1,"c":[1,2,3]},"d":"x","e~f":{"g/h":true},"n":null}`, ""},
+		{"insert into array", `[{"op":"add","path":"/a/c/1","value":9}]`, `{"a":{"b":1,"c":[1,9,2,3]},"d":"x","e~f":{"g/h":true}}`, ""},
+		{"append to array", `[{"op":"add","path":"/a/c/-","value":4}]`, `{"a":{"b":1,"c":[1,2,3,4]},"d":"x","e~f":{"g/h":true}}`, ""},
+		{"remove array element", `[{"op":"remove","path":"/a/c/0"}]`, `{"a":{"b":1,"c":[2,3]},"d":"x","e~f":{"g/h":true}}`, ""},
+		{"replace", `[{"op":"replace","path":"/d","value":{"k":1}}]`, `{"a":{"b":1,"c":[1,2,3]},"d":{"k":1},"e~f":{"g/h":true}}`, ""},
+		{"escaped pointer", `[{"op":"replace","path":"/e~0f/g~1h","value":false}]`, `{"a":{"b":1,"c":[1,2,3]},"d":"x","e~f":{"g/h":false}}`, ""},
+		{"move", `[{"op":"move","from":"/a/b","path":"/b"}]`, `{"a":{"c":[1,2,3]},"b":1,"d":"x","e~f":{"g/h":true}}`, ""},
+		{"copy", `[{"op":"copy","from":"/a/c","path":"/c"}]`, `{"a":{"b":1,"c":[1,2,3]},"c":[1,2,3],"d":"x","e~f":{"g/h":true}}`, ""},
+		{"test passes", `[{"op":"test","path":"/a/c","value":[1,2,3]},{"op":"remove","path":"/d"}]`, `{"a":{"b":1,"c":[1,2,3]},"e~f":{"g/h":true}}`, ""},
+		{"test fails", `[{"op":"test","path":"/d","value":"y"}]`, "", "test failed"},
+		{"replace missing", `[{"op":"replace","path":"/missing","value":1}]`, "", "path not found"},
+		{"add to missing parent", `[{"op":"add","path":"/missing/x","value":1}]`, "", "path not found"},
+		{"index out of range", `[{"op":"add","path":"/a/c/5","value":1}]`, "", "out of range"},
+		{"leading zero index", `[{"op":"remove","path":"/a/c/01"}]`, "", "invalid array index"},
+		{"move into child", `[{"op":"move","from":"/a","path":"/a/b/x"}]`, "", "can not be moved"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops, err := parseJSONPatch(tt.patch)
+			require.NoError(t, err)
+			var doc interface{}
+			require.NoError(t, json.Unmarshal([]byte(base), &doc))
+
+			result, err := applyJSONPatch(doc, ops)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			var expected interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.expected), &expected))
+			assert.Equal(t, expected, result)
+		})
+	}
+}
+
+func TestParseJSONPatch_Invalid(t *testing.T) {
+	tests := []struct {
+		patch string
+		err   string
+	}{
+		{`{"op":"add"}`, "invalid JSON Patch"},
+		{`[]`, "no operations"},
+		{`[{"op":"add","value":1}]`, "has no path"},
+		{`[{"op":"add","path":"/a"}]`, "has no value"},
+		{`[{"op":"move","path":"/a"}]`, "has no from"},
+		{`[{"op":"merge","path":"/a"}]`, "unknown op"},
+		{`[{"op":"remove","path":"/a","extra":1}]`, "unknown field"},
+	}
+	for _, tt := range tests {
+		_, err := parseJSONPatch(tt.patch)
+		assert.ErrorContains(t, err, tt.err, tt.patch)
+	}
+}
+
+func TestPatchConfigCommand(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{
+		"os_updater": map[string]interface{}{"maxCacheSize": 10, "trustedRepositories": []interface{}{}},
+	})
+
+	op := &ConfigOperation{Caller: "uid 0"}
+	require.NoError(t, op.PatchConfigCommand(`[
+		{"op": "replace", "path": "/os_updater/maxCacheSize", "value": 20},
+		{"op": "add", "path": "/os_updater/trustedRepositories/-", "value": "https://example.com/a;b:c"}
+	]`))
+
+	m := readTestConfig(t, fs)
+	osUpdater := m["os_updater"].(map[string]interface{})
+	assert.Equal(t, float64(20), osUpdater["maxCacheSize"])
+	assert.Equal(t, []interface{}{"https://example.com/a;b:c"}, osUpdater["trustedRepositories"])
+
+	revisions, err := op.ConfigHistoryCommand(1)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	assert.Equal(t, ConfigOperationPatch, revisions[0].Operation)
+	assert.Equal(t, "patch replace /os_updater/maxCacheSize, add /os_updater/trustedRepositories/-", revisions[0].Summary)
+}
+
+func TestPatchConfigCommand_Atomic(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{"os_updater": map[string]interface{}{"maxCacheSize": 10}})
+
+	op := &ConfigOperation{}
+	err := op.PatchConfigCommand(`[
+		{"op": "replace", "path": "/os_updater/maxCacheSize", "value": 20},
+		{"op": "remove", "path": "/os_updater/missing"}
+	]`)
+	assert.ErrorContains(t, err, "operation 1")
+
+	m := readTestConfig(t, fs)
+	assert.Equal(t, float64(10), m["os_updater"].(map[string]interface{})["maxCacheSize"])
+	revisions, err := op.ConfigHistoryCommand(0)
+	require.NoError(t, err)
+	assert.Empty(t, revisions)
+}
+
+func TestPatchConfigCommand_SchemaValidationFail(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{"foo": "bar"})
+	require.NoError(t, WriteFile(fs, schemaFilePath,
+		[]byte(`{"type":"object","properties":{"foo":{"type":"string"}},"additionalProperties":false}`), 0644))
+
+	op := &ConfigOperation{}
+	err := op.PatchConfigCommand(`[{"op":"replace","path":"/foo","value":"baz"},{"op":"add","path":"/bar","value":1}]`)
+	assert.ErrorContains(t, err, "config validation failed")
+	assert.Equal(t, "bar", readTestConfig(t, fs)["foo"])
+}
+
+func TestPatchConfigCommand_RejectsProtectedPaths(t *testing.T) {
+	setupConfigHistoryTest(t, map[string]interface{}{"os_updater": map[string]interface{}{"maxCacheSize": 10}})
+
+	op := &ConfigOperation{}
+	for _, patch := range []string{
+		`[{"op":"add","path":"/command_allowlist","value":[{"command":"/bin/sh"}]}]`,
+		`[{"op":"copy","from":"/authorization","path":"/x"}]`,
+		`[{"op":"replace","path":"","value":{}}]`,
+	} {
+		assert.ErrorContains(t, op.PatchConfigCommand(patch), "signed LoadConfig", patch)
+	}
+	assert.ErrorContains(t, op.PatchConfigCommand(`[{"op":"remove","path":"os_updater"}]`), "invalid JSON Pointer")
+}
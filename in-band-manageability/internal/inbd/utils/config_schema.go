@@ -0,0 +1,164 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// loadConfigSchema reads the configuration schema.  A schema that can not be read gives no types;
+// the change is then rejected by the schema validation.
+func loadConfigSchema(fs afero.Fs) map[string]interface{} {
+	data, err := ReadFile(fs, schemaFilePath)
+	if err != nil {
+		log.Printf("[Warning] Failed to read config schema: %v", err)
+		return nil
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		log.Printf("[Warning] Invalid config schema: %v", err)
+		return nil
+	}
+	return schema
+}
+
+// schemaAtPath returns the schema of the value at a dotted key path, or nil if the schema does
+// not describe it.
+func schemaAtPath(schema map[string]interface{}, path string) map[string]interface{} {
+	cur := schema
+	for _, key := range strings.Split(path, ".") {
+		if cur == nil {
+			return nil
+		}
+		if props, ok := cur["properties"].(map[string]interface{}); ok {
+			if next, ok := props[key].(map[string]interface{}); ok {
+				cur = next
+				continue
+			}
+		}
+		if next, ok := cur["additionalProperties"].(map[string]interface{}); ok {
+			cur = next
+			continue
+		}
+		if _, err := strconv.Atoi(key); err == nil {
+			if next, ok := cur["items"].(map[string]interface{}); ok {
+				cur = next
+				continue
+			}
+		}
+		return nil
+	}
+	return cur
+}
+
+// schemaTypes returns the JSON types the schema allows, in the order values are coerced.
+func schemaTypes(schema map[string]interface{}) []string {
+	var types []string
+	switch t := schema["type"].(type) {
+	case string:
+		types = []string{t}
+	case []interface{}:
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				types = append(types, s)
+			}
+		}
+	}
+	// Strings accept any value, so they are tried last.
+	order := []string{"null", "boolean", "integer", "number", "array", "object", "string"}
+	slices.SortStableFunc(types, func(a, b string) int {
+		return slices.Index(order, a) - slices.Index(order, b)
+	})
+	return types
+}
+
+// jsonType returns the JSON type of a decoded value.
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return "string"
+	}
+}
+
+// coerceConfigValue converts a value given as text to the type the schema gives the key path.
+// If the schema does not type the path, the type of the current value is kept, and a new key
+// is a string.
+func coerceConfigValue(schema map[string]interface{}, path, value string, current interface{}, exists bool) (interface{}, error) {
+	types := schemaTypes(schemaAtPath(schema, path))
+	if len(types) == 0 {
+		if !exists {
+			return value, nil
+		}
+		types = []string{jsonType(current)}
+	}
+	return convertValue(types, path, value)
+}
+
+// coerceConfigItem converts a value given as text to the type of the items of the list at the
+// key path.
+func coerceConfigItem(schema map[string]interface{}, path, value string) (interface{}, error) {
+	items, _ := schemaAtPath(schema, path)["items"].(map[string]interface{})
+	types := schemaTypes(items)
+	if len(types) == 0 {
+		return value, nil
+	}
+	return convertValue(types, path, value)
+}
+
+// convertValue converts the value to the first of the types it can be parsed as.
+func convertValue(types []string, path, value string) (interface{}, error) {
+	for _, t := range types {
+		if v, ok := parseValue(t, value); ok {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("value %q of %s is not of type %s", value, path, strings.Join(types, " or "))
+}
+
+func parseValue(t, value string) (interface{}, bool) {
+	switch t {
+	case "string":
+		return value, true
+	case "integer":
+		// Numbers are float64, as in a decoded configuration.
+		i, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		return float64(i), err == nil
+	case "number":
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		return f, err == nil
+	case "boolean":
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		return b, err == nil
+	case "null":
+		return nil, strings.TrimSpace(value) == "null"
+	case "array":
+		var a []interface{}
+		err := json.Unmarshal([]byte(value), &a)
+		return a, err == nil && a != nil
+	case "object":
+		var m map[string]interface{}
+		err := json.Unmarshal([]byte(value), &m)
+		return m, err == nil && m != nil
+	}
+	return nil, false
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTypedSchema = `{
+	"type": "object",
+	"properties": {
+		"os_updater": {
+			"type": "object",
+			"properties": {
+				"trustedRepositories": {"type": "array", "items": {"type": "string"}},
+				"proceedWithoutRollback": {"type": "boolean"},
+				"maxCacheSize": {"type": "integer"},
+				"label": {"type": "string"},
+				"ratio": {"type": ["number", "null"]},
+				"ports": {"type": "array", "items": {"type": "integer"}}
+			}
+		},
+		"labels": {"type": "object", "additionalProperties": {"type": "string"}}
+	}
+}`
+
+func TestCoerceConfigValue(t *testing.T) {
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(testTypedSchema), &schema))
+
+	tests := []struct {
+		name     string
+		path     string
+		value    string
+		current  interface{}
+		exists   bool
+		expected interface{}
+		err      bool
+	}{
+		{"string stays string", "os_updater.label", "1", nil, false, "1", false},
+		{"string true stays string", "os_updater.label", "true", nil, false, "true", false},
+		{"integer", "os_updater.maxCacheSize", "42", nil, false, float64(42), false},
+		{"integer rejects text", "os_updater.maxCacheSize", "lots", nil, false, nil, true},
+		{"integer rejects fraction", "os_updater.maxCacheSize", "1.5", nil, false, nil, true},
+		{"boolean", "os_updater.proceedWithoutRollback", "false", nil, false, false, false},
+		{"number or null", "os_updater.ratio", "null", nil, false, nil, false},
+		{"number", "os_updater.ratio", "0.5", nil, false, 0.5, false},
+		{"array", "os_updater.trustedRepositories", `["https://a/"]`, nil, false, []interface{}{"https://a/"}, false},
+		{"additional properties", "labels.site", "42", nil, false, "42", false},
+		{"untyped keeps current type", "other", "7", float64(3), true, float64(7), false},
+		{"untyped new key is string", "other", "7", nil, false, "7", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := coerceConfigValue(schema, tt.path, tt.value, tt.current, tt.exists)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, v)
+		})
+	}
+}
+
+func TestCoerceConfigItem(t *testing.T) {
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(testTypedSchema), &schema))
+
+	v, err := coerceConfigItem(schema, "os_updater.ports", "8080")
+	require.NoError(t, err)
+	assert.Equal(t, float64(8080), v)
+
+	v, err = coerceConfigItem(schema, "os_updater.trustedRepositories", "123")
+	require.NoError(t, err)
+	assert.Equal(t, "123", v)
+
+	_, err = coerceConfigItem(schema, "os_updater.ports", "http")
+	assert.Error(t, err)
+}
+
+func TestSetConfigCommand_TypesFromSchema(t *testing.T) {
+	fs := setupConfigHistoryTest(t, map[string]interface{}{
+		"os_updater": map[string]interface{}{"label": "a", "maxCacheSize": 1},
+	})
+	require.NoError(t, WriteFile(fs, schemaFilePath, []byte(testTypedSchema), 0644))
+
+	op := &ConfigOperation{}
+	require.NoError(t, op.SetConfigCommand("os_updater.label:1;os_updater.maxCacheSize:5;os_updater.proceedWithoutRollback:true"))
+
+	osUpdater := readTestConfig(t, fs)["os_updater"].(map[string]interface{})
+	assert.Equal(t, "1", osUpdater["label"])
+	assert.Equal(t, float64(5), osUpdater["maxCacheSize"])
+	assert.Equal(t, true, osUpdater["proceedWithoutRollback"])
+
+	assert.ErrorContains(t, op.SetConfigCommand("os_updater.maxCacheSize:big"), "is not of type integer")
+}
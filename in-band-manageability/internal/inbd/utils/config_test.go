@@ -9,6 +9,8 @@ package utils
 import (
 	"testing"
 
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/auth"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
@@ -125,3 +127,59 @@ func TestIsProceedWithoutRollback_False(t *testing.T) {
 	// Assertions
 	assert.False(t, result, "Expected rollback to be disallowed")
 }
+
+func TestApplyCommandAllowlist(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	defer func() { _ = common.SetCommandAllowlist(nil) }()
+
+	err := afero.WriteFile(fs, "/etc/intel_manageability.conf", []byte(`{
+		"os_updater": {"trustedRepositories": [], "proceedWithoutRollback": true},
+		"command_allowlist": [{"command": "/usr/bin/ls", "args": ["-l"]}]
+	}`), 0644)
+	assert.NoError(t, err)
+	assert.NoError(t, ApplyCommandAllowlist(fs, "/etc/intel_manageability.conf"))
+
+	err = afero.WriteFile(fs, "/etc/intel_manageability.conf", []byte(`{
+		"os_updater": {"trustedRepositories": [], "proceedWithoutRollback": true},
+		"command_allowlist": [{"command": "ls"}]
+	}`), 0644)
+	assert.NoError(t, err)
+	err = ApplyCommandAllowlist(fs, "/etc/intel_manageability.conf")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "absolute path")
+}
+
+func TestCheckRootOnly(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	assert.NoError(t, afero.WriteFile(fs, "/etc/intel_manageability.conf", []byte(`{}`), 0644))
+	assert.NoError(t, CheckRootOnly(fs, "/etc/intel_manageability.conf"))
+
+	assert.NoError(t, fs.Chmod("/etc/intel_manageability.conf", 0666))
+	err := CheckRootOnly(fs, "/etc/intel_manageability.conf")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "writable by users other than root")
+
+	assert.Error(t, CheckRootOnly(fs, "/etc/missing.conf"))
+}
+
+func TestApplyAuthorization(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	defer auth.SetPolicy(nil)
+
+	err := afero.WriteFile(fs, "/etc/intel_manageability.conf", []byte(`{
+		"os_updater": {"trustedRepositories": [], "proceedWithoutRollback": true},
+		"authorization": {"bindings": [{"gid": 1000, "role": "viewer"}]}
+	}`), 0644)
+	assert.NoError(t, err)
+	assert.NoError(t, ApplyAuthorization(fs, "/etc/intel_manageability.conf"))
+
+	err = afero.WriteFile(fs, "/etc/intel_manageability.conf", []byte(`{
+		"os_updater": {"trustedRepositories": [], "proceedWithoutRollback": true},
+		"authorization": {"bindings": [{"role": "viewer"}]}
+	}`), 0644)
+	assert.NoError(t, err)
+	err = ApplyAuthorization(fs, "/etc/intel_manageability.conf")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid authorization policy")
+}
@@ -115,7 +115,7 @@ func getFileSizeWithRange(url string) (int64, error) {
 	}
 	req.Header.Set("Range", "bytes=0-0")
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := LoadNetworkProfile(afero.NewOsFs()).HTTPClient(30 * time.Second).Do(req)
 	if err != nil {
 		return 0, fmt.Errorf("error performing Range GET request: %w", err)
 	}
@@ -205,8 +205,12 @@ func IsDiskSpaceAvailable(url string,
 // CreateSecureHTTPClient creates an HTTP client with appropriate TLS configuration
 // based on whether the URL uses an IP address or hostname.
 // When token is empty (anonymous access), uses InsecureSkipVerify for TLS.
+// The client applies the network profile of the configuration.
 func CreateSecureHTTPClient(fs afero.Fs, url string, token string) (*http.Client, error) {
-	parsedURL, err := neturl.Parse(url)
+	profile := LoadNetworkProfile(fs)
+
+	// The TLS server name is the one of the mirror that is actually contacted.
+	parsedURL, err := neturl.Parse(profile.RewriteURL(url))
 	if err != nil {
 		return nil, fmt.Errorf("error parsing URL: %w", err)
 	}
@@ -268,10 +272,8 @@ func CreateSecureHTTPClient(fs afero.Fs, url string, token string) (*http.Client
 	}
 
 	client := &http.Client{
-		Timeout: 30 * time.Second, // 30 second timeout for HEAD/size check requests
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-		},
+		Timeout:   30 * time.Second, // 30 second timeout for HEAD/size check requests
+		Transport: profile.Transport(tlsConfig),
 	}
 
 	return client, nil
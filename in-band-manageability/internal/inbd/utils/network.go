@@ -0,0 +1,319 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package utils provides utility functions.
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	neturl "net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// NetworkConfig is the network profile of the configuration: the proxies, extra CA bundles and
+// local mirrors used by every download, apt command and GPG key fetch of inbd.
+type NetworkConfig struct {
+	// HTTPProxy is the proxy for http URLs.
+	HTTPProxy string `json:"http_proxy,omitempty"`
+	// HTTPSProxy is the proxy for https URLs.
+	HTTPSProxy string `json:"https_proxy,omitempty"`
+	// NoProxy lists the hosts, domains (.example.com), IP addresses and CIDR ranges reached directly.
+	NoProxy []string `json:"no_proxy,omitempty"`
+	// ProxyCredentialsFile holds user:password for an authenticating proxy.  The credentials are
+	// kept out of the configuration so that GetConfig does not reveal them.
+	ProxyCredentialsFile string `json:"proxy_credentials_file,omitempty"`
+	// CABundles are PEM files with CA certificates trusted in addition to the system CAs.
+	CABundles []string `json:"ca_bundles,omitempty"`
+	// MirrorRewrites rewrite URL prefixes to local mirrors.
+	MirrorRewrites []MirrorRewrite `json:"mirror_rewrites,omitempty"`
+}
+
+// MirrorRewrite replaces the Prefix of a URL with the Mirror prefix.
+type MirrorRewrite struct {
+	Prefix string `json:"prefix"`
+	Mirror string `json:"mirror"`
+}
+
+// NetworkProfile applies a NetworkConfig to HTTP clients, apt and URLs.  The zero profile, and
+// the one of a configuration without a network section, connects directly and uses the proxy
+// environment variables of inbd, if any.
+type NetworkProfile struct {
+	config      NetworkConfig
+	credentials *neturl.Userinfo
+	rootCAs     *x509.CertPool
+	caBundles   [][]byte
+	proxyFunc   func(*neturl.URL) (*neturl.URL, error)
+}
+
+// NewNetworkProfile validates a network configuration and reads its credentials and CA bundles.
+func NewNetworkProfile(fs afero.Fs, config *NetworkConfig) (*NetworkProfile, error) {
+	p := &NetworkProfile{}
+	if config == nil {
+		return p, nil
+	}
+	p.config = *config
+
+	for name, proxy := range map[string]string{"http_proxy": config.HTTPProxy, "https_proxy": config.HTTPSProxy} {
+		if proxy == "" {
+			continue
+		}
+		u, err := neturl.Parse(proxy)
+		if err != nil || u.Host == "" || !slices.Contains([]string{"http", "https", "socks5"}, u.Scheme) {
+			return nil, fmt.Errorf("invalid %s '%s': must be an http, https or socks5 URL", name, proxy)
+		}
+		if u.User != nil {
+			return nil, fmt.Errorf("invalid %s: credentials belong in proxy_credentials_file", name)
+		}
+	}
+	for _, rewrite := range config.MirrorRewrites {
+		for _, prefix := range []string{rewrite.Prefix, rewrite.Mirror} {
+			u, err := neturl.Parse(prefix)
+			if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+				return nil, fmt.Errorf("invalid mirror rewrite '%s': must be an http or https URL", prefix)
+			}
+		}
+	}
+
+	if config.ProxyCredentialsFile != "" {
+		data, err := ReadFile(fs, config.ProxyCredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading proxy credentials: %w", err)
+		}
+		user, password, ok := strings.Cut(strings.TrimSpace(string(data)), ":")
+		if !ok || user == "" {
+			return nil, fmt.Errorf("proxy credentials in %s must be user:password", config.ProxyCredentialsFile)
+		}
+		p.credentials = neturl.UserPassword(user, password)
+	}
+
+	if len(config.CABundles) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			log.Printf("Failed to load system CA pool, creating new one: %v", err)
+			pool = x509.NewCertPool()
+		}
+		for _, bundle := range config.CABundles {
+			data, err := ReadFile(fs, bundle)
+			if err != nil {
+				return nil, fmt.Errorf("error reading CA bundle: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(data) {
+				return nil, fmt.Errorf("no CA certificates found in %s", bundle)
+			}
+			p.caBundles = append(p.caBundles, data)
+		}
+		p.rootCAs = pool
+	}
+
+	if config.HTTPProxy != "" || config.HTTPSProxy != "" {
+		proxyConfig := &httpproxy.Config{
+			HTTPProxy:  p.withCredentials(config.HTTPProxy),
+			HTTPSProxy: p.withCredentials(config.HTTPSProxy),
+			NoProxy:    strings.Join(config.NoProxy, ","),
+		}
+		p.proxyFunc = proxyConfig.ProxyFunc()
+	}
+	return p, nil
+}
+
+// LoadNetworkProfile returns the network profile of the configuration file.  An invalid or
+// unreadable network section is logged and the direct profile is returned, so that a broken
+// profile does not stop updates on sites with direct internet access.
+func LoadNetworkProfile(fs afero.Fs) *NetworkProfile {
+	config, err := LoadConfig(fs, ConfigFilePath)
+	if err != nil {
+		log.Printf("[Warning] Error loading network profile, connecting directly: %v", err)
+		return &NetworkProfile{}
+	}
+	profile, err := NewNetworkProfile(fs, config.Network)
+	if err != nil {
+		log.Printf("[Warning] Invalid network profile, connecting directly: %v", err)
+		return &NetworkProfile{}
+	}
+	return profile
+}
+
+func (p *NetworkProfile) withCredentials(proxy string) string {
+	if proxy == "" || p.credentials == nil {
+		return proxy
+	}
+	u, err := neturl.Parse(proxy)
+	if err != nil {
+		return proxy
+	}
+	u.User = p.credentials
+	return u.String()
+}
+
+// Proxy returns the proxy for a request, for use as http.Transport.Proxy.
+func (p *NetworkProfile) Proxy(req *http.Request) (*neturl.URL, error) {
+	if p.proxyFunc == nil {
+		return http.ProxyFromEnvironment(req)
+	}
+	return p.proxyFunc(req.URL)
+}
+
+// RewriteURL replaces the longest matching mirror prefix of a URL.  URLs without a matching
+// prefix, and all URLs of a nil profile, are returned unchanged.
+func (p *NetworkProfile) RewriteURL(rawURL string) string {
+	if p == nil {
+		return rawURL
+	}
+	best := -1
+	for i, rewrite := range p.config.MirrorRewrites {
+		if strings.HasPrefix(rawURL, rewrite.Prefix) &&
+			(best < 0 || len(rewrite.Prefix) > len(p.config.MirrorRewrites[best].Prefix)) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return rawURL
+	}
+	rewrite := p.config.MirrorRewrites[best]
+	return rewrite.Mirror + strings.TrimPrefix(rawURL, rewrite.Prefix)
+}
+
+// RewriteSourceLine rewrites the URLs in a line of an apt sources file.
+func (p *NetworkProfile) RewriteSourceLine(line string) string {
+	if p == nil || len(p.config.MirrorRewrites) == 0 {
+		return line
+	}
+	fields := strings.Fields(line)
+	changed := false
+	for i, field := range fields {
+		if strings.HasPrefix(field, "http://") || strings.HasPrefix(field, "https://") {
+			fields[i] = p.RewriteURL(field)
+			changed = changed || fields[i] != field
+		}
+	}
+	if !changed {
+		return line
+	}
+	return strings.Join(fields, " ")
+}
+
+// ConfigureTLS adds the CA bundles of the profile to the root CAs of a TLS configuration, or
+// trusts the system CAs and the CA bundles if it has no root CAs.
+func (p *NetworkProfile) ConfigureTLS(tlsConfig *tls.Config) {
+	if p.rootCAs == nil {
+		return
+	}
+	if tlsConfig.RootCAs == nil {
+		tlsConfig.RootCAs = p.rootCAs
+		return
+	}
+	for _, bundle := range p.caBundles {
+		tlsConfig.RootCAs.AppendCertsFromPEM(bundle)
+	}
+}
+
+// Transport returns an HTTP transport that rewrites URLs to mirrors, goes through the proxy
+// and trusts the CA bundles of the profile.  tlsConfig may be nil.
+func (p *NetworkProfile) Transport(tlsConfig *tls.Config) http.RoundTripper {
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	p.ConfigureTLS(tlsConfig)
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = p.Proxy
+	transport.TLSClientConfig = tlsConfig
+	return &mirrorTransport{profile: p, next: transport}
+}
+
+// HTTPClient returns an HTTP client that uses the transport of the profile.
+func (p *NetworkProfile) HTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout, Transport: p.Transport(nil)}
+}
+
+// Endpoints returns the host:port of the proxies and mirrors of the profile, which must be
+// reachable for downloads to work.
+func (p *NetworkProfile) Endpoints() []string {
+	var endpoints []string
+	urls := []string{p.config.HTTPProxy, p.config.HTTPSProxy}
+	for _, rewrite := range p.config.MirrorRewrites {
+		urls = append(urls, rewrite.Mirror)
+	}
+	for _, rawURL := range urls {
+		u, err := neturl.Parse(rawURL)
+		if rawURL == "" || err != nil {
+			continue
+		}
+		port := u.Port()
+		if port == "" {
+			port = map[string]string{"http": "80", "https": "443", "socks5": "1080"}[u.Scheme]
+		}
+		if endpoint := net.JoinHostPort(u.Hostname(), port); !slices.Contains(endpoints, endpoint) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// AptConfig returns the apt configuration of the profile, or "" if apt needs none.  It holds
+// the proxy credentials, so it must only be written to a file readable by root.
+func (p *NetworkProfile) AptConfig(caInfoPath string) string {
+	var b strings.Builder
+	for _, scheme := range []string{"http", "https"} {
+		proxy := p.config.HTTPProxy
+		if scheme == "https" {
+			proxy = p.config.HTTPSProxy
+		}
+		if proxy == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "Acquire::%s::Proxy \"%s\";\n", scheme, p.withCredentials(proxy))
+		// apt only bypasses the proxy for exact host names.
+		for _, host := range p.config.NoProxy {
+			if net.ParseIP(host) != nil || (host != "" && !strings.HasPrefix(host, ".") && !strings.ContainsAny(host, "*/:")) {
+				fmt.Fprintf(&b, "Acquire::%s::Proxy::%s \"DIRECT\";\n", scheme, host)
+			}
+		}
+	}
+	if len(p.caBundles) > 0 && caInfoPath != "" {
+		fmt.Fprintf(&b, "Acquire::https::CAInfo \"%s\";\n", caInfoPath)
+	}
+	return b.String()
+}
+
+// CABundles returns the PEM data of the CA bundles of the profile.
+func (p *NetworkProfile) CABundles() [][]byte {
+	return p.caBundles
+}
+
+// mirrorTransport rewrites the URL of every request to its mirror before sending it.
+type mirrorTransport struct {
+	profile *NetworkProfile
+	next    http.RoundTripper
+}
+
+func (t *mirrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rewritten := t.profile.RewriteURL(req.URL.String())
+	if rewritten == req.URL.String() {
+		return t.next.RoundTrip(req)
+	}
+	u, err := neturl.Parse(rewritten)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mirror URL '%s': %w", rewritten, err)
+	}
+	log.Printf("Fetching %s from mirror %s", req.URL.Redacted(), u.Redacted())
+	clone := req.Clone(req.Context())
+	clone.URL = u
+	clone.Host = ""
+	if u.Host != req.URL.Host {
+		// Credentials of the original server are not sent to the mirror.
+		clone.Header.Del("Authorization")
+	}
+	return t.next.RoundTrip(clone)
+}
@@ -40,3 +40,34 @@ func ShutdownSystem(cmdExecutor common.Executor) error {
 
 	return nil
 }
+
+// SuspendSystem suspends the system to RAM using the provided command executor.
+func SuspendSystem(cmdExecutor common.Executor) error {
+	return systemctlPowerAction(cmdExecutor, "suspend")
+}
+
+// HibernateSystem suspends the system to disk using the provided command executor.
+func HibernateSystem(cmdExecutor common.Executor) error {
+	return systemctlPowerAction(cmdExecutor, "hibernate")
+}
+
+// SoftRebootSystem restarts the userspace without restarting the kernel using the provided
+// command executor.  It requires systemd 254 or later.
+func SoftRebootSystem(cmdExecutor common.Executor) error {
+	return systemctlPowerAction(cmdExecutor, "soft-reboot")
+}
+
+// systemctlPowerAction performs a power action with systemctl, after a short delay so that
+// the response to the request can be sent.
+func systemctlPowerAction(cmdExecutor common.Executor, verb string) error {
+	fmt.Printf("Performing %s\n", verb)
+
+	time.Sleep(2 * time.Second)
+
+	_, _, err := cmdExecutor.Execute([]string{common.SystemctlCmd, verb})
+	if err != nil {
+		return fmt.Errorf("%s failed: %s", verb, err)
+	}
+
+	return nil
+}
@@ -463,3 +463,31 @@ func TestShutdownSystem_VerifyShutdownCommand(t *testing.T) {
 
 	mockExecutor.AssertCalled(t, "Execute", []string{common.ShutdownCmd, "now"})
 }
+
+// TestSystemctlPowerActions tests that suspend, hibernate and soft-reboot run systemctl
+func TestSystemctlPowerActions(t *testing.T) {
+	testCases := []struct {
+		name   string
+		verb   string
+		action func(common.Executor) error
+	}{
+		{"suspend", "suspend", SuspendSystem},
+		{"hibernate", "hibernate", HibernateSystem},
+		{"soft reboot", "soft-reboot", SoftRebootSystem},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockExecutor := &RebootMockExecutor{}
+			mockExecutor.On("Execute", []string{common.SystemctlCmd, tc.verb}).Return("", "", nil)
+			assert.NoError(t, tc.action(mockExecutor))
+			mockExecutor.AssertCalled(t, "Execute", []string{common.SystemctlCmd, tc.verb})
+
+			failing := &RebootMockExecutor{}
+			failing.On("Execute", []string{common.SystemctlCmd, tc.verb}).Return("", "", errors.New("exit status 1"))
+			assert.EqualError(t, tc.action(failing), tc.verb+" failed: exit status 1")
+		})
+	}
+}
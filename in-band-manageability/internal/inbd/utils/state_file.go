@@ -44,6 +44,16 @@ type INBDState struct {
 	SnapshotNumber int    `json:"snapshot_number"`
 	TiberVersion   string `json:"tiber-version"`
 	PackageList    string `json:"package_list,omitempty"`
+	// SnapshotBackend is the backend that created the pre-update snapshot (snapper, lvm or package-list).
+	// It is empty for state files written before backends were recorded, which always used snapper.
+	SnapshotBackend string `json:"snapshot_backend,omitempty"`
+	SnapshotID      string `json:"snapshot_id,omitempty"`
+	// RollbackState is set while the system is rolled back after a failed post-reboot verification.
+	RollbackState string `json:"rollback_state,omitempty"`
+	// RollbackReason is the granular log failure reason that caused the rollback.
+	RollbackReason string `json:"rollback_reason,omitempty"`
+	// VerificationAttempts counts the boots on which post-reboot verification ran for this update.
+	VerificationAttempts int `json:"verification_attempts,omitempty"`
 }
 
 // WriteToStateFile writes the content to the state file.
@@ -131,6 +131,63 @@ func verifySignatureWithFS(fs afero.Fs, signature, pathToFile string, hashAlgori
 	return nil
 }
 
+// ReadSignedDataFile reads a data file that is not an update package, such as a vulnerability
+// database, and verifies its signed checksum against the OTA package certificate.  Unlike
+// VerifySignature, a signature is always required.  The returned content is the one that was
+// verified, so the file can not be replaced between the check and its use.  A maxSize greater
+// than 0 limits the size of the file.
+func ReadSignedDataFile(fs afero.Fs, signature, pathToFile string, maxSize int64, hashAlgorithm *HashAlgorithm) ([]byte, error) {
+	if signature == "" {
+		return nil, fmt.Errorf("signature is required")
+	}
+	if err := isFilePathAbsolute(pathToFile); err != nil {
+		return nil, fmt.Errorf("signature check failed: invalid path: %w", err)
+	}
+	if err := isFilePathSymLink(pathToFile); err != nil {
+		return nil, fmt.Errorf("signature check failed: path is symlink: %w", err)
+	}
+
+	file, err := Open(fs, pathToFile)
+	if err != nil {
+		return nil, fmt.Errorf("signature check failed: %w", err)
+	}
+	defer file.Close()
+	var reader io.Reader = file
+	if maxSize > 0 {
+		reader = io.LimitReader(file, maxSize+1)
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("signature check failed: could not read file content: %w", err)
+	}
+	if maxSize > 0 && int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", pathToFile, maxSize)
+	}
+
+	if err := verifyTrustedSignature(fs, signature, content, hashAlgorithm); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// verifyTrustedSignature verifies the signed checksum of content against the OTA package
+// certificate of the device, never against a certificate that comes with the content.
+func verifyTrustedSignature(fs afero.Fs, signature string, content []byte, hashAlgorithm *HashAlgorithm) error {
+	if signature == "" {
+		return fmt.Errorf("signature is required")
+	}
+	checksum, err := calculateChecksum(content, hashAlgorithm)
+	if err != nil {
+		return fmt.Errorf("signature check failed: could not create checksum: %w", err)
+	}
+	if err := verifyChecksumWithCertificateFile(fs, OTAPackageCertPath, signature, checksum); err != nil {
+		return fmt.Errorf("signature check failed: %w", err)
+	}
+
+	log.Printf("Signature verification passed.")
+	return nil
+}
+
 // shouldRequireSignature checks if signature verification should be required
 func shouldRequireSignature(fs afero.Fs) bool {
 	return IsFileExist(fs, OTAPackageCertPath)
@@ -302,7 +359,11 @@ func calculateFileChecksum(fs afero.Fs, filePath string, hashAlgorithm *HashAlgo
 	if err != nil {
 		return nil, fmt.Errorf("could not read file content: %w", err)
 	}
+	return calculateChecksum(content, hashAlgorithm)
+}
 
+// calculateChecksum returns the hex checksum of content, with SHA-384 by default.
+func calculateChecksum(content []byte, hashAlgorithm *HashAlgorithm) ([]byte, error) {
 	var hasher hash.Hash
 	// Default to SHA384
 	switch {
@@ -410,6 +410,32 @@ func TestVerifySignature_DirectFile(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestReadSignedDataFile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	priv, certPEM := generateTestCertAndKey(t)
+	require.NoError(t, MkdirAll(fs, "/etc/intel-manageability/public", 0755))
+	require.NoError(t, WriteFile(fs, OTAPackageCertPath, certPEM, 0644))
+
+	content := []byte(`[{"id":"CVE-2024-0001"}]`)
+	dataPath := "/tmp/osv.json"
+	require.NoError(t, MkdirAll(fs, "/tmp", 0755))
+	require.NoError(t, WriteFile(fs, dataPath, content, 0644))
+	signature := generateSignature(t, priv, content)
+
+	data, err := ReadSignedDataFile(fs, signature, dataPath, int64(len(content)), nil)
+	require.NoError(t, err)
+	assert.Equal(t, content, data, "the verified content is returned")
+
+	_, err = ReadSignedDataFile(fs, signature, dataPath, int64(len(content))-1, nil)
+	assert.ErrorContains(t, err, "is larger than")
+
+	_, err = ReadSignedDataFile(fs, "", dataPath, 0, nil)
+	assert.ErrorContains(t, err, "signature is required")
+
+	_, err = ReadSignedDataFile(fs, generateSignature(t, priv, []byte("other")), dataPath, 0, nil)
+	assert.ErrorContains(t, err, "signature check failed")
+}
+
 func TestVerifySignature_TarWithPEM(t *testing.T) {
 	// Use memory filesystem to avoid requiring root privileges
 	fs := afero.NewMemMapFs()
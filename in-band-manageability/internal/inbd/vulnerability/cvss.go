@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vulnerability
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Severities of a vulnerability, from most to least severe.
+const (
+	SeverityCritical   = "critical"
+	SeverityHigh       = "high"
+	SeverityMedium     = "medium"
+	SeverityLow        = "low"
+	SeverityNegligible = "negligible"
+	SeverityUnknown    = "unknown"
+)
+
+// severityRank orders severities; a higher rank is more severe.
+var severityRank = map[string]int{
+	SeverityCritical:   5,
+	SeverityHigh:       4,
+	SeverityMedium:     3,
+	SeverityLow:        2,
+	SeverityNegligible: 1,
+	SeverityUnknown:    0,
+}
+
+// normalizeSeverity maps the severity names used by OSV, GHSA, Debian and Ubuntu to one of the
+// Severity constants.
+func normalizeSeverity(s string) string {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "critical":
+		return SeverityCritical
+	case "high", "important":
+		return SeverityHigh
+	case "medium", "moderate":
+		return SeverityMedium
+	case "low":
+		return SeverityLow
+	case "negligible", "unimportant", "none":
+		return SeverityNegligible
+	default:
+		return SeverityUnknown
+	}
+}
+
+// cvssSeverity returns the qualitative severity rating of a CVSS v3 base score.
+func cvssSeverity(score float64) string {
+	switch {
+	case score >= 9.0:
+		return SeverityCritical
+	case score >= 7.0:
+		return SeverityHigh
+	case score >= 4.0:
+		return SeverityMedium
+	case score > 0:
+		return SeverityLow
+	default:
+		return SeverityNegligible
+	}
+}
+
+var cvssWeights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"UI": {"N": 0.85, "R": 0.62},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0},
+}
+
+// CVSSv3BaseScore computes the base score of a CVSS v3.0 or v3.1 vector such as
+// CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H.
+func CVSSv3BaseScore(vector string) (float64, error) {
+	parts := strings.Split(vector, "/")
+	if len(parts) == 0 || (parts[0] != "CVSS:3.0" && parts[0] != "CVSS:3.1") {
+		return 0, fmt.Errorf("not a CVSS v3 vector: %q", vector)
+	}
+	metrics := make(map[string]string)
+	for _, part := range parts[1:] {
+		key, value, found := strings.Cut(part, ":")
+		if !found {
+			return 0, fmt.Errorf("invalid CVSS metric %q", part)
+		}
+		metrics[key] = value
+	}
+
+	scope := metrics["S"]
+	if scope != "U" && scope != "C" {
+		return 0, fmt.Errorf("invalid CVSS scope %q", scope)
+	}
+	weights := make(map[string]float64)
+	for metric, values := range cvssWeights {
+		w, ok := values[metrics[metric]]
+		if !ok {
+			return 0, fmt.Errorf("invalid CVSS metric %s:%s", metric, metrics[metric])
+		}
+		weights[metric] = w
+	}
+	switch {
+	case metrics["PR"] == "N":
+		weights["PR"] = 0.85
+	case metrics["PR"] == "L" && scope == "U":
+		weights["PR"] = 0.62
+	case metrics["PR"] == "L":
+		weights["PR"] = 0.68
+	case metrics["PR"] == "H" && scope == "U":
+		weights["PR"] = 0.27
+	case metrics["PR"] == "H":
+		weights["PR"] = 0.5
+	default:
+		return 0, fmt.Errorf("invalid CVSS metric PR:%s", metrics["PR"])
+	}
+
+	iss := 1 - (1-weights["C"])*(1-weights["I"])*(1-weights["A"])
+	impact := 6.42 * iss
+	if scope == "C" {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	if impact <= 0 {
+		return 0, nil
+	}
+	exploitability := 8.22 * weights["AV"] * weights["AC"] * weights["PR"] * weights["UI"]
+	if scope == "U" {
+		return cvssRoundUp(math.Min(impact+exploitability, 10)), nil
+	}
+	return cvssRoundUp(math.Min(1.08*(impact+exploitability), 10)), nil
+}
+
+// cvssRoundUp rounds up to one decimal as defined in appendix A of the CVSS v3.1 specification.
+func cvssRoundUp(x float64) float64 {
+	i := int64(math.Round(x * 100000))
+	if i%10000 == 0 {
+		return float64(i) / 100000
+	}
+	return float64(i/10000+1) / 10
+}
@@ -0,0 +1,53 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vulnerability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCVSSv3BaseScore(t *testing.T) {
+	tests := []struct {
+		vector string
+		want   float64
+	}{
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 9.8},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", 10.0},
+		{"CVSS:3.1/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N", 5.5},
+		{"CVSS:3.1/AV:N/AC:H/PR:N/UI:R/S:U/C:L/I:N/A:N", 3.1},
+		{"CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:C/C:L/I:L/A:N", 6.4},
+		{"CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N", 0},
+	}
+	for _, tt := range tests {
+		score, err := CVSSv3BaseScore(tt.vector)
+		require.NoError(t, err, tt.vector)
+		assert.Equal(t, tt.want, score, tt.vector)
+	}
+
+	for _, vector := range []string{
+		"AV:N/AC:L/Au:N/C:P/I:P/A:P",
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H",
+		"CVSS:3.1/AV:X/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+	} {
+		_, err := CVSSv3BaseScore(vector)
+		assert.Error(t, err, vector)
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	assert.Equal(t, SeverityHigh, normalizeSeverity("Important"))
+	assert.Equal(t, SeverityMedium, normalizeSeverity("MODERATE"))
+	assert.Equal(t, SeverityNegligible, normalizeSeverity("unimportant"))
+	assert.Equal(t, SeverityUnknown, normalizeSeverity("not yet assigned"))
+
+	assert.Equal(t, SeverityCritical, cvssSeverity(9.8))
+	assert.Equal(t, SeverityHigh, cvssSeverity(7.0))
+	assert.Equal(t, SeverityMedium, cvssSeverity(5.5))
+	assert.Equal(t, SeverityLow, cvssSeverity(3.1))
+}
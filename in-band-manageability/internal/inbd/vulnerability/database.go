@@ -0,0 +1,188 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package vulnerability matches the installed packages against an offline vulnerability database.
+//
+// The database is converted from a signed OSV dataset (a JSON file of OSV records, or a zip
+// archive of them as published at https://osv-vulnerabilities.storage.googleapis.com) or from an
+// Ubuntu or Debian OVAL file, optionally compressed with bzip2.  Only the advisories for the
+// distribution release of the device are kept.  Versions are compared like dpkg and rpm do.
+package vulnerability
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+)
+
+// DatabasePath is the file holding the loaded vulnerability database.
+const DatabasePath = "/var/intel-manageability/vulnerability_db.json"
+
+// maxDatasetSize is the largest dataset, after decompression, that is loaded.
+const maxDatasetSize = 512 << 20
+
+// Formats of the dataset a database was converted from.
+const (
+	FormatOSV  = "osv"
+	FormatOVAL = "oval"
+)
+
+// ErrNoDatabase is returned when no vulnerability database has been loaded.
+var ErrNoDatabase = errors.New("no vulnerability database is loaded")
+
+// Database is a vulnerability database converted from an OSV or OVAL dataset.
+type Database struct {
+	Format     string     `json:"format"`
+	Source     string     `json:"source"` // File name of the dataset
+	LoadedAt   time.Time  `json:"loaded_at"`
+	Advisories []Advisory `json:"advisories"`
+}
+
+// Advisory is a vulnerability and the packages it affects.
+type Advisory struct {
+	ID        string            `json:"id"`
+	Aliases   []string          `json:"aliases,omitempty"`
+	Summary   string            `json:"summary,omitempty"`
+	Severity  string            `json:"severity"`
+	CVSSScore float64           `json:"cvss_score,omitempty"`
+	Affected  []AffectedPackage `json:"affected"`
+}
+
+// AffectedPackage is a package affected by an advisory in one ecosystem.
+type AffectedPackage struct {
+	Ecosystem string         `json:"ecosystem"` // OSV ecosystem, e.g. Ubuntu:22.04:LTS or Debian:12
+	Name      string         `json:"name"`
+	Ranges    []VersionRange `json:"ranges,omitempty"`
+	Versions  []string       `json:"versions,omitempty"` // Affected versions listed one by one
+}
+
+// VersionRange is a range of affected versions.  Without Fixed and LastAffected, every version
+// from Introduced on is affected.
+type VersionRange struct {
+	Introduced   string `json:"introduced,omitempty"` // Empty or "0" for all earlier versions
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+}
+
+var databaseMutex sync.Mutex
+
+// LoadDatabase verifies the signature of a dataset, converts it and stores it as the
+// vulnerability database, replacing the previous one.
+func LoadDatabase(fs afero.Fs, path, signature string, hashAlgorithm *utils.HashAlgorithm) (*Database, error) {
+	// Parse the bytes that were verified, so that the file can not be swapped after the check.
+	data, err := utils.ReadSignedDataFile(fs, signature, path, maxDatasetSize, hashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	db, err := parseDataset(filepath.Base(path), data)
+	if err != nil {
+		return nil, err
+	}
+
+	release := ReadOSRelease(fs)
+	total := len(db.Advisories)
+	db.Advisories = filterAdvisories(db.Advisories, release)
+	if len(db.Advisories) == 0 {
+		return nil, fmt.Errorf("the dataset has none of its %d advisories for %s", total, release)
+	}
+	log.Printf("Loaded %d of %d advisories from %s for %s", len(db.Advisories), total, db.Source, release)
+
+	out, err := json.Marshal(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize vulnerability database: %w", err)
+	}
+	databaseMutex.Lock()
+	defer databaseMutex.Unlock()
+	if err := utils.WriteFile(fs, DatabasePath, out, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write vulnerability database: %w", err)
+	}
+	return db, nil
+}
+
+// ReadDatabase returns the loaded vulnerability database, or ErrNoDatabase.
+func ReadDatabase(fs afero.Fs) (*Database, error) {
+	databaseMutex.Lock()
+	defer databaseMutex.Unlock()
+
+	data, err := afero.ReadFile(fs, DatabasePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoDatabase
+		}
+		return nil, fmt.Errorf("failed to read vulnerability database: %w", err)
+	}
+	var db Database
+	if err := json.Unmarshal(data, &db); err != nil {
+		return nil, fmt.Errorf("failed to parse vulnerability database: %w", err)
+	}
+	return &db, nil
+}
+
+// parseDataset converts a dataset, detecting its format from the file name.
+func parseDataset(name string, data []byte) (*Database, error) {
+	lower := strings.ToLower(name)
+	if strings.HasSuffix(lower, ".bz2") {
+		decompressed, err := io.ReadAll(io.LimitReader(bzip2.NewReader(bytes.NewReader(data)), maxDatasetSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress vulnerability dataset: %w", err)
+		}
+		if len(decompressed) > maxDatasetSize {
+			return nil, fmt.Errorf("vulnerability dataset is larger than %d bytes", maxDatasetSize)
+		}
+		data = decompressed
+		lower = strings.TrimSuffix(lower, ".bz2")
+	}
+
+	db := &Database{Source: name, LoadedAt: time.Now().UTC()}
+	var err error
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		db.Format = FormatOSV
+		db.Advisories, err = parseOSVZip(data)
+	case strings.HasSuffix(lower, ".json"):
+		db.Format = FormatOSV
+		db.Advisories, err = parseOSVJSON(data)
+	case strings.HasSuffix(lower, ".xml"):
+		db.Format = FormatOVAL
+		db.Advisories, err = parseOVAL(data)
+	default:
+		return nil, fmt.Errorf("unsupported vulnerability dataset %q: must be an OSV .json or .zip file or an OVAL .xml file", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// filterAdvisories keeps the affected packages of the ecosystems of the release, and the
+// advisories that still have any.
+func filterAdvisories(advisories []Advisory, release OSRelease) []Advisory {
+	result := make([]Advisory, 0, len(advisories))
+	for _, a := range advisories {
+		var affected []AffectedPackage
+		for _, p := range a.Affected {
+			if release.matches(p.Ecosystem) {
+				affected = append(affected, p)
+			}
+		}
+		if len(affected) > 0 {
+			a.Affected = affected
+			result = append(result, a)
+		}
+	}
+	return result
+}
@@ -0,0 +1,277 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vulnerability
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOSVRecords = `[
+  {
+    "id": "UBUNTU-CVE-2024-0001",
+    "upstream": ["CVE-2024-0001"],
+    "summary": "openssl: buffer overflow in X.509 parsing",
+    "severity": [
+      {"type": "Ubuntu", "score": "high"},
+      {"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}
+    ],
+    "affected": [
+      {
+        "package": {"ecosystem": "Ubuntu:22.04:LTS", "name": "openssl"},
+        "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "3.0.2-0ubuntu1.16"}]}]
+      },
+      {
+        "package": {"ecosystem": "Ubuntu:24.04:LTS", "name": "openssl"},
+        "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "3.0.13-0ubuntu3.2"}]}]
+      }
+    ]
+  },
+  {
+    "id": "DEBIAN-CVE-2024-0002",
+    "aliases": ["CVE-2024-0002"],
+    "details": "curl: cookie injection\nMore details.",
+    "affected": [
+      {
+        "package": {"ecosystem": "Debian:12", "name": "curl"},
+        "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}]}],
+        "ecosystem_specific": {"urgency": "low"}
+      }
+    ]
+  },
+  {
+    "id": "GHSA-xxxx-yyyy-zzzz",
+    "affected": [{"package": {"ecosystem": "PyPI", "name": "requests"}, "versions": ["2.0.0"]}]
+  },
+  {
+    "id": "UBUNTU-CVE-2024-0003",
+    "withdrawn": "2024-02-01T00:00:00Z",
+    "affected": [{"package": {"ecosystem": "Ubuntu:22.04:LTS", "name": "bash"}, "versions": ["5.1-6ubuntu1"]}]
+  }
+]`
+
+const testOVAL = `<?xml version="1.0" encoding="UTF-8"?>
+<oval_definitions xmlns="http://oval.mitre.org/XMLSchema/oval-definitions-5"
+    xmlns:linux="http://oval.mitre.org/XMLSchema/oval-definitions-5#linux">
+  <definitions>
+    <definition class="inventory" id="oval:com.ubuntu.jammy:def:100" version="1">
+      <metadata><title>Check that Ubuntu 22.04 LTS (jammy) is installed.</title></metadata>
+      <criteria><criterion test_ref="oval:com.ubuntu.jammy:tst:100" comment="release"/></criteria>
+    </definition>
+    <definition class="vulnerability" id="oval:com.ubuntu.jammy:def:202400041000000" version="1">
+      <metadata>
+        <title>CVE-2024-0004 on Ubuntu 22.04 LTS (jammy) - medium</title>
+        <reference source="CVE" ref_id="CVE-2024-0004" ref_url="https://ubuntu.com/security/CVE-2024-0004"/>
+        <advisory>
+          <severity>Medium</severity>
+          <cve href="https://ubuntu.com/security/CVE-2024-0004" priority="medium" cvss_score="5.5"
+               cvss_vector="CVSS:3.1/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N">CVE-2024-0004</cve>
+        </advisory>
+      </metadata>
+      <criteria operator="OR">
+        <criteria operator="AND">
+          <criterion test_ref="oval:com.ubuntu.jammy:tst:1" comment="libc6 and libc-bin are earlier than 2.35-0ubuntu3.7"/>
+        </criteria>
+        <criterion test_ref="oval:com.ubuntu.jammy:tst:2" comment="vim is installed"/>
+      </criteria>
+    </definition>
+  </definitions>
+  <tests>
+    <linux:dpkginfo_test id="oval:com.ubuntu.jammy:tst:1" check="at least one" version="1">
+      <linux:object object_ref="oval:com.ubuntu.jammy:obj:1"/>
+      <linux:state state_ref="oval:com.ubuntu.jammy:ste:1"/>
+    </linux:dpkginfo_test>
+    <linux:dpkginfo_test id="oval:com.ubuntu.jammy:tst:2" check="at least one" version="1">
+      <linux:object object_ref="oval:com.ubuntu.jammy:obj:2"/>
+    </linux:dpkginfo_test>
+  </tests>
+  <objects>
+    <linux:dpkginfo_object id="oval:com.ubuntu.jammy:obj:1" version="1">
+      <linux:name var_ref="oval:com.ubuntu.jammy:var:1" var_check="at least one"/>
+    </linux:dpkginfo_object>
+    <linux:dpkginfo_object id="oval:com.ubuntu.jammy:obj:2" version="1">
+      <linux:name>vim</linux:name>
+    </linux:dpkginfo_object>
+  </objects>
+  <states>
+    <linux:dpkginfo_state id="oval:com.ubuntu.jammy:ste:1" version="1">
+      <linux:evr datatype="debian_evr_string" operation="less than">0:2.35-0ubuntu3.7</linux:evr>
+    </linux:dpkginfo_state>
+  </states>
+  <variables>
+    <constant_variable id="oval:com.ubuntu.jammy:var:1" version="1" datatype="string">
+      <value>libc6</value>
+      <value>libc-bin</value>
+    </constant_variable>
+  </variables>
+</oval_definitions>`
+
+func TestParseOSVJSON(t *testing.T) {
+	advisories, err := parseOSVJSON([]byte(testOSVRecords))
+	require.NoError(t, err)
+	require.Len(t, advisories, 3, "withdrawn records are skipped")
+
+	openssl := advisories[0]
+	assert.Equal(t, "UBUNTU-CVE-2024-0001", openssl.ID)
+	assert.Equal(t, []string{"CVE-2024-0001"}, openssl.Aliases)
+	assert.Equal(t, SeverityHigh, openssl.Severity)
+	assert.Equal(t, 9.8, openssl.CVSSScore)
+	require.Len(t, openssl.Affected, 2)
+	assert.Equal(t, []VersionRange{{Introduced: "0", Fixed: "3.0.2-0ubuntu1.16"}}, openssl.Affected[0].Ranges)
+
+	curl := advisories[1]
+	assert.Equal(t, "curl: cookie injection", curl.Summary)
+	assert.Equal(t, SeverityLow, curl.Severity)
+	assert.Equal(t, []VersionRange{{Introduced: "0"}}, curl.Affected[0].Ranges)
+}
+
+func TestParseOSVJSON_SingleRecord(t *testing.T) {
+	advisories, err := parseOSVJSON([]byte(`{"id": "DSA-5000-1", "affected": [{"package": {"ecosystem": "Debian:12", "name": "curl"},
+		"ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "7.0"}, {"last_affected": "7.88.1-10"}]}]}]}`))
+	require.NoError(t, err)
+	require.Len(t, advisories, 1)
+	assert.Equal(t, SeverityUnknown, advisories[0].Severity)
+	assert.Equal(t, []VersionRange{{Introduced: "7.0", LastAffected: "7.88.1-10"}}, advisories[0].Affected[0].Ranges)
+
+	_, err = parseOSVJSON([]byte(`{"id": `))
+	assert.ErrorContains(t, err, "failed to parse OSV record")
+}
+
+func TestConvertOSVEvents(t *testing.T) {
+	ranges := convertOSVEvents([]map[string]string{
+		{"introduced": "1.0"}, {"fixed": "1.5"},
+		{"fixed": "0.9"},
+		{"introduced": "2.0"},
+	})
+	assert.Equal(t, []VersionRange{
+		{Introduced: "1.0", Fixed: "1.5"},
+		{Introduced: "0", Fixed: "0.9"},
+		{Introduced: "2.0"},
+	}, ranges)
+}
+
+func TestParseOSVZip(t *testing.T) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"UBUNTU-CVE-2024-0005.json": `{"id": "UBUNTU-CVE-2024-0005", "affected": [{"package": {"ecosystem": "Ubuntu:22.04:LTS", "name": "bash"},
+			"ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "5.1-6ubuntu1.1"}]}]}]}`,
+		"README.txt": "not a record",
+	} {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	db, err := parseDataset("all.zip", buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, FormatOSV, db.Format)
+	require.Len(t, db.Advisories, 1)
+	assert.Equal(t, "UBUNTU-CVE-2024-0005", db.Advisories[0].ID)
+}
+
+func TestParseOVAL(t *testing.T) {
+	db, err := parseDataset("com.ubuntu.jammy.cve.oval.xml", []byte(testOVAL))
+	require.NoError(t, err)
+	assert.Equal(t, FormatOVAL, db.Format)
+	require.Len(t, db.Advisories, 1)
+
+	a := db.Advisories[0]
+	assert.Equal(t, "CVE-2024-0004", a.ID)
+	assert.Empty(t, a.Aliases)
+	assert.Equal(t, SeverityMedium, a.Severity)
+	assert.Equal(t, 5.5, a.CVSSScore)
+	assert.ElementsMatch(t, []AffectedPackage{
+		{Ecosystem: "Ubuntu", Name: "libc6", Ranges: []VersionRange{{Introduced: "0", Fixed: "0:2.35-0ubuntu3.7"}}},
+		{Ecosystem: "Ubuntu", Name: "libc-bin", Ranges: []VersionRange{{Introduced: "0", Fixed: "0:2.35-0ubuntu3.7"}}},
+		{Ecosystem: "Ubuntu", Name: "vim", Ranges: []VersionRange{{Introduced: "0"}}},
+	}, a.Affected)
+}
+
+func TestParseDataset_Unsupported(t *testing.T) {
+	_, err := parseDataset("feed.csv", []byte("id,package"))
+	assert.ErrorContains(t, err, "unsupported vulnerability dataset")
+}
+
+// writeSignedDataset writes a dataset and a certificate for its signature, and returns the signature.
+func writeSignedDataset(t *testing.T, fs afero.Fs, path string, content []byte) string {
+	priv, err := rsa.GenerateKey(rand.Reader, 3072)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Organization: []string{"Intel Test"}},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	require.NoError(t, err)
+	require.NoError(t, afero.WriteFile(fs, utils.OTAPackageCertPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0644))
+	require.NoError(t, afero.WriteFile(fs, path, content, 0644))
+
+	sum := sha512.Sum384(content)
+	hashed := sha512.Sum384([]byte(hex.EncodeToString(sum[:])))
+	sig, err := rsa.SignPSS(rand.Reader, priv, crypto.SHA384, hashed[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto})
+	require.NoError(t, err)
+	return hex.EncodeToString(sig)
+}
+
+func TestLoadDatabase(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, osReleasePath, []byte("NAME=\"Ubuntu\"\nID=ubuntu\nVERSION_ID=\"22.04\"\n"), 0644))
+
+	_, err := ReadDatabase(fs)
+	assert.True(t, errors.Is(err, ErrNoDatabase))
+
+	path := "/var/cache/manageability/ubuntu-osv.json"
+	signature := writeSignedDataset(t, fs, path, []byte(testOSVRecords))
+
+	_, err = LoadDatabase(fs, path, "", nil)
+	assert.ErrorContains(t, err, "signature is required")
+
+	db, err := LoadDatabase(fs, path, signature, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ubuntu-osv.json", db.Source)
+	require.Len(t, db.Advisories, 1, "only the advisories for Ubuntu 22.04 are kept")
+	require.Len(t, db.Advisories[0].Affected, 1)
+	assert.Equal(t, "Ubuntu:22.04:LTS", db.Advisories[0].Affected[0].Ecosystem)
+
+	stored, err := ReadDatabase(fs)
+	require.NoError(t, err)
+	assert.Equal(t, db.Advisories, stored.Advisories)
+	assert.Equal(t, FormatOSV, stored.Format)
+}
+
+func TestLoadDatabase_NoAdvisoriesForRelease(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, osReleasePath, []byte("ID=rhel\nVERSION_ID=\"9.3\"\n"), 0644))
+	path := "/var/cache/manageability/ubuntu-osv.json"
+	signature := writeSignedDataset(t, fs, path, []byte(testOSVRecords))
+
+	_, err := LoadDatabase(fs, path, signature, nil)
+	assert.ErrorContains(t, err, "none of its 3 advisories for rhel 9.3")
+	_, err = ReadDatabase(fs)
+	assert.ErrorIs(t, err, ErrNoDatabase)
+}
@@ -0,0 +1,237 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vulnerability
+
+import (
+	"bufio"
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+)
+
+// osReleasePath is the file identifying the distribution release.
+const osReleasePath = "/etc/os-release"
+
+// OSRelease identifies the distribution release that advisories must apply to.
+type OSRelease struct {
+	ID        string // os-release ID, e.g. ubuntu, debian or rhel
+	VersionID string // os-release VERSION_ID, e.g. 22.04
+}
+
+// ReadOSRelease reads the distribution release from /etc/os-release.  If it can not be read, the
+// release is empty and matches every distribution.
+func ReadOSRelease(fs afero.Fs) OSRelease {
+	var release OSRelease
+	data, err := utils.ReadFile(fs, osReleasePath)
+	if err != nil {
+		return release
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if !found {
+			continue
+		}
+		value = strings.Trim(value, "\"'")
+		switch key {
+		case "ID":
+			release.ID = strings.ToLower(value)
+		case "VERSION_ID":
+			release.VersionID = value
+		}
+	}
+	return release
+}
+
+func (r OSRelease) String() string {
+	if r.ID == "" {
+		return "an unknown distribution"
+	}
+	return strings.TrimSpace(r.ID + " " + r.VersionID)
+}
+
+// ecosystems maps the names of OSV ecosystems of Linux distributions to their os-release ID and
+// package type.
+var ecosystems = map[string]struct{ id, packageType string }{
+	"ubuntu":      {"ubuntu", "deb"},
+	"debian":      {"debian", "deb"},
+	"red hat":     {"rhel", "rpm"},
+	"almalinux":   {"almalinux", "rpm"},
+	"rocky linux": {"rocky", "rpm"},
+}
+
+// ecosystemPackageType returns the package type of an OSV ecosystem such as Ubuntu:22.04:LTS, or
+// "" if it is not a supported distribution.
+func ecosystemPackageType(ecosystem string) string {
+	name, _, _ := strings.Cut(ecosystem, ":")
+	return ecosystems[strings.ToLower(name)].packageType
+}
+
+// matches reports whether an OSV ecosystem applies to the release.  Ecosystems without a release
+// number, such as those converted from OVAL, apply to every release of the distribution.
+func (r OSRelease) matches(ecosystem string) bool {
+	parts := strings.Split(ecosystem, ":")
+	e, ok := ecosystems[strings.ToLower(parts[0])]
+	if !ok {
+		return false
+	}
+	if r.ID == "" {
+		return true
+	}
+	if e.id != r.ID {
+		return false
+	}
+	if r.VersionID == "" {
+		return true
+	}
+	major, _, _ := strings.Cut(r.VersionID, ".")
+	versioned := false
+	for _, part := range parts[1:] {
+		if part == "" || !isDigit(part[0]) {
+			continue
+		}
+		versioned = true
+		if part == r.VersionID || part == major {
+			return true
+		}
+	}
+	return !versioned
+}
+
+// Finding is a vulnerability of an installed package.
+type Finding struct {
+	ID               string
+	Aliases          []string
+	Summary          string
+	Severity         string
+	CVSSScore        float64
+	Package          string
+	Architecture     string
+	InstalledVersion string
+	FixedVersion     string // Empty if no fixed version is known
+}
+
+// affectedRef is an affected package of an advisory.
+type affectedRef struct {
+	advisory *Advisory
+	affected *AffectedPackage
+}
+
+// Match returns the vulnerabilities of the installed deb and rpm packages, most severe first.
+// Packages are matched by name and by source package name.
+func Match(db *Database, packages []*pb.SoftwarePackage, release OSRelease) []Finding {
+	index := make(map[string][]affectedRef)
+	for i := range db.Advisories {
+		a := &db.Advisories[i]
+		for j := range a.Affected {
+			p := &a.Affected[j]
+			if release.matches(p.Ecosystem) {
+				key := ecosystemPackageType(p.Ecosystem) + "/" + p.Name
+				index[key] = append(index[key], affectedRef{advisory: a, affected: p})
+			}
+		}
+	}
+
+	type findingKey struct{ id, name, arch string }
+	found := make(map[findingKey]int)
+	var findings []Finding
+	for _, pkg := range packages {
+		if pkg.GetVersion() == "" {
+			continue
+		}
+		refs := index[pkg.GetType()+"/"+pkg.GetName()]
+		if source := pkg.GetSourceName(); source != "" && source != pkg.GetName() {
+			refs = append(refs[:len(refs):len(refs)], index[pkg.GetType()+"/"+source]...)
+		}
+		for _, ref := range refs {
+			affected, fixed := isAffected(ref.affected, pkg.GetType(), pkg.GetVersion())
+			if !affected {
+				continue
+			}
+			key := findingKey{ref.advisory.ID, pkg.GetName(), pkg.GetArchitecture()}
+			if i, ok := found[key]; ok {
+				// The same advisory may list the package for several ecosystems of the release.
+				if findings[i].FixedVersion == "" {
+					findings[i].FixedVersion = fixed
+				}
+				continue
+			}
+			found[key] = len(findings)
+			findings = append(findings, Finding{
+				ID:               ref.advisory.ID,
+				Aliases:          ref.advisory.Aliases,
+				Summary:          ref.advisory.Summary,
+				Severity:         ref.advisory.Severity,
+				CVSSScore:        ref.advisory.CVSSScore,
+				Package:          pkg.GetName(),
+				Architecture:     pkg.GetArchitecture(),
+				InstalledVersion: pkg.GetVersion(),
+				FixedVersion:     fixed,
+			})
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if severityRank[a.Severity] != severityRank[b.Severity] {
+			return severityRank[a.Severity] > severityRank[b.Severity]
+		}
+		if a.CVSSScore != b.CVSSScore {
+			return a.CVSSScore > b.CVSSScore
+		}
+		if a.Package != b.Package {
+			return a.Package < b.Package
+		}
+		return a.ID < b.ID
+	})
+	return findings
+}
+
+// isAffected reports whether an installed version of a package is affected, and the version that
+// fixes it.
+func isAffected(p *AffectedPackage, packageType, installed string) (bool, string) {
+	compare := CompareDebianVersions
+	if packageType == "rpm" {
+		compare = CompareRPMVersions
+		if !strings.Contains(installed, ":") {
+			// rpm -qa does not report the epoch, so epochs of the advisory are not compared.
+			compare = func(a, b string) int {
+				if _, version, found := strings.Cut(b, ":"); found {
+					b = version
+				}
+				return CompareRPMVersions(a, b)
+			}
+		}
+	}
+
+	for _, v := range p.Versions {
+		if compare(installed, v) == 0 {
+			return true, ""
+		}
+	}
+	for _, r := range p.Ranges {
+		if r.Introduced != "" && r.Introduced != "0" && compare(installed, r.Introduced) < 0 {
+			continue
+		}
+		switch {
+		case r.Fixed != "":
+			if compare(installed, r.Fixed) < 0 {
+				return true, r.Fixed
+			}
+		case r.LastAffected != "":
+			if compare(installed, r.LastAffected) <= 0 {
+				return true, ""
+			}
+		default:
+			return true, ""
+		}
+	}
+	return false, ""
+}
@@ -0,0 +1,111 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vulnerability
+
+import (
+	"testing"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOSRelease(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	assert.Equal(t, OSRelease{}, ReadOSRelease(fs))
+
+	require.NoError(t, afero.WriteFile(fs, osReleasePath, []byte("NAME=\"Ubuntu\"\nID=ubuntu\nVERSION_ID=\"22.04\"\n"), 0644))
+	release := ReadOSRelease(fs)
+	assert.Equal(t, OSRelease{ID: "ubuntu", VersionID: "22.04"}, release)
+	assert.Equal(t, "ubuntu 22.04", release.String())
+}
+
+func TestOSRelease_Matches(t *testing.T) {
+	tests := []struct {
+		name      string
+		release   OSRelease
+		ecosystem string
+		want      bool
+	}{
+		{"unknown release matches distributions", OSRelease{}, "Debian:12", true},
+		{"unknown release does not match language ecosystems", OSRelease{}, "PyPI", false},
+		{"same release", OSRelease{"ubuntu", "22.04"}, "Ubuntu:22.04:LTS", true},
+		{"Pro release", OSRelease{"ubuntu", "22.04"}, "Ubuntu:Pro:22.04:LTS", true},
+		{"other release", OSRelease{"ubuntu", "22.04"}, "Ubuntu:20.04:LTS", false},
+		{"major version", OSRelease{"debian", "12"}, "Debian:12", true},
+		{"rpm major version", OSRelease{"rhel", "9.3"}, "Red Hat:9", true},
+		{"other distribution", OSRelease{"debian", "12"}, "Ubuntu:22.04:LTS", false},
+		{"unversioned ecosystem", OSRelease{"ubuntu", "24.04"}, "Ubuntu", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.release.matches(tt.ecosystem))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	db := &Database{Advisories: []Advisory{
+		{
+			ID: "UBUNTU-CVE-2024-0002", Severity: SeverityMedium, CVSSScore: 5.5,
+			Affected: []AffectedPackage{{Ecosystem: "Ubuntu:22.04:LTS", Name: "glibc", Ranges: []VersionRange{{Introduced: "0", Fixed: "2.35-0ubuntu3.7"}}}},
+		},
+		{
+			ID: "UBUNTU-CVE-2024-0001", Severity: SeverityHigh, CVSSScore: 9.8,
+			Affected: []AffectedPackage{{Ecosystem: "Ubuntu:22.04:LTS", Name: "openssl", Ranges: []VersionRange{{Introduced: "0", Fixed: "3.0.2-0ubuntu1.15"}}}},
+		},
+		{
+			ID: "UBUNTU-CVE-2024-0003", Severity: SeverityLow,
+			Affected: []AffectedPackage{{Ecosystem: "Ubuntu:22.04:LTS", Name: "vim", Versions: []string{"2:8.2.3995-1ubuntu2.15"}}},
+		},
+		{
+			ID: "RHSA-2024:0001", Severity: SeverityCritical,
+			Affected: []AffectedPackage{{Ecosystem: "Red Hat:9", Name: "openssl", Ranges: []VersionRange{{Introduced: "0", Fixed: "1:3.0.7-27.el9"}}}},
+		},
+	}}
+	packages := []*pb.SoftwarePackage{
+		{Name: "libc6", Version: "2.35-0ubuntu3.6", Architecture: "amd64", Type: "deb", SourceName: "glibc"},
+		{Name: "libc-bin", Version: "2.35-0ubuntu3.7", Architecture: "amd64", Type: "deb", SourceName: "glibc"},
+		{Name: "openssl", Version: "3.0.2-0ubuntu1.14", Architecture: "amd64", Type: "deb"},
+		{Name: "vim", Version: "2:8.2.3995-1ubuntu2.16", Architecture: "amd64", Type: "deb"},
+		{Name: "bash", Version: "5.1-6ubuntu1", Architecture: "amd64", Type: "deb"},
+	}
+
+	findings := Match(db, packages, OSRelease{ID: "ubuntu", VersionID: "22.04"})
+	require.Len(t, findings, 2)
+	assert.Equal(t, Finding{
+		ID: "UBUNTU-CVE-2024-0001", Severity: SeverityHigh, CVSSScore: 9.8,
+		Package: "openssl", Architecture: "amd64", InstalledVersion: "3.0.2-0ubuntu1.14", FixedVersion: "3.0.2-0ubuntu1.15",
+	}, findings[0])
+	assert.Equal(t, "UBUNTU-CVE-2024-0002", findings[1].ID)
+	assert.Equal(t, "libc6", findings[1].Package)
+	assert.Equal(t, "2.35-0ubuntu3.7", findings[1].FixedVersion)
+}
+
+func TestMatch_RPMWithoutEpoch(t *testing.T) {
+	db := &Database{Advisories: []Advisory{{
+		ID: "RHSA-2024:0001", Severity: SeverityCritical,
+		Affected: []AffectedPackage{{Ecosystem: "Red Hat:9", Name: "openssl", Ranges: []VersionRange{{Introduced: "0", Fixed: "1:3.0.7-27.el9"}}}},
+	}}}
+	release := OSRelease{ID: "rhel", VersionID: "9.3"}
+
+	findings := Match(db, []*pb.SoftwarePackage{{Name: "openssl", Version: "3.0.7-24.el9", Type: "rpm"}}, release)
+	require.Len(t, findings, 1)
+	assert.Equal(t, "1:3.0.7-27.el9", findings[0].FixedVersion)
+
+	assert.Empty(t, Match(db, []*pb.SoftwarePackage{{Name: "openssl", Version: "3.0.7-27.el9", Type: "rpm"}}, release))
+	assert.Empty(t, Match(db, []*pb.SoftwarePackage{{Name: "openssl", Version: "3.0.7-24.el9", Type: "deb"}}, release))
+}
+
+func TestIsAffected_LastAffected(t *testing.T) {
+	p := &AffectedPackage{Ranges: []VersionRange{{Introduced: "1.2", LastAffected: "1.4"}}}
+	for version, want := range map[string]bool{"1.1": false, "1.2": true, "1.4": true, "1.5": false} {
+		affected, fixed := isAffected(p, "deb", version)
+		assert.Equal(t, want, affected, version)
+		assert.Empty(t, fixed)
+	}
+}
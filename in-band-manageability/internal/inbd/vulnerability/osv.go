@@ -0,0 +1,241 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vulnerability
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// maxOSVRecordSize is the largest OSV record read from a zip archive.
+const maxOSVRecordSize = 16 << 20
+
+// osvRecord is the part of an OSV record (https://ossf.github.io/osv-schema/) that is used.
+type osvRecord struct {
+	ID               string          `json:"id"`
+	Aliases          []string        `json:"aliases"`
+	Upstream         []string        `json:"upstream"`
+	Summary          string          `json:"summary"`
+	Details          string          `json:"details"`
+	Withdrawn        string          `json:"withdrawn"`
+	Severity         []osvSeverity   `json:"severity"`
+	Affected         []osvAffected   `json:"affected"`
+	DatabaseSpecific json.RawMessage `json:"database_specific"`
+}
+
+type osvSeverity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+type osvAffected struct {
+	Package struct {
+		Ecosystem string `json:"ecosystem"`
+		Name      string `json:"name"`
+	} `json:"package"`
+	Severity          []osvSeverity   `json:"severity"`
+	Ranges            []osvRange      `json:"ranges"`
+	Versions          []string        `json:"versions"`
+	EcosystemSpecific json.RawMessage `json:"ecosystem_specific"`
+	DatabaseSpecific  json.RawMessage `json:"database_specific"`
+}
+
+type osvRange struct {
+	Type   string              `json:"type"`
+	Events []map[string]string `json:"events"`
+}
+
+// parseOSVJSON converts a JSON file holding one OSV record or an array of them.
+func parseOSVJSON(data []byte) ([]Advisory, error) {
+	data = bytes.TrimSpace(data)
+	var records []osvRecord
+	if bytes.HasPrefix(data, []byte("[")) {
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("failed to parse OSV records: %w", err)
+		}
+	} else {
+		var record osvRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("failed to parse OSV record: %w", err)
+		}
+		records = []osvRecord{record}
+	}
+
+	advisories := make([]Advisory, 0, len(records))
+	for _, r := range records {
+		if a, ok := convertOSVRecord(r); ok {
+			advisories = append(advisories, a)
+		}
+	}
+	return advisories, nil
+}
+
+// parseOSVZip converts a zip archive with one OSV record per .json file.
+func parseOSVZip(data []byte) ([]Advisory, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open OSV archive: %w", err)
+	}
+
+	var advisories []Advisory
+	var total int64
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() || !strings.EqualFold(path.Ext(f.Name), ".json") {
+			continue
+		}
+		if f.UncompressedSize64 > maxOSVRecordSize {
+			return nil, fmt.Errorf("OSV record %s is larger than %d bytes", f.Name, maxOSVRecordSize)
+		}
+		total += int64(f.UncompressedSize64)
+		if total > maxDatasetSize {
+			return nil, fmt.Errorf("OSV archive is larger than %d bytes", maxDatasetSize)
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open OSV record %s: %w", f.Name, err)
+		}
+		content, err := io.ReadAll(io.LimitReader(rc, maxOSVRecordSize+1))
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OSV record %s: %w", f.Name, err)
+		}
+		var record osvRecord
+		if err := json.Unmarshal(content, &record); err != nil {
+			return nil, fmt.Errorf("failed to parse OSV record %s: %w", f.Name, err)
+		}
+		if a, ok := convertOSVRecord(record); ok {
+			advisories = append(advisories, a)
+		}
+	}
+	return advisories, nil
+}
+
+// convertOSVRecord converts an OSV record; withdrawn records and records without affected
+// packages are skipped.
+func convertOSVRecord(r osvRecord) (Advisory, bool) {
+	if r.ID == "" || r.Withdrawn != "" {
+		return Advisory{}, false
+	}
+
+	a := Advisory{ID: r.ID, Summary: r.Summary}
+	if a.Summary == "" {
+		a.Summary, _, _ = strings.Cut(strings.TrimSpace(r.Details), "\n")
+	}
+	seen := map[string]bool{r.ID: true}
+	for _, alias := range append(append([]string{}, r.Upstream...), r.Aliases...) {
+		if !seen[alias] {
+			seen[alias] = true
+			a.Aliases = append(a.Aliases, alias)
+		}
+	}
+
+	severities := append([]osvSeverity{}, r.Severity...)
+	severity := stringField(r.DatabaseSpecific, "severity")
+	for _, affected := range r.Affected {
+		if affected.Package.Name == "" || affected.Package.Ecosystem == "" {
+			continue
+		}
+		severities = append(severities, affected.Severity...)
+		if severity == "" {
+			severity = stringField(affected.DatabaseSpecific, "severity")
+		}
+		if severity == "" {
+			// Debian publishes the urgency of the fix per affected package.
+			severity = stringField(affected.EcosystemSpecific, "urgency")
+		}
+
+		p := AffectedPackage{
+			Ecosystem: affected.Package.Ecosystem,
+			Name:      affected.Package.Name,
+			Versions:  affected.Versions,
+		}
+		for _, rng := range affected.Ranges {
+			if rng.Type == "ECOSYSTEM" || rng.Type == "SEMVER" {
+				p.Ranges = append(p.Ranges, convertOSVEvents(rng.Events)...)
+			}
+		}
+		if len(p.Ranges) > 0 || len(p.Versions) > 0 {
+			a.Affected = append(a.Affected, p)
+		}
+	}
+	if len(a.Affected) == 0 {
+		return Advisory{}, false
+	}
+
+	for _, s := range severities {
+		switch {
+		case strings.HasPrefix(s.Type, "CVSS_V3") && a.CVSSScore == 0:
+			if score, err := CVSSv3BaseScore(s.Score); err == nil {
+				a.CVSSScore = score
+			}
+		case s.Type == "Ubuntu":
+			// Ubuntu's priority takes precedence over the generic severity.
+			severity = s.Score
+		}
+	}
+	a.Severity = normalizeSeverity(severity)
+	if a.Severity == SeverityUnknown && a.CVSSScore > 0 {
+		a.Severity = cvssSeverity(a.CVSSScore)
+	}
+	return a, true
+}
+
+// convertOSVEvents converts the events of an OSV range into version ranges.  An event that ends
+// a range without a preceding introduced event ends a range starting at version 0.
+func convertOSVEvents(events []map[string]string) []VersionRange {
+	var ranges []VersionRange
+	var current *VersionRange
+	closeWith := func(set func(*VersionRange)) {
+		if current == nil {
+			current = &VersionRange{Introduced: "0"}
+		}
+		set(current)
+		ranges = append(ranges, *current)
+		current = nil
+	}
+	for _, event := range events {
+		switch {
+		case event["introduced"] != "":
+			if current != nil {
+				ranges = append(ranges, *current)
+			}
+			current = &VersionRange{Introduced: event["introduced"]}
+		case event["fixed"] != "":
+			closeWith(func(r *VersionRange) { r.Fixed = event["fixed"] })
+		case event["last_affected"] != "":
+			closeWith(func(r *VersionRange) { r.LastAffected = event["last_affected"] })
+		case event["limit"] != "":
+			// A limit only bounds the search for fixes in git ranges; treat it as unfixed.
+			closeWith(func(r *VersionRange) {})
+		}
+	}
+	if current != nil {
+		ranges = append(ranges, *current)
+	}
+	return ranges
+}
+
+// stringField returns a string field of a JSON object, or "" if the object has no such string.
+func stringField(raw json.RawMessage, key string) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return ""
+	}
+	var value string
+	if err := json.Unmarshal(fields[key], &value); err != nil {
+		return ""
+	}
+	return value
+}
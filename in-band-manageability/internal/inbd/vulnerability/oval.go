@@ -0,0 +1,219 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vulnerability
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ovalDefinitions is the part of an OVAL definitions file, as published by Ubuntu and Debian,
+// that is used.  Packages are checked by dpkginfo tests only.
+type ovalDefinitions struct {
+	Definitions []ovalDefinition `xml:"definitions>definition"`
+	Tests       []ovalTest       `xml:"tests>dpkginfo_test"`
+	Objects     []ovalObject     `xml:"objects>dpkginfo_object"`
+	States      []ovalState      `xml:"states>dpkginfo_state"`
+	Variables   []ovalVariable   `xml:"variables>constant_variable"`
+}
+
+type ovalDefinition struct {
+	ID         string          `xml:"id,attr"`
+	Class      string          `xml:"class,attr"`
+	Title      string          `xml:"metadata>title"`
+	References []ovalReference `xml:"metadata>reference"`
+	Severity   string          `xml:"metadata>advisory>severity"`
+	CVEs       []ovalCVE       `xml:"metadata>advisory>cve"`
+	Criteria   ovalCriteria    `xml:"criteria"`
+}
+
+type ovalReference struct {
+	Source string `xml:"source,attr"`
+	RefID  string `xml:"ref_id,attr"`
+}
+
+type ovalCVE struct {
+	ID         string `xml:",chardata"`
+	Priority   string `xml:"priority,attr"`
+	CVSSScore  string `xml:"cvss_score,attr"`
+	CVSSVector string `xml:"cvss_vector,attr"`
+}
+
+type ovalCriteria struct {
+	Criteria   []ovalCriteria `xml:"criteria"`
+	Criterions []struct {
+		TestRef string `xml:"test_ref,attr"`
+	} `xml:"criterion"`
+}
+
+type ovalTest struct {
+	ID     string `xml:"id,attr"`
+	Object struct {
+		Ref string `xml:"object_ref,attr"`
+	} `xml:"object"`
+	State struct {
+		Ref string `xml:"state_ref,attr"`
+	} `xml:"state"`
+}
+
+type ovalObject struct {
+	ID   string `xml:"id,attr"`
+	Name struct {
+		Value  string `xml:",chardata"`
+		VarRef string `xml:"var_ref,attr"`
+	} `xml:"name"`
+}
+
+type ovalState struct {
+	ID  string `xml:"id,attr"`
+	EVR struct {
+		Value     string `xml:",chardata"`
+		Operation string `xml:"operation,attr"`
+	} `xml:"evr"`
+}
+
+type ovalVariable struct {
+	ID     string   `xml:"id,attr"`
+	Values []string `xml:"value"`
+}
+
+// parseOVAL converts the vulnerability and patch definitions of an Ubuntu or Debian OVAL file.
+func parseOVAL(data []byte) ([]Advisory, error) {
+	var doc ovalDefinitions
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OVAL definitions: %w", err)
+	}
+
+	tests := make(map[string]ovalTest, len(doc.Tests))
+	for _, t := range doc.Tests {
+		tests[t.ID] = t
+	}
+	objects := make(map[string]ovalObject, len(doc.Objects))
+	for _, o := range doc.Objects {
+		objects[o.ID] = o
+	}
+	states := make(map[string]ovalState, len(doc.States))
+	for _, s := range doc.States {
+		states[s.ID] = s
+	}
+	variables := make(map[string][]string, len(doc.Variables))
+	for _, v := range doc.Variables {
+		variables[v.ID] = v.Values
+	}
+
+	var advisories []Advisory
+	for _, def := range doc.Definitions {
+		if def.Class != "vulnerability" && def.Class != "patch" {
+			continue
+		}
+		ecosystem := ovalEcosystem(def.ID)
+		if ecosystem == "" {
+			continue
+		}
+
+		a := Advisory{ID: def.ID, Summary: strings.TrimSpace(def.Title)}
+		var ids []string
+		for _, ref := range def.References {
+			if ref.Source == "USN" || ref.Source == "DSA" || ref.Source == "CVE" {
+				ids = append(ids, ref.RefID)
+			}
+		}
+		severity := def.Severity
+		for _, cve := range def.CVEs {
+			ids = append(ids, strings.TrimSpace(cve.ID))
+			if severity == "" {
+				severity = cve.Priority
+			}
+			score, err := strconv.ParseFloat(cve.CVSSScore, 64)
+			if err != nil && cve.CVSSVector != "" {
+				score, err = CVSSv3BaseScore(cve.CVSSVector)
+			}
+			if err == nil && score > a.CVSSScore {
+				a.CVSSScore = score
+			}
+		}
+		seen := map[string]bool{}
+		for _, id := range ids {
+			if id == "" || seen[id] {
+				continue
+			}
+			seen[id] = true
+			if a.ID == def.ID {
+				a.ID = id
+			} else {
+				a.Aliases = append(a.Aliases, id)
+			}
+		}
+		a.Severity = normalizeSeverity(severity)
+		if a.Severity == SeverityUnknown && a.CVSSScore > 0 {
+			a.Severity = cvssSeverity(a.CVSSScore)
+		}
+
+		for _, ref := range criterionTestRefs(def.Criteria) {
+			test, ok := tests[ref]
+			if !ok {
+				continue
+			}
+			object, ok := objects[test.Object.Ref]
+			if !ok {
+				continue
+			}
+			names := variables[object.Name.VarRef]
+			if object.Name.VarRef == "" {
+				names = []string{strings.TrimSpace(object.Name.Value)}
+			}
+
+			rng := VersionRange{Introduced: "0"}
+			if state, ok := states[test.State.Ref]; ok {
+				if state.EVR.Operation != "less than" {
+					continue
+				}
+				rng.Fixed = strings.TrimSpace(state.EVR.Value)
+			}
+			for _, name := range names {
+				if name = strings.TrimSpace(name); name != "" {
+					a.Affected = append(a.Affected, AffectedPackage{Ecosystem: ecosystem, Name: name, Ranges: []VersionRange{rng}})
+				}
+			}
+		}
+		if len(a.Affected) > 0 {
+			advisories = append(advisories, a)
+		}
+	}
+	return advisories, nil
+}
+
+// criterionTestRefs returns the tests referenced anywhere in the criteria.
+func criterionTestRefs(c ovalCriteria) []string {
+	var refs []string
+	for _, criterion := range c.Criterions {
+		refs = append(refs, criterion.TestRef)
+	}
+	for _, nested := range c.Criteria {
+		refs = append(refs, criterionTestRefs(nested)...)
+	}
+	return refs
+}
+
+// ovalEcosystem returns the OSV ecosystem of the distribution that publishes an OVAL definition,
+// from the namespace of its ID such as oval:com.ubuntu.jammy:def:1.
+func ovalEcosystem(id string) string {
+	parts := strings.Split(id, ":")
+	if len(parts) < 2 {
+		return ""
+	}
+	switch {
+	case strings.HasPrefix(parts[1], "com.ubuntu."):
+		return "Ubuntu"
+	case strings.HasPrefix(parts[1], "org.debian"):
+		return "Debian"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,232 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vulnerability
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CompareDebianVersions compares two Debian package versions of the form
+// [epoch:]upstream_version[-debian_revision] like dpkg --compare-versions.  It returns a negative
+// number if a is older than b, zero if they are equal and a positive number if a is newer.
+func CompareDebianVersions(a, b string) int {
+	epochA, upstreamA, revisionA := splitDebianVersion(a)
+	epochB, upstreamB, revisionB := splitDebianVersion(b)
+	if epochA != epochB {
+		if epochA < epochB {
+			return -1
+		}
+		return 1
+	}
+	if c := debianVerRevCmp(upstreamA, upstreamB); c != 0 {
+		return c
+	}
+	return debianVerRevCmp(revisionA, revisionB)
+}
+
+func splitDebianVersion(v string) (epoch int, upstream, revision string) {
+	v = strings.TrimSpace(v)
+	if e, rest, found := strings.Cut(v, ":"); found {
+		if n, err := strconv.Atoi(e); err == nil {
+			epoch = n
+			v = rest
+		}
+	}
+	upstream = v
+	if i := strings.LastIndex(v, "-"); i >= 0 {
+		upstream, revision = v[:i], v[i+1:]
+	}
+	return epoch, upstream, revision
+}
+
+// debianOrder is the sort weight of a character in the non-digit part of a version: the end of
+// the string and digits sort first, '~' sorts before even the end, letters sort before the other
+// characters.
+func debianOrder(s string) int {
+	if s == "" {
+		return 0
+	}
+	c := s[0]
+	switch {
+	case isDigit(c):
+		return 0
+	case isAlpha(c):
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+func debianVerRevCmp(a, b string) int {
+	for a != "" || b != "" {
+		for (a != "" && !isDigit(a[0])) || (b != "" && !isDigit(b[0])) {
+			if c := debianOrder(a) - debianOrder(b); c != 0 {
+				return c
+			}
+			if a != "" {
+				a = a[1:]
+			}
+			if b != "" {
+				b = b[1:]
+			}
+		}
+		a = strings.TrimLeft(a, "0")
+		b = strings.TrimLeft(b, "0")
+		firstDiff := 0
+		for a != "" && isDigit(a[0]) && b != "" && isDigit(b[0]) {
+			if firstDiff == 0 {
+				firstDiff = int(a[0]) - int(b[0])
+			}
+			a, b = a[1:], b[1:]
+		}
+		if a != "" && isDigit(a[0]) {
+			return 1
+		}
+		if b != "" && isDigit(b[0]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return firstDiff
+		}
+	}
+	return 0
+}
+
+// CompareRPMVersions compares two RPM versions of the form [epoch:]version[-release] like
+// rpmvercmp.  The release is only compared if both versions have one.
+func CompareRPMVersions(a, b string) int {
+	epochA, versionA, releaseA := splitRPMVersion(a)
+	epochB, versionB, releaseB := splitRPMVersion(b)
+	if epochA != epochB {
+		if epochA < epochB {
+			return -1
+		}
+		return 1
+	}
+	if c := rpmVerCmp(versionA, versionB); c != 0 {
+		return c
+	}
+	if releaseA == "" || releaseB == "" {
+		return 0
+	}
+	return rpmVerCmp(releaseA, releaseB)
+}
+
+func splitRPMVersion(v string) (epoch int, version, release string) {
+	v = strings.TrimSpace(v)
+	if e, rest, found := strings.Cut(v, ":"); found {
+		if n, err := strconv.Atoi(e); err == nil {
+			epoch = n
+			v = rest
+		}
+	}
+	version = v
+	if i := strings.LastIndex(v, "-"); i >= 0 {
+		version, release = v[:i], v[i+1:]
+	}
+	return epoch, version, release
+}
+
+// rpmVerCmp compares version segments like rpmvercmp: numeric segments are compared as numbers
+// and are newer than alphabetic ones, '~' sorts before anything and '^' sorts after the end of
+// the version but before any other segment.
+func rpmVerCmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+	isSeparator := func(r rune) bool {
+		return r != '~' && r != '^' && !isDigit(byte(r)) && !isAlpha(byte(r))
+	}
+	for a != "" || b != "" {
+		a = strings.TrimLeftFunc(a, isSeparator)
+		b = strings.TrimLeftFunc(b, isSeparator)
+
+		if strings.HasPrefix(a, "~") || strings.HasPrefix(b, "~") {
+			if !strings.HasPrefix(a, "~") {
+				return 1
+			}
+			if !strings.HasPrefix(b, "~") {
+				return -1
+			}
+			a, b = a[1:], b[1:]
+			continue
+		}
+		if strings.HasPrefix(a, "^") || strings.HasPrefix(b, "^") {
+			if a == "" {
+				return -1
+			}
+			if b == "" {
+				return 1
+			}
+			if !strings.HasPrefix(a, "^") {
+				return 1
+			}
+			if !strings.HasPrefix(b, "^") {
+				return -1
+			}
+			a, b = a[1:], b[1:]
+			continue
+		}
+		if a == "" || b == "" {
+			break
+		}
+
+		numeric := isDigit(a[0])
+		class := isAlpha
+		if numeric {
+			class = isDigit
+		}
+		segA, segB := leadingRun(a, class), leadingRun(b, class)
+		a, b = a[len(segA):], b[len(segB):]
+		if segB == "" {
+			// The segments are of different types; a numeric segment is newer.
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+		if numeric {
+			segA = strings.TrimLeft(segA, "0")
+			segB = strings.TrimLeft(segB, "0")
+			if len(segA) != len(segB) {
+				if len(segA) < len(segB) {
+					return -1
+				}
+				return 1
+			}
+		}
+		if c := strings.Compare(segA, segB); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return -1
+	default:
+		return 1
+	}
+}
+
+func leadingRun(s string, class func(byte) bool) string {
+	i := 0
+	for i < len(s) && class(s[i]) {
+		i++
+	}
+	return s[:i]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
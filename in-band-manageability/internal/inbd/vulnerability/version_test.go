@@ -0,0 +1,73 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vulnerability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestCompareDebianVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"0:2.35-0ubuntu3.1", "2.35-0ubuntu3.1", 0},
+		{"1.0", "1.0-1", -1},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0~~", "1.0~", -1},
+		{"1:0.9", "2.0", 1},
+		{"2.36-9+deb12u4", "2.36-9", 1},
+		{"1.2.3", "1.2.10", -1},
+		{"1.0a", "1.0", 1},
+		{"1.0+b1", "1.0", 1},
+		{"1.0+b1", "1.0a", 1},
+		{"3.0.2-0ubuntu1.15", "3.0.2-0ubuntu1.16", -1},
+		{"010", "10", 0},
+		{"7.81.0-1ubuntu1.16", "7.81.0-1ubuntu1.4", 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, sign(CompareDebianVersions(tt.a, tt.b)), "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, -tt.want, sign(CompareDebianVersions(tt.b, tt.a)), "%s vs %s", tt.b, tt.a)
+	}
+}
+
+func TestCompareRPMVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0", "1.0.1", -1},
+		{"1.0-1.el9", "1.0-2.el9", -1},
+		{"1.0-1", "1.0", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0^git1", "1.0", 1},
+		{"1.0^git1", "1.0.1", -1},
+		{"1.0a", "1.0.1", -1},
+		{"2:1.0", "1:9.9", 1},
+		{"010", "10", 0},
+		{"5.14.0-284.el9", "5.14.0-284.11.1.el9_2", -1},
+		{"2.34-60.el9", "2.34-60.el9_2.7", -1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, sign(CompareRPMVersions(tt.a, tt.b)), "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, -tt.want, sign(CompareRPMVersions(tt.b, tt.a)), "%s vs %s", tt.b, tt.a)
+	}
+}
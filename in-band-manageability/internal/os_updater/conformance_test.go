@@ -0,0 +1,257 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package osupdater
+
+import (
+	"encoding/json"
+	"testing"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/emt"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/ubuntu"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+// fakeExecutor records the commands instead of running them, and answers each command
+// with the output configured for its executable.
+type fakeExecutor struct {
+	commands [][]string
+	stdout   map[string]string
+}
+
+func (f *fakeExecutor) Execute(command []string) ([]byte, []byte, error) {
+	f.commands = append(f.commands, command)
+	return []byte(f.stdout[command[0]]), nil, nil
+}
+
+// conformanceCase describes how a distribution is exercised by TestDistributionConformance.
+type conformanceCase struct {
+	// factory creates the factory of the distribution working on fs.
+	factory func(fs afero.Fs) UpdaterFactory
+	// setup prepares fs and the executor output for a NO_DOWNLOAD update.
+	setup func(t *testing.T, fs afero.Fs, exec *fakeExecutor)
+	// hermetic pins the host-dependent checks of the snapshotter.
+	hermetic func(Snapshotter)
+	// imageBased is true for distributions updated from a downloaded image.
+	imageBased bool
+	// applyCommand is the command that applies a NO_DOWNLOAD update of the vim package.
+	applyCommand []string
+	// reboot is true if the update requires a reboot.
+	reboot bool
+}
+
+// aptUpgradeOutput is the apt-get --assume-no output of an available update.
+const aptUpgradeOutput = `The following packages will be upgraded:
+  vim
+1 upgraded, 0 newly installed, 0 to remove and 0 not upgraded.
+Need to get 1,642 kB of archives.
+After this operation, 12.3 kB of additional disk space will be used.
+`
+
+func aptCase(factory func(fs afero.Fs) UpdaterFactory) conformanceCase {
+	return conformanceCase{
+		factory: factory,
+		setup: func(_ *testing.T, _ afero.Fs, exec *fakeExecutor) {
+			exec.stdout[common.AptGetCmd] = aptUpgradeOutput
+		},
+		hermetic: func(s Snapshotter) {
+			s.(*ubuntu.Snapshotter).IsBTRFSFileSystemFunc = func(string, func(string, *unix.Statfs_t) error) (bool, error) {
+				return false, nil
+			}
+		},
+		applyCommand: []string{common.AptGetCmd, "-o", "Dpkg::Lock::Timeout=300", "-o", "Dpkg::Options::=--force-confdef", "-o",
+			"Dpkg::Options::=--force-confold", "--fix-missing", "-yq", "install", "vim"},
+		reboot: true,
+	}
+}
+
+var conformanceCases = map[string]conformanceCase{
+	"EMT": {
+		factory: func(fs afero.Fs) UpdaterFactory { return &EMTFactory{Fs: fs} },
+		setup: func(t *testing.T, fs afero.Fs, _ *fakeExecutor) {
+			require.NoError(t, afero.WriteFile(fs, "/etc/image-id", []byte("IMAGE_BUILD_DATE=20250115\n"), 0644))
+		},
+		imageBased:   true,
+		applyCommand: []string{common.OsUpdateToolCmd, "-a"},
+		reboot:       true,
+	},
+	"Ubuntu": aptCase(func(fs afero.Fs) UpdaterFactory { return &UbuntuFactory{Fs: fs} }),
+	"Debian": aptCase(func(fs afero.Fs) UpdaterFactory { return &DebianFactory{UbuntuFactory{Fs: fs}} }),
+	"RHEL": {
+		factory:      func(fs afero.Fs) UpdaterFactory { return &DnfFactory{Fs: fs} },
+		applyCommand: []string{common.DnfCmd, "-y", "--cacheonly", "install", "vim"},
+	},
+}
+
+// TestDistributionConformance runs the same checks against every registered distribution
+// so that new plugins are held to the contract expected by OSUpdater.
+func TestDistributionConformance(t *testing.T) {
+	names := RegisteredDistributions()
+	require.Subset(t, names, []string{"EMT", "Ubuntu", "Debian", "RHEL"})
+
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			d, ok := LookupDistribution(name)
+			require.True(t, ok)
+			require.NotNil(t, d.Factory)
+			require.NotNil(t, d.Verifier)
+
+			factory, err := GetOSUpdaterFactory(name)
+			require.NoError(t, err)
+			assert.Same(t, d.Factory, factory)
+
+			tc, ok := conformanceCases[name]
+			require.True(t, ok, "every registered distribution needs a conformance case")
+			assert.IsType(t, factory, tc.factory(nil), "the conformance case must exercise the registered factory type")
+
+			newTest := func(t *testing.T) (afero.Fs, *fakeExecutor, UpdaterFactory) {
+				fs := afero.NewMemMapFs()
+				exec := &fakeExecutor{stdout: map[string]string{}}
+				if tc.setup != nil {
+					tc.setup(t, fs, exec)
+				}
+				return fs, exec, tc.factory(fs)
+			}
+
+			t.Run("downloader", func(t *testing.T) {
+				fs, _, factory := newTest(t)
+				require.NoError(t, afero.WriteFile(fs, utils.ConfigFilePath,
+					[]byte(`{"os_updater": {"trustedRepositories": ["https://trusted.example.com/"]}}`), 0644))
+				downloader := factory.CreateDownloader(&pb.UpdateSystemSoftwareRequest{
+					Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL,
+					Url:  "https://untrusted.example.com/image.raw.gz",
+				})
+				require.NotNil(t, downloader)
+
+				err := downloader.Download()
+				if !tc.imageBased {
+					// Package managers fetch the packages themselves.
+					assert.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "not in the list of trusted repositories")
+				assertUpdateStatus(t, fs, emt.FAIL)
+			})
+
+			t.Run("snapshotter saves the state for post-reboot verification", func(t *testing.T) {
+				fs, exec, factory := newTest(t)
+				snapshotter := factory.CreateSnapshotter(exec, &pb.UpdateSystemSoftwareRequest{
+					Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_NO_DOWNLOAD,
+				})
+				require.NotNil(t, snapshotter)
+				if tc.hermetic != nil {
+					tc.hermetic(snapshotter)
+				}
+
+				require.NoError(t, snapshotter.Snapshot())
+				state, err := utils.ReadStateFile(fs, utils.StateFilePath)
+				require.NoError(t, err)
+				assert.Equal(t, "sota", state.RestartReason)
+			})
+
+			t.Run("updater applies the update", func(t *testing.T) {
+				fs, exec, factory := newTest(t)
+				req := &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_NO_DOWNLOAD}
+				if !tc.imageBased {
+					req.PackageList = []string{"vim"}
+				}
+				updater := factory.CreateUpdater(exec, req)
+				require.NotNil(t, updater)
+
+				reboot, err := updater.Update()
+				require.NoError(t, err)
+				assert.Equal(t, tc.reboot, reboot)
+				assert.Contains(t, exec.commands, tc.applyCommand)
+				assertUpdateStatus(t, fs, emt.SUCCESS)
+				if !tc.imageBased {
+					state, err := utils.ReadStateFile(fs, utils.StateFilePath)
+					require.NoError(t, err)
+					assert.Equal(t, "vim", state.PackageList, "installed packages must be verified after the reboot")
+				}
+			})
+
+			t.Run("updater rejects an invalid mode", func(t *testing.T) {
+				_, exec, factory := newTest(t)
+				updater := factory.CreateUpdater(exec, &pb.UpdateSystemSoftwareRequest{
+					Mode:        pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL + 100,
+					PackageList: []string{"vim"},
+				})
+				_, err := updater.Update()
+				if tc.imageBased {
+					// Image-based updaters only act on the modes they know.
+					return
+				}
+				assert.Error(t, err)
+			})
+
+			t.Run("cleaner accepts the download directory", func(t *testing.T) {
+				_, exec, factory := newTest(t)
+				cleaner := factory.CreateCleaner(exec, t.TempDir()+"/")
+				require.NotNil(t, cleaner)
+				assert.NoError(t, cleaner.Clean())
+			})
+
+			t.Run("rebooter reboots", func(t *testing.T) {
+				_, exec, factory := newTest(t)
+				rebooter := factory.CreateRebooter(exec, &pb.UpdateSystemSoftwareRequest{
+					Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL,
+				})
+				require.NoError(t, rebooter.Reboot())
+				assert.Contains(t, exec.commands, []string{common.RebootCmd})
+			})
+		})
+	}
+}
+
+// assertUpdateStatus checks the status recorded in the update status log.
+func assertUpdateStatus(t *testing.T, fs afero.Fs, status string) {
+	t.Helper()
+	content, err := afero.ReadFile(fs, emt.UpdateStatusLogPath)
+	require.NoError(t, err)
+	var updateStatus emt.UpdateStatus
+	require.NoError(t, json.Unmarshal(content, &updateStatus))
+	assert.Equal(t, status, updateStatus.Status)
+}
+
+func TestRegisterDistribution(t *testing.T) {
+	verifier := func(afero.Fs, utils.INBDState) error { return nil }
+
+	t.Run("rejects empty name", func(t *testing.T) {
+		err := RegisterDistribution(Distribution{Factory: &UbuntuFactory{}, Verifier: verifier})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects missing plugins", func(t *testing.T) {
+		err := RegisterDistribution(Distribution{Name: "Incomplete"})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects duplicate registration", func(t *testing.T) {
+		err := RegisterDistribution(Distribution{Name: "Ubuntu", Factory: &UbuntuFactory{}, Verifier: verifier})
+		assert.Error(t, err)
+	})
+
+	t.Run("registers new distribution", func(t *testing.T) {
+		err := RegisterDistribution(Distribution{Name: "TestOS", Factory: &UbuntuFactory{}, Verifier: verifier})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			registryMutex.Lock()
+			delete(distributions, "TestOS")
+			registryMutex.Unlock()
+		})
+
+		factory, err := GetOSUpdaterFactory("TestOS")
+		assert.NoError(t, err)
+		assert.IsType(t, &UbuntuFactory{}, factory)
+		assert.Contains(t, RegisteredDistributions(), "TestOS")
+	})
+}
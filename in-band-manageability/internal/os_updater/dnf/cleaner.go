@@ -0,0 +1,24 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package dnf updates RHEL-family operating systems using dnf.
+package dnf
+
+import (
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+)
+
+// Cleaner is the concrete implementation of the Cleaner interface
+// for dnf-based operating systems.
+type Cleaner struct {
+	CommandExecutor common.Executor
+	Path            string
+}
+
+// Clean method for dnf-based operating systems
+func (c *Cleaner) Clean() error {
+	// No clean up needed as dnf manages its own package cache
+	return nil
+}
@@ -0,0 +1,25 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package dnf updates RHEL-family operating systems using dnf.
+package dnf
+
+import (
+	"fmt"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+// Downloader is the concrete implementation of the IDownloader interface
+// for dnf-based operating systems.
+type Downloader struct {
+	Request *pb.UpdateSystemSoftwareRequest
+}
+
+// Download method for dnf-based operating systems
+func (d *Downloader) Download() error {
+	fmt.Println("RPM-based OS does not require a file download to perform a software update")
+	return nil
+}
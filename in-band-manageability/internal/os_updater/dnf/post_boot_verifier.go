@@ -0,0 +1,73 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package dnf updates RHEL-family operating systems using dnf.
+package dnf
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/emt"
+	"github.com/spf13/afero"
+)
+
+// Verifier verifies a dnf update after the reboot.
+type Verifier struct {
+	CommandExecutor common.Executor
+	fs              afero.Fs
+	RemoveFileFunc  func(afero.Fs, string) error
+}
+
+// NewVerifier creates a new instance of Verifier with a command executor and file system.
+func NewVerifier() *Verifier {
+	return &Verifier{
+		CommandExecutor: common.NewExecutor(exec.Command, common.ExecuteAndReadOutput),
+		fs:              afero.NewOsFs(),
+		RemoveFileFunc:  utils.RemoveFile,
+	}
+}
+
+// VerifyUpdateAfterReboot verifies the update after a reboot.
+// Requested packages are checked with rpm; system-wide upgrades are checked
+// for rpm database consistency with dnf check.
+func (v *Verifier) VerifyUpdateAfterReboot(state utils.INBDState) error {
+	var cmds [][]string
+	for _, pkg := range strings.Split(state.PackageList, ",") {
+		pkg = strings.TrimSpace(pkg)
+		if pkg != "" {
+			cmds = append(cmds, []string{common.RpmCmd, "-q", pkg})
+		}
+	}
+	if len(cmds) == 0 {
+		cmds = [][]string{{common.DnfCmd, "check"}}
+	}
+
+	for _, cmd := range cmds {
+		stdout, stderr, err := v.CommandExecutor.Execute(cmd)
+		if err != nil {
+			errMsg := fmt.Sprintf("Post update verification failed: %s: %v, stdout: %s, stderr: %s",
+				strings.Join(cmd, " "), err, string(stdout), string(stderr))
+			log.Println(errMsg)
+			emt.WriteUpdateStatus(v.fs, emt.FAIL, "", errMsg)
+			emt.WriteGranularLogWithOSType(v.fs, emt.FAIL, emt.FAILURE_REASON_UPDATE_TOOL, osType)
+			return fmt.Errorf("%s", errMsg)
+		}
+	}
+
+	log.Println("Post update verification passed.")
+	emt.WriteUpdateStatus(v.fs, emt.SUCCESS, "", "")
+	emt.WriteGranularLogWithOSType(v.fs, emt.SUCCESS, "", osType)
+
+	// Remove state file after checks
+	if err := v.RemoveFileFunc(v.fs, utils.StateFilePath); err != nil {
+		log.Printf("[Warning] Error removing state file: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package dnf
+
+import (
+	"errors"
+	"testing"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestVerifier(exec *mockExecutor, removed *bool) *Verifier {
+	return &Verifier{
+		CommandExecutor: exec,
+		fs:              afero.NewMemMapFs(),
+		RemoveFileFunc: func(_ afero.Fs, _ string) error {
+			*removed = true
+			return nil
+		},
+	}
+}
+
+func TestVerifier_VerifyUpdateAfterReboot(t *testing.T) {
+	t.Run("verifies each package with rpm", func(t *testing.T) {
+		exec := &mockExecutor{}
+		removed := false
+		v := newTestVerifier(exec, &removed)
+
+		err := v.VerifyUpdateAfterReboot(utils.INBDState{PackageList: "vim, curl"})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{common.RpmCmd, "-q", "vim"},
+			{common.RpmCmd, "-q", "curl"},
+		}, exec.commands)
+		assert.True(t, removed)
+	})
+
+	t.Run("checks rpm database after system upgrade", func(t *testing.T) {
+		exec := &mockExecutor{}
+		removed := false
+		v := newTestVerifier(exec, &removed)
+
+		err := v.VerifyUpdateAfterReboot(utils.INBDState{RestartReason: "sota"})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{common.DnfCmd, "check"}}, exec.commands)
+		assert.True(t, removed)
+	})
+
+	t.Run("missing package fails verification", func(t *testing.T) {
+		exec := &mockExecutor{err: errors.New("exit status 1")}
+		removed := false
+		v := newTestVerifier(exec, &removed)
+
+		err := v.VerifyUpdateAfterReboot(utils.INBDState{PackageList: "vim"})
+		assert.Error(t, err)
+		assert.False(t, removed)
+	})
+}
@@ -0,0 +1,32 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package dnf updates RHEL-family operating systems using dnf.
+package dnf
+
+import (
+	"log"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+// Rebooter is the concrete implementation of the Rebooter interface
+// for dnf-based operating systems.
+type Rebooter struct {
+	CommandExecutor common.Executor
+	Request         *pb.UpdateSystemSoftwareRequest
+}
+
+// Reboot method for dnf-based operating systems
+func (r *Rebooter) Reboot() error {
+	if r.Request.DoNotReboot {
+		log.Println("Reboot is disabled.  Skipping reboot.")
+		return nil
+	}
+
+	return utils.RebootSystem(r.CommandExecutor)
+}
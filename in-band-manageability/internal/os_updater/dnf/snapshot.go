@@ -0,0 +1,59 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package dnf updates RHEL-family operating systems using dnf.
+package dnf
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+)
+
+// Snapshotter is the concrete implementation of the Snapshotter interface
+// for dnf-based operating systems.  No filesystem snapshot is taken; the
+// state file is written so that the update is verified after the reboot.
+type Snapshotter struct {
+	CommandExecutor      common.Executor
+	ClearStateFileFunc   func(cmdExecutor common.Executor, stateFilePath string) error
+	WriteToStateFileFunc func(fs afero.Fs, stateFilePath string, content string) error
+	Fs                   afero.Fs
+}
+
+// NewSnapshotter creates a new dnf Snapshotter.
+func NewSnapshotter(commandExecutor common.Executor, fs afero.Fs) *Snapshotter {
+	return &Snapshotter{
+		CommandExecutor:      commandExecutor,
+		ClearStateFileFunc:   utils.ClearStateFile,
+		WriteToStateFileFunc: utils.WriteToStateFile,
+		Fs:                   fs,
+	}
+}
+
+// Snapshot method for dnf-based operating systems
+func (s *Snapshotter) Snapshot() error {
+	log.Println("dnf-based OS does not support snapshots.  Saving state for post-reboot verification.")
+
+	if err := s.ClearStateFileFunc(s.CommandExecutor, utils.StateFilePath); err != nil {
+		return fmt.Errorf("failed to clear dispatcher state file: %w", err)
+	}
+
+	state := utils.INBDState{
+		RestartReason: "sota",
+	}
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("error marshalling state: %w", err)
+	}
+
+	if err := s.WriteToStateFileFunc(s.Fs, utils.StateFilePath, string(stateJSON)); err != nil {
+		return fmt.Errorf("failed to write to state file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package dnf
+
+import (
+	"errors"
+	"testing"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotter_Snapshot(t *testing.T) {
+	t.Run("writes state file", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		s := NewSnapshotter(&mockExecutor{}, fs)
+		s.ClearStateFileFunc = func(common.Executor, string) error { return nil }
+
+		assert.NoError(t, s.Snapshot())
+		state, err := utils.ReadStateFile(fs, utils.StateFilePath)
+		require.NoError(t, err)
+		assert.Equal(t, "sota", state.RestartReason)
+	})
+
+	t.Run("clear state file failure", func(t *testing.T) {
+		s := NewSnapshotter(&mockExecutor{}, afero.NewMemMapFs())
+		s.ClearStateFileFunc = func(common.Executor, string) error { return errors.New("truncate failed") }
+
+		assert.Error(t, s.Snapshot())
+	})
+}
@@ -0,0 +1,144 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package dnf updates RHEL-family operating systems using dnf.
+package dnf
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/emt"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// osType is the OS type written to the granular log.
+const osType = "rhel"
+
+// Updater is the concrete implementation of the Updater interface
+// for dnf-based operating systems.
+type Updater struct {
+	CommandExecutor common.Executor
+	Request         *pb.UpdateSystemSoftwareRequest
+	Fs              afero.Fs
+}
+
+// Update method for dnf-based operating systems
+func (u *Updater) Update() (bool, error) {
+	fs := u.Fs
+	if fs == nil {
+		fs = afero.NewOsFs()
+	}
+
+	// Get the request details for logging
+	jsonString, err := protojson.Marshal(u.Request)
+	if err != nil {
+		log.Printf("Error converting request to string: %v\n", err)
+		jsonString = []byte("{}")
+	}
+
+	var cmds [][]string
+	switch u.Request.Mode {
+	case pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL:
+		cmds = fullInstall(u.Request.PackageList)
+	case pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_NO_DOWNLOAD:
+		cmds = noDownload(u.Request.PackageList)
+	case pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_DOWNLOAD_ONLY:
+		cmds = downloadOnly(u.Request.PackageList)
+	default:
+		return false, fmt.Errorf("SOTA Aborted: Invalid mode")
+	}
+
+	for _, cmd := range cmds {
+		log.Printf("Executing command: %s", cmd)
+		_, stderr, err := u.CommandExecutor.Execute(cmd)
+		if err != nil {
+			errMsg := fmt.Sprintf("SOTA Aborted: Command execution error: %v, stderr: %s", err, string(stderr))
+			emt.WriteUpdateStatus(fs, emt.FAIL, string(jsonString), errMsg)
+			emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_UPDATE_TOOL, osType)
+			return false, fmt.Errorf("%s", errMsg)
+		}
+		// dnf writes progress and warnings to stderr, so only the exit status is treated as a failure.
+		if len(stderr) > 0 {
+			log.Printf("Command stderr: %s", string(stderr))
+		}
+	}
+
+	if u.Request.Mode == pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_DOWNLOAD_ONLY {
+		emt.WriteUpdateStatus(fs, emt.SUCCESS, string(jsonString), "")
+		emt.WriteGranularLogWithOSType(fs, emt.SUCCESS, "", osType)
+		return false, nil
+	}
+
+	if len(u.Request.PackageList) > 0 {
+		if err := writeStateFileForPackageInstallation(fs, u.Request.PackageList); err != nil {
+			log.Printf("WARNING: Failed to write state file for package installation: %v", err)
+		}
+	}
+
+	emt.WriteUpdateStatus(fs, emt.SUCCESS, string(jsonString), "")
+	emt.WriteGranularLogWithOSType(fs, emt.SUCCESS, "", osType)
+
+	// Only system-wide upgrades need a reboot; installed packages are verified on the next boot.
+	return len(u.Request.PackageList) == 0, nil
+}
+
+// writeStateFileForPackageInstallation records the installed packages for post-reboot verification.
+func writeStateFileForPackageInstallation(fs afero.Fs, packageList []string) error {
+	state, err := utils.ReadStateFile(fs, utils.StateFilePath)
+	if err != nil {
+		state = utils.INBDState{RestartReason: "package_installation"}
+	}
+	state.PackageList = strings.Join(packageList, ",")
+
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	if err := fs.Remove(utils.StateFilePath); err != nil {
+		log.Printf("Error removing old state file (continuing): %v", err)
+	}
+	return utils.WriteToStateFile(fs, utils.StateFilePath, string(stateJSON))
+}
+
+func fullInstall(packages []string) [][]string {
+	log.Println("Download and install mode")
+
+	cmds := [][]string{
+		{common.DnfCmd, "-y", "makecache"},
+	}
+	if len(packages) == 0 {
+		return append(cmds, []string{common.DnfCmd, "-y", "upgrade"})
+	}
+	return append(cmds, append([]string{common.DnfCmd, "-y", "install"}, packages...))
+}
+
+func downloadOnly(packages []string) [][]string {
+	log.Println("Download only mode")
+
+	cmds := [][]string{
+		{common.DnfCmd, "-y", "makecache"},
+	}
+	if len(packages) == 0 {
+		return append(cmds, []string{common.DnfCmd, "-y", "--downloadonly", "upgrade"})
+	}
+	return append(cmds, append([]string{common.DnfCmd, "-y", "--downloadonly", "install"}, packages...))
+}
+
+func noDownload(packages []string) [][]string {
+	log.Println("No download mode")
+
+	if len(packages) == 0 {
+		return [][]string{{common.DnfCmd, "-y", "--cacheonly", "upgrade"}}
+	}
+	return [][]string{append([]string{common.DnfCmd, "-y", "--cacheonly", "install"}, packages...)}
+}
@@ -0,0 +1,146 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package dnf
+
+import (
+	"errors"
+	"testing"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExecutor struct {
+	commands [][]string
+	stderr   string
+	err      error
+}
+
+func (m *mockExecutor) Execute(command []string) ([]byte, []byte, error) {
+	m.commands = append(m.commands, command)
+	return nil, []byte(m.stderr), m.err
+}
+
+func TestDnfCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		build    func([]string) [][]string
+		packages []string
+		expected [][]string
+	}{
+		{
+			name:  "full upgrade",
+			build: fullInstall,
+			expected: [][]string{
+				{common.DnfCmd, "-y", "makecache"},
+				{common.DnfCmd, "-y", "upgrade"},
+			},
+		},
+		{
+			name:     "full install packages",
+			build:    fullInstall,
+			packages: []string{"vim", "curl"},
+			expected: [][]string{
+				{common.DnfCmd, "-y", "makecache"},
+				{common.DnfCmd, "-y", "install", "vim", "curl"},
+			},
+		},
+		{
+			name:  "download only upgrade",
+			build: downloadOnly,
+			expected: [][]string{
+				{common.DnfCmd, "-y", "makecache"},
+				{common.DnfCmd, "-y", "--downloadonly", "upgrade"},
+			},
+		},
+		{
+			name:     "no download packages",
+			build:    noDownload,
+			packages: []string{"vim"},
+			expected: [][]string{
+				{common.DnfCmd, "-y", "--cacheonly", "install", "vim"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.build(tt.packages))
+		})
+	}
+}
+
+func TestUpdater_Update(t *testing.T) {
+	t.Run("system-wide upgrade requests reboot", func(t *testing.T) {
+		exec := &mockExecutor{stderr: "warning: repo metadata is stale"}
+		u := &Updater{
+			CommandExecutor: exec,
+			Request:         &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL},
+			Fs:              afero.NewMemMapFs(),
+		}
+		reboot, err := u.Update()
+		assert.NoError(t, err)
+		assert.True(t, reboot)
+		assert.Len(t, exec.commands, 2)
+	})
+
+	t.Run("package install records packages for verification", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		u := &Updater{
+			CommandExecutor: &mockExecutor{},
+			Request: &pb.UpdateSystemSoftwareRequest{
+				Mode:        pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_NO_DOWNLOAD,
+				PackageList: []string{"vim", "curl"},
+			},
+			Fs: fs,
+		}
+		reboot, err := u.Update()
+		assert.NoError(t, err)
+		assert.False(t, reboot)
+
+		state, err := utils.ReadStateFile(fs, utils.StateFilePath)
+		require.NoError(t, err)
+		assert.Equal(t, "vim,curl", state.PackageList)
+	})
+
+	t.Run("download only does not reboot", func(t *testing.T) {
+		u := &Updater{
+			CommandExecutor: &mockExecutor{},
+			Request:         &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_DOWNLOAD_ONLY},
+			Fs:              afero.NewMemMapFs(),
+		}
+		reboot, err := u.Update()
+		assert.NoError(t, err)
+		assert.False(t, reboot)
+	})
+
+	t.Run("command failure aborts update", func(t *testing.T) {
+		exec := &mockExecutor{err: errors.New("exit status 1")}
+		u := &Updater{
+			CommandExecutor: exec,
+			Request:         &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL},
+			Fs:              afero.NewMemMapFs(),
+		}
+		reboot, err := u.Update()
+		assert.Error(t, err)
+		assert.False(t, reboot)
+		assert.Len(t, exec.commands, 1)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		u := &Updater{
+			CommandExecutor: &mockExecutor{},
+			Request:         &pb.UpdateSystemSoftwareRequest{},
+			Fs:              afero.NewMemMapFs(),
+		}
+		_, err := u.Update()
+		assert.Error(t, err)
+	})
+}
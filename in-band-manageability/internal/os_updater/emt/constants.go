@@ -11,6 +11,9 @@ const (
 	FAIL = "FAIL"
 	// SUCCESS is a possible value for the update status.
 	SUCCESS = "SUCCESS"
+	// ROLLED_BACK is a possible value for the update status.  The update failed post-reboot
+	// verification and the system was restored to the pre-update snapshot.
+	ROLLED_BACK = "ROLLED_BACK"
 )
 
 // Failure reasons for granular log
@@ -26,5 +29,7 @@ const (
 	FAILURE_REASON_CRITICAL_SERVICES    = "criticalservices"
 	FAILURE_REASON_INBM                 = "inbm"
 	FAILURE_REASON_OS_COMMIT            = "oscommit"
-	FAILURE_REASON_UPDATE_TOOL          = "updatetool" // For Ubuntu apt/package manager failures
+	FAILURE_REASON_UPDATE_TOOL          = "updatetool"   // For Ubuntu apt/package manager failures
+	FAILURE_REASON_NETWORK_CHECK        = "networkcheck" // For Ubuntu post-reboot network check failures
+	FAILURE_REASON_PROVENANCE           = "provenance"   // For failed supply-chain provenance verification
 )
@@ -43,6 +43,11 @@ type Downloader struct {
 
 // NewDownloader creates a new Downloader.
 func NewDownloader(request *pb.UpdateSystemSoftwareRequest) *Downloader {
+	return NewDownloaderWithFs(request, afero.NewOsFs())
+}
+
+// NewDownloaderWithFs creates a new Downloader working on the given file system.
+func NewDownloaderWithFs(request *pb.UpdateSystemSoftwareRequest, fs afero.Fs) *Downloader {
 	return &Downloader{
 		request:                 request,
 		readJWTTokenFunc:        utils.ReadJWTToken,
@@ -50,9 +55,9 @@ func NewDownloader(request *pb.UpdateSystemSoftwareRequest) *Downloader {
 		writeUpdateStatus:       writeUpdateStatus,
 		writeGranularLog:        writeGranularLog,
 		statfs:                  unix.Statfs,
-		httpClient:              &http.Client{Timeout: 30 * time.Minute},
+		httpClient:              utils.LoadNetworkProfile(fs).HTTPClient(30 * time.Minute),
 		requestCreator:          http.NewRequest,
-		fs:                      afero.NewOsFs(),
+		fs:                      fs,
 		getFreeDiskSpaceInBytes: utils.GetFreeDiskSpaceInBytes,
 		getFileSizeInBytesFunc:  utils.GetFileSizeInBytes,
 	}
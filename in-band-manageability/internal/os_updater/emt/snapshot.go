@@ -33,11 +33,7 @@ type Snapshotter struct {
 
 // NewSnapshotter creates a new EMTSnapshotter.
 func NewSnapshotter(commandExecutor common.Executor, req *pb.UpdateSystemSoftwareRequest) *Snapshotter {
-	return &Snapshotter{
-		commandExecutor: commandExecutor,
-		fs:              afero.NewOsFs(),
-		stateFilePath:   utils.StateFilePath,
-	}
+	return NewSnapshotterWithConfig(commandExecutor, afero.NewOsFs(), utils.StateFilePath)
 }
 
 // NewSnapshotterWithConfig creates a new EMTSnapshotter with custom configuration.
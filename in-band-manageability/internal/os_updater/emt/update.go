@@ -15,6 +15,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/staging"
 	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
 	"github.com/spf13/afero"
@@ -28,16 +29,22 @@ type Updater struct {
 	writeUpdateStatus func(afero.Fs, string, string, string)
 	writeGranularLog  func(afero.Fs, string, string)
 	fs                afero.Fs
+	stagedArtifactID  string
 }
 
 // NewUpdater creates a new Updater.
 func NewUpdater(commandExecutor common.Executor, request *pb.UpdateSystemSoftwareRequest) *Updater {
+	return NewUpdaterWithFs(commandExecutor, request, afero.NewOsFs())
+}
+
+// NewUpdaterWithFs creates a new Updater working on the given file system.
+func NewUpdaterWithFs(commandExecutor common.Executor, request *pb.UpdateSystemSoftwareRequest, fs afero.Fs) *Updater {
 	return &Updater{
 		commandExecutor:   commandExecutor,
 		request:           request,
 		writeUpdateStatus: writeUpdateStatus,
 		writeGranularLog:  writeGranularLog,
-		fs:                afero.NewOsFs(),
+		fs:                fs,
 	}
 }
 
@@ -82,12 +89,21 @@ func (t *Updater) Update() (bool, error) {
 			common.OsUpdateToolCmd, "-w", "-u", filePath, "-s", t.request.Signature,
 		}
 
+		t.clearWritten()
 		if _, _, err := t.commandExecutor.Execute(updateToolWriteCommand); err != nil {
 			t.writeUpdateStatus(t.fs, FAIL, string(jsonString), err.Error())
 			t.writeGranularLog(t.fs, FAIL, FAILURE_REASON_UT_WRITE)
 			return false, fmt.Errorf("failed to execute shell command(%v)- %v", updateToolWriteCommand, err)
 		}
 
+		// Keep the image so that it can be installed later by its staged artifact ID.
+		artifact, err := staging.Stage(t.fs, t.request.Url, filePath, t.request.Signature, true)
+		if err != nil {
+			log.Printf("[Warning] Error staging %s: %v", filePath, err)
+		} else {
+			t.stagedArtifactID = artifact.ID
+		}
+
 		jsonString, err := protojson.Marshal(t.request)
 		if err != nil {
 			log.Printf("Error converting request to string: %v\n", err)
@@ -98,8 +114,14 @@ func (t *Updater) Update() (bool, error) {
 	}
 
 	if t.request.Mode == pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_NO_DOWNLOAD {
+		if t.request.StagedArtifactId != "" {
+			if err := t.writeStagedArtifact(string(jsonString)); err != nil {
+				return false, err
+			}
+		}
+
 		log.Println("Save snapshot before applying the update.")
-		if err := NewSnapshotter(t.commandExecutor, t.request).Snapshot(); err != nil {
+		if err := NewSnapshotterWithConfig(t.commandExecutor, t.fs, utils.StateFilePath).Snapshot(); err != nil {
 			errMsg := fmt.Sprintf("Error taking snapshot: %v", err)
 			t.writeUpdateStatus(t.fs, FAIL, string(jsonString), errMsg)
 			t.writeGranularLog(t.fs, FAIL, FAILURE_REASON_INBM)
@@ -111,12 +133,19 @@ func (t *Updater) Update() (bool, error) {
 			common.OsUpdateToolCmd, "-a",
 		}
 
+		t.clearWritten()
 		if _, _, err := t.commandExecutor.Execute(updateToolApplyCommand); err != nil {
 			t.writeUpdateStatus(t.fs, FAIL, string(jsonString), err.Error())
 			t.writeGranularLog(t.fs, FAIL, FAILURE_REASON_BOOT_CONFIGURATION)
 			return false, fmt.Errorf("failed to execute shell command(%v)- %v", updateToolApplyCommand, err)
 		}
 
+		if t.request.StagedArtifactId != "" {
+			if err := staging.Delete(t.fs, t.request.StagedArtifactId); err != nil {
+				log.Printf("[Warning] Error deleting staged artifact %s: %v", t.request.StagedArtifactId, err)
+			}
+		}
+
 		// Write the update status to the status log file
 		writeUpdateStatus(t.fs, SUCCESS, string(jsonString), "")
 		writeGranularLog(t.fs, SUCCESS, "")
@@ -125,6 +154,49 @@ func (t *Updater) Update() (bool, error) {
 	return true, nil
 }
 
+// StagedArtifactID returns the ID the downloaded image was staged with.
+func (t *Updater) StagedArtifactID() string {
+	return t.stagedArtifactID
+}
+
+// writeStagedArtifact checks the staged image of the request and writes it to the update
+// partition, unless it is the image that was written last.
+func (t *Updater) writeStagedArtifact(request string) error {
+	artifact, err := staging.Verify(t.fs, t.request.StagedArtifactId)
+	if err != nil {
+		t.writeUpdateStatus(t.fs, FAIL, request, err.Error())
+		t.writeGranularLog(t.fs, FAIL, FAILURE_REASON_SIGNATURE_CHECK)
+		return fmt.Errorf("staged image verification failed: %w", err)
+	}
+	if artifact.Written {
+		return nil
+	}
+
+	log.Printf("Execute update tool write command for staged image %s.", artifact.ID)
+	updateToolWriteCommand := []string{
+		common.OsUpdateToolCmd, "-w", "-u", artifact.Path(), "-s", artifact.SHA256,
+	}
+	t.clearWritten()
+	if _, _, err := t.commandExecutor.Execute(updateToolWriteCommand); err != nil {
+		t.writeUpdateStatus(t.fs, FAIL, request, err.Error())
+		t.writeGranularLog(t.fs, FAIL, FAILURE_REASON_UT_WRITE)
+		return fmt.Errorf("failed to execute shell command(%v)- %v", updateToolWriteCommand, err)
+	}
+	if err := staging.MarkWritten(t.fs, artifact.ID); err != nil {
+		log.Printf("[Warning] Error recording that staged artifact %s was written: %v", artifact.ID, err)
+	}
+	return nil
+}
+
+// clearWritten records that no staged image is known to be on the update partition.  It is
+// called before every command that changes the update partition, so that a staged image is
+// written again if in doubt.
+func (t *Updater) clearWritten() {
+	if err := staging.ClearWritten(t.fs); err != nil {
+		log.Printf("[Warning] Error clearing the written staged artifact: %v", err)
+	}
+}
+
 func (t *Updater) commitUpdate() error {
 	log.Println("Committing the update.")
 	// Get the request details
@@ -138,6 +210,7 @@ func (t *Updater) commitUpdate() error {
 		common.OsUpdateToolCmd, "-c",
 	}
 
+	t.clearWritten()
 	if _, _, err := t.commandExecutor.Execute(updateToolCommitCommand); err != nil {
 		log.Printf("Error executing shell command(%v): %v\n", updateToolCommitCommand, err)
 		t.writeUpdateStatus(t.fs, FAIL, string(jsonString), err.Error())
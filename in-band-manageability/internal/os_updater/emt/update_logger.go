@@ -36,18 +36,8 @@ type UpdateStatus struct {
 
 // WriteUpdateStatus writes the update status to the log file (exported for use by other packages)
 func WriteUpdateStatus(fs afero.Fs, status, metadata, errorDetails string) {
-	// Create the update status log file if it does not exist.
-	if _, err := os.Stat(UpdateStatusLogPath); os.IsNotExist(err) {
-		file, err := os.Create(UpdateStatusLogPath)
-		if err != nil {
-			log.Printf("[Warning] Error writing update status: failed to create update status log file: %v", err)
-			return
-		}
-		file.Close()
-	}
-
-	// Open the update status log file for writing and truncate it.
-	file, err := utils.OpenFile(fs, UpdateStatusLogPath, os.O_WRONLY|os.O_TRUNC, 0644)
+	// Open the update status log file for writing, creating it if it does not exist, and truncate it.
+	file, err := utils.OpenFile(fs, UpdateStatusLogPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		log.Printf("[Warning] Error writing update status: failed to open update status log file: %v", err)
 		return
@@ -85,18 +75,11 @@ func WriteGranularLog(fs afero.Fs, statusDetail string, failureReason string) {
 
 // WriteGranularLogWithOSType writes the granular update log with OS type specification
 func WriteGranularLogWithOSType(fs afero.Fs, statusDetail string, failureReason string, osType string) {
-	// Create the granular log file if it does not exist.
-	if _, err := os.Stat(GranularLogPath); os.IsNotExist(err) {
-		file, err := os.Create(GranularLogPath)
-		if err != nil {
-			log.Printf("[Warning] Error writing granular log: failed to create granular log file: %v", err)
-			return
-		}
-		file.Close()
-	}
+	// Keep the provenance of the update when its status is written.
+	provenance := readGranularProvenance(fs)
 
-	// Open the granular log file for writing and truncate it.
-	file, err := utils.OpenFile(fs, GranularLogPath, os.O_WRONLY|os.O_TRUNC, 0644)
+	// Open the granular log file for writing, creating it if it does not exist, and truncate it.
+	file, err := utils.OpenFile(fs, GranularLogPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		log.Printf("[Warning] Error writing granular log: failed to open granular log file: %v", err)
 		return
@@ -110,8 +93,8 @@ func WriteGranularLogWithOSType(fs afero.Fs, statusDetail string, failureReason
 		var version string
 		var err error
 
-		if osType == "ubuntu" {
-			// For Ubuntu, we need to import and call ubuntu
+		if osType != "emt" {
+			// For Ubuntu, Debian and RHEL-family distributions, read /etc/os-release directly.
 			// Since we can't have circular imports, we'll check for /etc/os-release directly here
 			version, err = getVersionForOS("/etc/os-release")
 		} else {
@@ -142,6 +125,10 @@ func WriteGranularLogWithOSType(fs afero.Fs, statusDetail string, failureReason
 		}
 	}
 
+	if provenance != nil {
+		granularLogData["Provenance"] = provenance
+	}
+
 	// Marshal the JSON structure to a string.
 	jsonData, err := json.MarshalIndent(granularLogData, "", "  ")
 	if err != nil {
@@ -156,6 +143,45 @@ func WriteGranularLogWithOSType(fs afero.Fs, statusDetail string, failureReason
 	}
 }
 
+// WriteGranularProvenance records the provenance verification result of the update in the
+// granular log.  The entry is kept when the status of the update is written; a nil entry removes it.
+func WriteGranularProvenance(fs afero.Fs, entry map[string]string) {
+	granularLogData := map[string][]map[string]string{}
+	if data, err := afero.ReadFile(fs, GranularLogPath); err == nil && len(data) > 0 {
+		if err := json.Unmarshal(data, &granularLogData); err != nil {
+			log.Printf("[Warning] Error reading granular log: %v", err)
+			granularLogData = map[string][]map[string]string{}
+		}
+	}
+	if entry == nil {
+		delete(granularLogData, "Provenance")
+	} else {
+		granularLogData["Provenance"] = []map[string]string{entry}
+	}
+
+	jsonData, err := json.MarshalIndent(granularLogData, "", "  ")
+	if err != nil {
+		log.Printf("[Warning] Error writing granular log: failed to marshal JSON for granular log: %v", err)
+		return
+	}
+	if err := utils.WriteFile(fs, GranularLogPath, jsonData, 0644); err != nil {
+		log.Printf("[Warning] Error writing granular log: %v", err)
+	}
+}
+
+// readGranularProvenance returns the provenance entry of the granular log, if any.
+func readGranularProvenance(fs afero.Fs) []map[string]string {
+	data, err := afero.ReadFile(fs, GranularLogPath)
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+	var granularLogData map[string][]map[string]string
+	if err := json.Unmarshal(data, &granularLogData); err != nil {
+		return nil
+	}
+	return granularLogData["Provenance"]
+}
+
 // getVersionForOS reads version from OS-specific release file (Ubuntu's /etc/os-release)
 func getVersionForOS(filePath string) (string, error) {
 	file, err := os.Open(filePath)
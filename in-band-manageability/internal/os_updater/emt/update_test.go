@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package emt
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/staging"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+// recordingExecutor records the commands it is given and fails those listed in fail.
+type recordingExecutor struct {
+	commands [][]string
+	fail     map[string]bool
+}
+
+func (e *recordingExecutor) Execute(args []string) ([]byte, []byte, error) {
+	e.commands = append(e.commands, args)
+	if e.fail[args[1]] {
+		return nil, nil, errors.New("update tool failed")
+	}
+	return nil, nil, nil
+}
+
+func newTestUpdater(fs afero.Fs, executor *recordingExecutor, request *pb.UpdateSystemSoftwareRequest) (*Updater, *[]string) {
+	var reasons []string
+	return &Updater{
+		commandExecutor:   executor,
+		request:           request,
+		writeUpdateStatus: func(afero.Fs, string, string, string) {},
+		writeGranularLog:  func(_ afero.Fs, _ string, reason string) { reasons = append(reasons, reason) },
+		fs:                fs,
+	}, &reasons
+}
+
+func TestUpdate_DownloadOnlyStagesImage(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	sum := sha256.Sum256([]byte("image"))
+	signature := hex.EncodeToString(sum[:])
+	require.NoError(t, afero.WriteFile(fs, utils.SOTADownloadDir+"/emt.raw.gz", []byte("image"), 0644))
+
+	executor := &recordingExecutor{}
+	updater, _ := newTestUpdater(fs, executor, &pb.UpdateSystemSoftwareRequest{
+		Mode:      pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_DOWNLOAD_ONLY,
+		Url:       "https://example.com/emt.raw.gz",
+		Signature: signature,
+	})
+
+	proceedWithReboot, err := updater.Update()
+	require.NoError(t, err)
+	assert.True(t, proceedWithReboot)
+	assert.Equal(t, [][]string{{common.OsUpdateToolCmd, "-w", "-u", utils.SOTADownloadDir + "/emt.raw.gz", "-s", signature}}, executor.commands)
+
+	artifact, err := staging.Get(fs, updater.StagedArtifactID())
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/emt.raw.gz", artifact.URL)
+	assert.Equal(t, signature, artifact.SHA256)
+	assert.Equal(t, staging.SignatureVerified, artifact.SignatureStatus)
+	assert.True(t, artifact.Written)
+}
+
+func TestWriteStagedArtifact(t *testing.T) {
+	stage := func(t *testing.T, fs afero.Fs, name string) *staging.Artifact {
+		imagePath := utils.SOTADownloadDir + "/" + name
+		require.NoError(t, afero.WriteFile(fs, imagePath, []byte(name), 0644))
+		sum := sha256.Sum256([]byte(name))
+		artifact, err := staging.Stage(fs, "https://example.com/"+name, imagePath, hex.EncodeToString(sum[:]), true)
+		require.NoError(t, err)
+		return artifact
+	}
+
+	t.Run("written image is not written again", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		artifact := stage(t, fs, "emt.raw.gz")
+		executor := &recordingExecutor{}
+		updater, _ := newTestUpdater(fs, executor, &pb.UpdateSystemSoftwareRequest{StagedArtifactId: artifact.ID})
+
+		require.NoError(t, updater.writeStagedArtifact("{}"))
+		assert.Empty(t, executor.commands)
+	})
+
+	t.Run("older image is written again", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		older := stage(t, fs, "older.raw.gz")
+		stage(t, fs, "newer.raw.gz")
+		executor := &recordingExecutor{}
+		updater, _ := newTestUpdater(fs, executor, &pb.UpdateSystemSoftwareRequest{StagedArtifactId: older.ID})
+
+		require.NoError(t, updater.writeStagedArtifact("{}"))
+		assert.Equal(t, [][]string{{common.OsUpdateToolCmd, "-w", "-u", older.Path(), "-s", older.SHA256}}, executor.commands)
+		got, err := staging.Get(fs, older.ID)
+		require.NoError(t, err)
+		assert.True(t, got.Written)
+	})
+
+	t.Run("tampered image is rejected", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		artifact := stage(t, fs, "emt.raw.gz")
+		require.NoError(t, afero.WriteFile(fs, artifact.Path(), []byte("tampered"), 0644))
+		executor := &recordingExecutor{}
+		updater, reasons := newTestUpdater(fs, executor, &pb.UpdateSystemSoftwareRequest{StagedArtifactId: artifact.ID})
+
+		err := updater.writeStagedArtifact("{}")
+		assert.ErrorContains(t, err, "staged image verification failed")
+		assert.Equal(t, []string{FAILURE_REASON_SIGNATURE_CHECK}, *reasons)
+		assert.Empty(t, executor.commands)
+	})
+
+	t.Run("write failure", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		older := stage(t, fs, "older.raw.gz")
+		newer := stage(t, fs, "newer.raw.gz")
+		executor := &recordingExecutor{fail: map[string]bool{"-w": true}}
+		updater, reasons := newTestUpdater(fs, executor, &pb.UpdateSystemSoftwareRequest{StagedArtifactId: older.ID})
+
+		assert.Error(t, updater.writeStagedArtifact("{}"))
+		assert.Equal(t, []string{FAILURE_REASON_UT_WRITE}, *reasons)
+		got, err := staging.Get(fs, newer.ID)
+		require.NoError(t, err)
+		assert.False(t, got.Written, "a failed write leaves no image on the update partition")
+	})
+}
+
+func TestCommitUpdate_ClearsWritten(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	imagePath := utils.SOTADownloadDir + "/emt.raw.gz"
+	require.NoError(t, afero.WriteFile(fs, imagePath, []byte("image"), 0644))
+	sum := sha256.Sum256([]byte("image"))
+	artifact, err := staging.Stage(fs, "https://example.com/emt.raw.gz", imagePath, hex.EncodeToString(sum[:]), true)
+	require.NoError(t, err)
+
+	executor := &recordingExecutor{fail: map[string]bool{"-c": true}}
+	updater, _ := newTestUpdater(fs, executor, &pb.UpdateSystemSoftwareRequest{})
+	assert.Error(t, updater.commitUpdate())
+	got, err := staging.Get(fs, artifact.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Written, "a commit clears the written image even if it fails")
+}
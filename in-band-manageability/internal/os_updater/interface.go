@@ -11,6 +11,7 @@ import (
 
 	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
 	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	dnf "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/dnf"
 	emt "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/emt"
 	ubuntu "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/ubuntu"
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
@@ -28,31 +29,40 @@ type UpdaterFactory interface {
 
 // GetOSUpdaterFactory returns the correct concrete classes for the OS updater based on the OS type.
 func GetOSUpdaterFactory(os string) (UpdaterFactory, error) {
-	if os == "EMT" {
-		return &EMTFactory{}, nil
+	d, ok := LookupDistribution(os)
+	if !ok {
+		return nil, fmt.Errorf("unsupported OS")
 	}
-	if os == "Ubuntu" {
-		return &UbuntuFactory{}, nil
+	return d.Factory, nil
+}
+
+// factoryFs returns the file system the plugins of a factory work on, the OS file system by default.
+func factoryFs(fs afero.Fs) afero.Fs {
+	if fs == nil {
+		return afero.NewOsFs()
 	}
-	return nil, fmt.Errorf("unsupported OS")
+	return fs
 }
 
 // EMTFactory represents an EMT factory.
-type EMTFactory struct{}
+type EMTFactory struct {
+	// Fs is the file system used by the plugins.  Defaults to the OS file system when nil.
+	Fs afero.Fs
+}
 
 // CreateDownloader creates a downloader concrete class for EMT OS.
 func (f *EMTFactory) CreateDownloader(req *pb.UpdateSystemSoftwareRequest) Downloader {
-	return emt.NewDownloader(req)
+	return emt.NewDownloaderWithFs(req, factoryFs(f.Fs))
 }
 
 // CreateUpdater creates an OS updater concrete class for EMT OS.
 func (f *EMTFactory) CreateUpdater(commandExecutor common.Executor, req *pb.UpdateSystemSoftwareRequest) Updater {
-	return emt.NewUpdater(commandExecutor, req)
+	return emt.NewUpdaterWithFs(commandExecutor, req, factoryFs(f.Fs))
 }
 
 // CreateSnapshotter creates a snapshotter concrete class for EMT OS.
 func (f *EMTFactory) CreateSnapshotter(commandExecutor common.Executor, req *pb.UpdateSystemSoftwareRequest) Snapshotter {
-	return emt.NewSnapshotter(commandExecutor, req)
+	return emt.NewSnapshotterWithConfig(commandExecutor, factoryFs(f.Fs), utils.StateFilePath)
 }
 
 // CreateCleaner creates a cleaner concrete class for EMT OS.
@@ -65,8 +75,11 @@ func (f *EMTFactory) CreateRebooter(commandExecutor common.Executor, req *pb.Upd
 	return emt.NewRebooter(commandExecutor, req)
 }
 
-// UbuntuFactory represents an EMT factory.
-type UbuntuFactory struct{}
+// UbuntuFactory represents an Ubuntu factory.
+type UbuntuFactory struct {
+	// Fs is the file system used by the plugins.  Defaults to the OS file system when nil.
+	Fs afero.Fs
+}
 
 // CreateDownloader creates a downloader concrete class for Ubuntu OS.
 func (f *UbuntuFactory) CreateDownloader(req *pb.UpdateSystemSoftwareRequest) Downloader {
@@ -75,17 +88,22 @@ func (f *UbuntuFactory) CreateDownloader(req *pb.UpdateSystemSoftwareRequest) Do
 
 // CreateUpdater creates an OS updater concrete class for Ubuntu OS.
 func (f *UbuntuFactory) CreateUpdater(commandExecutor common.Executor, req *pb.UpdateSystemSoftwareRequest) Updater {
+	fs := factoryFs(f.Fs)
 	return &ubuntu.Updater{
-		CommandExecutor:         commandExecutor,
+		CommandExecutor:         ubuntu.NewNetworkExecutor(commandExecutor, fs),
 		Request:                 req,
 		GetFreeDiskSpaceInBytes: utils.GetFreeDiskSpaceInBytes,
+		Fs:                      fs,
 	}
 }
 
 // CreateSnapshotter creates a snapshotter concrete class for Ubuntu OS.
 func (f *UbuntuFactory) CreateSnapshotter(commandExecutor common.Executor, req *pb.UpdateSystemSoftwareRequest) Snapshotter {
 	// Use ubuntu.NewSnapshotter to get the proper configuration with writeStateFileWithTruncate
-	return ubuntu.NewSnapshotter(commandExecutor, afero.NewOsFs())
+	fs := factoryFs(f.Fs)
+	snapshotter := ubuntu.NewSnapshotter(ubuntu.NewNetworkExecutor(commandExecutor, fs), fs)
+	snapshotter.PackageList = req.PackageList
+	return snapshotter
 }
 
 // CreateCleaner creates a cleaner concrete class for Ubuntu OS.
@@ -103,3 +121,62 @@ func (f *UbuntuFactory) CreateRebooter(commandExecutor common.Executor, req *pb.
 		Request:         req,
 	}
 }
+
+// DebianFactory represents a Debian factory.  Debian uses the same apt-based
+// plugins as Ubuntu and only differs in the OS type written to the update logs.
+type DebianFactory struct {
+	UbuntuFactory
+}
+
+// CreateUpdater creates an OS updater concrete class for Debian OS.
+func (f *DebianFactory) CreateUpdater(commandExecutor common.Executor, req *pb.UpdateSystemSoftwareRequest) Updater {
+	fs := factoryFs(f.Fs)
+	return &ubuntu.Updater{
+		CommandExecutor:         ubuntu.NewNetworkExecutor(commandExecutor, fs),
+		Request:                 req,
+		GetFreeDiskSpaceInBytes: utils.GetFreeDiskSpaceInBytes,
+		Fs:                      fs,
+		OSType:                  "debian",
+	}
+}
+
+// DnfFactory represents a factory for RHEL-family distributions using dnf.
+type DnfFactory struct {
+	// Fs is the file system used by the plugins.  Defaults to the OS file system when nil.
+	Fs afero.Fs
+}
+
+// CreateDownloader creates a downloader concrete class for dnf-based OS.
+func (f *DnfFactory) CreateDownloader(req *pb.UpdateSystemSoftwareRequest) Downloader {
+	return &dnf.Downloader{Request: req}
+}
+
+// CreateUpdater creates an OS updater concrete class for dnf-based OS.
+func (f *DnfFactory) CreateUpdater(commandExecutor common.Executor, req *pb.UpdateSystemSoftwareRequest) Updater {
+	return &dnf.Updater{
+		CommandExecutor: commandExecutor,
+		Request:         req,
+		Fs:              factoryFs(f.Fs),
+	}
+}
+
+// CreateSnapshotter creates a snapshotter concrete class for dnf-based OS.
+func (f *DnfFactory) CreateSnapshotter(commandExecutor common.Executor, req *pb.UpdateSystemSoftwareRequest) Snapshotter {
+	return dnf.NewSnapshotter(commandExecutor, factoryFs(f.Fs))
+}
+
+// CreateCleaner creates a cleaner concrete class for dnf-based OS.
+func (f *DnfFactory) CreateCleaner(commandExecutor common.Executor, path string) Cleaner {
+	return &dnf.Cleaner{
+		CommandExecutor: commandExecutor,
+		Path:            path,
+	}
+}
+
+// CreateRebooter creates a rebooter concrete class for dnf-based OS.
+func (f *DnfFactory) CreateRebooter(commandExecutor common.Executor, req *pb.UpdateSystemSoftwareRequest) Rebooter {
+	return &dnf.Rebooter{
+		CommandExecutor: commandExecutor,
+		Request:         req,
+	}
+}
@@ -28,6 +28,18 @@ func TestGetOSUpdaterFactory(t *testing.T) {
 		assert.IsType(t, &UbuntuFactory{}, factory)
 	})
 
+	t.Run("returns DebianFactory for Debian OS", func(t *testing.T) {
+		factory, err := GetOSUpdaterFactory("Debian")
+		assert.NoError(t, err)
+		assert.IsType(t, &DebianFactory{}, factory)
+	})
+
+	t.Run("returns DnfFactory for RHEL OS", func(t *testing.T) {
+		factory, err := GetOSUpdaterFactory("RHEL")
+		assert.NoError(t, err)
+		assert.IsType(t, &DnfFactory{}, factory)
+	})
+
 	t.Run("returns error for unsupported OS", func(t *testing.T) {
 		factory, err := GetOSUpdaterFactory("UnsupportedOS")
 		assert.Error(t, err)
@@ -24,6 +24,7 @@ func (m *MockDownloader) Download() error {
 // MockUpdater is a mock implementation of the Updater interface.
 type MockUpdater struct {
 	UpdateFunc func() (bool, error)
+	StagedID   string
 }
 
 // Update calls the UpdateFunc.
@@ -32,6 +33,11 @@ func (m *MockUpdater) Update() (bool, error) {
 	return proceedWithReboot, err
 }
 
+// StagedArtifactID returns StagedID.
+func (m *MockUpdater) StagedArtifactID() string {
+	return m.StagedID
+}
+
 // MockSnapshotter is a mock implementation of the Snapshotter interface.
 type MockSnapshotter struct {
 	SnapshotFunc func() error
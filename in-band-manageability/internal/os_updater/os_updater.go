@@ -7,12 +7,18 @@
 package osupdater
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os/exec"
+	"path"
+	"path/filepath"
 
 	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/provenance"
 	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/emt"
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
 	"github.com/spf13/afero"
 )
@@ -22,6 +28,8 @@ type OSUpdater struct {
 	req                          *pb.UpdateSystemSoftwareRequest
 	isProceedWithoutRollbackFunc func(*utils.Configurations) bool
 	loadConfigFunc               func(afero.Fs, string) (*utils.Configurations, error)
+	verifyProvenanceFunc         func(*pb.UpdateSystemSoftwareRequest) error
+	ctx                          context.Context
 }
 
 // NewOSUpdater creates a new OSUpdater instance.
@@ -30,9 +38,17 @@ func NewOSUpdater(req *pb.UpdateSystemSoftwareRequest) *OSUpdater {
 		req:                          req,
 		isProceedWithoutRollbackFunc: utils.IsProceedWithoutRollback,
 		loadConfigFunc:               utils.LoadConfig,
+		verifyProvenanceFunc:         verifyProvenance,
 	}
 }
 
+// WithContext sets the context carrying the caller that the commands of the update are
+// attributed to in the audit log.
+func (u *OSUpdater) WithContext(ctx context.Context) *OSUpdater {
+	u.ctx = ctx
+	return u
+}
+
 // UpdateOS updates the OS based on the request.
 func (u *OSUpdater) UpdateOS(factory UpdaterFactory) (*pb.UpdateResponse, error) {
 	log.Printf("Request Mode: %v\n", u.req.Mode)
@@ -45,9 +61,14 @@ func (u *OSUpdater) UpdateOS(factory UpdaterFactory) (*pb.UpdateResponse, error)
 			return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
 		}
 	}
-	execCmd := common.NewExecutor(exec.Command, common.ExecuteAndReadOutput)
+	execCmd := common.NewExecutorWithContext(u.ctx, exec.Command, common.ExecuteAndReadOutput)
 	cleaner := factory.CreateCleaner(execCmd, utils.SOTADownloadDir+"/")
 
+	if err := u.verifyProvenanceFunc(u.req); err != nil {
+		cleanFiles(cleaner)
+		return &pb.UpdateResponse{StatusCode: 400, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
+	}
+
 	snapshot := factory.CreateSnapshotter(execCmd, u.req)
 	// Create a snapshot of the current system
 	err := snapshot.Snapshot()
@@ -67,7 +88,7 @@ func (u *OSUpdater) UpdateOS(factory UpdaterFactory) (*pb.UpdateResponse, error)
 	}
 
 	// Update the OS
-	updater := factory.CreateUpdater(common.NewExecutor(exec.Command, common.ExecuteAndReadOutput), u.req)
+	updater := factory.CreateUpdater(common.NewExecutorWithContext(u.ctx, exec.Command, common.ExecuteAndReadOutput), u.req)
 	proceedWithReboot, err := updater.Update()
 	if err != nil {
 		// Remove the artifacts if failure happens.
@@ -83,7 +104,7 @@ func (u *OSUpdater) UpdateOS(factory UpdaterFactory) (*pb.UpdateResponse, error)
 	if proceedWithReboot {
 		if u.req.Mode != pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_DOWNLOAD_ONLY {
 			// Reboot the system
-			rebooter := factory.CreateRebooter(common.NewExecutor(exec.Command, common.ExecuteAndReadOutput), u.req)
+			rebooter := factory.CreateRebooter(common.NewExecutorWithContext(u.ctx, exec.Command, common.ExecuteAndReadOutput), u.req)
 			if err = rebooter.Reboot(); err != nil {
 				return &pb.UpdateResponse{StatusCode: 500, Error: err.Error()}, nil //nolint:nilerr // gRPC response pattern
 			}
@@ -92,7 +113,11 @@ func (u *OSUpdater) UpdateOS(factory UpdaterFactory) (*pb.UpdateResponse, error)
 		}
 	}
 
-	return &pb.UpdateResponse{StatusCode: 200, Error: "Success"}, nil //nolint:nilerr // gRPC response pattern
+	resp := &pb.UpdateResponse{StatusCode: 200, Error: "Success"}
+	if staging, ok := updater.(StagingUpdater); ok {
+		resp.StagedArtifactId = staging.StagedArtifactID()
+	}
+	return resp, nil
 }
 
 func cleanFiles(cleaner Cleaner) {
@@ -100,3 +125,59 @@ func cleanFiles(cleaner Cleaner) {
 		log.Printf("[Warning] unable to cleanup files: %v", err.Error())
 	}
 }
+
+// verifyProvenance verifies the provenance of the downloaded image, if provenance was supplied
+// or is required, and records the result in the granular log.
+func verifyProvenance(req *pb.UpdateSystemSoftwareRequest) error {
+	if req.StagedArtifactId != "" {
+		// The image was verified when it was staged.
+		return nil
+	}
+	fs := afero.NewOsFs()
+	verifier := provenance.NewVerifier(fs)
+
+	artifactPath := ""
+	if req.Url != "" {
+		artifactPath = filepath.Join(utils.SOTADownloadDir, path.Base(req.Url))
+	}
+
+	provenancePath := ""
+	var err error
+	if req.ProvenanceUrl != "" {
+		if artifactPath == "" {
+			err = errors.New("provenance was supplied for an update without an image URL")
+		} else {
+			provenancePath, err = verifier.Fetch(req.ProvenanceUrl, utils.SOTADownloadDir)
+		}
+	}
+
+	var result *provenance.Result
+	if err == nil {
+		if artifactPath == "" {
+			// Package manager updates have no image to verify.
+			result, err = verifier.VerifyPackage(provenance.PackageTypeSOTA, "", "")
+		} else {
+			result, err = verifier.VerifyPackage(provenance.PackageTypeSOTA, artifactPath, provenancePath)
+		}
+	}
+
+	if err != nil {
+		emt.WriteGranularProvenance(fs, map[string]string{"Verified": "false", "Error": err.Error()})
+		emt.WriteUpdateStatus(fs, emt.FAIL, "", err.Error())
+		emt.WriteGranularLog(fs, emt.FAIL, emt.FAILURE_REASON_PROVENANCE)
+		return err
+	}
+	if result == nil {
+		emt.WriteGranularProvenance(fs, nil)
+		return nil
+	}
+	emt.WriteGranularProvenance(fs, map[string]string{
+		"Verified":       "true",
+		"Format":         result.Format,
+		"BuilderID":      result.BuilderID,
+		"SourceRepo":     result.SourceRepo,
+		"Signer":         result.Signer,
+		"ArtifactDigest": result.ArtifactDigest,
+	})
+	return nil
+}
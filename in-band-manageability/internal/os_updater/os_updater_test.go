@@ -47,7 +47,8 @@ func TestUpdateOS_Success(t *testing.T) {
 	req := &pb.UpdateSystemSoftwareRequest{Mode: *pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_NO_DOWNLOAD.Enum()}
 
 	var updater *OSUpdater = &OSUpdater{
-		req: req,
+		req:                  req,
+		verifyProvenanceFunc: skipProvenance,
 		isProceedWithoutRollbackFunc: func(*utils.Configurations) bool {
 			return true
 		},
@@ -63,6 +64,37 @@ func TestUpdateOS_Success(t *testing.T) {
 	assert.Equal(t, "Success", resp.Error)
 }
 
+func TestUpdateOS_ReturnsStagedArtifactID(t *testing.T) {
+	mockFactory := &MockUpdaterFactory{
+		CreateDownloaderFunc: func(*pb.UpdateSystemSoftwareRequest) Downloader {
+			return &MockDownloader{DownloadFunc: func() error { return nil }}
+		},
+		CreateUpdaterFunc: func(common.Executor, *pb.UpdateSystemSoftwareRequest) Updater {
+			return &MockUpdater{
+				UpdateFunc: func() (bool, error) { return true, nil },
+				StagedID:   "3f2a9c4e1b7d6a05",
+			}
+		},
+		CreateCleanerFunc: func(common.Executor, string) Cleaner {
+			return &MockCleaner{CleanFunc: func() error { return nil }}
+		},
+		CreateSnapshotterFunc: func(common.Executor, *pb.UpdateSystemSoftwareRequest) Snapshotter {
+			return &MockSnapshotter{SnapshotFunc: func() error { return nil }}
+		},
+	}
+
+	updater := &OSUpdater{
+		req:                  &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_DOWNLOAD_ONLY},
+		verifyProvenanceFunc: skipProvenance,
+	}
+
+	resp, err := updater.UpdateOS(mockFactory)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(200), resp.StatusCode)
+	assert.Equal(t, "3f2a9c4e1b7d6a05", resp.StagedArtifactId)
+}
+
 func TestUpdateOS_DownloadError(t *testing.T) {
 	mockFactory := &MockUpdaterFactory{
 		CreateDownloaderFunc: func(*pb.UpdateSystemSoftwareRequest) Downloader {
@@ -75,7 +107,8 @@ func TestUpdateOS_DownloadError(t *testing.T) {
 	req := &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL}
 
 	var updater *OSUpdater = &OSUpdater{
-		req: req,
+		req:                  req,
+		verifyProvenanceFunc: skipProvenance,
 		isProceedWithoutRollbackFunc: func(*utils.Configurations) bool {
 			return true
 		},
@@ -122,7 +155,8 @@ func TestUpdateOS_SnapshotErrorProceedWithoutRollback(t *testing.T) {
 	req := &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL}
 
 	var updater *OSUpdater = &OSUpdater{
-		req: req,
+		req:                  req,
+		verifyProvenanceFunc: skipProvenance,
 		isProceedWithoutRollbackFunc: func(*utils.Configurations) bool {
 			return true
 		},
@@ -160,7 +194,8 @@ func TestUpdateOS_SnapshotErrorDoNotProceedWithoutRollback(t *testing.T) {
 	req := &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL}
 
 	var updater *OSUpdater = &OSUpdater{
-		req: req,
+		req:                  req,
+		verifyProvenanceFunc: skipProvenance,
 		isProceedWithoutRollbackFunc: func(*utils.Configurations) bool {
 			return false
 		},
@@ -202,7 +237,8 @@ func TestUpdateOS_UpdateError(t *testing.T) {
 	req := &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL}
 
 	var updater *OSUpdater = &OSUpdater{
-		req: req,
+		req:                  req,
+		verifyProvenanceFunc: skipProvenance,
 		isProceedWithoutRollbackFunc: func(*utils.Configurations) bool {
 			return true
 		},
@@ -250,7 +286,8 @@ func TestUpdateOS_RebootError(t *testing.T) {
 	req := &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL}
 
 	var updater *OSUpdater = &OSUpdater{
-		req: req,
+		req:                  req,
+		verifyProvenanceFunc: skipProvenance,
 		isProceedWithoutRollbackFunc: func(*utils.Configurations) bool {
 			return true
 		},
@@ -265,3 +302,38 @@ func TestUpdateOS_RebootError(t *testing.T) {
 	assert.Equal(t, int32(500), resp.StatusCode)
 	assert.Equal(t, "reboot error", resp.Error)
 }
+
+func skipProvenance(*pb.UpdateSystemSoftwareRequest) error {
+	return nil
+}
+
+func TestUpdateOS_ProvenanceError(t *testing.T) {
+	cleaned := false
+	mockFactory := &MockUpdaterFactory{
+		CreateDownloaderFunc: func(*pb.UpdateSystemSoftwareRequest) Downloader {
+			return &MockDownloader{
+				DownloadFunc: func() error { return nil },
+			}
+		},
+		CreateCleanerFunc: func(common.Executor, string) Cleaner {
+			return &MockCleaner{
+				CleanFunc: func() error { cleaned = true; return nil },
+			}
+		},
+	}
+
+	req := &pb.UpdateSystemSoftwareRequest{Mode: pb.UpdateSystemSoftwareRequest_DOWNLOAD_MODE_FULL}
+
+	var updater *OSUpdater = &OSUpdater{
+		req: req,
+		verifyProvenanceFunc: func(*pb.UpdateSystemSoftwareRequest) error {
+			return fmt.Errorf("provenance verification failed: builder is not allowed")
+		},
+	}
+	resp, err := updater.UpdateOS(mockFactory)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), resp.StatusCode)
+	assert.Contains(t, resp.Error, "builder is not allowed")
+	assert.True(t, cleaned)
+}
@@ -13,8 +13,6 @@ import (
 
 	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
 	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
-	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/emt"
-	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/ubuntu"
 	"github.com/spf13/afero"
 )
 
@@ -41,28 +39,14 @@ func VerifyUpdateAfterReboot(fs afero.Fs) error {
 			return fmt.Errorf("error reading state file: %w", err)
 		}
 
-		switch osType {
-		case "EMT":
-			err := emt.VerifyUpdateAfterReboot(fs, state)
-			if err != nil {
-				return err
-			}
-		case "Ubuntu":
-			v := ubuntu.NewVerifier()
-			err := v.VerifyUpdateAfterReboot(state)
-			if err != nil {
-				return err
-			}
-		default:
+		d, ok := LookupDistribution(osType)
+		if !ok {
 			return fmt.Errorf("unsupported OS type: %s", osType)
 		}
-		log.Println("Post update verification completed.")
-
-		// Write SUCCESS status for Ubuntu (EMT writes its own status)
-		if osType == "Ubuntu" {
-			emt.WriteUpdateStatus(fs, "SUCCESS", "", "")
-			emt.WriteGranularLogWithOSType(fs, emt.SUCCESS, "", "ubuntu")
+		if err := d.Verifier(fs, state); err != nil {
+			return err
 		}
+		log.Println("Post update verification completed.")
 	} else {
 		log.Println("No dispatcher state file. Skip post update verification.")
 	}
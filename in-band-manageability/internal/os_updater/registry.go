@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package osupdater updates the OS.
+package osupdater
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/dnf"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/emt"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/os_updater/ubuntu"
+	"github.com/spf13/afero"
+)
+
+// PostRebootVerifier verifies an OS update after the reboot using the saved state.
+type PostRebootVerifier func(afero.Fs, utils.INBDState) error
+
+// Distribution is the set of plugins used to update a Linux distribution.
+type Distribution struct {
+	// Name is the OS name as returned by common.DetectOS.
+	Name     string
+	Factory  UpdaterFactory
+	Verifier PostRebootVerifier
+}
+
+var (
+	registryMutex sync.RWMutex
+	distributions = map[string]Distribution{}
+)
+
+func init() {
+	for _, d := range []Distribution{
+		{Name: "EMT", Factory: &EMTFactory{}, Verifier: emt.VerifyUpdateAfterReboot},
+		{Name: "Ubuntu", Factory: &UbuntuFactory{}, Verifier: aptVerifier("ubuntu")},
+		{Name: "Debian", Factory: &DebianFactory{}, Verifier: aptVerifier("debian")},
+		{Name: "RHEL", Factory: &DnfFactory{}, Verifier: func(_ afero.Fs, state utils.INBDState) error {
+			return dnf.NewVerifier().VerifyUpdateAfterReboot(state)
+		}},
+	} {
+		if err := RegisterDistribution(d); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterDistribution registers the updater plugins for a distribution.
+func RegisterDistribution(d Distribution) error {
+	if d.Name == "" {
+		return fmt.Errorf("distribution name must not be empty")
+	}
+	if d.Factory == nil || d.Verifier == nil {
+		return fmt.Errorf("distribution %s must provide a factory and a post-reboot verifier", d.Name)
+	}
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	if _, exists := distributions[d.Name]; exists {
+		return fmt.Errorf("distribution %s is already registered", d.Name)
+	}
+	distributions[d.Name] = d
+	return nil
+}
+
+// LookupDistribution returns the registered plugins for a distribution.
+func LookupDistribution(name string) (Distribution, bool) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	d, ok := distributions[name]
+	return d, ok
+}
+
+// RegisteredDistributions returns the sorted names of all registered distributions.
+func RegisteredDistributions() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	names := make([]string, 0, len(distributions))
+	for name := range distributions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// aptVerifier returns the apt-based post-reboot verifier logging with the given OS type.
+// The verifier writes the final update status itself, including ROLLED_BACK after a rollback.
+func aptVerifier(osType string) PostRebootVerifier {
+	return func(_ afero.Fs, state utils.INBDState) error {
+		return ubuntu.NewVerifierWithOSType(osType).VerifyUpdateAfterReboot(state)
+	}
+}
@@ -7,6 +7,8 @@
 package appsource
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -30,25 +32,39 @@ type Adder struct {
 	loadConfigFunc    func(afero.Fs, string) (*utils.Configurations, error)
 	isTrustedRepoFunc func(string, *utils.Configurations) bool
 	addGpgKeyFunc     func(string, string, func(string, string, io.Reader) (*http.Request, error), *http.Client, common.Executor) error
+	network           *utils.NetworkProfile
 	fs                afero.Fs
 }
 
 // NewAdder creates a new Adder.
 func NewAdder() *Adder {
+	return NewAdderWithContext(context.Background())
+}
+
+// NewAdderWithContext creates a new Adder whose commands are attributed in the audit log to
+// the caller carried by the context.
+func NewAdderWithContext(ctx context.Context) *Adder {
+	network := utils.LoadNetworkProfile(afero.NewOsFs())
 	return &Adder{
-		httpClient:        &http.Client{},
+		httpClient:        network.HTTPClient(0),
 		requestCreator:    http.NewRequest,
-		CommandExecutor:   common.NewExecutor(exec.Command, common.ExecuteAndReadOutput),
+		CommandExecutor:   common.NewExecutorWithContext(ctx, exec.Command, common.ExecuteAndReadOutput),
 		openFileFunc:      utils.OpenFile,
 		loadConfigFunc:    utils.LoadConfig,
 		isTrustedRepoFunc: utils.IsTrustedRepository,
 		addGpgKeyFunc:     addGpgKey,
+		network:           network,
 		fs:                afero.NewOsFs(),
 	}
 }
 
 // Add adds a source file and optional GPG key to be used during Ubuntu application updates.
+// A structured source is written as a deb822 file instead; see addSignedSource.
 func (a *Adder) Add(req *pb.AddApplicationSourceRequest) error {
+	if req.ApplicationSource != nil {
+		return a.addSignedSource(req.ApplicationSource, req.GpgKeyUri)
+	}
+
 	// Verify GPG key URI is from trusted repo list
 	if req.GpgKeyUri != "" && req.GpgKeyName != "" {
 		config, err := a.loadConfigFunc(a.fs, utils.ConfigFilePath)
@@ -83,9 +99,9 @@ func (a *Adder) Add(req *pb.AddApplicationSourceRequest) error {
 	}
 	defer file.Close()
 
-	// Write sources to file
+	// Write sources to file, with their URLs rewritten to the local mirrors
 	for _, source := range req.Source {
-		if _, err := file.WriteString(source + "\n"); err != nil {
+		if _, err := file.WriteString(a.network.RewriteSourceLine(source) + "\n"); err != nil {
 			return fmt.Errorf("error writing to source list file: %w", err)
 		}
 	}
@@ -99,48 +115,75 @@ func addGpgKey(gpgKeyURI string,
 	client *http.Client,
 	cmdExec common.Executor) error {
 
+	tempFile, err := downloadToTempFile(gpgKeyURI, "gpgkey-*.asc", requestCreator, client)
+	if err != nil {
+		var statusErr *httpStatusError
+		if errors.As(err, &statusErr) {
+			return fmt.Errorf("error getting GPG key.  Status code: %d. Expected 200/Success", statusErr.statusCode)
+		}
+		return err
+	}
+	defer os.Remove(tempFile) // Clean up the temporary file
+
+	//  Use GPG to dearmor the key and save it to the keyrings directory
+	gpgKeyPath := filepath.Join(linuxGPGKeyPath, gpgKeyName)
+
+	dearmorGpgKeyCommand := []string{
+		common.GPGCmd, "--dearmor", "--output", gpgKeyPath, tempFile,
+	}
+	_, _, err = cmdExec.Execute(dearmorGpgKeyCommand)
+	if err != nil {
+		return fmt.Errorf("error dearmoring GPG key: %w", err)
+	}
+
+	fmt.Printf("GPG key added to %s\n", gpgKeyPath)
+	return nil
+}
+
+// httpStatusError is returned by downloadToTempFile when the server does not respond with 200/Success.
+type httpStatusError struct {
+	uri        string
+	statusCode int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("error downloading %s.  Status code: %d. Expected 200/Success", e.uri, e.statusCode)
+}
+
+// downloadToTempFile downloads a file into a new temporary file, and returns the path of the
+// temporary file.  The caller removes the file.
+func downloadToTempFile(uri string,
+	pattern string,
+	requestCreator func(string, string, io.Reader) (*http.Request, error),
+	client *http.Client) (string, error) {
+
 	// Create a new HTTP request
-	req, err := requestCreator("GET", gpgKeyURI, nil)
+	req, err := requestCreator("GET", uri, nil)
 	if err != nil {
-		return fmt.Errorf("error creating request: %w", err)
+		return "", fmt.Errorf("error creating request: %w", err)
 	}
 
 	// Perform the request
 	resp, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("error performing request: %w", err)
+		return "", fmt.Errorf("error performing request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Check if the status code is 200/Success. If not, return the error.
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("error getting GPG key.  Status code: %d. Expected 200/Success", resp.StatusCode)
+		return "", &httpStatusError{uri: uri, statusCode: resp.StatusCode}
 	}
 
-	// Save the GPG key to a temporary file
-	tempFile, err := os.CreateTemp("", "gpgkey-*.asc")
+	tempFile, err := os.CreateTemp("", pattern)
 	if err != nil {
-		return fmt.Errorf("error creating temporary file for GPG key: %w", err)
+		return "", fmt.Errorf("error creating temporary file: %w", err)
 	}
-	defer os.Remove(tempFile.Name()) // Clean up the temporary file
 	defer tempFile.Close()
 
-	_, err = io.Copy(tempFile, resp.Body)
-	if err != nil {
-		return fmt.Errorf("error saving GPG key to temporary file: %w", err)
+	if _, err := io.Copy(tempFile, resp.Body); err != nil {
+		os.Remove(tempFile.Name())
+		return "", fmt.Errorf("error saving %s to temporary file: %w", uri, err)
 	}
-
-	//  Use GPG to dearmor the key and save it to the keyrings directory
-	gpgKeyPath := filepath.Join(linuxGPGKeyPath, gpgKeyName)
-
-	dearmorGpgKeyCommand := []string{
-		common.GPGCmd, "--dearmor", "--output", gpgKeyPath, tempFile.Name(),
-	}
-	_, _, err = cmdExec.Execute(dearmorGpgKeyCommand)
-	if err != nil {
-		return fmt.Errorf("error dearmoring GPG key: %w", err)
-	}
-
-	fmt.Printf("GPG key added to %s\n", gpgKeyPath)
-	return nil
+	return tempFile.Name(), nil
 }
@@ -0,0 +1,231 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package appsource provides functionality to add an application source.
+package appsource
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+)
+
+// deb822SourcesExt is the extension of the sources files in the deb822 format.
+const deb822SourcesExt = ".sources"
+
+// oneLineSourcesExt is the extension of the sources files in the one-line format.
+const oneLineSourcesExt = ".list"
+
+// keyFingerprintComment starts the comment that records the pinned key fingerprint of a source.
+// apt ignores comments, so the pin does not affect how the source is used.
+const keyFingerprintComment = "# Key-Fingerprint:"
+
+var sourceNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// Fingerprints of OpenPGP v4 (SHA-1) and v6 (SHA-256) keys.
+var keyFingerprintPattern = regexp.MustCompile(`^([0-9A-F]{40}|[0-9A-F]{64})$`)
+
+// normalizeFingerprint converts a fingerprint to upper case without the spaces that gpg
+// prints to make it readable.
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToUpper(strings.ReplaceAll(fingerprint, " ", ""))
+}
+
+// keyringPath returns the Signed-By keyring of a structured source.
+func keyringPath(name string) string {
+	return filepath.Join(linuxGPGKeyPath, name+".gpg")
+}
+
+// sourcesPath returns the deb822 sources file of a structured source.
+func sourcesPath(name string) string {
+	return filepath.Join(ubuntuAptSourcesListDir, name+deb822SourcesExt)
+}
+
+// isFlatSuite returns true if a suite is the path of a flat repository, which has no dists
+// directory and no components.
+func isFlatSuite(suite string) bool {
+	return strings.HasSuffix(suite, "/")
+}
+
+// validateApplicationSource checks that a structured source can be written as a deb822 stanza.
+func validateApplicationSource(src *pb.ApplicationSource) error {
+	if !sourceNamePattern.MatchString(src.Name) {
+		return fmt.Errorf("invalid source name '%s'.  It must start with a letter or digit and contain only letters, digits, '_', '.' and '-'", src.Name)
+	}
+	for _, t := range src.Types {
+		if t != "deb" && t != "deb-src" {
+			return fmt.Errorf("invalid source type '%s'.  Valid types: deb, deb-src", t)
+		}
+	}
+	if len(src.Uris) == 0 {
+		return fmt.Errorf("at least one URI is required")
+	}
+	for _, uri := range src.Uris {
+		parsed, err := url.Parse(uri)
+		if err != nil {
+			return fmt.Errorf("invalid URI '%s': %w", uri, err)
+		}
+		if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid URI '%s'.  It must be an http or https URL", uri)
+		}
+	}
+	if len(src.Suites) == 0 {
+		return fmt.Errorf("at least one suite is required")
+	}
+	for _, suite := range src.Suites {
+		if isFlatSuite(suite) && len(src.Components) > 0 {
+			return fmt.Errorf("suite '%s' is a flat repository, which has no components", suite)
+		}
+		if !isFlatSuite(suite) && len(src.Components) == 0 {
+			return fmt.Errorf("suite '%s' requires at least one component", suite)
+		}
+	}
+	for _, values := range [][]string{src.Suites, src.Components, src.Architectures} {
+		for _, value := range values {
+			if value == "" || strings.ContainsAny(value, " \t\n") {
+				return fmt.Errorf("invalid value '%s'.  Suites, components and architectures must not be empty or contain whitespace", value)
+			}
+		}
+	}
+	if !keyFingerprintPattern.MatchString(normalizeFingerprint(src.KeyFingerprint)) {
+		return fmt.Errorf("invalid key fingerprint '%s'.  It must be the 40 or 64 hexadecimal digits of the key that signs the repository", src.KeyFingerprint)
+	}
+	return nil
+}
+
+// formatDeb822 formats a structured source as a deb822 stanza that uses the keyring of the
+// source.
+func formatDeb822(src *pb.ApplicationSource) string {
+	types := src.Types
+	if len(types) == 0 {
+		types = []string{"deb"}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s\n", keyFingerprintComment, normalizeFingerprint(src.KeyFingerprint))
+	fmt.Fprintf(&b, "Types: %s\n", strings.Join(types, " "))
+	fmt.Fprintf(&b, "URIs: %s\n", strings.Join(src.Uris, " "))
+	fmt.Fprintf(&b, "Suites: %s\n", strings.Join(src.Suites, " "))
+	if len(src.Components) > 0 {
+		fmt.Fprintf(&b, "Components: %s\n", strings.Join(src.Components, " "))
+	}
+	if len(src.Architectures) > 0 {
+		fmt.Fprintf(&b, "Architectures: %s\n", strings.Join(src.Architectures, " "))
+	}
+	fmt.Fprintf(&b, "Signed-By: %s\n", keyringPath(src.Name))
+	return b.String()
+}
+
+// parseDeb822 parses the stanzas of a deb822 sources file.  Fields that are not part of the
+// structured source, like an inline Signed-By key block, are ignored.
+func parseDeb822(filename string, data string) []*pb.ApplicationSource {
+	name := strings.TrimSuffix(filename, deb822SourcesExt)
+	var sources []*pb.ApplicationSource
+	var current *pb.ApplicationSource
+	var field string
+	flush := func() {
+		if current != nil && len(current.Uris) > 0 {
+			sources = append(sources, current)
+		}
+		current = nil
+		field = ""
+	}
+
+	for _, line := range strings.Split(data, "\n") {
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+		if current == nil {
+			current = &pb.ApplicationSource{Name: name, Filename: filename, Enabled: true}
+		}
+		if strings.HasPrefix(line, keyFingerprintComment) {
+			current.KeyFingerprint = strings.TrimSpace(strings.TrimPrefix(line, keyFingerprintComment))
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			// Continuation of a multiline field.
+			if field == "signed-by" && current.SignedBy == "" {
+				current.SignedBy = strings.TrimSpace(line)
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		field = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		switch field {
+		case "types":
+			current.Types = strings.Fields(value)
+		case "uris":
+			current.Uris = strings.Fields(value)
+		case "suites":
+			current.Suites = strings.Fields(value)
+		case "components":
+			current.Components = strings.Fields(value)
+		case "architectures":
+			current.Architectures = strings.Fields(value)
+		case "signed-by":
+			current.SignedBy = value
+		case "enabled":
+			current.Enabled = value != "no"
+		}
+	}
+	flush()
+	return sources
+}
+
+// parseOneLine parses the entries of a sources file in the one-line format.  Every entry
+// becomes a source with a single URI and suite.
+func parseOneLine(filename string, data string) []*pb.ApplicationSource {
+	name := strings.TrimSuffix(filename, oneLineSourcesExt)
+	var sources []*pb.ApplicationSource
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		src := &pb.ApplicationSource{Name: name, Filename: filename, Enabled: true}
+		fields := strings.Fields(line)
+		src.Types = []string{fields[0]}
+		fields = fields[1:]
+		if len(fields) > 0 && strings.HasPrefix(fields[0], "[") {
+			end := slices.IndexFunc(fields, func(f string) bool { return strings.HasSuffix(f, "]") })
+			if end < 0 {
+				continue
+			}
+			options := strings.Fields(strings.Trim(strings.Join(fields[:end+1], " "), "[]"))
+			for _, option := range options {
+				key, value, _ := strings.Cut(option, "=")
+				switch key {
+				case "arch":
+					src.Architectures = strings.Split(value, ",")
+				case "signed-by":
+					src.SignedBy = value
+				}
+			}
+			fields = fields[end+1:]
+		}
+		if len(fields) < 2 {
+			continue
+		}
+		src.Uris = []string{fields[0]}
+		src.Suites = []string{fields[1]}
+		if len(fields) > 2 {
+			src.Components = fields[2:]
+		}
+		sources = append(sources, src)
+	}
+	return sources
+}
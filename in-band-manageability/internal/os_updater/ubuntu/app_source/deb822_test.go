@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package appsource
+
+import (
+	"testing"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+const testFingerprint = "0123456789ABCDEF0123456789ABCDEF01234567"
+
+func testApplicationSource() *pb.ApplicationSource {
+	return &pb.ApplicationSource{
+		Name:           "example",
+		Uris:           []string{"https://repo.example.com/apt"},
+		Suites:         []string{"noble"},
+		Components:     []string{"main", "extra"},
+		Architectures:  []string{"amd64"},
+		KeyFingerprint: "0123 4567 89ab cdef 0123  4567 89AB CDEF 0123 4567",
+	}
+}
+
+func TestValidateApplicationSource(t *testing.T) {
+	tests := []struct {
+		name          string
+		modify        func(*pb.ApplicationSource)
+		expectedError string
+	}{
+		{"valid", func(*pb.ApplicationSource) {}, ""},
+		{"flat repository", func(s *pb.ApplicationSource) { s.Suites = []string{"./"}; s.Components = nil }, ""},
+		{"invalid name", func(s *pb.ApplicationSource) { s.Name = "../example" }, "invalid source name"},
+		{"invalid type", func(s *pb.ApplicationSource) { s.Types = []string{"rpm"} }, "invalid source type"},
+		{"no URIs", func(s *pb.ApplicationSource) { s.Uris = nil }, "at least one URI is required"},
+		{"file URI", func(s *pb.ApplicationSource) { s.Uris = []string{"file:///srv/apt"} }, "must be an http or https URL"},
+		{"no suites", func(s *pb.ApplicationSource) { s.Suites = nil }, "at least one suite is required"},
+		{"components of flat repository", func(s *pb.ApplicationSource) { s.Suites = []string{"./"} }, "flat repository"},
+		{"no components", func(s *pb.ApplicationSource) { s.Components = nil }, "requires at least one component"},
+		{"whitespace in component", func(s *pb.ApplicationSource) { s.Components = []string{"main contrib"} }, "must not be empty or contain whitespace"},
+		{"no fingerprint", func(s *pb.ApplicationSource) { s.KeyFingerprint = "" }, "invalid key fingerprint"},
+		{"key ID instead of fingerprint", func(s *pb.ApplicationSource) { s.KeyFingerprint = "89ABCDEF01234567" }, "invalid key fingerprint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := testApplicationSource()
+			tt.modify(src)
+			err := validateApplicationSource(src)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestFormatDeb822(t *testing.T) {
+	assert.Equal(t, `# Key-Fingerprint: `+testFingerprint+`
+Types: deb
+URIs: https://repo.example.com/apt
+Suites: noble
+Components: main extra
+Architectures: amd64
+Signed-By: /usr/share/keyrings/example.gpg
+`, formatDeb822(testApplicationSource()))
+
+	flat := &pb.ApplicationSource{Name: "flat", Types: []string{"deb", "deb-src"}, Uris: []string{"https://repo.example.com/flat"}, Suites: []string{"./"}, KeyFingerprint: testFingerprint}
+	assert.Equal(t, `# Key-Fingerprint: `+testFingerprint+`
+Types: deb deb-src
+URIs: https://repo.example.com/flat
+Suites: ./
+Signed-By: /usr/share/keyrings/flat.gpg
+`, formatDeb822(flat))
+}
+
+func TestParseDeb822(t *testing.T) {
+	t.Run("formatted source", func(t *testing.T) {
+		sources := parseDeb822("example.sources", formatDeb822(testApplicationSource()))
+		assert.Equal(t, []*pb.ApplicationSource{{
+			Name:           "example",
+			Types:          []string{"deb"},
+			Uris:           []string{"https://repo.example.com/apt"},
+			Suites:         []string{"noble"},
+			Components:     []string{"main", "extra"},
+			Architectures:  []string{"amd64"},
+			KeyFingerprint: testFingerprint,
+			SignedBy:       "/usr/share/keyrings/example.gpg",
+			Filename:       "example.sources",
+			Enabled:        true,
+		}}, sources)
+	})
+
+	t.Run("several stanzas with an inline key", func(t *testing.T) {
+		data := `Types: deb
+URIs: http://archive.ubuntu.com/ubuntu/
+Suites: noble noble-updates
+Components: main restricted
+Signed-By: /usr/share/keyrings/ubuntu-archive-keyring.gpg
+
+# Disabled source
+Types: deb-src
+URIs: http://archive.ubuntu.com/ubuntu/
+Suites: noble
+Components: main
+Enabled: no
+Signed-By:
+ -----BEGIN PGP PUBLIC KEY BLOCK-----
+ .
+ mQINBFit2ioBEADhWpZ8/wvZ6hUTiXOwQHXMAlaFHcPH9hAtr4F1y2+OYdbtMuth
+ -----END PGP PUBLIC KEY BLOCK-----
+`
+		sources := parseDeb822("ubuntu.sources", data)
+		assert.Len(t, sources, 2)
+		assert.Equal(t, []string{"noble", "noble-updates"}, sources[0].Suites)
+		assert.Equal(t, "/usr/share/keyrings/ubuntu-archive-keyring.gpg", sources[0].SignedBy)
+		assert.True(t, sources[0].Enabled)
+		assert.Equal(t, []string{"deb-src"}, sources[1].Types)
+		assert.False(t, sources[1].Enabled)
+		assert.Equal(t, "-----BEGIN PGP PUBLIC KEY BLOCK-----", sources[1].SignedBy)
+	})
+}
+
+func TestParseOneLine(t *testing.T) {
+	data := `# Comment
+deb [arch=amd64,arm64 signed-by=/usr/share/keyrings/example.gpg] https://repo.example.com/apt noble main extra
+
+deb-src https://repo.example.com/apt ./
+deb [arch=amd64 https://broken.example.com noble main
+`
+	assert.Equal(t, []*pb.ApplicationSource{
+		{
+			Name:          "example",
+			Types:         []string{"deb"},
+			Uris:          []string{"https://repo.example.com/apt"},
+			Suites:        []string{"noble"},
+			Components:    []string{"main", "extra"},
+			Architectures: []string{"amd64", "arm64"},
+			SignedBy:      "/usr/share/keyrings/example.gpg",
+			Filename:      "example.list",
+			Enabled:       true,
+		},
+		{
+			Name:     "example",
+			Types:    []string{"deb-src"},
+			Uris:     []string{"https://repo.example.com/apt"},
+			Suites:   []string{"./"},
+			Filename: "example.list",
+			Enabled:  true,
+		},
+	}, parseOneLine("example.list", data))
+}
@@ -0,0 +1,53 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package appsource provides functionality to add an application source.
+package appsource
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+)
+
+// Lister lists the application sources.
+type Lister struct {
+	fs afero.Fs
+}
+
+// NewLister creates a new Lister.
+func NewLister() *Lister {
+	return &Lister{fs: afero.NewOsFs()}
+}
+
+// List returns the sources of the deb822 and one-line files under /etc/apt/sources.list.d,
+// ordered by filename.
+func (l *Lister) List() ([]*pb.ApplicationSource, error) {
+	entries, err := afero.ReadDir(l.fs, ubuntuAptSourcesListDir)
+	if err != nil {
+		return nil, fmt.Errorf("error listing application source files: %w", err)
+	}
+
+	var sources []*pb.ApplicationSource
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || (!strings.HasSuffix(name, deb822SourcesExt) && !strings.HasSuffix(name, oneLineSourcesExt)) {
+			continue
+		}
+		data, err := afero.ReadFile(l.fs, filepath.Join(ubuntuAptSourcesListDir, name))
+		if err != nil {
+			return nil, fmt.Errorf("error reading application source file: %w", err)
+		}
+		if strings.HasSuffix(name, deb822SourcesExt) {
+			sources = append(sources, parseDeb822(name, string(data))...)
+		} else {
+			sources = append(sources, parseOneLine(name, string(data))...)
+		}
+	}
+	return sources, nil
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package appsource
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/etc/apt/sources.list.d/example.sources", []byte(formatDeb822(testApplicationSource())), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/etc/apt/sources.list.d/docker.list", []byte("deb https://download.docker.com/linux/ubuntu noble stable\n"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/etc/apt/sources.list.d/old.list.save", []byte("deb https://old.example.com noble main\n"), 0644))
+
+	sources, err := (&Lister{fs: fs}).List()
+	require.NoError(t, err)
+	require.Len(t, sources, 2)
+	assert.Equal(t, "docker.list", sources[0].Filename)
+	assert.Equal(t, []string{"stable"}, sources[0].Components)
+	assert.Equal(t, "example.sources", sources[1].Filename)
+	assert.Equal(t, testFingerprint, sources[1].KeyFingerprint)
+
+	_, err = (&Lister{fs: afero.NewMemMapFs()}).List()
+	assert.ErrorContains(t, err, "error listing application source files")
+}
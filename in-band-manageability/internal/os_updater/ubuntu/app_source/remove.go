@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"log"
 	"path/filepath"
+	"strings"
 
 	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
 	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
@@ -43,9 +44,14 @@ func NewRemover() *Remover {
 }
 
 // Remove removes a source file and optional GPG key used during Ubuntu application updates.
+// The keyring of a structured deb822 source is removed with it.
 func (a *Remover) Remove(req *pb.RemoveApplicationSourceRequest) error {
-	if req.GpgKeyName != "" {
-		gpgKeyPath := filepath.Join(linuxGPGKeyPath, req.GpgKeyName)
+	gpgKeyName := req.GpgKeyName
+	if gpgKeyName == "" && strings.HasSuffix(req.Filename, deb822SourcesExt) {
+		gpgKeyName = a.ownKeyringName(req.Filename)
+	}
+	if gpgKeyName != "" {
+		gpgKeyPath := filepath.Join(linuxGPGKeyPath, gpgKeyName)
 		isExist := a.isExistGpgKeyFileFunc(a.fs, gpgKeyPath)
 		if isExist {
 			err := a.removeGpgKeyFunc(a.fs, gpgKeyPath)
@@ -72,3 +78,19 @@ func (a *Remover) Remove(req *pb.RemoveApplicationSourceRequest) error {
 
 	return nil
 }
+
+// ownKeyringName returns the name of the keyring that a deb822 source file was added with, or
+// "" if the file uses another keyring, which may be shared with other sources.
+func (a *Remover) ownKeyringName(filename string) string {
+	data, err := afero.ReadFile(a.fs, filepath.Join(ubuntuAptSourcesListDir, filename))
+	if err != nil {
+		return ""
+	}
+	keyring := keyringPath(strings.TrimSuffix(filename, deb822SourcesExt))
+	for _, src := range parseDeb822(filename, string(data)) {
+		if src.SignedBy == keyring {
+			return filepath.Base(keyring)
+		}
+	}
+	return ""
+}
@@ -160,3 +160,27 @@ func TestRemove_SourceFileRemovalFailure(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "error removing application source file")
 }
+
+func TestRemove_SignedSourceRemovesOwnKeyring(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	assert.NoError(t, afero.WriteFile(fs, "/etc/apt/sources.list.d/example.sources", []byte(formatDeb822(testApplicationSource())), 0644))
+	assert.NoError(t, afero.WriteFile(fs, "/etc/apt/sources.list.d/shared.sources", []byte("Types: deb\nURIs: https://repo.example.com/apt\nSuites: noble\nComponents: main\nSigned-By: /usr/share/keyrings/example.gpg\n"), 0644))
+
+	var removedKeys []string
+	remover := &Remover{
+		fs: fs,
+		removeGpgKeyFunc: func(fs afero.Fs, path string) error {
+			removedKeys = append(removedKeys, path)
+			return nil
+		},
+		removeSourceFileFunc:  func(afero.Fs, string) error { return nil },
+		isExistGpgKeyFileFunc: func(afero.Fs, string) bool { return true },
+		isExistSourceFileFunc: func(afero.Fs, string) bool { return true },
+	}
+
+	assert.NoError(t, remover.Remove(&pb.RemoveApplicationSourceRequest{Filename: "shared.sources"}))
+	assert.Empty(t, removedKeys, "a keyring of another source is kept")
+
+	assert.NoError(t, remover.Remove(&pb.RemoveApplicationSourceRequest{Filename: "example.sources"}))
+	assert.Equal(t, []string{"/usr/share/keyrings/example.gpg"}, removedKeys)
+}
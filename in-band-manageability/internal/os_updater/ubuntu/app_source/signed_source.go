@@ -0,0 +1,264 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package appsource provides functionality to add an application source.
+package appsource
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+)
+
+// addSignedSource adds a structured source as a deb822 <name>.sources file that trusts only the
+// pinned key.  The key is downloaded into the keyring of the source, and the Release file of
+// every suite is fetched and verified with that keyring before the source is written, so that
+// apt never sees a source it can not use.
+func (a *Adder) addSignedSource(src *pb.ApplicationSource, gpgKeyURI string) error {
+	if err := validateApplicationSource(src); err != nil {
+		return err
+	}
+	if gpgKeyURI == "" {
+		return fmt.Errorf("GPG key URI is required for a structured application source")
+	}
+
+	config, err := a.loadConfigFunc(a.fs, utils.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("error loading config: %w", err)
+	}
+	for _, uri := range append([]string{gpgKeyURI}, src.Uris...) {
+		if !a.isTrustedRepoFunc(uri, config) {
+			return fmt.Errorf("URI verification failed.  %s is not in the list of trusted repositories", uri)
+		}
+	}
+
+	// The key is validated in a keyring of its own, which replaces the keyring of the source
+	// only once the repository has been verified with it, so that a failed request leaves the
+	// existing keyring of a source untouched.
+	keyring := keyringPath(src.Name)
+	staged, err := a.stageKeyring(src.Name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if removeErr := a.fs.Remove(staged); removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) {
+			log.Printf("[Warning] Error removing keyring %s: %v", staged, removeErr)
+		}
+	}()
+	if err := a.addPinnedKey(gpgKeyURI, src.KeyFingerprint, staged); err != nil {
+		return fmt.Errorf("error adding GPG key: %w", err)
+	}
+	if err := a.validateRelease(src, staged); err != nil {
+		return fmt.Errorf("repository validation failed: %w", err)
+	}
+	if err := a.fs.Chmod(staged, 0644); err != nil {
+		return fmt.Errorf("error setting permissions of keyring %s: %w", staged, err)
+	}
+	if err := a.fs.Rename(staged, keyring); err != nil {
+		return fmt.Errorf("error installing keyring %s: %w", keyring, err)
+	}
+	log.Printf("Keyring installed: %s", keyring)
+
+	// The trust checks above are made on the URIs of the request; apt uses the local mirrors.
+	mirrored := proto.Clone(src).(*pb.ApplicationSource)
+	for i, uri := range mirrored.Uris {
+		mirrored.Uris[i] = a.network.RewriteURL(uri)
+	}
+	path := sourcesPath(src.Name)
+	if err := afero.WriteFile(a.fs, path, []byte(formatDeb822(mirrored)), 0644); err != nil {
+		return fmt.Errorf("error writing application source file: %w", err)
+	}
+	log.Printf("Application source added: %s", path)
+	return nil
+}
+
+// stageKeyring creates an empty file for the keyring of a source next to the keyring, so that
+// it can be renamed over the keyring.
+func (a *Adder) stageKeyring(name string) (string, error) {
+	file, err := afero.TempFile(a.fs, linuxGPGKeyPath, name+"-*.gpg.tmp")
+	if err != nil {
+		return "", fmt.Errorf("error creating keyring: %w", err)
+	}
+	defer file.Close()
+	return file.Name(), nil
+}
+
+// addPinnedKey downloads a key, checks that it is exactly the key with the pinned fingerprint,
+// and saves it as a keyring.
+func (a *Adder) addPinnedKey(gpgKeyURI string, fingerprint string, keyring string) error {
+	keyFile, err := downloadToTempFile(gpgKeyURI, "gpgkey-*.asc", a.requestCreator, a.httpClient)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(keyFile)
+
+	stdout, stderr, err := a.CommandExecutor.Execute([]string{
+		common.GPGCmd, "--show-keys", "--with-colons", "--with-fingerprint", keyFile,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading GPG key: %w: %s", err, strings.TrimSpace(string(stderr)))
+	}
+	fingerprints := primaryKeyFingerprints(string(stdout))
+	want := normalizeFingerprint(fingerprint)
+	// Every key in the keyring is trusted to sign the repository, so it must hold the pinned key
+	// and nothing else.
+	if len(fingerprints) != 1 || fingerprints[0] != want {
+		return fmt.Errorf("GPG key fingerprint mismatch.  Expected %s, got %s", want, strings.Join(fingerprints, ", "))
+	}
+
+	if _, stderr, err := a.CommandExecutor.Execute([]string{
+		common.GPGCmd, "--dearmor", "--yes", "--output", keyring, keyFile,
+	}); err != nil {
+		return fmt.Errorf("error dearmoring GPG key: %w: %s", err, strings.TrimSpace(string(stderr)))
+	}
+	log.Printf("GPG key %s added to %s", want, keyring)
+	return nil
+}
+
+// primaryKeyFingerprints returns the fingerprints of the primary keys in the output of
+// gpg --with-colons.  The fpr record that follows a pub record is the fingerprint of the
+// primary key; the ones that follow sub records belong to subkeys.
+func primaryKeyFingerprints(colons string) []string {
+	var fingerprints []string
+	inPrimary := false
+	for _, line := range strings.Split(colons, "\n") {
+		fields := strings.Split(line, ":")
+		switch fields[0] {
+		case "pub":
+			inPrimary = true
+		case "sub":
+			inPrimary = false
+		case "fpr":
+			if inPrimary && len(fields) > 9 {
+				fingerprints = append(fingerprints, strings.ToUpper(fields[9]))
+				inPrimary = false
+			}
+		}
+	}
+	return fingerprints
+}
+
+// validateRelease fetches the Release file of every URI and suite of a source, verifies its
+// signature with the keyring of the source, and checks that it provides the requested
+// components and architectures.
+func (a *Adder) validateRelease(src *pb.ApplicationSource, keyring string) error {
+	for _, uri := range src.Uris {
+		for _, suite := range src.Suites {
+			elements := []string{"dists", suite}
+			if isFlatSuite(suite) {
+				elements = []string{suite}
+			}
+			base, err := url.JoinPath(uri, elements...)
+			if err != nil {
+				return fmt.Errorf("invalid URI '%s': %w", uri, err)
+			}
+			base = strings.TrimSuffix(base, "/")
+			release, err := a.fetchVerifiedRelease(base, keyring)
+			if err != nil {
+				return err
+			}
+			if err := checkReleaseFields(release, src); err != nil {
+				return fmt.Errorf("%s: %w", base, err)
+			}
+		}
+	}
+	return nil
+}
+
+// fetchVerifiedRelease fetches the InRelease file of a suite, or the Release and Release.gpg
+// files if the repository has no InRelease file, and returns the Release fields after their
+// signature has been verified.
+func (a *Adder) fetchVerifiedRelease(base string, keyring string) (string, error) {
+	verify := []string{common.GPGCmd, "--no-default-keyring", "--keyring", keyring, "--verify"}
+
+	inRelease, err := downloadToTempFile(base+"/InRelease", "InRelease-*", a.requestCreator, a.httpClient)
+	var statusErr *httpStatusError
+	switch {
+	case err == nil:
+		defer os.Remove(inRelease)
+		if _, stderr, err := a.CommandExecutor.Execute(append(verify, inRelease)); err != nil {
+			return "", fmt.Errorf("signature verification of %s/InRelease failed: %w: %s", base, err, strings.TrimSpace(string(stderr)))
+		}
+		return readReleaseFile(inRelease)
+	case !errors.As(err, &statusErr) || statusErr.statusCode != http.StatusNotFound:
+		return "", err
+	}
+
+	release, err := downloadToTempFile(base+"/Release", "Release-*", a.requestCreator, a.httpClient)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(release)
+	signature, err := downloadToTempFile(base+"/Release.gpg", "Release-*.gpg", a.requestCreator, a.httpClient)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(signature)
+	if _, stderr, err := a.CommandExecutor.Execute(append(verify, signature, release)); err != nil {
+		return "", fmt.Errorf("signature verification of %s/Release failed: %w: %s", base, err, strings.TrimSpace(string(stderr)))
+	}
+	return readReleaseFile(release)
+}
+
+// readReleaseFile reads a Release file, or the signed part of an InRelease file.
+func readReleaseFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading Release file: %w", err)
+	}
+	content := string(data)
+	if strings.HasPrefix(content, "-----BEGIN PGP SIGNED MESSAGE-----") {
+		// Skip the armor headers, which end at the first empty line.
+		if _, body, ok := strings.Cut(content, "\n\n"); ok {
+			content = body
+		}
+		content, _, _ = strings.Cut(content, "-----BEGIN PGP SIGNATURE-----")
+	}
+	return content, nil
+}
+
+// releaseField returns the space separated values of a field of a Release file.
+func releaseField(release string, name string) ([]string, bool) {
+	for _, line := range strings.Split(release, "\n") {
+		if value, ok := strings.CutPrefix(line, name+":"); ok {
+			return strings.Fields(value), true
+		}
+	}
+	return nil, false
+}
+
+// checkReleaseFields checks that a Release file lists the components and architectures of a
+// source.  Fields that the Release file does not have are not checked.
+func checkReleaseFields(release string, src *pb.ApplicationSource) error {
+	if components, ok := releaseField(release, "Components"); ok {
+		for _, component := range src.Components {
+			// Debian security lists its components as e.g. updates/main.
+			if !slices.ContainsFunc(components, func(c string) bool {
+				return c == component || strings.HasSuffix(c, "/"+component)
+			}) {
+				return fmt.Errorf("component '%s' is not in the Release file (%s)", component, strings.Join(components, " "))
+			}
+		}
+	}
+	if architectures, ok := releaseField(release, "Architectures"); ok {
+		for _, arch := range src.Architectures {
+			if !slices.Contains(architectures, arch) {
+				return fmt.Errorf("architecture '%s' is not in the Release file (%s)", arch, strings.Join(architectures, " "))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package appsource
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	pb "github.com/open-edge-platform/edge-node-agents/in-band-manageability/pkg/api/inbd/v1"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeyColons = `pub:-:4096:1:89ABCDEF01234567:1700000000:::-:::scSC::::::23::0:
+fpr:::::::::0123456789ABCDEF0123456789ABCDEF01234567:
+uid:-::::1700000000::0000000000000000000000000000000000000000::Example Repository <repo@example.com>::::::::::0:
+sub:-:4096:1:1111111111111111:1700000000::::::e::::::23:
+fpr:::::::::11111111111111111111111111111111111111111:
+`
+
+const testRelease = `Origin: Example
+Suite: noble
+Architectures: amd64 arm64
+Components: main extra
+Date: Mon, 01 Jan 2025 00:00:00 UTC
+`
+
+const testInRelease = `-----BEGIN PGP SIGNED MESSAGE-----
+Hash: SHA512
+
+` + testRelease + `-----BEGIN PGP SIGNATURE-----
+
+iQIzBAEBCgAdFiEE
+-----END PGP SIGNATURE-----
+`
+
+// testRepository serves files by URL, and 404 for the others.
+func testRepository(files map[string]string) *http.Client {
+	return &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) *http.Response {
+			content, ok := files[req.URL.String()]
+			if !ok {
+				return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(content))}
+		}),
+	}
+}
+
+func testSignedAdder(fs afero.Fs, client *http.Client, exec *mockExecutor) *Adder {
+	return &Adder{
+		httpClient:      client,
+		requestCreator:  http.NewRequest,
+		CommandExecutor: exec,
+		loadConfigFunc: func(afero.Fs, string) (*utils.Configurations, error) {
+			return &utils.Configurations{}, nil
+		},
+		isTrustedRepoFunc: func(uri string, _ *utils.Configurations) bool {
+			return strings.HasPrefix(uri, "https://repo.example.com/")
+		},
+		fs: fs,
+	}
+}
+
+// assertKeyrings checks the files in the keyring directory, so that no staged keyring is left.
+func assertKeyrings(t *testing.T, fs afero.Fs, names ...string) {
+	t.Helper()
+	entries, err := afero.ReadDir(fs, "/usr/share/keyrings")
+	if err != nil {
+		assert.Empty(t, names)
+		return
+	}
+	var found []string
+	for _, entry := range entries {
+		found = append(found, entry.Name())
+	}
+	assert.ElementsMatch(t, names, found)
+}
+
+func TestAddSignedSource(t *testing.T) {
+	files := map[string]string{
+		"https://repo.example.com/key.asc":                   "key",
+		"https://repo.example.com/apt/dists/noble/InRelease": testInRelease,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		exec := &mockExecutor{stdout: []string{testKeyColons}, errors: []error{nil}}
+		adder := testSignedAdder(fs, testRepository(files), exec)
+
+		err := adder.Add(&pb.AddApplicationSourceRequest{
+			GpgKeyUri:         "https://repo.example.com/key.asc",
+			ApplicationSource: testApplicationSource(),
+		})
+		require.NoError(t, err)
+
+		content, err := afero.ReadFile(fs, "/etc/apt/sources.list.d/example.sources")
+		require.NoError(t, err)
+		assert.Equal(t, formatDeb822(testApplicationSource()), string(content))
+
+		require.Len(t, exec.commands, 3)
+		assert.Equal(t, []string{"/usr/bin/gpg", "--show-keys", "--with-colons", "--with-fingerprint"}, exec.commands[0][:4])
+		assert.Equal(t, []string{"/usr/bin/gpg", "--dearmor", "--yes", "--output"}, exec.commands[1][:4])
+		staged := exec.commands[1][4]
+		assert.Regexp(t, `^/usr/share/keyrings/example-\d+\.gpg\.tmp$`, staged)
+		assert.Equal(t, []string{"/usr/bin/gpg", "--no-default-keyring", "--keyring", staged, "--verify"}, exec.commands[2][:5], "the staged keyring is validated")
+		assert.Len(t, exec.commands[2], 6, "InRelease is verified without a detached signature")
+
+		assertKeyrings(t, fs, "example.gpg")
+		info, err := fs.Stat("/usr/share/keyrings/example.gpg")
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+	})
+
+	t.Run("existing keyring is replaced", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, "/usr/share/keyrings/example.gpg", []byte("old key"), 0644))
+		exec := &mockExecutor{stdout: []string{testKeyColons}, errors: []error{nil}}
+		adder := testSignedAdder(fs, testRepository(files), exec)
+
+		err := adder.Add(&pb.AddApplicationSourceRequest{
+			GpgKeyUri:         "https://repo.example.com/key.asc",
+			ApplicationSource: testApplicationSource(),
+		})
+		require.NoError(t, err)
+		content, err := afero.ReadFile(fs, "/usr/share/keyrings/example.gpg")
+		require.NoError(t, err)
+		assert.NotEqual(t, "old key", string(content))
+		assertKeyrings(t, fs, "example.gpg")
+	})
+
+	t.Run("existing keyring is kept when validation fails", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, "/usr/share/keyrings/example.gpg", []byte("old key"), 0644))
+		exec := &mockExecutor{stdout: []string{testKeyColons}, errors: []error{nil}}
+		adder := testSignedAdder(fs, testRepository(files), exec)
+		src := testApplicationSource()
+		src.Components = []string{"contrib"}
+
+		err := adder.Add(&pb.AddApplicationSourceRequest{GpgKeyUri: "https://repo.example.com/key.asc", ApplicationSource: src})
+		require.ErrorContains(t, err, "repository validation failed")
+		content, err := afero.ReadFile(fs, "/usr/share/keyrings/example.gpg")
+		require.NoError(t, err)
+		assert.Equal(t, "old key", string(content))
+		assertKeyrings(t, fs, "example.gpg")
+	})
+
+	t.Run("Release and Release.gpg without InRelease", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		exec := &mockExecutor{stdout: []string{testKeyColons}, errors: []error{nil}}
+		adder := testSignedAdder(fs, testRepository(map[string]string{
+			"https://repo.example.com/key.asc":                     "key",
+			"https://repo.example.com/apt/dists/noble/Release":     testRelease,
+			"https://repo.example.com/apt/dists/noble/Release.gpg": "signature",
+		}), exec)
+
+		err := adder.Add(&pb.AddApplicationSourceRequest{
+			GpgKeyUri:         "https://repo.example.com/key.asc",
+			ApplicationSource: testApplicationSource(),
+		})
+		require.NoError(t, err)
+		require.Len(t, exec.commands, 3)
+		assert.Len(t, exec.commands[2], 7, "Release is verified with its detached signature")
+	})
+
+	t.Run("flat repository", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		exec := &mockExecutor{stdout: []string{testKeyColons}, errors: []error{nil}}
+		adder := testSignedAdder(fs, testRepository(map[string]string{
+			"https://repo.example.com/key.asc":        "key",
+			"https://repo.example.com/flat/InRelease": testInRelease,
+		}), exec)
+
+		src := &pb.ApplicationSource{Name: "flat", Uris: []string{"https://repo.example.com/flat/"}, Suites: []string{"./"}, KeyFingerprint: testFingerprint}
+		err := adder.Add(&pb.AddApplicationSourceRequest{GpgKeyUri: "https://repo.example.com/key.asc", ApplicationSource: src})
+		assert.NoError(t, err)
+	})
+
+	t.Run("mirrored repository", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		exec := &mockExecutor{stdout: []string{testKeyColons}, errors: []error{nil}}
+		adder := testSignedAdder(fs, testRepository(files), exec)
+		network, err := utils.NewNetworkProfile(fs, &utils.NetworkConfig{MirrorRewrites: []utils.MirrorRewrite{
+			{Prefix: "https://repo.example.com/", Mirror: "http://mirror.local/example/"},
+		}})
+		require.NoError(t, err)
+		adder.network = network
+
+		err = adder.Add(&pb.AddApplicationSourceRequest{
+			GpgKeyUri:         "https://repo.example.com/key.asc",
+			ApplicationSource: testApplicationSource(),
+		})
+		require.NoError(t, err)
+
+		content, err := afero.ReadFile(fs, "/etc/apt/sources.list.d/example.sources")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "URIs: http://mirror.local/example/apt\n", "apt uses the mirror")
+	})
+
+	tests := []struct {
+		name          string
+		keyURI        string
+		modify        func(*pb.ApplicationSource)
+		colons        string
+		execErr       error
+		expectedError string
+	}{
+		{"invalid source", "https://repo.example.com/key.asc", func(s *pb.ApplicationSource) { s.Suites = nil }, testKeyColons, nil, "at least one suite is required"},
+		{"no key URI", "", func(*pb.ApplicationSource) {}, testKeyColons, nil, "GPG key URI is required"},
+		{"untrusted repository", "https://repo.example.com/key.asc", func(s *pb.ApplicationSource) { s.Uris = []string{"https://evil.example.com/apt"} }, testKeyColons, nil, "https://evil.example.com/apt is not in the list of trusted repositories"},
+		{"fingerprint mismatch", "https://repo.example.com/key.asc", func(s *pb.ApplicationSource) { s.KeyFingerprint = strings.Repeat("F", 40) }, testKeyColons, nil, "GPG key fingerprint mismatch"},
+		{"additional key", "https://repo.example.com/key.asc", func(*pb.ApplicationSource) {}, testKeyColons + "pub:-:4096:1:FFFFFFFFFFFFFFFF:1700000000:::-:::scSC::::::23::0:\nfpr:::::::::" + strings.Repeat("F", 40) + ":\n", nil, "GPG key fingerprint mismatch"},
+		{"key not found", "https://repo.example.com/missing.asc", func(*pb.ApplicationSource) {}, testKeyColons, nil, "Status code: 404"},
+		{"no Release file", "https://repo.example.com/key.asc", func(s *pb.ApplicationSource) { s.Suites = []string{"jammy"} }, testKeyColons, nil, "repository validation failed"},
+		{"unknown component", "https://repo.example.com/key.asc", func(s *pb.ApplicationSource) { s.Components = []string{"main", "contrib"} }, testKeyColons, nil, "component 'contrib' is not in the Release file"},
+		{"unknown architecture", "https://repo.example.com/key.asc", func(s *pb.ApplicationSource) { s.Architectures = []string{"riscv64"} }, testKeyColons, nil, "architecture 'riscv64' is not in the Release file"},
+		{"gpg failure", "https://repo.example.com/key.asc", func(*pb.ApplicationSource) {}, testKeyColons, errors.New("exit status 1"), "error reading GPG key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			exec := &mockExecutor{stdout: []string{tt.colons}, errors: []error{tt.execErr}}
+			adder := testSignedAdder(fs, testRepository(files), exec)
+			src := testApplicationSource()
+			tt.modify(src)
+
+			err := adder.Add(&pb.AddApplicationSourceRequest{GpgKeyUri: tt.keyURI, ApplicationSource: src})
+			assert.ErrorContains(t, err, tt.expectedError)
+			exists, _ := afero.Exists(fs, "/etc/apt/sources.list.d/example.sources")
+			assert.False(t, exists, "the source must not be written")
+			assertKeyrings(t, fs)
+		})
+	}
+}
+
+func TestPrimaryKeyFingerprints(t *testing.T) {
+	assert.Equal(t, []string{testFingerprint}, primaryKeyFingerprints(testKeyColons))
+	assert.Empty(t, primaryKeyFingerprints(""))
+}
+
+func TestReadReleaseFile(t *testing.T) {
+	fs := afero.NewOsFs()
+	dir := t.TempDir()
+	require.NoError(t, afero.WriteFile(fs, dir+"/InRelease", []byte(testInRelease), 0644))
+	release, err := readReleaseFile(dir + "/InRelease")
+	require.NoError(t, err)
+	assert.Equal(t, testRelease, release)
+
+	components, ok := releaseField(release, "Components")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"main", "extra"}, components)
+	_, ok = releaseField(release, "Label")
+	assert.False(t, ok)
+}
+
+func TestCheckReleaseFields(t *testing.T) {
+	src := &pb.ApplicationSource{Components: []string{"main"}, Architectures: []string{"amd64"}}
+	assert.NoError(t, checkReleaseFields("Components: updates/main\n", src), "Debian security components have a prefix")
+	assert.NoError(t, checkReleaseFields("Origin: Example\n", src), "missing fields are not checked")
+	assert.Error(t, checkReleaseFields("Architectures: arm64\n", src))
+}
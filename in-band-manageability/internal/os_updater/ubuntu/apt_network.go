@@ -0,0 +1,88 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package ubuntu updates the Ubuntu OS.
+package ubuntu
+
+import (
+	"log"
+	"os"
+	"slices"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+)
+
+const (
+	// aptNetworkConfigPath is the apt configuration written from the network profile.  It holds
+	// the proxy credentials, so it is only readable by root and passed to apt-get with -c rather
+	// than placed in /etc/apt/apt.conf.d.
+	aptNetworkConfigPath = utils.IntelManageabilityCachePathPrefix + "/apt-network.conf"
+	// aptNetworkCAPath holds the system CAs and the CA bundles of the network profile, because
+	// Acquire::https::CAInfo replaces the system CAs instead of adding to them.
+	aptNetworkCAPath   = utils.IntelManageabilityCachePathPrefix + "/apt-network-ca.crt"
+	systemCABundlePath = "/etc/ssl/certs/ca-certificates.crt"
+)
+
+// networkExecutor runs apt-get with the apt configuration of the network profile.
+type networkExecutor struct {
+	common.Executor
+	configPath string
+}
+
+// NewNetworkExecutor returns an executor that applies the network profile of the configuration
+// to apt-get.  Other commands are run unchanged.  If the profile needs no apt configuration, or
+// it can not be written, the executor is returned as is.
+func NewNetworkExecutor(executor common.Executor, fs afero.Fs) common.Executor {
+	return newNetworkExecutor(executor, fs, utils.LoadNetworkProfile(fs))
+}
+
+func newNetworkExecutor(executor common.Executor, fs afero.Fs, profile *utils.NetworkProfile) common.Executor {
+	bundles := profile.CABundles()
+	if profile.AptConfig("") == "" && len(bundles) == 0 {
+		// Do not leave the credentials of a previous profile behind.
+		if err := fs.Remove(aptNetworkConfigPath); err != nil && !os.IsNotExist(err) {
+			log.Printf("[Warning] Error removing %s: %v", aptNetworkConfigPath, err)
+		}
+		return executor
+	}
+	if err := fs.MkdirAll(utils.IntelManageabilityCachePathPrefix, 0755); err != nil {
+		log.Printf("[Warning] Error creating %s, apt connects directly: %v", utils.IntelManageabilityCachePathPrefix, err)
+		return executor
+	}
+
+	caInfoPath := ""
+	if len(bundles) > 0 {
+		systemCAs, err := afero.ReadFile(fs, systemCABundlePath)
+		if err != nil {
+			log.Printf("[Warning] Error reading system CAs, apt only trusts the configured CA bundles: %v", err)
+		}
+		if err := utils.WriteFile(fs, aptNetworkCAPath, slices.Concat(append([][]byte{systemCAs}, bundles...)...), 0644); err != nil {
+			log.Printf("[Warning] Error writing apt CA bundle: %v", err)
+		} else {
+			caInfoPath = aptNetworkCAPath
+		}
+	}
+
+	if err := utils.WriteFile(fs, aptNetworkConfigPath, []byte(profile.AptConfig(caInfoPath)), 0600); err != nil {
+		log.Printf("[Warning] Error writing apt network configuration, apt connects directly: %v", err)
+		return executor
+	}
+	// WriteFile keeps the mode of an existing file.
+	if err := fs.Chmod(aptNetworkConfigPath, 0600); err != nil {
+		log.Printf("[Warning] Error restricting apt network configuration, apt connects directly: %v", err)
+		return executor
+	}
+	return &networkExecutor{Executor: executor, configPath: aptNetworkConfigPath}
+}
+
+// Execute runs a command, passing the apt network configuration to apt-get.
+func (e *networkExecutor) Execute(args []string) ([]byte, []byte, error) {
+	if len(args) > 0 && args[0] == common.AptGetCmd {
+		args = append([]string{args[0], "-c", e.configPath}, args[1:]...)
+	}
+	return e.Executor.Execute(args)
+}
@@ -8,12 +8,45 @@ package ubuntu
 
 import (
 	"log"
+	"net"
+	"time"
 
 	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+)
+
+const endpointDialTimeout = 10 * time.Second
+
+// loadNetworkProfile and dialEndpoint are replaced in tests.
+var (
+	loadNetworkProfile = func() *utils.NetworkProfile {
+		return utils.LoadNetworkProfile(afero.NewOsFs())
+	}
+	dialEndpoint = func(endpoint string) error {
+		conn, err := net.DialTimeout("tcp", endpoint, endpointDialTimeout)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
 )
 
 // CheckNetworkConnection verifies if there is an active network connection.
+// With a network profile that uses a proxy or local mirrors, the connection is active if they
+// can all be reached; air-gapped devices may have no default gateway at all.
 func CheckNetworkConnection(cmdExecutor common.Executor) bool {
+	if endpoints := loadNetworkProfile().Endpoints(); len(endpoints) > 0 {
+		for _, endpoint := range endpoints {
+			if err := dialEndpoint(endpoint); err != nil {
+				log.Printf("Proxy or mirror %s is not reachable: %v", endpoint, err)
+				return false
+			}
+		}
+		log.Printf("Proxies and mirrors %v are reachable. Network connection is active.", endpoints)
+		return true
+	}
+
 	cmd := []string{common.IPCmd, "route", "show", "default"}
 
 	stdout, _, err := cmdExecutor.Execute(cmd)
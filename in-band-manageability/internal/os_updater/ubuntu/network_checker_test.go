@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCheckNetworkConnection_Success(t *testing.T) {
@@ -51,3 +54,33 @@ func TestCheckNetworkConnection_CommandError(t *testing.T) {
 	assert.False(t, result, "Expected network connection to be inactive due to command error")
 	mockExecutor.AssertCalled(t, "Execute", []string{"ip", "route", "show", "default"})
 }
+
+func TestCheckNetworkConnection_NetworkProfile(t *testing.T) {
+	profile, err := utils.NewNetworkProfile(afero.NewMemMapFs(), &utils.NetworkConfig{
+		HTTPSProxy:     "http://proxy.example.com:3128",
+		MirrorRewrites: []utils.MirrorRewrite{{Prefix: "http://archive.ubuntu.com/", Mirror: "http://mirror.local/"}},
+	})
+	require.NoError(t, err)
+	originalLoad, originalDial := loadNetworkProfile, dialEndpoint
+	defer func() { loadNetworkProfile, dialEndpoint = originalLoad, originalDial }()
+	loadNetworkProfile = func() *utils.NetworkProfile { return profile }
+
+	var dialed []string
+	unreachable := ""
+	dialEndpoint = func(endpoint string) error {
+		dialed = append(dialed, endpoint)
+		if endpoint == unreachable {
+			return fmt.Errorf("connection refused")
+		}
+		return nil
+	}
+
+	// The default route is not checked: an air-gapped device may not have one.
+	mockExecutor := new(MockExecutor)
+	assert.True(t, CheckNetworkConnection(mockExecutor))
+	assert.Equal(t, []string{"proxy.example.com:3128", "mirror.local:80"}, dialed)
+	mockExecutor.AssertNotCalled(t, "Execute", []string{"ip", "route", "show", "default"})
+
+	unreachable = "mirror.local:80"
+	assert.False(t, CheckNetworkConnection(mockExecutor), "every proxy and mirror must be reachable")
+}
@@ -22,6 +22,7 @@ type Manager interface {
 // Updater is a struct that implements the Manager interface.
 type Updater struct {
 	fs           afero.Fs
+	network      *utils.NetworkProfile
 	openFileFunc func(afero.Fs, string, int, os.FileMode) (afero.File, error)
 	copyFileFunc func(afero.Fs, string, string) error
 }
@@ -30,6 +31,7 @@ type Updater struct {
 func NewUpdater() *Updater {
 	return &Updater{
 		fs:           afero.NewOsFs(),
+		network:      utils.LoadNetworkProfile(afero.NewOsFs()),
 		openFileFunc: utils.OpenFile,
 		copyFileFunc: utils.CopyFile,
 	}
@@ -50,8 +52,9 @@ func (u *Updater) Update(newContents []string, aptSourcesListPath string) error
 	}
 	defer file.Close()
 
+	// URLs are rewritten to the local mirrors of the network profile
 	for _, line := range newContents {
-		if _, err := file.WriteString(line + "\n"); err != nil {
+		if _, err := file.WriteString(u.network.RewriteSourceLine(line) + "\n"); err != nil {
 			return fmt.Errorf("failed to write to file: %w", err)
 		}
 	}
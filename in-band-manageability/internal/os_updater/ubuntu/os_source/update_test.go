@@ -8,8 +8,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const mockAptSourcesList = "/tmp/apt/sources.list"
@@ -51,6 +53,34 @@ func TestUpdate_Success(t *testing.T) {
 	assert.Equal(t, expectedContent, string(content))
 }
 
+func TestUpdate_MirrorRewrite(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	network, err := utils.NewNetworkProfile(fs, &utils.NetworkConfig{MirrorRewrites: []utils.MirrorRewrite{
+		{Prefix: "http://archive.ubuntu.com/", Mirror: "http://mirror.local/"},
+	}})
+	require.NoError(t, err)
+
+	updater := &Updater{
+		fs:      fs,
+		network: network,
+		openFileFunc: func(fs afero.Fs, name string, flag int, perm os.FileMode) (afero.File, error) {
+			return fs.OpenFile(name, flag, perm)
+		},
+		copyFileFunc: func(afero.Fs, string, string) error { return nil },
+	}
+
+	err = updater.Update([]string{
+		"deb http://archive.ubuntu.com/ubuntu focal main restricted",
+		"deb http://security.ubuntu.com/ubuntu focal-security main restricted",
+	}, mockAptSourcesList)
+	require.NoError(t, err)
+
+	content, err := afero.ReadFile(fs, mockAptSourcesList)
+	require.NoError(t, err)
+	assert.Equal(t, "deb http://mirror.local/ubuntu focal main restricted\n"+
+		"deb http://security.ubuntu.com/ubuntu focal-security main restricted\n", string(content))
+}
+
 func TestUpdate_BackupFailure(t *testing.T) {
 	fs := afero.NewMemMapFs() // Use an in-memory filesystem for testing
 
@@ -7,6 +7,7 @@
 package ubuntu
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"os/exec"
@@ -23,92 +24,188 @@ type Verifier struct {
 	CommandExecutor            common.Executor
 	fs                         afero.Fs
 	CheckNetworkConnectionFunc func(common.Executor) bool
-	UndoChangeFunc             func(common.Executor, int) error
-	DeleteSnapshotFunc         func(common.Executor, int) error
+	UndoChangeFunc             func(common.Executor, afero.Fs, utils.INBDState) error
+	DeleteSnapshotFunc         func(common.Executor, afero.Fs, utils.INBDState) error
 	rebootSystemFunc           func(common.Executor) error
 	RemoveFileFunc             func(afero.Fs, string) error
+	WriteStateFileFunc         func(afero.Fs, string, string) error
+	osType                     string
 }
 
 // NewVerifier creates a new instance of Verifier with a command executor and file system.
 func NewVerifier() *Verifier {
+	return NewVerifierWithOSType("ubuntu")
+}
+
+// NewVerifierWithOSType creates a new instance of Verifier that writes the given OS type
+// to the granular log.  It is used to reuse the apt-based verification for Debian.
+func NewVerifierWithOSType(osType string) *Verifier {
 	return &Verifier{
 		CommandExecutor:            common.NewExecutor(exec.Command, common.ExecuteAndReadOutput),
 		fs:                         afero.NewOsFs(),
 		CheckNetworkConnectionFunc: CheckNetworkConnection,
-		UndoChangeFunc:             UndoChange,
-		DeleteSnapshotFunc:         DeleteSnapshot,
+		UndoChangeFunc:             UndoStateChange,
+		DeleteSnapshotFunc:         DeleteStateSnapshot,
 		rebootSystemFunc:           utils.RebootSystem,
 		RemoveFileFunc:             utils.RemoveFile,
+		WriteStateFileFunc:         writeStateFileWithTruncate,
+		osType:                     osType,
 	}
 }
 
-// VerifyUpdateAfterReboot verifies the update after a reboot.
-// It checks if the state file exists and compares the previous and current versions.
-// If the versions are different, it commits the update; otherwise, it reverts to the previous image.
+// RollbackStateRollingBack is recorded in the state file while the system reboots into the restored snapshot.
+const RollbackStateRollingBack = "rolling_back"
+
+// MaxVerificationAttempts is the number of boots on which post-reboot verification runs for an update
+// before it gives up, so that a failing verification or rollback can not cause a reboot loop.
+const MaxVerificationAttempts = 3
+
+// verificationError is a failed post-reboot check with its granular log failure reason.
+type verificationError struct {
+	reason string
+	err    error
+}
+
+func (e *verificationError) Error() string {
+	return e.err.Error()
+}
+
+// VerifyUpdateAfterReboot runs the post-reboot state machine driven by the state file.
+//
+//   - The update is verified.  On success, SUCCESS is logged and the state file is removed.
+//   - If verification fails and a snapshot was taken, the state is set to rolling back,
+//     the snapshot is restored and the system reboots.
+//   - On the boot after the rollback, the snapshot is deleted, ROLLED_BACK is logged and
+//     the state file is removed.
+//
+// Every boot increments the verification attempts in the state file.  After
+// MaxVerificationAttempts the update is marked as failed without another reboot.
 func (v *Verifier) VerifyUpdateAfterReboot(state utils.INBDState) error {
+	state.VerificationAttempts++
+	if state.VerificationAttempts > MaxVerificationAttempts {
+		errMsg := fmt.Sprintf("post-reboot verification did not complete after %d boots; giving up to avoid a reboot loop",
+			MaxVerificationAttempts)
+		log.Println(errMsg)
+		emt.WriteUpdateStatus(v.fs, emt.FAIL, "", errMsg)
+		emt.WriteGranularLogWithOSType(v.fs, emt.FAIL, emt.FAILURE_REASON_INBM, v.logOSType())
+		v.removeStateFile()
+		return fmt.Errorf("%s", errMsg)
+	}
+	// Save the attempt before doing anything that can hang or reboot.
+	if err := v.saveState(state); err != nil {
+		log.Printf("[Warning] Error saving verification attempt: %v", err)
+	}
+
+	if state.RollbackState == RollbackStateRollingBack {
+		return v.completeRollback(state)
+	}
+
+	if verr := v.verify(state); verr != nil {
+		log.Printf("Post-reboot verification failed: %v", verr)
+		if !HasSnapshot(state) {
+			emt.WriteUpdateStatus(v.fs, emt.FAIL, "", verr.Error())
+			emt.WriteGranularLogWithOSType(v.fs, emt.FAIL, verr.reason, v.logOSType())
+			v.removeStateFile()
+			return verr
+		}
+		return v.rollback(state, verr)
+	}
+
+	log.Println("Post-reboot verification passed.")
+	emt.WriteUpdateStatus(v.fs, emt.SUCCESS, "", "")
+	emt.WriteGranularLogWithOSType(v.fs, emt.SUCCESS, "", v.logOSType())
+	v.removeStateFile()
+	return nil
+}
+
+// verify checks the installed packages and the network connection after the update.
+func (v *Verifier) verify(state utils.INBDState) *verificationError {
 	// Check for package installation verification first
 	if state.PackageList != "" {
 		log.Printf("Found PackageList in state file: %s", state.PackageList)
 		if err := v.verifyPackageInstallation(state); err != nil {
-			log.Printf("Package verification failed: %v", err)
-			return err
+			return &verificationError{reason: emt.FAILURE_REASON_UPDATE_TOOL, err: err}
 		}
-		// If only packages (no snapshot), we're done - remove state file
-		if state.SnapshotNumber == 0 {
+		// If only packages (no snapshot), there is no OS update to verify.
+		if !HasSnapshot(state) {
 			log.Println("Package-only installation verified successfully")
-			// Remove state file after successful verification
-			if err := v.RemoveFileFunc(v.fs, utils.StateFilePath); err != nil {
-				log.Printf("[Warning] Error removing state file: %v", err)
-			}
 			return nil
 		}
-		// Continue to OS verification if snapshot exists
 		log.Println("Package verification complete, proceeding with OS verification")
 	}
 
-	snapshotNumber := state.SnapshotNumber
-	log.Printf("Snapshot number: %v", snapshotNumber)
+	log.Printf("Snapshot backend: %v, snapshot number: %v, snapshot ID: %v",
+		state.SnapshotBackend, state.SnapshotNumber, state.SnapshotID)
 
-	// Network check
 	if !v.CheckNetworkConnectionFunc(v.CommandExecutor) {
-		log.Println("No network connection detected.  Reverting to previous snapshot.")
-		if err := v.UndoChangeFunc(v.CommandExecutor, snapshotNumber); err != nil {
-			log.Printf("Failed to revert to previous snapshot: %v", err)
-			return err
+		return &verificationError{
+			reason: emt.FAILURE_REASON_NETWORK_CHECK,
+			err:    fmt.Errorf("no network connection detected after the update"),
 		}
-		if err := v.DeleteSnapshotFunc(v.CommandExecutor, snapshotNumber); err != nil {
-			log.Printf("Failed to delete snapshot: %v", err)
-			return err
-		}
-		if err := v.rebootSystemFunc(v.CommandExecutor); err != nil {
-			log.Printf("Failed to reboot system: %v", err)
-			return err
-		}
-		log.Println("Reverted to previous snapshot and deleted it.")
-		return nil
+	}
+	log.Println("Network connection detected.")
+	return nil
+}
+
+// rollback restores the pre-update snapshot and reboots into it.
+func (v *Verifier) rollback(state utils.INBDState, verr *verificationError) error {
+	log.Println("Reverting to previous snapshot.")
 
+	// Record the rollback first so that the next boot completes it instead of verifying again.
+	state.RollbackState = RollbackStateRollingBack
+	state.RollbackReason = verr.reason
+	if err := v.saveState(state); err != nil {
+		return fmt.Errorf("failed to save rollback state: %w", err)
 	}
-	log.Println("Network connection detected.  Proceeding with update verification.")
 
-	// Write SUCCESS status to update log files
-	emt.WriteUpdateStatus(v.fs, emt.SUCCESS, "", "")
-	emt.WriteGranularLogWithOSType(v.fs, emt.SUCCESS, "", "ubuntu")
+	if err := v.UndoChangeFunc(v.CommandExecutor, v.fs, state); err != nil {
+		log.Printf("Failed to revert to previous snapshot: %v", err)
+		errMsg := fmt.Sprintf("%v; rollback failed: %v", verr, err)
+		emt.WriteUpdateStatus(v.fs, emt.FAIL, "", errMsg)
+		emt.WriteGranularLogWithOSType(v.fs, emt.FAIL, verr.reason, v.logOSType())
+		v.removeStateFile()
+		return fmt.Errorf("%s", errMsg)
+	}
 
-	// Remove state file after checks
-	err := v.RemoveFileFunc(v.fs, utils.StateFilePath)
-	if err != nil {
-		log.Printf("[Warning] Error removing state file: %v", err)
+	if err := v.rebootSystemFunc(v.CommandExecutor); err != nil {
+		log.Printf("Failed to reboot system: %v", err)
+		return err
 	}
+	return nil
+}
+
+// completeRollback runs on the boot after a rollback and records the outcome.
+func (v *Verifier) completeRollback(state utils.INBDState) error {
+	log.Println("System restored to the pre-update snapshot.")
 
+	if err := v.DeleteSnapshotFunc(v.CommandExecutor, v.fs, state); err != nil {
+		log.Printf("[Warning] Failed to delete snapshot: %v", err)
+	}
+
+	emt.WriteUpdateStatus(v.fs, emt.ROLLED_BACK, "", state.RollbackReason)
+	emt.WriteGranularLogWithOSType(v.fs, emt.ROLLED_BACK, state.RollbackReason, v.logOSType())
+	v.removeStateFile()
 	return nil
 }
 
-// verifyPackageInstallation verifies that packages in PackageList are installed
-func (v *Verifier) verifyPackageInstallation(state utils.INBDState) error {
-	if state.PackageList == "" {
-		return nil
+// saveState writes the state file.
+func (v *Verifier) saveState(state utils.INBDState) error {
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to serialize state to JSON: %w", err)
 	}
+	return v.WriteStateFileFunc(v.fs, utils.StateFilePath, string(stateJSON))
+}
 
+// removeStateFile removes the state file once the update reached a final status.
+func (v *Verifier) removeStateFile() {
+	if err := v.RemoveFileFunc(v.fs, utils.StateFilePath); err != nil {
+		log.Printf("[Warning] Error removing state file: %v", err)
+	}
+}
+
+// verifyPackageInstallation verifies that packages in PackageList are installed
+func (v *Verifier) verifyPackageInstallation(state utils.INBDState) error {
 	log.Printf("Verifying package installation: %s", state.PackageList)
 	packages := strings.Split(state.PackageList, ",")
 
@@ -121,31 +218,26 @@ func (v *Verifier) verifyPackageInstallation(state utils.INBDState) error {
 		// Check if package is installed using dpkg
 		cmd := []string{common.DpkgCmd, "-l", pkg}
 		stdout, stderr, err := v.CommandExecutor.Execute(cmd)
-
 		if err != nil || len(stderr) > 0 {
-			errMsg := fmt.Sprintf("Package %s verification failed: %v, stderr: %s", pkg, err, string(stderr))
-			log.Println(errMsg)
-			emt.WriteUpdateStatus(v.fs, emt.FAIL, "", errMsg)
-			emt.WriteGranularLogWithOSType(v.fs, emt.FAIL, emt.FAILURE_REASON_UPDATE_TOOL, "ubuntu")
-			return fmt.Errorf("%s", errMsg)
+			return fmt.Errorf("package %s verification failed: %v, stderr: %s", pkg, err, string(stderr))
 		}
 
 		// Check if output contains "ii" status (installed)
 		if !strings.Contains(string(stdout), "ii  "+pkg) {
-			errMsg := fmt.Sprintf("Package %s not found in installed packages", pkg)
-			log.Println(errMsg)
-			emt.WriteUpdateStatus(v.fs, emt.FAIL, "", errMsg)
-			emt.WriteGranularLogWithOSType(v.fs, emt.FAIL, emt.FAILURE_REASON_UPDATE_TOOL, "ubuntu")
-			return fmt.Errorf("%s", errMsg)
+			return fmt.Errorf("package %s not found in installed packages", pkg)
 		}
 
 		log.Printf("Package %s verified successfully", pkg)
 	}
 
-	// All packages verified - write SUCCESS
 	log.Println("All packages verified successfully")
-	emt.WriteUpdateStatus(v.fs, emt.SUCCESS, "", "")
-	emt.WriteGranularLogWithOSType(v.fs, emt.SUCCESS, "", "ubuntu")
-
 	return nil
 }
+
+// logOSType returns the OS type used in the granular log.
+func (v *Verifier) logOSType() string {
+	if v.osType == "" {
+		return "ubuntu"
+	}
+	return v.osType
+}
@@ -11,69 +11,159 @@ import (
 	utils "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// verifierCalls records the side effects of the post-reboot state machine.
+type verifierCalls struct {
+	undo, deleteSnapshot, reboot, removeState int
+}
+
 func newTestVerifier(
 	undoErr, deleteSnapErr, rebootErr, removeFileErr error,
 	networkOK bool,
-) *Verifier {
+) (*Verifier, *verifierCalls) {
+	calls := &verifierCalls{}
+	fs := afero.NewMemMapFs()
 	return &Verifier{
 		CommandExecutor: &mockExecutor{},
-		fs:              afero.NewMemMapFs(),
+		fs:              fs,
 		CheckNetworkConnectionFunc: func(_ common.Executor) bool {
 			return networkOK
 		},
-		UndoChangeFunc: func(_ common.Executor, _ int) error {
+		UndoChangeFunc: func(_ common.Executor, _ afero.Fs, _ utils.INBDState) error {
+			calls.undo++
 			return undoErr
 		},
-		DeleteSnapshotFunc: func(_ common.Executor, _ int) error {
+		DeleteSnapshotFunc: func(_ common.Executor, _ afero.Fs, _ utils.INBDState) error {
+			calls.deleteSnapshot++
 			return deleteSnapErr
 		},
 		rebootSystemFunc: func(_ common.Executor) error {
+			calls.reboot++
 			return rebootErr
 		},
-		RemoveFileFunc: func(_ afero.Fs, _ string) error {
-			return removeFileErr
+		RemoveFileFunc: func(fs afero.Fs, path string) error {
+			calls.removeState++
+			if removeFileErr != nil {
+				return removeFileErr
+			}
+			return fs.Remove(path)
 		},
-	}
+		WriteStateFileFunc: func(fs afero.Fs, path string, content string) error {
+			return afero.WriteFile(fs, path, []byte(content), 0644)
+		},
+	}, calls
 }
 
 func TestVerifier_VerifyUpdateAfterReboot(t *testing.T) {
 	t.Run("Network OK", func(t *testing.T) {
-		v := newTestVerifier(nil, nil, nil, nil, true)
+		v, calls := newTestVerifier(nil, nil, nil, nil, true)
 		state := utils.INBDState{SnapshotNumber: 1}
 		err := v.VerifyUpdateAfterReboot(state)
 		assert.NoError(t, err)
+		assert.Equal(t, 0, calls.undo)
+		assert.Equal(t, 1, calls.removeState)
 	})
 
-	t.Run("No Network, All Success", func(t *testing.T) {
-		v := newTestVerifier(nil, nil, nil, nil, false)
+	t.Run("No Network, rolls back and reboots", func(t *testing.T) {
+		v, calls := newTestVerifier(nil, nil, nil, nil, false)
 		state := utils.INBDState{SnapshotNumber: 2}
 		err := v.VerifyUpdateAfterReboot(state)
 		assert.NoError(t, err)
+		assert.Equal(t, 1, calls.undo)
+		assert.Equal(t, 1, calls.reboot)
+		// The snapshot is kept until the rollback completes on the next boot.
+		assert.Equal(t, 0, calls.deleteSnapshot)
+		assert.Equal(t, 0, calls.removeState)
+
+		saved, err := utils.ReadStateFile(v.fs, utils.StateFilePath)
+		require.NoError(t, err)
+		assert.Equal(t, RollbackStateRollingBack, saved.RollbackState)
+		assert.Equal(t, "networkcheck", saved.RollbackReason)
+		assert.Equal(t, 1, saved.VerificationAttempts)
 	})
 
 	t.Run("No Network, Undo Fails", func(t *testing.T) {
-		v := newTestVerifier(errors.New("undo failed"), nil, nil, nil, false)
+		v, calls := newTestVerifier(errors.New("undo failed"), nil, nil, nil, false)
 		state := utils.INBDState{SnapshotNumber: 3}
 		err := v.VerifyUpdateAfterReboot(state)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "undo failed")
+		assert.Equal(t, 0, calls.reboot)
+		assert.Equal(t, 1, calls.removeState)
 	})
 
-	t.Run("No Network, Delete Snapshot Fails", func(t *testing.T) {
-		v := newTestVerifier(nil, errors.New("delete snapshot failed"), nil, nil, false)
-		state := utils.INBDState{SnapshotNumber: 4}
+	t.Run("No Network, Reboot Fails", func(t *testing.T) {
+		v, _ := newTestVerifier(nil, nil, errors.New("reboot failed"), nil, false)
+		state := utils.INBDState{SnapshotNumber: 5}
 		err := v.VerifyUpdateAfterReboot(state)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "delete snapshot failed")
+		assert.Contains(t, err.Error(), "reboot failed")
 	})
 
-	t.Run("No Network, Reboot Fails", func(t *testing.T) {
-		v := newTestVerifier(nil, nil, errors.New("reboot failed"), nil, false)
-		state := utils.INBDState{SnapshotNumber: 5}
+	t.Run("No Network without snapshot fails without reboot", func(t *testing.T) {
+		v, calls := newTestVerifier(nil, nil, nil, nil, false)
+		err := v.VerifyUpdateAfterReboot(utils.INBDState{RestartReason: "sota"})
+		assert.Error(t, err)
+		assert.Equal(t, 0, calls.undo)
+		assert.Equal(t, 0, calls.reboot)
+		assert.Equal(t, 1, calls.removeState)
+	})
+
+	t.Run("Rollback completes on next boot", func(t *testing.T) {
+		v, calls := newTestVerifier(nil, nil, nil, nil, true)
+		state := utils.INBDState{
+			SnapshotNumber:       2,
+			RollbackState:        RollbackStateRollingBack,
+			RollbackReason:       "networkcheck",
+			VerificationAttempts: 1,
+		}
+		err := v.VerifyUpdateAfterReboot(state)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls.deleteSnapshot)
+		assert.Equal(t, 0, calls.reboot)
+		assert.Equal(t, 1, calls.removeState)
+	})
+
+	t.Run("Rollback completes when snapshot deletion fails", func(t *testing.T) {
+		v, calls := newTestVerifier(nil, errors.New("delete snapshot failed"), nil, nil, true)
+		state := utils.INBDState{SnapshotNumber: 4, RollbackState: RollbackStateRollingBack}
+		err := v.VerifyUpdateAfterReboot(state)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls.removeState)
+	})
+
+	t.Run("Gives up after max attempts", func(t *testing.T) {
+		v, calls := newTestVerifier(nil, nil, nil, nil, false)
+		state := utils.INBDState{SnapshotNumber: 6, VerificationAttempts: MaxVerificationAttempts}
 		err := v.VerifyUpdateAfterReboot(state)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "reboot failed")
+		assert.Contains(t, err.Error(), "reboot loop")
+		assert.Equal(t, 0, calls.undo)
+		assert.Equal(t, 0, calls.reboot)
+		assert.Equal(t, 1, calls.removeState)
+	})
+
+	t.Run("Package verification failure rolls back", func(t *testing.T) {
+		v, calls := newTestVerifier(nil, nil, nil, nil, true)
+		v.CommandExecutor = &mockExecutor{stdout: []string{"un  vim"}, errors: []error{nil}}
+		state := utils.INBDState{SnapshotNumber: 7, PackageList: "vim"}
+		err := v.VerifyUpdateAfterReboot(state)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls.undo)
+		assert.Equal(t, 1, calls.reboot)
+
+		saved, err := utils.ReadStateFile(v.fs, utils.StateFilePath)
+		require.NoError(t, err)
+		assert.Equal(t, "updatetool", saved.RollbackReason)
+	})
+
+	t.Run("Package-only installation verified", func(t *testing.T) {
+		v, calls := newTestVerifier(nil, nil, nil, nil, false)
+		v.CommandExecutor = &mockExecutor{stdout: []string{"ii  vim  2:8.2"}, errors: []error{nil}}
+		err := v.VerifyUpdateAfterReboot(utils.INBDState{PackageList: "vim"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls.removeState)
 	})
 }
@@ -29,19 +29,24 @@ type Snapshotter struct {
 	EnsureSnapperConfigFunc func(cmdExecutor common.Executor, configName string) error
 	ClearStateFileFunc      func(cmdExecutor common.Executor, stateFilePath string) error
 	WriteToStateFileFunc    func(fs afero.Fs, stateFilePath string, content string) error
-	Fs                      afero.Fs
+	// SelectFallbackBackendFunc selects the snapshot backend used when the root file system is not BTRFS.
+	SelectFallbackBackendFunc func(cmdExecutor common.Executor, fs afero.Fs) SnapshotBackend
+	Fs                        afero.Fs
+	// PackageList is the list of packages the update installs; the whole system is upgraded when empty.
+	PackageList []string
 }
 
 // NewSnapshotter creates a new Ubuntu Snapshotter.
 func NewSnapshotter(commandExecutor common.Executor, fs afero.Fs) *Snapshotter {
 	return &Snapshotter{
-		CommandExecutor:         commandExecutor,
-		IsBTRFSFileSystemFunc:   utils.IsBTRFSFileSystem,
-		IsSnapperInstalledFunc:  IsSnapperInstalled,
-		EnsureSnapperConfigFunc: EnsureSnapperConfig,
-		ClearStateFileFunc:      utils.ClearStateFile,
-		WriteToStateFileFunc:    writeStateFileWithTruncate,
-		Fs:                      fs,
+		CommandExecutor:           commandExecutor,
+		IsBTRFSFileSystemFunc:     utils.IsBTRFSFileSystem,
+		IsSnapperInstalledFunc:    IsSnapperInstalled,
+		EnsureSnapperConfigFunc:   EnsureSnapperConfig,
+		ClearStateFileFunc:        utils.ClearStateFile,
+		WriteToStateFileFunc:      writeStateFileWithTruncate,
+		SelectFallbackBackendFunc: SelectFallbackBackend,
+		Fs:                        fs,
 	}
 }
 
@@ -52,106 +57,59 @@ func (u *Snapshotter) Snapshot() error {
 	if err != nil {
 		return fmt.Errorf("failed to check if file system is BTRFS: %w", err)
 	}
+
+	var backend SnapshotBackend
 	if isBtrfs {
 		log.Println("OS is Ubuntu and FileSystem is BTRFS.  Take a snapshot.")
-
-		// Check if snapper is installed
-		isInstalled, err := u.IsSnapperInstalledFunc(u.CommandExecutor)
-		if err != nil {
-			return fmt.Errorf("snapper installation check failed: %w", err)
-		}
-		if !isInstalled {
-			return fmt.Errorf("snapper is not installed")
-		}
-
-		err = u.EnsureSnapperConfigFunc(u.CommandExecutor, "rootConfig")
-		if err != nil {
-			return fmt.Errorf("failed to ensure snapper config exists: %w", err)
-		}
-
-		// Create a snapshot using snapper
-		snapshotCmd := []string{
-			common.SnapperCmd, "-c", "rootConfig", "create", "-p", "--description", "sota_update",
-		}
-		stdout, stderr, err := u.CommandExecutor.Execute(snapshotCmd)
-		if err != nil {
-			return fmt.Errorf("error executing command: %s, stderr: %s, err: %w", stdout, stderr, err)
-		}
-
-		// Log a warning if stderr is non-empty but the command succeeded
-		if string(stderr) != "" {
-			log.Printf("Warning: snapshot command produced stderr: %s", stderr)
-		}
-		log.Println("Snapshot created successfully. SnapshotID: ", string(stdout))
-
-		// Ensure stdout is not blank and is an integer
-		snapshotID := strings.TrimSpace(string(stdout))
-		if snapshotID == "" {
-			return fmt.Errorf("snapshot ID is blank")
+		backend = &snapperBackend{
+			commandExecutor:         u.CommandExecutor,
+			isSnapperInstalledFunc:  u.IsSnapperInstalledFunc,
+			ensureSnapperConfigFunc: u.EnsureSnapperConfigFunc,
 		}
-		snapshotNumber, err := strconv.Atoi(snapshotID)
-		if err != nil {
-			return fmt.Errorf("snapshot ID is not a valid integer: %s", snapshotID)
-		}
-
-		// Check if there's an existing state file with PackageList
-		var packageList string
-		if existingState, err := utils.ReadStateFile(u.Fs, utils.StateFilePath); err == nil {
-			if existingState.PackageList != "" {
-				log.Printf("Preserving existing PackageList: %s", existingState.PackageList)
-				packageList = existingState.PackageList
-			}
+	} else {
+		backend = u.SelectFallbackBackendFunc(u.CommandExecutor, u.Fs)
+		if packageList, ok := backend.(*packageListBackend); ok {
+			packageList.packages = u.PackageList
 		}
+		log.Printf("FileSystem is not BTRFS.  Take a snapshot using the %s backend.", backend.Name())
+	}
 
-		state := utils.INBDState{
-			RestartReason:  "sota",
-			SnapshotNumber: snapshotNumber,
-			PackageList:    packageList,
-		}
+	snapshotID, err := backend.Create()
+	if err != nil {
+		return err
+	}
+	log.Printf("Snapshot created successfully. Backend: %s, SnapshotID: %s", backend.Name(), snapshotID)
 
-		stateJSON, err := json.Marshal(state)
-		if err != nil {
-			return fmt.Errorf("failed to serialize state to JSON: %w", err)
-		}
-		log.Printf("State JSON: %s", string(stateJSON))
-		err = u.WriteToStateFileFunc(u.Fs, utils.StateFilePath, string(stateJSON))
-		if err != nil {
-			return fmt.Errorf("failed to write to state file: %w", err)
-		}
-		log.Printf("Snapshot ID: %s", snapshotID)
-	} else {
-		// TODO: Check if ProceedWithRollback flag is set to false.
-		// If it set to false, send error and do not proceed.
-		log.Println("No snapshot taken as the file system is not BTRFS.")
-
-		// Check if there's an existing state file with PackageList
-		var packageList string
-		if existingState, err := utils.ReadStateFile(u.Fs, utils.StateFilePath); err == nil {
-			if existingState.PackageList != "" {
-				log.Printf("Preserving existing PackageList: %s", existingState.PackageList)
-				packageList = existingState.PackageList
-			}
+	// Check if there's an existing state file with PackageList
+	var packageList string
+	if existingState, err := utils.ReadStateFile(u.Fs, utils.StateFilePath); err == nil {
+		if existingState.PackageList != "" {
+			log.Printf("Preserving existing PackageList: %s", existingState.PackageList)
+			packageList = existingState.PackageList
 		}
+	}
 
-		// Still create state file for post-reboot verification even without BTRFS snapshot
-		state := utils.INBDState{
-			RestartReason:  "sota",
-			SnapshotNumber: 0, // No snapshot number for non-BTRFS
-			PackageList:    packageList,
-		}
+	state := utils.INBDState{
+		RestartReason:   "sota",
+		PackageList:     packageList,
+		SnapshotBackend: backend.Name(),
+		SnapshotID:      snapshotID,
+	}
+	if backend.Name() == SnapshotBackendSnapper {
+		// Keep the snapshot number for agents that only understand snapper state files.
+		state.SnapshotNumber, _ = strconv.Atoi(snapshotID)
+	}
 
-		stateJSON, err := json.Marshal(state)
-		if err != nil {
-			return fmt.Errorf("failed to serialize state to JSON: %w", err)
-		}
-		log.Printf("State JSON: %s", string(stateJSON))
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to serialize state to JSON: %w", err)
+	}
+	log.Printf("State JSON: %s", string(stateJSON))
 
-		// WriteToStateFile will create directory and file if they don't exist
-		err = u.WriteToStateFileFunc(u.Fs, utils.StateFilePath, string(stateJSON))
-		if err != nil {
-			return fmt.Errorf("failed to write to state file: %w", err)
-		}
-		log.Println("State file created for post-reboot verification (non-BTRFS filesystem)")
+	// WriteToStateFile will create directory and file if they don't exist
+	err = u.WriteToStateFileFunc(u.Fs, utils.StateFilePath, string(stateJSON))
+	if err != nil {
+		return fmt.Errorf("failed to write to state file: %w", err)
 	}
 	return nil
 }
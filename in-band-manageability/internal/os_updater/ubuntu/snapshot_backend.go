@@ -0,0 +1,455 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package ubuntu updates the Ubuntu OS.
+package ubuntu
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+)
+
+// Snapshot backends recorded in the state file.
+const (
+	SnapshotBackendSnapper     = "snapper"
+	SnapshotBackendLVM         = "lvm"
+	SnapshotBackendPackageList = "package-list"
+)
+
+// PackageSnapshotDir is the directory where the package-list backend saves the dpkg state.
+const PackageSnapshotDir = "/var/intel-manageability/package_snapshots"
+
+// SnapshotBackend creates, restores and deletes the snapshot taken before an update.
+type SnapshotBackend interface {
+	// Name returns the backend name recorded in the state file.
+	Name() string
+	// Create takes a snapshot and returns its ID.
+	Create() (string, error)
+	// Restore reverts the system to the snapshot with the given ID.
+	Restore(snapshotID string) error
+	// Delete removes the snapshot with the given ID.
+	Delete(snapshotID string) error
+}
+
+// SelectFallbackBackend selects the snapshot backend for a root file system that is not BTRFS.
+// A thin-provisioned LVM root uses LVM snapshots; everything else records the dpkg state so
+// the update can be rolled back by downgrading packages.
+func SelectFallbackBackend(cmdExecutor common.Executor, fs afero.Fs) SnapshotBackend {
+	origin, isThin, err := detectLVMThinRoot(cmdExecutor)
+	if err != nil {
+		log.Printf("Root file system is not on LVM: %v", err)
+	}
+	if isThin {
+		return &lvmBackend{commandExecutor: cmdExecutor, origin: origin}
+	}
+	return &packageListBackend{commandExecutor: cmdExecutor, fs: fs}
+}
+
+// HasSnapshot returns true if the state file records a pre-update snapshot.
+func HasSnapshot(state utils.INBDState) bool {
+	return state.SnapshotID != "" || state.SnapshotNumber != 0
+}
+
+// UndoStateChange reverts the changes made after the snapshot recorded in the state file,
+// using the backend that created the snapshot.
+func UndoStateChange(cmdExecutor common.Executor, fs afero.Fs, state utils.INBDState) error {
+	backend, snapshotID := backendForState(cmdExecutor, fs, state)
+	return backend.Restore(snapshotID)
+}
+
+// DeleteStateSnapshot deletes the snapshot recorded in the state file.
+func DeleteStateSnapshot(cmdExecutor common.Executor, fs afero.Fs, state utils.INBDState) error {
+	backend, snapshotID := backendForState(cmdExecutor, fs, state)
+	return backend.Delete(snapshotID)
+}
+
+// backendForState returns the backend and snapshot ID recorded in the state file.
+func backendForState(cmdExecutor common.Executor, fs afero.Fs, state utils.INBDState) (SnapshotBackend, string) {
+	switch state.SnapshotBackend {
+	case SnapshotBackendLVM:
+		return &lvmBackend{commandExecutor: cmdExecutor}, state.SnapshotID
+	case SnapshotBackendPackageList:
+		return &packageListBackend{commandExecutor: cmdExecutor, fs: fs}, state.SnapshotID
+	default:
+		// State files written before backends were recorded only have the snapper snapshot number.
+		snapshotID := state.SnapshotID
+		if snapshotID == "" {
+			snapshotID = strconv.Itoa(state.SnapshotNumber)
+		}
+		return &snapperBackend{commandExecutor: cmdExecutor}, snapshotID
+	}
+}
+
+// snapperBackend takes snapper snapshots on a BTRFS root file system.
+type snapperBackend struct {
+	commandExecutor         common.Executor
+	isSnapperInstalledFunc  func(cmdExecutor common.Executor) (bool, error)
+	ensureSnapperConfigFunc func(cmdExecutor common.Executor, configName string) error
+}
+
+func (b *snapperBackend) Name() string {
+	return SnapshotBackendSnapper
+}
+
+func (b *snapperBackend) Create() (string, error) {
+	// Check if snapper is installed
+	isInstalled, err := b.isSnapperInstalledFunc(b.commandExecutor)
+	if err != nil {
+		return "", fmt.Errorf("snapper installation check failed: %w", err)
+	}
+	if !isInstalled {
+		return "", fmt.Errorf("snapper is not installed")
+	}
+
+	err = b.ensureSnapperConfigFunc(b.commandExecutor, "rootConfig")
+	if err != nil {
+		return "", fmt.Errorf("failed to ensure snapper config exists: %w", err)
+	}
+
+	// Create a snapshot using snapper
+	snapshotCmd := []string{
+		common.SnapperCmd, "-c", "rootConfig", "create", "-p", "--description", "sota_update",
+	}
+	stdout, stderr, err := b.commandExecutor.Execute(snapshotCmd)
+	if err != nil {
+		return "", fmt.Errorf("error executing command: %s, stderr: %s, err: %w", stdout, stderr, err)
+	}
+
+	// Log a warning if stderr is non-empty but the command succeeded
+	if string(stderr) != "" {
+		log.Printf("Warning: snapshot command produced stderr: %s", stderr)
+	}
+
+	// Ensure stdout is not blank and is an integer
+	snapshotID := strings.TrimSpace(string(stdout))
+	if snapshotID == "" {
+		return "", fmt.Errorf("snapshot ID is blank")
+	}
+	if _, err := strconv.Atoi(snapshotID); err != nil {
+		return "", fmt.Errorf("snapshot ID is not a valid integer: %s", snapshotID)
+	}
+	return snapshotID, nil
+}
+
+func (b *snapperBackend) Restore(snapshotID string) error {
+	snapshotNumber, err := strconv.Atoi(snapshotID)
+	if err != nil {
+		return fmt.Errorf("invalid snapper snapshot ID: %s", snapshotID)
+	}
+	return UndoChange(b.commandExecutor, snapshotNumber)
+}
+
+func (b *snapperBackend) Delete(snapshotID string) error {
+	snapshotNumber, err := strconv.Atoi(snapshotID)
+	if err != nil {
+		return fmt.Errorf("invalid snapper snapshot ID: %s", snapshotID)
+	}
+	return DeleteSnapshot(b.commandExecutor, snapshotNumber)
+}
+
+// detectLVMThinRoot returns the VG/LV of the root file system and whether it is thin-provisioned.
+func detectLVMThinRoot(cmdExecutor common.Executor) (string, bool, error) {
+	stdout, _, err := cmdExecutor.Execute([]string{common.FindmntCmd, "-n", "-o", "SOURCE", "/"})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to find root device: %w", err)
+	}
+	device := strings.TrimSpace(string(stdout))
+	if device == "" {
+		return "", false, fmt.Errorf("root device not found")
+	}
+
+	stdout, _, err = cmdExecutor.Execute([]string{
+		common.LvsCmd, "--noheadings", "--separator", ",", "-o", "vg_name,lv_name,pool_lv", device,
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to list logical volume %s: %w", device, err)
+	}
+
+	fields := strings.Split(strings.TrimSpace(string(stdout)), ",")
+	if len(fields) != 3 || fields[0] == "" || fields[1] == "" {
+		return "", false, fmt.Errorf("unexpected lvs output: %s", strings.TrimSpace(string(stdout)))
+	}
+	origin := fields[0] + "/" + fields[1]
+	// Only thin volumes can be snapshotted without reserving space up front.
+	return origin, strings.TrimSpace(fields[2]) != "", nil
+}
+
+// lvmBackend takes thin snapshots of an LVM root logical volume.
+type lvmBackend struct {
+	commandExecutor common.Executor
+	origin          string
+}
+
+func (b *lvmBackend) Name() string {
+	return SnapshotBackendLVM
+}
+
+func (b *lvmBackend) Create() (string, error) {
+	vg, _, found := strings.Cut(b.origin, "/")
+	if !found {
+		return "", fmt.Errorf("invalid logical volume: %s", b.origin)
+	}
+	name := "inbm_sota_" + time.Now().Format("20060102150405")
+
+	_, stderr, err := b.commandExecutor.Execute([]string{common.LvcreateCmd, "-s", "-n", name, b.origin})
+	if err != nil {
+		return "", fmt.Errorf("error creating LVM snapshot of %s: stderr: %s, err: %w", b.origin, stderr, err)
+	}
+	return vg + "/" + name, nil
+}
+
+// Restore merges the snapshot back into its origin.  As the origin is the mounted root
+// file system, the merge is deferred by LVM until the next activation, i.e. the next boot.
+func (b *lvmBackend) Restore(snapshotID string) error {
+	_, stderr, err := b.commandExecutor.Execute([]string{common.LvconvertCmd, "--merge", snapshotID})
+	if err != nil {
+		return fmt.Errorf("error merging LVM snapshot %s: stderr: %s, err: %w", snapshotID, stderr, err)
+	}
+	log.Println("LVM snapshot merge scheduled.  It completes on the next boot.")
+	return nil
+}
+
+func (b *lvmBackend) Delete(snapshotID string) error {
+	_, stderr, err := b.commandExecutor.Execute([]string{common.LvremoveCmd, "-y", snapshotID})
+	if err != nil {
+		return fmt.Errorf("error removing LVM snapshot %s: stderr: %s, err: %w", snapshotID, stderr, err)
+	}
+	return nil
+}
+
+// packageListBackend records the installed dpkg packages so that an update can be
+// rolled back by reinstalling the previous package versions.  The packages the update
+// replaces or removes are cached at snapshot time, as the mirrors may no longer serve
+// their versions when the update is rolled back.
+type packageListBackend struct {
+	commandExecutor common.Executor
+	fs              afero.Fs
+	// packages are the packages the update installs; the whole system is upgraded when empty.
+	packages []string
+}
+
+func (b *packageListBackend) Name() string {
+	return SnapshotBackendPackageList
+}
+
+func (b *packageListBackend) Create() (string, error) {
+	packages, err := b.installedPackages()
+	if err != nil {
+		return "", err
+	}
+
+	snapshotID := time.Now().Format("20060102150405")
+	var content strings.Builder
+	for _, name := range sortedKeys(packages) {
+		content.WriteString(name + "\t" + packages[name] + "\n")
+	}
+	if err := utils.WriteFile(b.fs, b.path(snapshotID), []byte(content.String()), 0640); err != nil {
+		return "", fmt.Errorf("error saving package list: %w", err)
+	}
+
+	if err := b.cachePackages(snapshotID); err != nil {
+		if deleteErr := b.Delete(snapshotID); deleteErr != nil {
+			log.Printf("[Warning] Error deleting package snapshot %s: %v", snapshotID, deleteErr)
+		}
+		return "", fmt.Errorf("package-list rollback is unavailable: %w", err)
+	}
+	return snapshotID, nil
+}
+
+// cachePackages downloads the installed version of every package the update replaces or
+// removes into the cache directory of the snapshot.
+func (b *packageListBackend) cachePackages(snapshotID string) error {
+	replaced, err := b.replacedPackages()
+	if err != nil {
+		return err
+	}
+	if len(replaced) == 0 {
+		return nil
+	}
+
+	cacheDir := b.cacheDir(snapshotID)
+	// apt-get requires the partial directory for its downloads.
+	if err := b.fs.MkdirAll(filepath.Join(cacheDir, "partial"), 0750); err != nil {
+		return fmt.Errorf("error creating package cache: %w", err)
+	}
+	cmd := []string{common.AptGetCmd, "-o", aptLockTimeoutOption, "-o", "Dir::Cache::archives=" + cacheDir,
+		"-yq", "--download-only", "--reinstall", "install"}
+	for _, name := range sortedKeys(replaced) {
+		cmd = append(cmd, name+"="+replaced[name])
+	}
+	log.Printf("Caching the installed packages replaced by the update: %v", sortedKeys(replaced))
+	if _, stderr, err := b.commandExecutor.Execute(cmd); err != nil {
+		return fmt.Errorf("the installed versions of the packages replaced by the update cannot be downloaded: stderr: %s, err: %w", stderr, err)
+	}
+	return nil
+}
+
+// replacedPackages simulates the update and returns the installed version of every package it
+// upgrades, downgrades or removes.
+func (b *packageListBackend) replacedPackages() (map[string]string, error) {
+	cmd := []string{common.AptGetCmd, "-o", aptLockTimeoutOption, "-s"}
+	if len(b.packages) == 0 {
+		cmd = append(cmd, "--with-new-pkgs", "upgrade")
+	} else {
+		cmd = append(append(cmd, "install"), b.packages...)
+	}
+	stdout, stderr, err := b.commandExecutor.Execute(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("error simulating the update: stderr: %s, err: %w", stderr, err)
+	}
+	return parseSimulation(stdout), nil
+}
+
+// parseSimulation parses the "Inst name [installed] (candidate ...)" and "Remv name [installed]"
+// lines of an apt-get simulation.  Newly installed packages have no installed version.
+func parseSimulation(output []byte) map[string]string {
+	replaced := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || (fields[0] != "Inst" && fields[0] != "Remv") {
+			continue
+		}
+		if version, ok := strings.CutPrefix(fields[2], "["); ok {
+			replaced[fields[1]] = strings.TrimSuffix(version, "]")
+		}
+	}
+	return replaced
+}
+
+// Restore reinstalls the saved version of every package that was upgraded or removed,
+// and removes packages that were newly installed by the update.  Packages cached at
+// snapshot time are installed from the cache.
+func (b *packageListBackend) Restore(snapshotID string) error {
+	data, err := afero.ReadFile(b.fs, b.path(snapshotID))
+	if err != nil {
+		return fmt.Errorf("error reading package list: %w", err)
+	}
+	saved := parsePackageList(data)
+
+	current, err := b.installedPackages()
+	if err != nil {
+		return err
+	}
+	cached := b.cachedPackages(snapshotID)
+
+	var install, remove []string
+	for _, name := range sortedKeys(saved) {
+		if current[name] != saved[name] {
+			if path, ok := cached[name]; ok {
+				install = append(install, path)
+			} else {
+				install = append(install, name+"="+saved[name])
+			}
+		}
+	}
+	for _, name := range sortedKeys(current) {
+		if _, ok := saved[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+
+	var cmds [][]string
+	if len(install) > 0 {
+		cmds = append(cmds, append([]string{common.AptGetCmd, "-o", aptLockTimeoutOption, "-yq",
+			"--allow-downgrades", "install"}, install...))
+	}
+	if len(remove) > 0 {
+		cmds = append(cmds, append([]string{common.AptGetCmd, "-o", aptLockTimeoutOption, "-yq", "remove"}, remove...))
+	}
+	for _, cmd := range cmds {
+		log.Printf("Executing command: %s", cmd)
+		if _, stderr, err := b.commandExecutor.Execute(cmd); err != nil {
+			return fmt.Errorf("error restoring packages: stderr: %s, err: %w", stderr, err)
+		}
+	}
+	return nil
+}
+
+// cachedPackages returns the paths of the .deb files cached for the snapshot by package name.
+func (b *packageListBackend) cachedPackages(snapshotID string) map[string]string {
+	cached := map[string]string{}
+	files, err := afero.ReadDir(b.fs, b.cacheDir(snapshotID))
+	if err != nil {
+		return cached
+	}
+	for _, file := range files {
+		// Debian archives are named <package>_<version>_<architecture>.deb.
+		name, _, found := strings.Cut(file.Name(), "_")
+		if found && !file.IsDir() && strings.HasSuffix(file.Name(), ".deb") {
+			cached[name] = filepath.Join(b.cacheDir(snapshotID), file.Name())
+		}
+	}
+	return cached
+}
+
+func (b *packageListBackend) Delete(snapshotID string) error {
+	if err := b.fs.RemoveAll(b.cacheDir(snapshotID)); err != nil {
+		return fmt.Errorf("error removing package cache: %w", err)
+	}
+	return utils.RemoveFile(b.fs, b.path(snapshotID))
+}
+
+func (b *packageListBackend) path(snapshotID string) string {
+	return filepath.Join(PackageSnapshotDir, snapshotID+".list")
+}
+
+func (b *packageListBackend) cacheDir(snapshotID string) string {
+	return filepath.Join(PackageSnapshotDir, snapshotID)
+}
+
+// installedPackages returns the installed dpkg packages and their versions.
+func (b *packageListBackend) installedPackages() (map[string]string, error) {
+	stdout, stderr, err := b.commandExecutor.Execute([]string{
+		common.DpkgQueryCmd, "-W", "-f=${db:Status-Abbrev}\t${Package}\t${Version}\n",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing installed packages: stderr: %s, err: %w", stderr, err)
+	}
+
+	packages := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(stdout))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		// Only fully installed packages ("ii") are part of the snapshot.
+		if len(fields) == 3 && strings.HasPrefix(fields[0], "ii") {
+			packages[fields[1]] = fields[2]
+		}
+	}
+	return packages, nil
+}
+
+// parsePackageList parses the package list saved by the package-list backend.
+func parsePackageList(data []byte) map[string]string {
+	packages := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		name, version, found := strings.Cut(scanner.Text(), "\t")
+		if found && name != "" {
+			packages[name] = version
+		}
+	}
+	return packages
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package ubuntu
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	common "github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/common"
+	"github.com/open-edge-platform/edge-node-agents/in-band-manageability/internal/inbd/utils"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSnapshotBackend is a SnapshotBackend returning a fixed snapshot ID or error.
+type fakeSnapshotBackend struct {
+	name string
+	id   string
+	err  error
+}
+
+func (f *fakeSnapshotBackend) Name() string            { return f.name }
+func (f *fakeSnapshotBackend) Create() (string, error) { return f.id, f.err }
+func (f *fakeSnapshotBackend) Restore(_ string) error  { return f.err }
+func (f *fakeSnapshotBackend) Delete(_ string) error   { return f.err }
+
+var findmntCmd = []string{common.FindmntCmd, "-n", "-o", "SOURCE", "/"}
+var lvsCmd = []string{common.LvsCmd, "--noheadings", "--separator", ",", "-o", "vg_name,lv_name,pool_lv",
+	"/dev/mapper/ubuntu--vg-root"}
+
+func TestSelectFallbackBackend_LVMThinRoot(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Execute", findmntCmd).Return("/dev/mapper/ubuntu--vg-root\n", "", nil)
+	mockExecutor.On("Execute", lvsCmd).Return("  ubuntu-vg,root,thinpool\n", "", nil)
+
+	backend := SelectFallbackBackend(mockExecutor, afero.NewMemMapFs())
+	require.IsType(t, &lvmBackend{}, backend)
+	assert.Equal(t, "ubuntu-vg/root", backend.(*lvmBackend).origin)
+}
+
+func TestSelectFallbackBackend_ThickLVM(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Execute", findmntCmd).Return("/dev/mapper/ubuntu--vg-root\n", "", nil)
+	mockExecutor.On("Execute", lvsCmd).Return("  ubuntu-vg,root,\n", "", nil)
+
+	backend := SelectFallbackBackend(mockExecutor, afero.NewMemMapFs())
+	assert.Equal(t, SnapshotBackendPackageList, backend.Name())
+}
+
+func TestSelectFallbackBackend_NotLVM(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Execute", findmntCmd).Return("/dev/sda2\n", "", nil)
+	mockExecutor.On("Execute", []string{common.LvsCmd, "--noheadings", "--separator", ",", "-o",
+		"vg_name,lv_name,pool_lv", "/dev/sda2"}).Return("", "not an LV", errors.New("exit status 5"))
+
+	backend := SelectFallbackBackend(mockExecutor, afero.NewMemMapFs())
+	assert.Equal(t, SnapshotBackendPackageList, backend.Name())
+}
+
+func TestLVMBackend(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Execute", mock.MatchedBy(func(cmd []string) bool {
+		return cmd[0] == common.LvcreateCmd && cmd[len(cmd)-1] == "ubuntu-vg/root"
+	})).Return("", "", nil)
+	mockExecutor.On("Execute", []string{common.LvconvertCmd, "--merge", "ubuntu-vg/inbm_sota_1"}).Return("", "", nil)
+	mockExecutor.On("Execute", []string{common.LvremoveCmd, "-y", "ubuntu-vg/inbm_sota_1"}).Return("", "", nil)
+
+	backend := &lvmBackend{commandExecutor: mockExecutor, origin: "ubuntu-vg/root"}
+	id, err := backend.Create()
+	require.NoError(t, err)
+	assert.Regexp(t, `^ubuntu-vg/inbm_sota_\d{14}$`, id)
+
+	assert.NoError(t, backend.Restore("ubuntu-vg/inbm_sota_1"))
+	assert.NoError(t, backend.Delete("ubuntu-vg/inbm_sota_1"))
+	mockExecutor.AssertExpectations(t)
+}
+
+var dpkgQuery = []string{common.DpkgQueryCmd, "-W", "-f=${db:Status-Abbrev}\t${Package}\t${Version}\n"}
+
+var aptSimulateUpgrade = []string{common.AptGetCmd, "-o", aptLockTimeoutOption, "-s", "--with-new-pkgs", "upgrade"}
+
+func isPackageCacheDownload(cmd []string) bool {
+	return len(cmd) > 4 && cmd[0] == common.AptGetCmd && strings.HasPrefix(cmd[4], "Dir::Cache::archives=")
+}
+
+func TestPackageListBackend_CreateAndRestore(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	createExecutor := new(MockExecutor)
+	createExecutor.On("Execute", dpkgQuery).Return("ii \tcurl\t7.81.0-1\nii \tvim\t2:8.2\nrc \told\t1.0\n", "", nil)
+	createExecutor.On("Execute", aptSimulateUpgrade).Return("Inst curl [7.81.0-1] (7.81.0-2 Ubuntu:22.04/jammy-updates [amd64])\n"+
+		"Inst newpkg (1.0 Ubuntu:22.04/jammy [amd64])\nConf curl (7.81.0-2 Ubuntu:22.04/jammy-updates [amd64])\n", "", nil)
+	var cacheDir string
+	createExecutor.On("Execute", mock.MatchedBy(isPackageCacheDownload)).Run(func(args mock.Arguments) {
+		cmd := args.Get(0).([]string)
+		assert.Equal(t, []string{"-yq", "--download-only", "--reinstall", "install", "curl=7.81.0-1"}, cmd[5:])
+		cacheDir = strings.TrimPrefix(cmd[4], "Dir::Cache::archives=")
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(cacheDir, "curl_7.81.0-1_amd64.deb"), []byte("deb"), 0640))
+	}).Return("", "", nil)
+	backend := &packageListBackend{commandExecutor: createExecutor, fs: fs}
+
+	id, err := backend.Create()
+	require.NoError(t, err)
+	createExecutor.AssertExpectations(t)
+	saved, err := afero.ReadFile(fs, backend.path(id))
+	require.NoError(t, err)
+	assert.Equal(t, "curl\t7.81.0-1\nvim\t2:8.2\n", string(saved))
+	assert.Equal(t, backend.cacheDir(id), cacheDir)
+
+	restoreExecutor := new(MockExecutor)
+	restoreExecutor.On("Execute", dpkgQuery).Return("ii \tcurl\t7.81.0-2\nii \tnewpkg\t1.0\n", "", nil)
+	restoreExecutor.On("Execute", []string{common.AptGetCmd, "-o", aptLockTimeoutOption, "-yq",
+		"--allow-downgrades", "install", filepath.Join(cacheDir, "curl_7.81.0-1_amd64.deb"), "vim=2:8.2"}).Return("", "", nil)
+	restoreExecutor.On("Execute", []string{common.AptGetCmd, "-o", aptLockTimeoutOption, "-yq",
+		"remove", "newpkg"}).Return("", "", nil)
+	backend.commandExecutor = restoreExecutor
+
+	assert.NoError(t, backend.Restore(id))
+	restoreExecutor.AssertExpectations(t)
+
+	require.NoError(t, backend.Delete(id))
+	exists, err := afero.Exists(fs, cacheDir)
+	require.NoError(t, err)
+	assert.False(t, exists, "the package cache must be deleted with the snapshot")
+}
+
+func TestPackageListBackend_CreatePackageInstall(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Execute", dpkgQuery).Return("ii \tcurl\t7.81.0-1\n", "", nil)
+	mockExecutor.On("Execute", []string{common.AptGetCmd, "-o", aptLockTimeoutOption, "-s", "install", "vim"}).
+		Return("Inst vim (2:8.2 Ubuntu:22.04/jammy [amd64])\n", "", nil)
+	backend := &packageListBackend{commandExecutor: mockExecutor, fs: afero.NewMemMapFs(), packages: []string{"vim"}}
+
+	_, err := backend.Create()
+	require.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+	mockExecutor.AssertNotCalled(t, "Execute", mock.MatchedBy(isPackageCacheDownload))
+}
+
+func TestPackageListBackend_CreateRollbackUnavailable(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Execute", dpkgQuery).Return("ii \tcurl\t7.81.0-1\n", "", nil)
+	mockExecutor.On("Execute", aptSimulateUpgrade).Return("Inst curl [7.81.0-1] (7.81.0-2 Ubuntu:22.04/jammy-updates [amd64])\n", "", nil)
+	mockExecutor.On("Execute", mock.MatchedBy(isPackageCacheDownload)).
+		Return("", "E: Version '7.81.0-1' for 'curl' was not found", errors.New("exit status 100"))
+	backend := &packageListBackend{commandExecutor: mockExecutor, fs: fs}
+
+	_, err := backend.Create()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "package-list rollback is unavailable")
+	files, err := afero.ReadDir(fs, PackageSnapshotDir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "an unusable snapshot must not be left behind")
+}
+
+func TestParseSimulation(t *testing.T) {
+	replaced := parseSimulation([]byte("NOTE: This is only a simulation!\n" +
+		"Inst libc6 [2.35-0ubuntu3.6] (2.35-0ubuntu3.7 Ubuntu:22.04/jammy-updates [amd64])\n" +
+		"Inst linux-image-6.8.0-50-generic (6.8.0-50.51 Ubuntu:22.04/jammy-updates [amd64])\n" +
+		"Remv oldpkg [1.0]\n" +
+		"Conf libc6 (2.35-0ubuntu3.7 Ubuntu:22.04/jammy-updates [amd64])\n"))
+	assert.Equal(t, map[string]string{"libc6": "2.35-0ubuntu3.6", "oldpkg": "1.0"}, replaced)
+}
+
+func TestPackageListBackend_RestoreMissingSnapshot(t *testing.T) {
+	backend := &packageListBackend{commandExecutor: new(MockExecutor), fs: afero.NewMemMapFs()}
+	assert.Error(t, backend.Restore("20250101000000"))
+}
+
+func TestUndoStateChange_LegacySnapperState(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Execute", []string{"snapper", "-c", "rootConfig", "undochange", "7..0"}).Return("", "", nil)
+
+	err := UndoStateChange(mockExecutor, afero.NewMemMapFs(), utils.INBDState{SnapshotNumber: 7})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestUndoStateChange_LVMState(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Execute", []string{common.LvconvertCmd, "--merge", "vg/inbm_sota_1"}).Return("", "", nil)
+
+	err := UndoStateChange(mockExecutor, afero.NewMemMapFs(), utils.INBDState{
+		SnapshotBackend: SnapshotBackendLVM,
+		SnapshotID:      "vg/inbm_sota_1",
+	})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestHasSnapshot(t *testing.T) {
+	assert.False(t, HasSnapshot(utils.INBDState{}))
+	assert.True(t, HasSnapshot(utils.INBDState{SnapshotNumber: 3}))
+	assert.True(t, HasSnapshot(utils.INBDState{SnapshotBackend: SnapshotBackendLVM, SnapshotID: "vg/snap"}))
+}
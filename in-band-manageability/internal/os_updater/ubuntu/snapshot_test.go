@@ -72,6 +72,7 @@ func TestSnapshot_Success(t *testing.T) {
 
 func TestSnapshot_NotBTRFS(t *testing.T) {
 	mockExecutor := new(MockExecutor)
+	var writtenState string
 
 	snapshotter := Snapshotter{
 		Fs:              afero.NewMemMapFs(),
@@ -83,8 +84,12 @@ func TestSnapshot_NotBTRFS(t *testing.T) {
 			return nil
 		},
 		WriteToStateFileFunc: func(fs afero.Fs, stateFilePath string, content string) error {
+			writtenState = content
 			return nil
 		},
+		SelectFallbackBackendFunc: func(cmdExecutor common.Executor, fs afero.Fs) SnapshotBackend {
+			return &fakeSnapshotBackend{name: SnapshotBackendLVM, id: "vg/inbm_sota_1"}
+		},
 	}
 
 	// Call Snapshot
@@ -92,6 +97,25 @@ func TestSnapshot_NotBTRFS(t *testing.T) {
 
 	// Assertions
 	assert.NoError(t, err)
+	assert.Contains(t, writtenState, `"snapshot_backend":"lvm"`)
+	assert.Contains(t, writtenState, `"snapshot_id":"vg/inbm_sota_1"`)
+}
+
+func TestSnapshot_FallbackBackendError(t *testing.T) {
+	snapshotter := Snapshotter{
+		Fs:              afero.NewMemMapFs(),
+		CommandExecutor: new(MockExecutor),
+		IsBTRFSFileSystemFunc: func(path string, statfsFunc func(string, *unix.Statfs_t) error) (bool, error) {
+			return false, nil
+		},
+		SelectFallbackBackendFunc: func(cmdExecutor common.Executor, fs afero.Fs) SnapshotBackend {
+			return &fakeSnapshotBackend{name: SnapshotBackendPackageList, err: errors.New("dpkg-query failed")}
+		},
+	}
+
+	err := snapshotter.Snapshot()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "dpkg-query failed")
 }
 
 func TestSnapshot_SnapperNotInstalled(t *testing.T) {
@@ -34,6 +34,17 @@ type Updater struct {
 	Request                 *pb.UpdateSystemSoftwareRequest
 	GetFreeDiskSpaceInBytes func(string, func(string, *unix.Statfs_t) error) (uint64, error)
 	Fs                      afero.Fs
+	// OSType is the OS type written to the granular log.  Defaults to "ubuntu" when empty so
+	// that the same apt-based updater can be reused for Debian.
+	OSType string
+}
+
+// logOSType returns the OS type used in the granular log.
+func (u *Updater) logOSType() string {
+	if u.OSType == "" {
+		return "ubuntu"
+	}
+	return u.OSType
 }
 
 // Update method for Ubuntu
@@ -54,7 +65,7 @@ func (u *Updater) Update() (bool, error) {
 	err = os.Setenv("DEBIAN_FRONTEND", "noninteractive")
 	if err != nil {
 		emt.WriteUpdateStatus(fs, emt.FAIL, string(jsonString), err.Error())
-		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INBM, "ubuntu")
+		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INBM, u.logOSType())
 		return false, fmt.Errorf("SOTA Aborted: Failed to set environment variable: %v", err)
 	}
 
@@ -72,7 +83,7 @@ func (u *Updater) Update() (bool, error) {
 	err = os.Setenv("PATH", os.Getenv("PATH")+":/usr/bin:/bin")
 	if err != nil {
 		emt.WriteUpdateStatus(fs, emt.FAIL, string(jsonString), err.Error())
-		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INBM, "ubuntu")
+		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INBM, u.logOSType())
 		return false, fmt.Errorf("SOTA Aborted: Failed to set environment variable: %v", err)
 	}
 
@@ -82,7 +93,7 @@ func (u *Updater) Update() (bool, error) {
 		// Invalid package names or validation errors caught here
 		log.Printf("Package validation failed: %v", err)
 		emt.WriteUpdateStatus(fs, emt.FAIL, string(jsonString), err.Error())
-		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_DOWNLOAD, "ubuntu")
+		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_DOWNLOAD, u.logOSType())
 		return false, fmt.Errorf("SOTA Aborted: Update Failed: %s", err)
 	}
 
@@ -102,7 +113,7 @@ func (u *Updater) Update() (bool, error) {
 				}
 			}
 			emt.WriteUpdateStatus(fs, emt.SUCCESS, string(jsonString), "")
-			emt.WriteGranularLogWithOSType(fs, emt.SUCCESS, "", "ubuntu")
+			emt.WriteGranularLogWithOSType(fs, emt.SUCCESS, "", u.logOSType())
 			return false, nil
 		}
 
@@ -111,7 +122,7 @@ func (u *Updater) Update() (bool, error) {
 		if isSystemWideUpdate {
 			log.Println("System-wide update requested but no apt packages to update - triggering reboot for kernel args update")
 			emt.WriteUpdateStatus(fs, emt.SUCCESS, string(jsonString), "")
-			emt.WriteGranularLogWithOSType(fs, emt.SUCCESS, "", "ubuntu")
+			emt.WriteGranularLogWithOSType(fs, emt.SUCCESS, "", u.logOSType())
 			return true, nil
 		}
 
@@ -123,14 +134,14 @@ func (u *Updater) Update() (bool, error) {
 	freeSpace, err := u.GetFreeDiskSpaceInBytes("/", unix.Statfs)
 	if err != nil {
 		emt.WriteUpdateStatus(fs, emt.FAIL, string(jsonString), err.Error())
-		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INSUFFICIENT_STORAGE, "ubuntu")
+		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INSUFFICIENT_STORAGE, u.logOSType())
 		return false, fmt.Errorf("SOTA Aborted: Failed to get free disk space: %v", err)
 	}
 	log.Printf("Free disk space: %d bytes", freeSpace)
 	if freeSpace < updateSize {
 		err := fmt.Errorf("SOTA Aborted: Not enough free disk space.  Free: %d bytes, Required: %d bytes", freeSpace, updateSize)
 		emt.WriteUpdateStatus(fs, emt.FAIL, string(jsonString), err.Error())
-		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INSUFFICIENT_STORAGE, "ubuntu")
+		emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INSUFFICIENT_STORAGE, u.logOSType())
 		return false, err
 	}
 
@@ -144,7 +155,7 @@ func (u *Updater) Update() (bool, error) {
 			if err := NewSnapshotter(u.CommandExecutor, fs).Snapshot(); err != nil {
 				errMsg := fmt.Sprintf("Error taking snapshot: %v", err)
 				emt.WriteUpdateStatus(fs, emt.FAIL, string(jsonString), errMsg)
-				emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INBM, "ubuntu")
+				emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_INBM, u.logOSType())
 				return false, fmt.Errorf("failed to take snapshot before applying the update: %v", err)
 			}
 		} else {
@@ -169,13 +180,13 @@ func (u *Updater) Update() (bool, error) {
 		_, stderr, err := u.CommandExecutor.Execute(cmd)
 		if err != nil {
 			emt.WriteUpdateStatus(fs, emt.FAIL, string(jsonString), err.Error())
-			emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_UPDATE_TOOL, "ubuntu")
+			emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_UPDATE_TOOL, u.logOSType())
 			return false, fmt.Errorf("SOTA Aborted: Command execution error: %v", err)
 		}
 		if len(stderr) > 0 {
 			errMsg := fmt.Sprintf("SOTA Aborted: Command failed: %s", string(stderr))
 			emt.WriteUpdateStatus(fs, emt.FAIL, string(jsonString), errMsg)
-			emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_UPDATE_TOOL, "ubuntu")
+			emt.WriteGranularLogWithOSType(fs, emt.FAIL, emt.FAILURE_REASON_UPDATE_TOOL, u.logOSType())
 			return false, fmt.Errorf("%s", errMsg)
 		}
 	}
@@ -190,7 +201,7 @@ func (u *Updater) Update() (bool, error) {
 
 	// Success - write success status (will be verified after reboot)
 	emt.WriteUpdateStatus(fs, emt.SUCCESS, string(jsonString), "")
-	emt.WriteGranularLogWithOSType(fs, emt.SUCCESS, "", "ubuntu")
+	emt.WriteGranularLogWithOSType(fs, emt.SUCCESS, "", u.logOSType())
 
 	// Determine reboot based on update type:
 	// Scenario 1: System-wide update (kernel args) - ALWAYS reboot
@@ -392,7 +403,7 @@ func writeStateFileForPackageInstallation(fs afero.Fs, packageList []string) err
 
 	// Check if state file already exists (from previous kernel/OS update)
 	existingState, err := utils.ReadStateFile(fs, utils.StateFilePath)
-	if err == nil && existingState.SnapshotNumber > 0 {
+	if err == nil && HasSnapshot(existingState) {
 		log.Printf("State file exists from previous update. Preserving snapshot info and adding packages.")
 		existingState.PackageList = strings.Join(packageList, ",")
 		stateJSON, _ := json.Marshal(existingState)
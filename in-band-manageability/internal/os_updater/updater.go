@@ -10,3 +10,10 @@ package osupdater
 type Updater interface {
 	Update() (bool, error)
 }
+
+// StagingUpdater is implemented by updaters that can stage a downloaded image to be installed
+// later.
+type StagingUpdater interface {
+	// StagedArtifactID returns the ID the image was staged with, or "" if none was staged.
+	StagedArtifactID() string
+}
@@ -28,34 +28,49 @@ const (
 type QueryOption int32
 
 const (
-	QueryOption_QUERY_OPTION_UNSPECIFIED QueryOption = 0
-	QueryOption_QUERY_OPTION_HARDWARE    QueryOption = 1 // hw - Hardware information
-	QueryOption_QUERY_OPTION_FIRMWARE    QueryOption = 2 // fw - Firmware information
-	QueryOption_QUERY_OPTION_OS          QueryOption = 3 // os - Operating System information
-	QueryOption_QUERY_OPTION_SWBOM       QueryOption = 4 // swbom - Software BOM information
-	QueryOption_QUERY_OPTION_VERSION     QueryOption = 5 // version - Version information
-	QueryOption_QUERY_OPTION_ALL         QueryOption = 6 // all - All available information
+	QueryOption_QUERY_OPTION_UNSPECIFIED         QueryOption = 0
+	QueryOption_QUERY_OPTION_HARDWARE            QueryOption = 1  // hw - Hardware information
+	QueryOption_QUERY_OPTION_FIRMWARE            QueryOption = 2  // fw - Firmware information
+	QueryOption_QUERY_OPTION_OS                  QueryOption = 3  // os - Operating System information
+	QueryOption_QUERY_OPTION_SWBOM               QueryOption = 4  // swbom - Software BOM information
+	QueryOption_QUERY_OPTION_VERSION             QueryOption = 5  // version - Version information
+	QueryOption_QUERY_OPTION_ALL                 QueryOption = 6  // all - All available information
+	QueryOption_QUERY_OPTION_FIRMWARE_COMPONENTS QueryOption = 7  // fwcomponents - UEFI ESRT firmware component inventory
+	QueryOption_QUERY_OPTION_AUDIT_LOG           QueryOption = 8  // auditlog - Recent commands executed by inbd
+	QueryOption_QUERY_OPTION_PROVENANCE          QueryOption = 9  // provenance - Recent package provenance verification results
+	QueryOption_QUERY_OPTION_VULNERABILITIES     QueryOption = 10 // vulnerabilities - Installed packages matched against the vulnerability database
+	QueryOption_QUERY_OPTION_POWER               QueryOption = 11 // power - Power capabilities and pending power actions
 )
 
 // Enum value maps for QueryOption.
 var (
 	QueryOption_name = map[int32]string{
-		0: "QUERY_OPTION_UNSPECIFIED",
-		1: "QUERY_OPTION_HARDWARE",
-		2: "QUERY_OPTION_FIRMWARE",
-		3: "QUERY_OPTION_OS",
-		4: "QUERY_OPTION_SWBOM",
-		5: "QUERY_OPTION_VERSION",
-		6: "QUERY_OPTION_ALL",
+		0:  "QUERY_OPTION_UNSPECIFIED",
+		1:  "QUERY_OPTION_HARDWARE",
+		2:  "QUERY_OPTION_FIRMWARE",
+		3:  "QUERY_OPTION_OS",
+		4:  "QUERY_OPTION_SWBOM",
+		5:  "QUERY_OPTION_VERSION",
+		6:  "QUERY_OPTION_ALL",
+		7:  "QUERY_OPTION_FIRMWARE_COMPONENTS",
+		8:  "QUERY_OPTION_AUDIT_LOG",
+		9:  "QUERY_OPTION_PROVENANCE",
+		10: "QUERY_OPTION_VULNERABILITIES",
+		11: "QUERY_OPTION_POWER",
 	}
 	QueryOption_value = map[string]int32{
-		"QUERY_OPTION_UNSPECIFIED": 0,
-		"QUERY_OPTION_HARDWARE":    1,
-		"QUERY_OPTION_FIRMWARE":    2,
-		"QUERY_OPTION_OS":          3,
-		"QUERY_OPTION_SWBOM":       4,
-		"QUERY_OPTION_VERSION":     5,
-		"QUERY_OPTION_ALL":         6,
+		"QUERY_OPTION_UNSPECIFIED":         0,
+		"QUERY_OPTION_HARDWARE":            1,
+		"QUERY_OPTION_FIRMWARE":            2,
+		"QUERY_OPTION_OS":                  3,
+		"QUERY_OPTION_SWBOM":               4,
+		"QUERY_OPTION_VERSION":             5,
+		"QUERY_OPTION_ALL":                 6,
+		"QUERY_OPTION_FIRMWARE_COMPONENTS": 7,
+		"QUERY_OPTION_AUDIT_LOG":           8,
+		"QUERY_OPTION_PROVENANCE":          9,
+		"QUERY_OPTION_VULNERABILITIES":     10,
+		"QUERY_OPTION_POWER":               11,
 	}
 )
 
@@ -86,12 +101,68 @@ func (QueryOption) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{0}
 }
 
+// Format of a streamed software BOM
+type SBOMFormat int32
+
+const (
+	SBOMFormat_SBOM_FORMAT_UNSPECIFIED    SBOMFormat = 0 // Same as SBOM_FORMAT_PACKAGES
+	SBOMFormat_SBOM_FORMAT_PACKAGES       SBOMFormat = 1 // Pages of SoftwarePackage messages
+	SBOMFormat_SBOM_FORMAT_SPDX_JSON      SBOMFormat = 2 // SPDX 2.3 JSON document
+	SBOMFormat_SBOM_FORMAT_CYCLONEDX_JSON SBOMFormat = 3 // CycloneDX 1.5 JSON document
+)
+
+// Enum value maps for SBOMFormat.
+var (
+	SBOMFormat_name = map[int32]string{
+		0: "SBOM_FORMAT_UNSPECIFIED",
+		1: "SBOM_FORMAT_PACKAGES",
+		2: "SBOM_FORMAT_SPDX_JSON",
+		3: "SBOM_FORMAT_CYCLONEDX_JSON",
+	}
+	SBOMFormat_value = map[string]int32{
+		"SBOM_FORMAT_UNSPECIFIED":    0,
+		"SBOM_FORMAT_PACKAGES":       1,
+		"SBOM_FORMAT_SPDX_JSON":      2,
+		"SBOM_FORMAT_CYCLONEDX_JSON": 3,
+	}
+)
+
+func (x SBOMFormat) Enum() *SBOMFormat {
+	p := new(SBOMFormat)
+	*p = x
+	return p
+}
+
+func (x SBOMFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SBOMFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_inbd_v1_inbd_proto_enumTypes[1].Descriptor()
+}
+
+func (SBOMFormat) Type() protoreflect.EnumType {
+	return &file_pkg_api_inbd_v1_inbd_proto_enumTypes[1]
+}
+
+func (x SBOMFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SBOMFormat.Descriptor instead.
+func (SBOMFormat) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{1}
+}
+
 type SetPowerStateRequest_PowerAction int32
 
 const (
 	SetPowerStateRequest_POWER_ACTION_UNSPECIFIED SetPowerStateRequest_PowerAction = 0
 	SetPowerStateRequest_POWER_ACTION_OFF         SetPowerStateRequest_PowerAction = 1 // Power off the node
 	SetPowerStateRequest_POWER_ACTION_CYCLE       SetPowerStateRequest_PowerAction = 2 // Cycle power to the node
+	SetPowerStateRequest_POWER_ACTION_SUSPEND     SetPowerStateRequest_PowerAction = 3 // Suspend to RAM
+	SetPowerStateRequest_POWER_ACTION_HIBERNATE   SetPowerStateRequest_PowerAction = 4 // Suspend to disk
+	SetPowerStateRequest_POWER_ACTION_SOFT_REBOOT SetPowerStateRequest_PowerAction = 5 // Restart the userspace without restarting the kernel (systemd soft-reboot)
 )
 
 // Enum value maps for SetPowerStateRequest_PowerAction.
@@ -100,11 +171,17 @@ var (
 		0: "POWER_ACTION_UNSPECIFIED",
 		1: "POWER_ACTION_OFF",
 		2: "POWER_ACTION_CYCLE",
+		3: "POWER_ACTION_SUSPEND",
+		4: "POWER_ACTION_HIBERNATE",
+		5: "POWER_ACTION_SOFT_REBOOT",
 	}
 	SetPowerStateRequest_PowerAction_value = map[string]int32{
 		"POWER_ACTION_UNSPECIFIED": 0,
 		"POWER_ACTION_OFF":         1,
 		"POWER_ACTION_CYCLE":       2,
+		"POWER_ACTION_SUSPEND":     3,
+		"POWER_ACTION_HIBERNATE":   4,
+		"POWER_ACTION_SOFT_REBOOT": 5,
 	}
 )
 
@@ -119,11 +196,11 @@ func (x SetPowerStateRequest_PowerAction) String() string {
 }
 
 func (SetPowerStateRequest_PowerAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_api_inbd_v1_inbd_proto_enumTypes[1].Descriptor()
+	return file_pkg_api_inbd_v1_inbd_proto_enumTypes[2].Descriptor()
 }
 
 func (SetPowerStateRequest_PowerAction) Type() protoreflect.EnumType {
-	return &file_pkg_api_inbd_v1_inbd_proto_enumTypes[1]
+	return &file_pkg_api_inbd_v1_inbd_proto_enumTypes[2]
 }
 
 func (x SetPowerStateRequest_PowerAction) Number() protoreflect.EnumNumber {
@@ -171,11 +248,11 @@ func (x UpdateSystemSoftwareRequest_DownloadMode) String() string {
 }
 
 func (UpdateSystemSoftwareRequest_DownloadMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_api_inbd_v1_inbd_proto_enumTypes[2].Descriptor()
+	return file_pkg_api_inbd_v1_inbd_proto_enumTypes[3].Descriptor()
 }
 
 func (UpdateSystemSoftwareRequest_DownloadMode) Type() protoreflect.EnumType {
-	return &file_pkg_api_inbd_v1_inbd_proto_enumTypes[2]
+	return &file_pkg_api_inbd_v1_inbd_proto_enumTypes[3]
 }
 
 func (x UpdateSystemSoftwareRequest_DownloadMode) Number() protoreflect.EnumNumber {
@@ -184,7 +261,59 @@ func (x UpdateSystemSoftwareRequest_DownloadMode) Number() protoreflect.EnumNumb
 
 // Deprecated: Use UpdateSystemSoftwareRequest_DownloadMode.Descriptor instead.
 func (UpdateSystemSoftwareRequest_DownloadMode) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{2, 0}
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type SoftwarePackageChange_ChangeType int32
+
+const (
+	SoftwarePackageChange_CHANGE_TYPE_UNSPECIFIED SoftwarePackageChange_ChangeType = 0
+	SoftwarePackageChange_CHANGE_TYPE_ADDED       SoftwarePackageChange_ChangeType = 1
+	SoftwarePackageChange_CHANGE_TYPE_REMOVED     SoftwarePackageChange_ChangeType = 2
+	SoftwarePackageChange_CHANGE_TYPE_CHANGED     SoftwarePackageChange_ChangeType = 3
+)
+
+// Enum value maps for SoftwarePackageChange_ChangeType.
+var (
+	SoftwarePackageChange_ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_ADDED",
+		2: "CHANGE_TYPE_REMOVED",
+		3: "CHANGE_TYPE_CHANGED",
+	}
+	SoftwarePackageChange_ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_ADDED":       1,
+		"CHANGE_TYPE_REMOVED":     2,
+		"CHANGE_TYPE_CHANGED":     3,
+	}
+)
+
+func (x SoftwarePackageChange_ChangeType) Enum() *SoftwarePackageChange_ChangeType {
+	p := new(SoftwarePackageChange_ChangeType)
+	*p = x
+	return p
+}
+
+func (x SoftwarePackageChange_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SoftwarePackageChange_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_inbd_v1_inbd_proto_enumTypes[4].Descriptor()
+}
+
+func (SoftwarePackageChange_ChangeType) Type() protoreflect.EnumType {
+	return &file_pkg_api_inbd_v1_inbd_proto_enumTypes[4]
+}
+
+func (x SoftwarePackageChange_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SoftwarePackageChange_ChangeType.Descriptor instead.
+func (SoftwarePackageChange_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{57, 0}
 }
 
 type SetPowerStateRequest struct {
@@ -192,7 +321,9 @@ type SetPowerStateRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action SetPowerStateRequest_PowerAction `protobuf:"varint,1,opt,name=action,proto3,enum=inbd.v1.SetPowerStateRequest_PowerAction" json:"action,omitempty"` // Action to perform on the node's power state
+	Action        SetPowerStateRequest_PowerAction `protobuf:"varint,1,opt,name=action,proto3,enum=inbd.v1.SetPowerStateRequest_PowerAction" json:"action,omitempty"` // Action to perform on the node's power state
+	DelaySeconds  uint32                           `protobuf:"varint,2,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`               // Perform the action after this many seconds; at most 30 days
+	ScheduledTime *timestamppb.Timestamp           `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`             // Perform the action at this time; can not be combined with delay_seconds
 }
 
 func (x *SetPowerStateRequest) Reset() {
@@ -234,6 +365,130 @@ func (x *SetPowerStateRequest) GetAction() SetPowerStateRequest_PowerAction {
 	return SetPowerStateRequest_POWER_ACTION_UNSPECIFIED
 }
 
+func (x *SetPowerStateRequest) GetDelaySeconds() uint32 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+func (x *SetPowerStateRequest) GetScheduledTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return nil
+}
+
+type CancelPowerActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionId string `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"` // ID of the pending action to cancel; all pending actions are canceled if empty
+}
+
+func (x *CancelPowerActionRequest) Reset() {
+	*x = CancelPowerActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPowerActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPowerActionRequest) ProtoMessage() {}
+
+func (x *CancelPowerActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPowerActionRequest.ProtoReflect.Descriptor instead.
+func (*CancelPowerActionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CancelPowerActionRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+type CancelPowerActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32              `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // Status code of the operation
+	Error      string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // set if there is an error
+	Canceled   []*PowerActionInfo `protobuf:"bytes,3,rep,name=canceled,proto3" json:"canceled,omitempty"`                        // The canceled actions
+}
+
+func (x *CancelPowerActionResponse) Reset() {
+	*x = CancelPowerActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPowerActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPowerActionResponse) ProtoMessage() {}
+
+func (x *CancelPowerActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPowerActionResponse.ProtoReflect.Descriptor instead.
+func (*CancelPowerActionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CancelPowerActionResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CancelPowerActionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CancelPowerActionResponse) GetCanceled() []*PowerActionInfo {
+	if x != nil {
+		return x.Canceled
+	}
+	return nil
+}
+
 type UpdateFirmwareRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -245,12 +500,13 @@ type UpdateFirmwareRequest struct {
 	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`                                // Username if authentication is required for the package source
 	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`                              // Signature of the package
 	HashAlgorithm string                 `protobuf:"bytes,6,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"` // "sha256", "sha384", "sha512"
+	ProvenanceUrl string                 `protobuf:"bytes,7,opt,name=provenance_url,json=provenanceUrl,proto3" json:"provenance_url,omitempty"` // URL of the Sigstore bundle, DSSE envelope or in-toto JSON lines provenance of the package
 }
 
 func (x *UpdateFirmwareRequest) Reset() {
 	*x = UpdateFirmwareRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[1]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -263,7 +519,7 @@ func (x *UpdateFirmwareRequest) String() string {
 func (*UpdateFirmwareRequest) ProtoMessage() {}
 
 func (x *UpdateFirmwareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[1]
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,7 +532,7 @@ func (x *UpdateFirmwareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFirmwareRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFirmwareRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{1}
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdateFirmwareRequest) GetUrl() string {
@@ -321,23 +577,32 @@ func (x *UpdateFirmwareRequest) GetHashAlgorithm() string {
 	return ""
 }
 
+func (x *UpdateFirmwareRequest) GetProvenanceUrl() string {
+	if x != nil {
+		return x.ProvenanceUrl
+	}
+	return ""
+}
+
 type UpdateSystemSoftwareRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url         string                                   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                                          // URL from which to remotely retrieve the package
-	ReleaseDate *timestamppb.Timestamp                   `protobuf:"bytes,2,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`                       // Release date of the new SW update.
-	Mode        UpdateSystemSoftwareRequest_DownloadMode `protobuf:"varint,3,opt,name=mode,proto3,enum=inbd.v1.UpdateSystemSoftwareRequest_DownloadMode" json:"mode,omitempty"` // Mode for installing the software update regarding download and install steps.
-	DoNotReboot bool                                     `protobuf:"varint,4,opt,name=do_not_reboot,json=doNotReboot,proto3" json:"do_not_reboot,omitempty"`                    // Whether to reboot the node after the software update attempt
-	PackageList []string                                 `protobuf:"bytes,5,rep,name=package_list,json=packageList,proto3" json:"package_list,omitempty"`                       // List of packages to install if whole package update isn't desired.
-	Signature   string                                   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`                                              // Signature of the package
+	Url              string                                   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                                          // URL from which to remotely retrieve the package
+	ReleaseDate      *timestamppb.Timestamp                   `protobuf:"bytes,2,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`                       // Release date of the new SW update.
+	Mode             UpdateSystemSoftwareRequest_DownloadMode `protobuf:"varint,3,opt,name=mode,proto3,enum=inbd.v1.UpdateSystemSoftwareRequest_DownloadMode" json:"mode,omitempty"` // Mode for installing the software update regarding download and install steps.
+	DoNotReboot      bool                                     `protobuf:"varint,4,opt,name=do_not_reboot,json=doNotReboot,proto3" json:"do_not_reboot,omitempty"`                    // Whether to reboot the node after the software update attempt
+	PackageList      []string                                 `protobuf:"bytes,5,rep,name=package_list,json=packageList,proto3" json:"package_list,omitempty"`                       // List of packages to install if whole package update isn't desired.
+	Signature        string                                   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`                                              // Signature of the package
+	ProvenanceUrl    string                                   `protobuf:"bytes,7,opt,name=provenance_url,json=provenanceUrl,proto3" json:"provenance_url,omitempty"`                 // URL of the Sigstore bundle, DSSE envelope or in-toto JSON lines provenance of the image
+	StagedArtifactId string                                   `protobuf:"bytes,8,opt,name=staged_artifact_id,json=stagedArtifactId,proto3" json:"staged_artifact_id,omitempty"`      // ID of a staged image to install; only with DOWNLOAD_MODE_NO_DOWNLOAD on EMT
 }
 
 func (x *UpdateSystemSoftwareRequest) Reset() {
 	*x = UpdateSystemSoftwareRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[2]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -350,7 +615,7 @@ func (x *UpdateSystemSoftwareRequest) String() string {
 func (*UpdateSystemSoftwareRequest) ProtoMessage() {}
 
 func (x *UpdateSystemSoftwareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[2]
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +628,7 @@ func (x *UpdateSystemSoftwareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSystemSoftwareRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSystemSoftwareRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{2}
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateSystemSoftwareRequest) GetUrl() string {
@@ -408,31 +673,53 @@ func (x *UpdateSystemSoftwareRequest) GetSignature() string {
 	return ""
 }
 
-type UpdateOSSourceRequest struct {
+func (x *UpdateSystemSoftwareRequest) GetProvenanceUrl() string {
+	if x != nil {
+		return x.ProvenanceUrl
+	}
+	return ""
+}
+
+func (x *UpdateSystemSoftwareRequest) GetStagedArtifactId() string {
+	if x != nil {
+		return x.StagedArtifactId
+	}
+	return ""
+}
+
+// An update image that was downloaded with DOWNLOAD_MODE_DOWNLOAD_ONLY and not yet installed
+type StagedArtifact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceList []string `protobuf:"bytes,1,rep,name=source_list,json=sourceList,proto3" json:"source_list,omitempty"` // List of sources to update.
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // ID to install or delete the image with
+	Url             string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                                // URL the image was downloaded from
+	FileName        string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`                      // File name of the image
+	Sha256          string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`                                          // SHA-256 of the image when it was staged
+	SizeBytes       int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`                  // Size of the image
+	SignatureStatus string                 `protobuf:"bytes,6,opt,name=signature_status,json=signatureStatus,proto3" json:"signature_status,omitempty"` // verified, or failed if the image no longer matches its SHA-256
+	StagedTime      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=staged_time,json=stagedTime,proto3" json:"staged_time,omitempty"`                // When the image was staged
+	Written         bool                   `protobuf:"varint,8,opt,name=written,proto3" json:"written,omitempty"`                                       // Whether the image is the one written to the update partition
 }
 
-func (x *UpdateOSSourceRequest) Reset() {
-	*x = UpdateOSSourceRequest{}
+func (x *StagedArtifact) Reset() {
+	*x = StagedArtifact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[3]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateOSSourceRequest) String() string {
+func (x *StagedArtifact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOSSourceRequest) ProtoMessage() {}
+func (*StagedArtifact) ProtoMessage() {}
 
-func (x *UpdateOSSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[3]
+func (x *StagedArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,115 +730,90 @@ func (x *UpdateOSSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOSSourceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOSSourceRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use StagedArtifact.ProtoReflect.Descriptor instead.
+func (*StagedArtifact) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdateOSSourceRequest) GetSourceList() []string {
+func (x *StagedArtifact) GetId() string {
 	if x != nil {
-		return x.SourceList
+		return x.Id
 	}
-	return nil
-}
-
-type AddApplicationSourceRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Source     []string `protobuf:"bytes,1,rep,name=source,proto3" json:"source,omitempty"`
-	Filename   string   `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	GpgKeyUri  string   `protobuf:"bytes,3,opt,name=gpg_key_uri,json=gpgKeyUri,proto3" json:"gpg_key_uri,omitempty"` // URL from which to remotely retrieve the package
-	GpgKeyName string   `protobuf:"bytes,4,opt,name=gpg_key_name,json=gpgKeyName,proto3" json:"gpg_key_name,omitempty"`
+	return ""
 }
 
-func (x *AddApplicationSourceRequest) Reset() {
-	*x = AddApplicationSourceRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StagedArtifact) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
+	return ""
 }
 
-func (x *AddApplicationSourceRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AddApplicationSourceRequest) ProtoMessage() {}
-
-func (x *AddApplicationSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StagedArtifact) GetFileName() string {
+	if x != nil {
+		return x.FileName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AddApplicationSourceRequest.ProtoReflect.Descriptor instead.
-func (*AddApplicationSourceRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{4}
+func (x *StagedArtifact) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
 }
 
-func (x *AddApplicationSourceRequest) GetSource() []string {
+func (x *StagedArtifact) GetSizeBytes() int64 {
 	if x != nil {
-		return x.Source
+		return x.SizeBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *AddApplicationSourceRequest) GetFilename() string {
+func (x *StagedArtifact) GetSignatureStatus() string {
 	if x != nil {
-		return x.Filename
+		return x.SignatureStatus
 	}
 	return ""
 }
 
-func (x *AddApplicationSourceRequest) GetGpgKeyUri() string {
+func (x *StagedArtifact) GetStagedTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GpgKeyUri
+		return x.StagedTime
 	}
-	return ""
+	return nil
 }
 
-func (x *AddApplicationSourceRequest) GetGpgKeyName() string {
+func (x *StagedArtifact) GetWritten() bool {
 	if x != nil {
-		return x.GpgKeyName
+		return x.Written
 	}
-	return ""
+	return false
 }
 
-type RemoveApplicationSourceRequest struct {
+type ListStagedArtifactsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Filename   string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	GpgKeyName string `protobuf:"bytes,2,opt,name=gpg_key_name,json=gpgKeyName,proto3" json:"gpg_key_name,omitempty"`
-}
-
-func (x *RemoveApplicationSourceRequest) Reset() {
-	*x = RemoveApplicationSourceRequest{}
+func (x *ListStagedArtifactsRequest) Reset() {
+	*x = ListStagedArtifactsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[5]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveApplicationSourceRequest) String() string {
+func (x *ListStagedArtifactsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveApplicationSourceRequest) ProtoMessage() {}
+func (*ListStagedArtifactsRequest) ProtoMessage() {}
 
-func (x *RemoveApplicationSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[5]
+func (x *ListStagedArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,51 +824,38 @@ func (x *RemoveApplicationSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveApplicationSourceRequest.ProtoReflect.Descriptor instead.
-func (*RemoveApplicationSourceRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *RemoveApplicationSourceRequest) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
-}
-
-func (x *RemoveApplicationSourceRequest) GetGpgKeyName() string {
-	if x != nil {
-		return x.GpgKeyName
-	}
-	return ""
+// Deprecated: Use ListStagedArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ListStagedArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{6}
 }
 
-type UpdateResponse struct {
+type ListStagedArtifactsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // Status code of the operation
-	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // set if there is an error
+	StatusCode int32             `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // Status code of the operation
+	Error      string            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // set if there is an error
+	Artifacts  []*StagedArtifact `protobuf:"bytes,3,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
 }
 
-func (x *UpdateResponse) Reset() {
-	*x = UpdateResponse{}
+func (x *ListStagedArtifactsResponse) Reset() {
+	*x = ListStagedArtifactsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[6]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateResponse) String() string {
+func (x *ListStagedArtifactsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResponse) ProtoMessage() {}
+func (*ListStagedArtifactsResponse) ProtoMessage() {}
 
-func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[6]
+func (x *ListStagedArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,51 +866,57 @@ func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
-func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListStagedArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ListStagedArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateResponse) GetStatusCode() int32 {
+func (x *ListStagedArtifactsResponse) GetStatusCode() int32 {
 	if x != nil {
 		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *UpdateResponse) GetError() string {
+func (x *ListStagedArtifactsResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type SetPowerStateResponse struct {
+func (x *ListStagedArtifactsResponse) GetArtifacts() []*StagedArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+type DeleteStagedArtifactRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // Status code of the operation
-	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // set if there is an error
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the staged image
 }
 
-func (x *SetPowerStateResponse) Reset() {
-	*x = SetPowerStateResponse{}
+func (x *DeleteStagedArtifactRequest) Reset() {
+	*x = DeleteStagedArtifactRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[7]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetPowerStateResponse) String() string {
+func (x *DeleteStagedArtifactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPowerStateResponse) ProtoMessage() {}
+func (*DeleteStagedArtifactRequest) ProtoMessage() {}
 
-func (x *SetPowerStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[7]
+func (x *DeleteStagedArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,52 +927,44 @@ func (x *SetPowerStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPowerStateResponse.ProtoReflect.Descriptor instead.
-func (*SetPowerStateResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *SetPowerStateResponse) GetStatusCode() int32 {
-	if x != nil {
-		return x.StatusCode
-	}
-	return 0
+// Deprecated: Use DeleteStagedArtifactRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStagedArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SetPowerStateResponse) GetError() string {
+func (x *DeleteStagedArtifactRequest) GetId() string {
 	if x != nil {
-		return x.Error
+		return x.Id
 	}
 	return ""
 }
 
-type LoadConfigRequest struct {
+type DeleteStagedArtifactResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri           string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Signature     string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`                              // Signature of the package
-	HashAlgorithm string `protobuf:"bytes,3,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"` // "sha256", "sha384", "sha512"
+	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // Status code of the operation
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // set if there is an error
 }
 
-func (x *LoadConfigRequest) Reset() {
-	*x = LoadConfigRequest{}
+func (x *DeleteStagedArtifactResponse) Reset() {
+	*x = DeleteStagedArtifactResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[8]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadConfigRequest) String() string {
+func (x *DeleteStagedArtifactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadConfigRequest) ProtoMessage() {}
+func (*DeleteStagedArtifactResponse) ProtoMessage() {}
 
-func (x *LoadConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[8]
+func (x *DeleteStagedArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,57 +975,50 @@ func (x *LoadConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadConfigRequest.ProtoReflect.Descriptor instead.
-func (*LoadConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *LoadConfigRequest) GetUri() string {
-	if x != nil {
-		return x.Uri
-	}
-	return ""
+// Deprecated: Use DeleteStagedArtifactResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStagedArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LoadConfigRequest) GetSignature() string {
+func (x *DeleteStagedArtifactResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.Signature
+		return x.StatusCode
 	}
-	return ""
+	return 0
 }
 
-func (x *LoadConfigRequest) GetHashAlgorithm() string {
+func (x *DeleteStagedArtifactResponse) GetError() string {
 	if x != nil {
-		return x.HashAlgorithm
+		return x.Error
 	}
 	return ""
 }
 
-type GetConfigRequest struct {
+type UpdateOSSourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SourceList []string `protobuf:"bytes,1,rep,name=source_list,json=sourceList,proto3" json:"source_list,omitempty"` // List of sources to update.
 }
 
-func (x *GetConfigRequest) Reset() {
-	*x = GetConfigRequest{}
+func (x *UpdateOSSourceRequest) Reset() {
+	*x = UpdateOSSourceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[9]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetConfigRequest) String() string {
+func (x *UpdateOSSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigRequest) ProtoMessage() {}
+func (*UpdateOSSourceRequest) ProtoMessage() {}
 
-func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[9]
+func (x *UpdateOSSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,43 +1029,49 @@ func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdateOSSourceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOSSourceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetConfigRequest) GetPath() string {
+func (x *UpdateOSSourceRequest) GetSourceList() []string {
 	if x != nil {
-		return x.Path
+		return x.SourceList
 	}
-	return ""
+	return nil
 }
 
-type SetConfigRequest struct {
+type AddApplicationSourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Source     []string `protobuf:"bytes,1,rep,name=source,proto3" json:"source,omitempty"`
+	Filename   string   `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	GpgKeyUri  string   `protobuf:"bytes,3,opt,name=gpg_key_uri,json=gpgKeyUri,proto3" json:"gpg_key_uri,omitempty"` // URL from which to remotely retrieve the package
+	GpgKeyName string   `protobuf:"bytes,4,opt,name=gpg_key_name,json=gpgKeyName,proto3" json:"gpg_key_name,omitempty"`
+	// Structured source written as a deb822 <name>.sources file with a Signed-By keyring.  When set,
+	// source, filename and gpg_key_name must be empty, and gpg_key_uri is required.
+	ApplicationSource *ApplicationSource `protobuf:"bytes,5,opt,name=application_source,json=applicationSource,proto3" json:"application_source,omitempty"`
 }
 
-func (x *SetConfigRequest) Reset() {
-	*x = SetConfigRequest{}
+func (x *AddApplicationSourceRequest) Reset() {
+	*x = AddApplicationSourceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[10]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetConfigRequest) String() string {
+func (x *AddApplicationSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetConfigRequest) ProtoMessage() {}
+func (*AddApplicationSourceRequest) ProtoMessage() {}
 
-func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[10]
+func (x *AddApplicationSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,43 +1082,72 @@ func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetConfigRequest.ProtoReflect.Descriptor instead.
-func (*SetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AddApplicationSourceRequest.ProtoReflect.Descriptor instead.
+func (*AddApplicationSourceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SetConfigRequest) GetPath() string {
+func (x *AddApplicationSourceRequest) GetSource() []string {
 	if x != nil {
-		return x.Path
+		return x.Source
+	}
+	return nil
+}
+
+func (x *AddApplicationSourceRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
 	}
 	return ""
 }
 
-type AppendConfigRequest struct {
+func (x *AddApplicationSourceRequest) GetGpgKeyUri() string {
+	if x != nil {
+		return x.GpgKeyUri
+	}
+	return ""
+}
+
+func (x *AddApplicationSourceRequest) GetGpgKeyName() string {
+	if x != nil {
+		return x.GpgKeyName
+	}
+	return ""
+}
+
+func (x *AddApplicationSourceRequest) GetApplicationSource() *ApplicationSource {
+	if x != nil {
+		return x.ApplicationSource
+	}
+	return nil
+}
+
+type RemoveApplicationSourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Filename   string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	GpgKeyName string `protobuf:"bytes,2,opt,name=gpg_key_name,json=gpgKeyName,proto3" json:"gpg_key_name,omitempty"`
 }
 
-func (x *AppendConfigRequest) Reset() {
-	*x = AppendConfigRequest{}
+func (x *RemoveApplicationSourceRequest) Reset() {
+	*x = RemoveApplicationSourceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[11]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AppendConfigRequest) String() string {
+func (x *RemoveApplicationSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppendConfigRequest) ProtoMessage() {}
+func (*RemoveApplicationSourceRequest) ProtoMessage() {}
 
-func (x *AppendConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[11]
+func (x *RemoveApplicationSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,43 +1158,60 @@ func (x *AppendConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppendConfigRequest.ProtoReflect.Descriptor instead.
-func (*AppendConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RemoveApplicationSourceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveApplicationSourceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AppendConfigRequest) GetPath() string {
+func (x *RemoveApplicationSourceRequest) GetFilename() string {
 	if x != nil {
-		return x.Path
+		return x.Filename
 	}
 	return ""
 }
 
-type RemoveConfigRequest struct {
+func (x *RemoveApplicationSourceRequest) GetGpgKeyName() string {
+	if x != nil {
+		return x.GpgKeyName
+	}
+	return ""
+}
+
+// An apt repository in the fields of the deb822 sources format
+type ApplicationSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Name           string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                           // Name of the .sources file and of the keyring, without extension
+	Types          []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`                                         // deb and/or deb-src; deb if empty
+	Uris           []string `protobuf:"bytes,3,rep,name=uris,proto3" json:"uris,omitempty"`                                           // Repository URIs
+	Suites         []string `protobuf:"bytes,4,rep,name=suites,proto3" json:"suites,omitempty"`                                       // e.g. noble; a suite ending in / is a flat repository
+	Components     []string `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`                               // e.g. main; must be empty for a flat repository
+	Architectures  []string `protobuf:"bytes,6,rep,name=architectures,proto3" json:"architectures,omitempty"`                         // e.g. amd64; all architectures of the system if empty
+	KeyFingerprint string   `protobuf:"bytes,7,opt,name=key_fingerprint,json=keyFingerprint,proto3" json:"key_fingerprint,omitempty"` // Pinned fingerprint of the key that signs the repository
+	SignedBy       string   `protobuf:"bytes,8,opt,name=signed_by,json=signedBy,proto3" json:"signed_by,omitempty"`                   // Keyring of the repository; output only
+	Filename       string   `protobuf:"bytes,9,opt,name=filename,proto3" json:"filename,omitempty"`                                   // File under /etc/apt/sources.list.d; output only
+	Enabled        bool     `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`                                   // False if the source has Enabled: no; output only
 }
 
-func (x *RemoveConfigRequest) Reset() {
-	*x = RemoveConfigRequest{}
+func (x *ApplicationSource) Reset() {
+	*x = ApplicationSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[12]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveConfigRequest) String() string {
+func (x *ApplicationSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveConfigRequest) ProtoMessage() {}
+func (*ApplicationSource) ProtoMessage() {}
 
-func (x *RemoveConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[12]
+func (x *ApplicationSource) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,103 +1222,89 @@ func (x *RemoveConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveConfigRequest.ProtoReflect.Descriptor instead.
-func (*RemoveConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ApplicationSource.ProtoReflect.Descriptor instead.
+func (*ApplicationSource) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RemoveConfigRequest) GetPath() string {
+func (x *ApplicationSource) GetName() string {
 	if x != nil {
-		return x.Path
+		return x.Name
 	}
 	return ""
 }
 
-type ConfigResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // Status code of the operation
-	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // set if there is an error
-	Success    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	Message    string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+func (x *ApplicationSource) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
 }
 
-func (x *ConfigResponse) Reset() {
-	*x = ConfigResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ApplicationSource) GetUris() []string {
+	if x != nil {
+		return x.Uris
 	}
+	return nil
 }
 
-func (x *ConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ApplicationSource) GetSuites() []string {
+	if x != nil {
+		return x.Suites
+	}
+	return nil
 }
 
-func (*ConfigResponse) ProtoMessage() {}
-
-func (x *ConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ApplicationSource) GetComponents() []string {
+	if x != nil {
+		return x.Components
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ConfigResponse.ProtoReflect.Descriptor instead.
-func (*ConfigResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{13}
+func (x *ApplicationSource) GetArchitectures() []string {
+	if x != nil {
+		return x.Architectures
+	}
+	return nil
 }
 
-func (x *ConfigResponse) GetStatusCode() int32 {
+func (x *ApplicationSource) GetKeyFingerprint() string {
 	if x != nil {
-		return x.StatusCode
+		return x.KeyFingerprint
 	}
-	return 0
+	return ""
 }
 
-func (x *ConfigResponse) GetError() string {
+func (x *ApplicationSource) GetSignedBy() string {
 	if x != nil {
-		return x.Error
+		return x.SignedBy
 	}
 	return ""
 }
 
-func (x *ConfigResponse) GetSuccess() bool {
+func (x *ApplicationSource) GetFilename() string {
 	if x != nil {
-		return x.Success
+		return x.Filename
 	}
-	return false
+	return ""
 }
 
-func (x *ConfigResponse) GetMessage() string {
+func (x *ApplicationSource) GetEnabled() bool {
 	if x != nil {
-		return x.Message
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-type GetConfigResponse struct {
+type ListApplicationSourcesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Success    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	Value      string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Message    string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetConfigResponse) Reset() {
-	*x = GetConfigResponse{}
+func (x *ListApplicationSourcesRequest) Reset() {
+	*x = ListApplicationSourcesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1034,13 +1312,13 @@ func (x *GetConfigResponse) Reset() {
 	}
 }
 
-func (x *GetConfigResponse) String() string {
+func (x *ListApplicationSourcesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigResponse) ProtoMessage() {}
+func (*ListApplicationSourcesRequest) ProtoMessage() {}
 
-func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *ListApplicationSourcesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1052,71 +1330,101 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListApplicationSourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationSourcesRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetConfigResponse) GetStatusCode() int32 {
-	if x != nil {
-		return x.StatusCode
+type ListApplicationSourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32                `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // Status code of the operation
+	Error      string               `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // set if there is an error
+	Sources    []*ApplicationSource `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`                          // Sources under /etc/apt/sources.list.d, ordered by filename
+}
+
+func (x *ListApplicationSourcesResponse) Reset() {
+	*x = ListApplicationSourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetConfigResponse) GetError() string {
-	if x != nil {
-		return x.Error
+func (x *ListApplicationSourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationSourcesResponse) ProtoMessage() {}
+
+func (x *ListApplicationSourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetConfigResponse) GetSuccess() bool {
+// Deprecated: Use ListApplicationSourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationSourcesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListApplicationSourcesResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.Success
+		return x.StatusCode
 	}
-	return false
+	return 0
 }
 
-func (x *GetConfigResponse) GetValue() string {
+func (x *ListApplicationSourcesResponse) GetError() string {
 	if x != nil {
-		return x.Value
+		return x.Error
 	}
 	return ""
 }
 
-func (x *GetConfigResponse) GetMessage() string {
+func (x *ListApplicationSourcesResponse) GetSources() []*ApplicationSource {
 	if x != nil {
-		return x.Message
+		return x.Sources
 	}
-	return ""
+	return nil
 }
 
-type QueryRequest struct {
+type UpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Option QueryOption `protobuf:"varint,1,opt,name=option,proto3,enum=inbd.v1.QueryOption" json:"option,omitempty"`
+	StatusCode       int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`                    // Status code of the operation
+	Error            string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                                                 // set if there is an error
+	StagedArtifactId string `protobuf:"bytes,3,opt,name=staged_artifact_id,json=stagedArtifactId,proto3" json:"staged_artifact_id,omitempty"` // ID of the image staged by DOWNLOAD_MODE_DOWNLOAD_ONLY on EMT
 }
 
-func (x *QueryRequest) Reset() {
-	*x = QueryRequest{}
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[15]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequest) String() string {
+func (x *UpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequest) ProtoMessage() {}
+func (*UpdateResponse) ProtoMessage() {}
 
-func (x *QueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[15]
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,46 +1435,60 @@ func (x *QueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryRequest) GetOption() QueryOption {
+func (x *UpdateResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.Option
+		return x.StatusCode
 	}
-	return QueryOption_QUERY_OPTION_UNSPECIFIED
+	return 0
 }
 
-type QueryResponse struct {
+func (x *UpdateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UpdateResponse) GetStagedArtifactId() string {
+	if x != nil {
+		return x.StagedArtifactId
+	}
+	return ""
+}
+
+type SetPowerStateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int32      `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // HTTP-style status code
-	Error      string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // Error message if any
-	Success    bool       `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`                         // Success indicator
-	Data       *QueryData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`                                // Query result data
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`         // Status code of the operation
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                                      // set if there is an error
+	ActionId      string                 `protobuf:"bytes,3,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`                // ID of the pending action if it was scheduled
+	ScheduledTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"` // When the pending action will be performed
 }
 
-func (x *QueryResponse) Reset() {
-	*x = QueryResponse{}
+func (x *SetPowerStateResponse) Reset() {
+	*x = SetPowerStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[16]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryResponse) String() string {
+func (x *SetPowerStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryResponse) ProtoMessage() {}
+func (*SetPowerStateResponse) ProtoMessage() {}
 
-func (x *QueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[16]
+func (x *SetPowerStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,74 +1499,67 @@ func (x *QueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
-func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SetPowerStateResponse.ProtoReflect.Descriptor instead.
+func (*SetPowerStateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryResponse) GetStatusCode() int32 {
+func (x *SetPowerStateResponse) GetStatusCode() int32 {
 	if x != nil {
 		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *QueryResponse) GetError() string {
+func (x *SetPowerStateResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-func (x *QueryResponse) GetSuccess() bool {
+func (x *SetPowerStateResponse) GetActionId() string {
 	if x != nil {
-		return x.Success
+		return x.ActionId
 	}
-	return false
+	return ""
 }
 
-func (x *QueryResponse) GetData() *QueryData {
+func (x *SetPowerStateResponse) GetScheduledTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Data
+		return x.ScheduledTime
 	}
 	return nil
 }
 
-type QueryData struct {
+type LoadConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Timestamp when data was collected
-	Type      string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`           // Type of telemetry data
-	// Types that are assignable to Values:
-	//
-	//	*QueryData_Hardware
-	//	*QueryData_Firmware
-	//	*QueryData_OsInfo
-	//	*QueryData_Swbom
-	//	*QueryData_Version
-	//	*QueryData_AllInfo
-	Values isQueryData_Values `protobuf_oneof:"values"`
+	Uri           string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Signature     string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`                              // Signature of the package
+	HashAlgorithm string `protobuf:"bytes,3,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"` // "sha256", "sha384", "sha512"
+	ProvenanceUri string `protobuf:"bytes,4,opt,name=provenance_uri,json=provenanceUri,proto3" json:"provenance_uri,omitempty"` // Local path of the provenance of the configuration file
 }
 
-func (x *QueryData) Reset() {
-	*x = QueryData{}
+func (x *LoadConfigRequest) Reset() {
+	*x = LoadConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[17]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryData) String() string {
+func (x *LoadConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryData) ProtoMessage() {}
+func (*LoadConfigRequest) ProtoMessage() {}
 
-func (x *QueryData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[17]
+func (x *LoadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,144 +1570,2921 @@ func (x *QueryData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryData.ProtoReflect.Descriptor instead.
-func (*QueryData) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use LoadConfigRequest.ProtoReflect.Descriptor instead.
+func (*LoadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryData) GetTimestamp() *timestamppb.Timestamp {
+func (x *LoadConfigRequest) GetUri() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Uri
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryData) GetType() string {
+func (x *LoadConfigRequest) GetSignature() string {
 	if x != nil {
-		return x.Type
+		return x.Signature
 	}
 	return ""
 }
 
-func (m *QueryData) GetValues() isQueryData_Values {
-	if m != nil {
-		return m.Values
+func (x *LoadConfigRequest) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryData) GetHardware() *HardwareInfo {
-	if x, ok := x.GetValues().(*QueryData_Hardware); ok {
-		return x.Hardware
+func (x *LoadConfigRequest) GetProvenanceUri() string {
+	if x != nil {
+		return x.ProvenanceUri
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryData) GetFirmware() *FirmwareInfo {
-	if x, ok := x.GetValues().(*QueryData_Firmware); ok {
-		return x.Firmware
+type LoadVulnerabilityDatabaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri           string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`                                          // Local path of an OSV (.json or .zip) or OVAL (.xml or .xml.bz2) dataset
+	Signature     string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`                              // Signature of the dataset
+	HashAlgorithm string `protobuf:"bytes,3,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"` // "sha256", "sha384", "sha512"
+}
+
+func (x *LoadVulnerabilityDatabaseRequest) Reset() {
+	*x = LoadVulnerabilityDatabaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadVulnerabilityDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadVulnerabilityDatabaseRequest) ProtoMessage() {}
+
+func (x *LoadVulnerabilityDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadVulnerabilityDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*LoadVulnerabilityDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LoadVulnerabilityDatabaseRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *LoadVulnerabilityDatabaseRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *LoadVulnerabilityDatabaseRequest) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
+	}
+	return ""
+}
+
+type LoadVulnerabilityDatabaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // Status code of the operation
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // set if there is an error
+	Success    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Format     string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`          // osv or oval
+	Advisories int32  `protobuf:"varint,5,opt,name=advisories,proto3" json:"advisories,omitempty"` // Number of advisories kept for this distribution release
+}
+
+func (x *LoadVulnerabilityDatabaseResponse) Reset() {
+	*x = LoadVulnerabilityDatabaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadVulnerabilityDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadVulnerabilityDatabaseResponse) ProtoMessage() {}
+
+func (x *LoadVulnerabilityDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadVulnerabilityDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*LoadVulnerabilityDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LoadVulnerabilityDatabaseResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *LoadVulnerabilityDatabaseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LoadVulnerabilityDatabaseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LoadVulnerabilityDatabaseResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *LoadVulnerabilityDatabaseResponse) GetAdvisories() int32 {
+	if x != nil {
+		return x.Advisories
+	}
+	return 0
+}
+
+type GetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetConfigRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type SetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                            // "key:value;key2:value2"; values are typed by the schema
+	JsonPatch string `protobuf:"bytes,2,opt,name=json_patch,json=jsonPatch,proto3" json:"json_patch,omitempty"` // RFC 6902 JSON Patch document, applied atomically
+}
+
+func (x *SetConfigRequest) Reset() {
+	*x = SetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigRequest) ProtoMessage() {}
+
+func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetConfigRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetConfigRequest) GetJsonPatch() string {
+	if x != nil {
+		return x.JsonPatch
+	}
+	return ""
+}
+
+type AppendConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *AppendConfigRequest) Reset() {
+	*x = AppendConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendConfigRequest) ProtoMessage() {}
+
+func (x *AppendConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendConfigRequest.ProtoReflect.Descriptor instead.
+func (*AppendConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AppendConfigRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveConfigRequest) Reset() {
+	*x = RemoveConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveConfigRequest) ProtoMessage() {}
+
+func (x *RemoveConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveConfigRequest.ProtoReflect.Descriptor instead.
+func (*RemoveConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveConfigRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetConfigHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Number of most recent revisions to return; 0 returns all
+}
+
+func (x *GetConfigHistoryRequest) Reset() {
+	*x = GetConfigHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigHistoryRequest) ProtoMessage() {}
+
+func (x *GetConfigHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetConfigHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetConfigHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32             `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error      string            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Revisions  []*ConfigRevision `protobuf:"bytes,3,rep,name=revisions,proto3" json:"revisions,omitempty"` // Newest first
+}
+
+func (x *GetConfigHistoryResponse) Reset() {
+	*x = GetConfigHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigHistoryResponse) ProtoMessage() {}
+
+func (x *GetConfigHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetConfigHistoryResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetConfigHistoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetConfigHistoryResponse) GetRevisions() []*ConfigRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// A stored revision of the configuration file
+type ConfigRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision  int32                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`  // Revision number, increasing with every change
+	Time      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`           // When the revision was written
+	Caller    string                 `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`       // Who made the change
+	Operation string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"` // initial, load, set, append, remove or rollback
+	Summary   string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`     // Summary of the change
+}
+
+func (x *ConfigRevision) Reset() {
+	*x = ConfigRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRevision) ProtoMessage() {}
+
+func (x *ConfigRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRevision.ProtoReflect.Descriptor instead.
+func (*ConfigRevision) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ConfigRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ConfigRevision) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ConfigRevision) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *ConfigRevision) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ConfigRevision) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+type DiffConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromRevision int32 `protobuf:"varint,1,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"` // Revision to compare from; 0 is the revision before to_revision
+	ToRevision   int32 `protobuf:"varint,2,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`       // Revision to compare to; 0 is the current configuration
+}
+
+func (x *DiffConfigRequest) Reset() {
+	*x = DiffConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigRequest) ProtoMessage() {}
+
+func (x *DiffConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigRequest.ProtoReflect.Descriptor instead.
+func (*DiffConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DiffConfigRequest) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffConfigRequest) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+type DiffConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode   int32           `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error        string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	FromRevision int32           `protobuf:"varint,3,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision   int32           `protobuf:"varint,4,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	Changes      []*ConfigChange `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *DiffConfigResponse) Reset() {
+	*x = DiffConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigResponse) ProtoMessage() {}
+
+func (x *DiffConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigResponse.ProtoReflect.Descriptor instead.
+func (*DiffConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DiffConfigResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *DiffConfigResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DiffConfigResponse) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffConfigResponse) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *DiffConfigResponse) GetChanges() []*ConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// A changed value of the configuration file
+type ConfigChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                         // Dotted path of the value, e.g. os_updater.trustedRepositories
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // JSON value before the change; empty if the value was added
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // JSON value after the change; empty if the value was removed
+}
+
+func (x *ConfigChange) Reset() {
+	*x = ConfigChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigChange) ProtoMessage() {}
+
+func (x *ConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigChange.ProtoReflect.Descriptor instead.
+func (*ConfigChange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ConfigChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type RollbackConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision int32 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"` // Revision to restore
+}
+
+func (x *RollbackConfigRequest) Reset() {
+	*x = RollbackConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackConfigRequest) ProtoMessage() {}
+
+func (x *RollbackConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackConfigRequest.ProtoReflect.Descriptor instead.
+func (*RollbackConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RollbackConfigRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // Status code of the operation
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // set if there is an error
+	Success    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message    string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ConfigResponse) Reset() {
+	*x = ConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigResponse) ProtoMessage() {}
+
+func (x *ConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigResponse.ProtoReflect.Descriptor instead.
+func (*ConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ConfigResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ConfigResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Success    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Value      string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Message    string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigResponse) ProtoMessage() {}
+
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetConfigResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetConfigResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetConfigResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GetConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type QueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Option QueryOption `protobuf:"varint,1,opt,name=option,proto3,enum=inbd.v1.QueryOption" json:"option,omitempty"`
+}
+
+func (x *QueryRequest) Reset() {
+	*x = QueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequest) ProtoMessage() {}
+
+func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QueryRequest) GetOption() QueryOption {
+	if x != nil {
+		return x.Option
+	}
+	return QueryOption_QUERY_OPTION_UNSPECIFIED
+}
+
+type QueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32      `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // HTTP-style status code
+	Error      string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                              // Error message if any
+	Success    bool       `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`                         // Success indicator
+	Data       *QueryData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`                                // Query result data
+}
+
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResponse) ProtoMessage() {}
+
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *QueryResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *QueryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *QueryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *QueryResponse) GetData() *QueryData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type QueryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Timestamp when data was collected
+	Type      string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`           // Type of telemetry data
+	// Types that are assignable to Values:
+	//
+	//	*QueryData_Hardware
+	//	*QueryData_Firmware
+	//	*QueryData_OsInfo
+	//	*QueryData_Swbom
+	//	*QueryData_Version
+	//	*QueryData_AllInfo
+	//	*QueryData_FirmwareComponents
+	//	*QueryData_AuditLog
+	//	*QueryData_Provenance
+	//	*QueryData_Vulnerabilities
+	//	*QueryData_Power
+	Values isQueryData_Values `protobuf_oneof:"values"`
+}
+
+func (x *QueryData) Reset() {
+	*x = QueryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryData) ProtoMessage() {}
+
+func (x *QueryData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryData.ProtoReflect.Descriptor instead.
+func (*QueryData) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QueryData) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *QueryData) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (m *QueryData) GetValues() isQueryData_Values {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (x *QueryData) GetHardware() *HardwareInfo {
+	if x, ok := x.GetValues().(*QueryData_Hardware); ok {
+		return x.Hardware
+	}
+	return nil
+}
+
+func (x *QueryData) GetFirmware() *FirmwareInfo {
+	if x, ok := x.GetValues().(*QueryData_Firmware); ok {
+		return x.Firmware
+	}
+	return nil
+}
+
+func (x *QueryData) GetOsInfo() *OSInfo {
+	if x, ok := x.GetValues().(*QueryData_OsInfo); ok {
+		return x.OsInfo
+	}
+	return nil
+}
+
+func (x *QueryData) GetSwbom() *SWBOMInfo {
+	if x, ok := x.GetValues().(*QueryData_Swbom); ok {
+		return x.Swbom
+	}
+	return nil
+}
+
+func (x *QueryData) GetVersion() *VersionInfo {
+	if x, ok := x.GetValues().(*QueryData_Version); ok {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *QueryData) GetAllInfo() *AllInfo {
+	if x, ok := x.GetValues().(*QueryData_AllInfo); ok {
+		return x.AllInfo
+	}
+	return nil
+}
+
+func (x *QueryData) GetFirmwareComponents() *FirmwareComponentsInfo {
+	if x, ok := x.GetValues().(*QueryData_FirmwareComponents); ok {
+		return x.FirmwareComponents
+	}
+	return nil
+}
+
+func (x *QueryData) GetAuditLog() *AuditLogInfo {
+	if x, ok := x.GetValues().(*QueryData_AuditLog); ok {
+		return x.AuditLog
+	}
+	return nil
+}
+
+func (x *QueryData) GetProvenance() *ProvenanceInfo {
+	if x, ok := x.GetValues().(*QueryData_Provenance); ok {
+		return x.Provenance
+	}
+	return nil
+}
+
+func (x *QueryData) GetVulnerabilities() *VulnerabilitiesInfo {
+	if x, ok := x.GetValues().(*QueryData_Vulnerabilities); ok {
+		return x.Vulnerabilities
+	}
+	return nil
+}
+
+func (x *QueryData) GetPower() *PowerInfo {
+	if x, ok := x.GetValues().(*QueryData_Power); ok {
+		return x.Power
+	}
+	return nil
+}
+
+type isQueryData_Values interface {
+	isQueryData_Values()
+}
+
+type QueryData_Hardware struct {
+	Hardware *HardwareInfo `protobuf:"bytes,3,opt,name=hardware,proto3,oneof"` // Hardware information
+}
+
+type QueryData_Firmware struct {
+	Firmware *FirmwareInfo `protobuf:"bytes,4,opt,name=firmware,proto3,oneof"` // Firmware information
+}
+
+type QueryData_OsInfo struct {
+	OsInfo *OSInfo `protobuf:"bytes,5,opt,name=os_info,json=osInfo,proto3,oneof"` // Operating system information
+}
+
+type QueryData_Swbom struct {
+	Swbom *SWBOMInfo `protobuf:"bytes,6,opt,name=swbom,proto3,oneof"` // Software BOM information
+}
+
+type QueryData_Version struct {
+	Version *VersionInfo `protobuf:"bytes,7,opt,name=version,proto3,oneof"` // Version information
+}
+
+type QueryData_AllInfo struct {
+	AllInfo *AllInfo `protobuf:"bytes,8,opt,name=all_info,json=allInfo,proto3,oneof"` // All information combined
+}
+
+type QueryData_FirmwareComponents struct {
+	FirmwareComponents *FirmwareComponentsInfo `protobuf:"bytes,9,opt,name=firmware_components,json=firmwareComponents,proto3,oneof"` // UEFI ESRT firmware components
+}
+
+type QueryData_AuditLog struct {
+	AuditLog *AuditLogInfo `protobuf:"bytes,10,opt,name=audit_log,json=auditLog,proto3,oneof"` // Recent entries of the command audit log
+}
+
+type QueryData_Provenance struct {
+	Provenance *ProvenanceInfo `protobuf:"bytes,11,opt,name=provenance,proto3,oneof"` // Recent provenance verification results
+}
+
+type QueryData_Vulnerabilities struct {
+	Vulnerabilities *VulnerabilitiesInfo `protobuf:"bytes,12,opt,name=vulnerabilities,proto3,oneof"` // Vulnerabilities of the installed packages
+}
+
+type QueryData_Power struct {
+	Power *PowerInfo `protobuf:"bytes,13,opt,name=power,proto3,oneof"` // Power capabilities and pending power actions
+}
+
+func (*QueryData_Hardware) isQueryData_Values() {}
+
+func (*QueryData_Firmware) isQueryData_Values() {}
+
+func (*QueryData_OsInfo) isQueryData_Values() {}
+
+func (*QueryData_Swbom) isQueryData_Values() {}
+
+func (*QueryData_Version) isQueryData_Values() {}
+
+func (*QueryData_AllInfo) isQueryData_Values() {}
+
+func (*QueryData_FirmwareComponents) isQueryData_Values() {}
+
+func (*QueryData_AuditLog) isQueryData_Values() {}
+
+func (*QueryData_Provenance) isQueryData_Values() {}
+
+func (*QueryData_Vulnerabilities) isQueryData_Values() {}
+
+func (*QueryData_Power) isQueryData_Values() {}
+
+// Hardware information structure
+type HardwareInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpuId               string              `protobuf:"bytes,1,opt,name=cpu_id,json=cpuId,proto3" json:"cpu_id,omitempty"`                                             // cpuId
+	TotalPhysicalMemory string              `protobuf:"bytes,2,opt,name=total_physical_memory,json=totalPhysicalMemory,proto3" json:"total_physical_memory,omitempty"` // totalPhysicalMemory
+	DiskInformation     string              `protobuf:"bytes,3,opt,name=disk_information,json=diskInformation,proto3" json:"disk_information,omitempty"`               // diskInformation
+	SystemManufacturer  string              `protobuf:"bytes,4,opt,name=system_manufacturer,json=systemManufacturer,proto3" json:"system_manufacturer,omitempty"`      // systemManufacturer
+	SystemProductName   string              `protobuf:"bytes,5,opt,name=system_product_name,json=systemProductName,proto3" json:"system_product_name,omitempty"`       // systemProductName
+	Disks               []*DiskInfo         `protobuf:"bytes,6,rep,name=disks,proto3" json:"disks,omitempty"`                                                          // Block devices from /sys/block
+	NetworkInterfaces   []*NetworkInterface `protobuf:"bytes,7,rep,name=network_interfaces,json=networkInterfaces,proto3" json:"network_interfaces,omitempty"`         // Physical network interfaces from /sys/class/net
+	PciDevices          []*PCIDevice        `protobuf:"bytes,8,rep,name=pci_devices,json=pciDevices,proto3" json:"pci_devices,omitempty"`                              // PCI devices from /sys/bus/pci/devices
+	Tpm                 *TPMInfo            `protobuf:"bytes,9,opt,name=tpm,proto3" json:"tpm,omitempty"`                                                              // TPM from /sys/class/tpm
+	SecureBoot          string              `protobuf:"bytes,10,opt,name=secure_boot,json=secureBoot,proto3" json:"secure_boot,omitempty"`                             // enabled, disabled, setup_mode, unsupported (not booted with UEFI) or unknown
+	MemoryModules       []*MemoryModule     `protobuf:"bytes,11,rep,name=memory_modules,json=memoryModules,proto3" json:"memory_modules,omitempty"`                    // Installed memory modules from the SMBIOS type 17 entries
+}
+
+func (x *HardwareInfo) Reset() {
+	*x = HardwareInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HardwareInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HardwareInfo) ProtoMessage() {}
+
+func (x *HardwareInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HardwareInfo.ProtoReflect.Descriptor instead.
+func (*HardwareInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HardwareInfo) GetCpuId() string {
+	if x != nil {
+		return x.CpuId
+	}
+	return ""
+}
+
+func (x *HardwareInfo) GetTotalPhysicalMemory() string {
+	if x != nil {
+		return x.TotalPhysicalMemory
+	}
+	return ""
+}
+
+func (x *HardwareInfo) GetDiskInformation() string {
+	if x != nil {
+		return x.DiskInformation
+	}
+	return ""
+}
+
+func (x *HardwareInfo) GetSystemManufacturer() string {
+	if x != nil {
+		return x.SystemManufacturer
+	}
+	return ""
+}
+
+func (x *HardwareInfo) GetSystemProductName() string {
+	if x != nil {
+		return x.SystemProductName
+	}
+	return ""
+}
+
+func (x *HardwareInfo) GetDisks() []*DiskInfo {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *HardwareInfo) GetNetworkInterfaces() []*NetworkInterface {
+	if x != nil {
+		return x.NetworkInterfaces
+	}
+	return nil
+}
+
+func (x *HardwareInfo) GetPciDevices() []*PCIDevice {
+	if x != nil {
+		return x.PciDevices
+	}
+	return nil
+}
+
+func (x *HardwareInfo) GetTpm() *TPMInfo {
+	if x != nil {
+		return x.Tpm
+	}
+	return nil
+}
+
+func (x *HardwareInfo) GetSecureBoot() string {
+	if x != nil {
+		return x.SecureBoot
+	}
+	return ""
+}
+
+func (x *HardwareInfo) GetMemoryModules() []*MemoryModule {
+	if x != nil {
+		return x.MemoryModules
+	}
+	return nil
+}
+
+// Block device
+type DiskInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                 // Kernel name, e.g. sda or nvme0n1
+	Model            string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`                                               // Model
+	Serial           string `protobuf:"bytes,3,opt,name=serial,proto3" json:"serial,omitempty"`                                             // Serial number
+	SizeBytes        uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`                     // Size in bytes
+	Rotational       bool   `protobuf:"varint,5,opt,name=rotational,proto3" json:"rotational,omitempty"`                                    // Whether the disk is rotational (HDD)
+	Removable        bool   `protobuf:"varint,6,opt,name=removable,proto3" json:"removable,omitempty"`                                      // Whether the media is removable
+	FirmwareRevision string `protobuf:"bytes,7,opt,name=firmware_revision,json=firmwareRevision,proto3" json:"firmware_revision,omitempty"` // Firmware revision, if reported
+	SmartHealth      string `protobuf:"bytes,8,opt,name=smart_health,json=smartHealth,proto3" json:"smart_health,omitempty"`                // passed, failed or empty if SMART health is not available
+}
+
+func (x *DiskInfo) Reset() {
+	*x = DiskInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskInfo) ProtoMessage() {}
+
+func (x *DiskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskInfo.ProtoReflect.Descriptor instead.
+func (*DiskInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DiskInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DiskInfo) GetRotational() bool {
+	if x != nil {
+		return x.Rotational
+	}
+	return false
+}
+
+func (x *DiskInfo) GetRemovable() bool {
+	if x != nil {
+		return x.Removable
+	}
+	return false
+}
+
+func (x *DiskInfo) GetFirmwareRevision() string {
+	if x != nil {
+		return x.FirmwareRevision
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetSmartHealth() string {
+	if x != nil {
+		return x.SmartHealth
+	}
+	return ""
+}
+
+// Physical network interface
+type NetworkInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // Interface name, e.g. eno1
+	MacAddress string `protobuf:"bytes,2,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"` // MAC address
+	SpeedMbps  int32  `protobuf:"varint,3,opt,name=speed_mbps,json=speedMbps,proto3" json:"speed_mbps,omitempty"`   // Link speed in Mb/s; 0 if the link is down or unknown
+	Operstate  string `protobuf:"bytes,4,opt,name=operstate,proto3" json:"operstate,omitempty"`                     // Operational state, e.g. up or down
+	Driver     string `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`                           // Kernel driver
+	PciAddress string `protobuf:"bytes,6,opt,name=pci_address,json=pciAddress,proto3" json:"pci_address,omitempty"` // PCI address of the device, if on PCI
+	Mtu        int32  `protobuf:"varint,7,opt,name=mtu,proto3" json:"mtu,omitempty"`                                // MTU
+}
+
+func (x *NetworkInterface) Reset() {
+	*x = NetworkInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInterface) ProtoMessage() {}
+
+func (x *NetworkInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInterface.ProtoReflect.Descriptor instead.
+func (*NetworkInterface) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NetworkInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetSpeedMbps() int32 {
+	if x != nil {
+		return x.SpeedMbps
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetOperstate() string {
+	if x != nil {
+		return x.Operstate
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetPciAddress() string {
+	if x != nil {
+		return x.PciAddress
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetMtu() int32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+// PCI device
+type PCIDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                                                // Domain:bus:device.function, e.g. 0000:00:1f.6
+	VendorId          string `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`                              // Vendor ID, e.g. 8086
+	DeviceId          string `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`                              // Device ID
+	SubsystemVendorId string `protobuf:"bytes,4,opt,name=subsystem_vendor_id,json=subsystemVendorId,proto3" json:"subsystem_vendor_id,omitempty"` // Subsystem vendor ID
+	SubsystemDeviceId string `protobuf:"bytes,5,opt,name=subsystem_device_id,json=subsystemDeviceId,proto3" json:"subsystem_device_id,omitempty"` // Subsystem device ID
+	ClassCode         string `protobuf:"bytes,6,opt,name=class_code,json=classCode,proto3" json:"class_code,omitempty"`                           // Class, subclass and programming interface, e.g. 020000
+	ClassName         string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`                           // Name of the base class, e.g. network controller
+	Driver            string `protobuf:"bytes,8,opt,name=driver,proto3" json:"driver,omitempty"`                                                  // Kernel driver, if bound
+	NumaNode          int32  `protobuf:"varint,9,opt,name=numa_node,json=numaNode,proto3" json:"numa_node,omitempty"`                             // NUMA node; -1 if unknown
+}
+
+func (x *PCIDevice) Reset() {
+	*x = PCIDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PCIDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PCIDevice) ProtoMessage() {}
+
+func (x *PCIDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PCIDevice.ProtoReflect.Descriptor instead.
+func (*PCIDevice) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PCIDevice) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetSubsystemVendorId() string {
+	if x != nil {
+		return x.SubsystemVendorId
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetSubsystemDeviceId() string {
+	if x != nil {
+		return x.SubsystemDeviceId
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetClassCode() string {
+	if x != nil {
+		return x.ClassCode
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetNumaNode() int32 {
+	if x != nil {
+		return x.NumaNode
+	}
+	return 0
+}
+
+// Trusted Platform Module
+type TPMInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Present bool   `protobuf:"varint,1,opt,name=present,proto3" json:"present,omitempty"` // Whether a TPM is present
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`  // 1.2 or 2.0
+	Device  string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`    // Device name, e.g. tpm0
+}
+
+func (x *TPMInfo) Reset() {
+	*x = TPMInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TPMInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TPMInfo) ProtoMessage() {}
+
+func (x *TPMInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TPMInfo.ProtoReflect.Descriptor instead.
+func (*TPMInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TPMInfo) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+func (x *TPMInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *TPMInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+// Installed memory module
+type MemoryModule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locator            string `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`                                                    // Slot, e.g. DIMM_A1
+	BankLocator        string `protobuf:"bytes,2,opt,name=bank_locator,json=bankLocator,proto3" json:"bank_locator,omitempty"`                         // Bank, e.g. BANK 0
+	SizeBytes          uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`                              // Size in bytes
+	Type               string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                                          // Memory type, e.g. DDR4
+	FormFactor         string `protobuf:"bytes,5,opt,name=form_factor,json=formFactor,proto3" json:"form_factor,omitempty"`                            // Form factor, e.g. SODIMM
+	SpeedMts           uint32 `protobuf:"varint,6,opt,name=speed_mts,json=speedMts,proto3" json:"speed_mts,omitempty"`                                 // Maximum speed in MT/s; 0 if unknown
+	ConfiguredSpeedMts uint32 `protobuf:"varint,7,opt,name=configured_speed_mts,json=configuredSpeedMts,proto3" json:"configured_speed_mts,omitempty"` // Configured speed in MT/s; 0 if unknown
+	Manufacturer       string `protobuf:"bytes,8,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`                                          // Manufacturer
+	Serial             string `protobuf:"bytes,9,opt,name=serial,proto3" json:"serial,omitempty"`                                                      // Serial number
+	PartNumber         string `protobuf:"bytes,10,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`                           // Part number
+}
+
+func (x *MemoryModule) Reset() {
+	*x = MemoryModule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryModule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryModule) ProtoMessage() {}
+
+func (x *MemoryModule) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryModule.ProtoReflect.Descriptor instead.
+func (*MemoryModule) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MemoryModule) GetLocator() string {
+	if x != nil {
+		return x.Locator
+	}
+	return ""
+}
+
+func (x *MemoryModule) GetBankLocator() string {
+	if x != nil {
+		return x.BankLocator
+	}
+	return ""
+}
+
+func (x *MemoryModule) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *MemoryModule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MemoryModule) GetFormFactor() string {
+	if x != nil {
+		return x.FormFactor
+	}
+	return ""
+}
+
+func (x *MemoryModule) GetSpeedMts() uint32 {
+	if x != nil {
+		return x.SpeedMts
+	}
+	return 0
+}
+
+func (x *MemoryModule) GetConfiguredSpeedMts() uint32 {
+	if x != nil {
+		return x.ConfiguredSpeedMts
+	}
+	return 0
+}
+
+func (x *MemoryModule) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *MemoryModule) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *MemoryModule) GetPartNumber() string {
+	if x != nil {
+		return x.PartNumber
+	}
+	return ""
+}
+
+// Firmware information structure
+type FirmwareInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BiosVendor      string                 `protobuf:"bytes,1,opt,name=bios_vendor,json=biosVendor,proto3" json:"bios_vendor,omitempty"`                  // biosVendor
+	BiosVersion     string                 `protobuf:"bytes,2,opt,name=bios_version,json=biosVersion,proto3" json:"bios_version,omitempty"`               // biosVersion
+	BiosReleaseDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=bios_release_date,json=biosReleaseDate,proto3" json:"bios_release_date,omitempty"` // biosReleaseDate
+}
+
+func (x *FirmwareInfo) Reset() {
+	*x = FirmwareInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirmwareInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareInfo) ProtoMessage() {}
+
+func (x *FirmwareInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareInfo.ProtoReflect.Descriptor instead.
+func (*FirmwareInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *FirmwareInfo) GetBiosVendor() string {
+	if x != nil {
+		return x.BiosVendor
+	}
+	return ""
+}
+
+func (x *FirmwareInfo) GetBiosVersion() string {
+	if x != nil {
+		return x.BiosVersion
+	}
+	return ""
+}
+
+func (x *FirmwareInfo) GetBiosReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BiosReleaseDate
+	}
+	return nil
+}
+
+// Firmware component inventory read from the UEFI ESRT
+type FirmwareComponentsInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Components []*FirmwareComponent `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"` // Updatable firmware components
+	Source     string               `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`         // Where the inventory was read from (e.g. esrt)
+}
+
+func (x *FirmwareComponentsInfo) Reset() {
+	*x = FirmwareComponentsInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirmwareComponentsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareComponentsInfo) ProtoMessage() {}
+
+func (x *FirmwareComponentsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareComponentsInfo.ProtoReflect.Descriptor instead.
+func (*FirmwareComponentsInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FirmwareComponentsInfo) GetComponents() []*FirmwareComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *FirmwareComponentsInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// A single updatable firmware component from the UEFI ESRT
+type FirmwareComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FwClass                      string `protobuf:"bytes,1,opt,name=fw_class,json=fwClass,proto3" json:"fw_class,omitempty"`                                                                    // Firmware class GUID
+	FwType                       string `protobuf:"bytes,2,opt,name=fw_type,json=fwType,proto3" json:"fw_type,omitempty"`                                                                       // system, device, uefi_driver or unknown
+	FwVersion                    uint32 `protobuf:"varint,3,opt,name=fw_version,json=fwVersion,proto3" json:"fw_version,omitempty"`                                                             // Current firmware version
+	LowestSupportedFwVersion     uint32 `protobuf:"varint,4,opt,name=lowest_supported_fw_version,json=lowestSupportedFwVersion,proto3" json:"lowest_supported_fw_version,omitempty"`            // Lowest version the component can be updated to
+	CapsuleFlags                 uint32 `protobuf:"varint,5,opt,name=capsule_flags,json=capsuleFlags,proto3" json:"capsule_flags,omitempty"`                                                    // Capsule flags required by the component
+	LastAttemptVersion           uint32 `protobuf:"varint,6,opt,name=last_attempt_version,json=lastAttemptVersion,proto3" json:"last_attempt_version,omitempty"`                                // Version of the last attempted update
+	LastAttemptStatus            uint32 `protobuf:"varint,7,opt,name=last_attempt_status,json=lastAttemptStatus,proto3" json:"last_attempt_status,omitempty"`                                   // Raw status of the last attempted update
+	LastAttemptStatusDescription string `protobuf:"bytes,8,opt,name=last_attempt_status_description,json=lastAttemptStatusDescription,proto3" json:"last_attempt_status_description,omitempty"` // Human readable status of the last attempted update
+}
+
+func (x *FirmwareComponent) Reset() {
+	*x = FirmwareComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirmwareComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareComponent) ProtoMessage() {}
+
+func (x *FirmwareComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareComponent.ProtoReflect.Descriptor instead.
+func (*FirmwareComponent) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FirmwareComponent) GetFwClass() string {
+	if x != nil {
+		return x.FwClass
+	}
+	return ""
+}
+
+func (x *FirmwareComponent) GetFwType() string {
+	if x != nil {
+		return x.FwType
+	}
+	return ""
+}
+
+func (x *FirmwareComponent) GetFwVersion() uint32 {
+	if x != nil {
+		return x.FwVersion
+	}
+	return 0
+}
+
+func (x *FirmwareComponent) GetLowestSupportedFwVersion() uint32 {
+	if x != nil {
+		return x.LowestSupportedFwVersion
+	}
+	return 0
+}
+
+func (x *FirmwareComponent) GetCapsuleFlags() uint32 {
+	if x != nil {
+		return x.CapsuleFlags
+	}
+	return 0
+}
+
+func (x *FirmwareComponent) GetLastAttemptVersion() uint32 {
+	if x != nil {
+		return x.LastAttemptVersion
+	}
+	return 0
+}
+
+func (x *FirmwareComponent) GetLastAttemptStatus() uint32 {
+	if x != nil {
+		return x.LastAttemptStatus
+	}
+	return 0
+}
+
+func (x *FirmwareComponent) GetLastAttemptStatusDescription() string {
+	if x != nil {
+		return x.LastAttemptStatusDescription
+	}
+	return ""
+}
+
+// Recent entries of the inbd command audit log
+type AuditLogInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AuditLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Entries, oldest first
+}
+
+func (x *AuditLogInfo) Reset() {
+	*x = AuditLogInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogInfo) ProtoMessage() {}
+
+func (x *AuditLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogInfo.ProtoReflect.Descriptor instead.
+func (*AuditLogInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AuditLogInfo) GetEntries() []*AuditLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// A single command executed, or denied, by inbd
+type AuditLogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                                // When the command was started
+	Caller     string                 `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`                            // RPC that ran the command, or inbd outside of an RPC
+	Command    string                 `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`                          // Command path
+	Args       []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`                                // Command arguments
+	ExitCode   int32                  `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`       // Exit code; -1 if the command did not run
+	DurationMs int64                  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // Run time in milliseconds
+	Allowed    bool                   `protobuf:"varint,7,opt,name=allowed,proto3" json:"allowed,omitempty"`                         // Whether the command passed the allowlist
+	Error      string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`                              // Error message if the command failed
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AuditLogEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *AuditLogEntry) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AuditLogEntry) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *AuditLogEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Recent package provenance verification results
+type ProvenanceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*ProvenanceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"` // Records, oldest first
+}
+
+func (x *ProvenanceInfo) Reset() {
+	*x = ProvenanceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvenanceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvenanceInfo) ProtoMessage() {}
+
+func (x *ProvenanceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvenanceInfo.ProtoReflect.Descriptor instead.
+func (*ProvenanceInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ProvenanceInfo) GetRecords() []*ProvenanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// The provenance verification result of one package
+type ProvenanceRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                                            // When the package was verified
+	PackageType    string                 `protobuf:"bytes,2,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`           // sota, fota or config
+	Artifact       string                 `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`                                    // File name of the package
+	Verified       bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`                                   // Whether the provenance was verified
+	Format         string                 `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`                                        // sigstore-bundle, dsse or in-toto-jsonl
+	PredicateType  string                 `protobuf:"bytes,6,opt,name=predicate_type,json=predicateType,proto3" json:"predicate_type,omitempty"`     // In-toto predicate type, e.g. SLSA provenance
+	BuilderId      string                 `protobuf:"bytes,7,opt,name=builder_id,json=builderId,proto3" json:"builder_id,omitempty"`                 // Builder named in the provenance
+	SourceRepo     string                 `protobuf:"bytes,8,opt,name=source_repo,json=sourceRepo,proto3" json:"source_repo,omitempty"`              // Source repository named in the provenance
+	Signer         string                 `protobuf:"bytes,9,opt,name=signer,proto3" json:"signer,omitempty"`                                        // Trusted key or certificate identity that signed the provenance
+	ArtifactDigest string                 `protobuf:"bytes,10,opt,name=artifact_digest,json=artifactDigest,proto3" json:"artifact_digest,omitempty"` // Digest of the package
+	Error          string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`                                         // Reason the verification failed
+}
+
+func (x *ProvenanceRecord) Reset() {
+	*x = ProvenanceRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvenanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvenanceRecord) ProtoMessage() {}
+
+func (x *ProvenanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvenanceRecord.ProtoReflect.Descriptor instead.
+func (*ProvenanceRecord) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ProvenanceRecord) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ProvenanceRecord) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetArtifact() string {
+	if x != nil {
+		return x.Artifact
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *ProvenanceRecord) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetPredicateType() string {
+	if x != nil {
+		return x.PredicateType
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetBuilderId() string {
+	if x != nil {
+		return x.BuilderId
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetSourceRepo() string {
+	if x != nil {
+		return x.SourceRepo
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetArtifactDigest() string {
+	if x != nil {
+		return x.ArtifactDigest
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Vulnerabilities of the installed packages found in the loaded vulnerability database
+type VulnerabilitiesInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseFormat string                  `protobuf:"bytes,1,opt,name=database_format,json=databaseFormat,proto3" json:"database_format,omitempty"` // osv or oval
+	DatabaseSource string                  `protobuf:"bytes,2,opt,name=database_source,json=databaseSource,proto3" json:"database_source,omitempty"` // File name of the dataset the database was loaded from
+	DatabaseLoaded *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=database_loaded,json=databaseLoaded,proto3" json:"database_loaded,omitempty"` // When the database was loaded
+	Advisories     int32                   `protobuf:"varint,4,opt,name=advisories,proto3" json:"advisories,omitempty"`                              // Number of advisories in the database
+	Findings       []*VulnerabilityFinding `protobuf:"bytes,5,rep,name=findings,proto3" json:"findings,omitempty"`                                   // Findings, most severe first
+}
+
+func (x *VulnerabilitiesInfo) Reset() {
+	*x = VulnerabilitiesInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilitiesInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilitiesInfo) ProtoMessage() {}
+
+func (x *VulnerabilitiesInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilitiesInfo.ProtoReflect.Descriptor instead.
+func (*VulnerabilitiesInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VulnerabilitiesInfo) GetDatabaseFormat() string {
+	if x != nil {
+		return x.DatabaseFormat
+	}
+	return ""
+}
+
+func (x *VulnerabilitiesInfo) GetDatabaseSource() string {
+	if x != nil {
+		return x.DatabaseSource
+	}
+	return ""
+}
+
+func (x *VulnerabilitiesInfo) GetDatabaseLoaded() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DatabaseLoaded
+	}
+	return nil
+}
+
+func (x *VulnerabilitiesInfo) GetAdvisories() int32 {
+	if x != nil {
+		return x.Advisories
+	}
+	return 0
+}
+
+func (x *VulnerabilitiesInfo) GetFindings() []*VulnerabilityFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+// A vulnerability of an installed package
+type VulnerabilityFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                     // Advisory ID, e.g. CVE-2024-1234 or USN-6700-1
+	Aliases          []string `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`                                           // Other IDs of the advisory
+	Package          string   `protobuf:"bytes,3,opt,name=package,proto3" json:"package,omitempty"`                                           // Installed package name
+	Architecture     string   `protobuf:"bytes,4,opt,name=architecture,proto3" json:"architecture,omitempty"`                                 // Installed package architecture
+	InstalledVersion string   `protobuf:"bytes,5,opt,name=installed_version,json=installedVersion,proto3" json:"installed_version,omitempty"` // Installed version
+	FixedVersion     string   `protobuf:"bytes,6,opt,name=fixed_version,json=fixedVersion,proto3" json:"fixed_version,omitempty"`             // First version with the fix; empty if no fix is available
+	Severity         string   `protobuf:"bytes,7,opt,name=severity,proto3" json:"severity,omitempty"`                                         // critical, high, medium, low, negligible or unknown
+	CvssScore        float64  `protobuf:"fixed64,8,opt,name=cvss_score,json=cvssScore,proto3" json:"cvss_score,omitempty"`                    // CVSS v3 base score; 0 if unknown
+	Summary          string   `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`                                           // Summary of the advisory
+}
+
+func (x *VulnerabilityFinding) Reset() {
+	*x = VulnerabilityFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityFinding) ProtoMessage() {}
+
+func (x *VulnerabilityFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *QueryData) GetOsInfo() *OSInfo {
-	if x, ok := x.GetValues().(*QueryData_OsInfo); ok {
-		return x.OsInfo
+// Deprecated: Use VulnerabilityFinding.ProtoReflect.Descriptor instead.
+func (*VulnerabilityFinding) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *VulnerabilityFinding) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryData) GetSwbom() *SWBOMInfo {
-	if x, ok := x.GetValues().(*QueryData_Swbom); ok {
-		return x.Swbom
+func (x *VulnerabilityFinding) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
 	}
 	return nil
 }
 
-func (x *QueryData) GetVersion() *VersionInfo {
-	if x, ok := x.GetValues().(*QueryData_Version); ok {
-		return x.Version
+func (x *VulnerabilityFinding) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *VulnerabilityFinding) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *VulnerabilityFinding) GetInstalledVersion() string {
+	if x != nil {
+		return x.InstalledVersion
+	}
+	return ""
+}
+
+func (x *VulnerabilityFinding) GetFixedVersion() string {
+	if x != nil {
+		return x.FixedVersion
+	}
+	return ""
+}
+
+func (x *VulnerabilityFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *VulnerabilityFinding) GetCvssScore() float64 {
+	if x != nil {
+		return x.CvssScore
+	}
+	return 0
+}
+
+func (x *VulnerabilityFinding) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+// Operating system information structure
+type OSInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OsInformation string `protobuf:"bytes,1,opt,name=os_information,json=osInformation,proto3" json:"os_information,omitempty"` // OS information
+}
+
+func (x *OSInfo) Reset() {
+	*x = OSInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OSInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OSInfo) ProtoMessage() {}
+
+func (x *OSInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OSInfo.ProtoReflect.Descriptor instead.
+func (*OSInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *OSInfo) GetOsInformation() string {
+	if x != nil {
+		return x.OsInformation
+	}
+	return ""
+}
+
+// Software BOM information structure
+type SWBOMInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packages            []*SoftwarePackage     `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`                                                  // List of installed software packages
+	CollectionTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=collection_timestamp,json=collectionTimestamp,proto3" json:"collection_timestamp,omitempty"` // When SWBOM was collected
+	CollectionMethod    string                 `protobuf:"bytes,3,opt,name=collection_method,json=collectionMethod,proto3" json:"collection_method,omitempty"`          // How SWBOM was collected
+}
+
+func (x *SWBOMInfo) Reset() {
+	*x = SWBOMInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SWBOMInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SWBOMInfo) ProtoMessage() {}
+
+func (x *SWBOMInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SWBOMInfo.ProtoReflect.Descriptor instead.
+func (*SWBOMInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SWBOMInfo) GetPackages() []*SoftwarePackage {
+	if x != nil {
+		return x.Packages
 	}
 	return nil
 }
 
-func (x *QueryData) GetAllInfo() *AllInfo {
-	if x, ok := x.GetValues().(*QueryData_AllInfo); ok {
-		return x.AllInfo
+func (x *SWBOMInfo) GetCollectionTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectionTimestamp
 	}
 	return nil
 }
 
-type isQueryData_Values interface {
-	isQueryData_Values()
+func (x *SWBOMInfo) GetCollectionMethod() string {
+	if x != nil {
+		return x.CollectionMethod
+	}
+	return ""
 }
 
-type QueryData_Hardware struct {
-	Hardware *HardwareInfo `protobuf:"bytes,3,opt,name=hardware,proto3,oneof"` // Hardware information
+type SoftwarePackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // Package name
+	Version      string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                            // Package version
+	Vendor       string                 `protobuf:"bytes,3,opt,name=vendor,proto3" json:"vendor,omitempty"`                              // Package vendor/publisher
+	InstallDate  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=install_date,json=installDate,proto3" json:"install_date,omitempty"` // Installation date
+	License      string                 `protobuf:"bytes,5,opt,name=license,proto3" json:"license,omitempty"`                            // License information
+	Description  string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                    // Package description
+	Type         string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                  // Package type (deb, rpm, mender, etc.)
+	Architecture string                 `protobuf:"bytes,8,opt,name=architecture,proto3" json:"architecture,omitempty"`                  // Package architecture
+	SourceName   string                 `protobuf:"bytes,9,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`    // Source package name, if it differs from the name
 }
 
-type QueryData_Firmware struct {
-	Firmware *FirmwareInfo `protobuf:"bytes,4,opt,name=firmware,proto3,oneof"` // Firmware information
+func (x *SoftwarePackage) Reset() {
+	*x = SoftwarePackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type QueryData_OsInfo struct {
-	OsInfo *OSInfo `protobuf:"bytes,5,opt,name=os_info,json=osInfo,proto3,oneof"` // Operating system information
+func (x *SoftwarePackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type QueryData_Swbom struct {
-	Swbom *SWBOMInfo `protobuf:"bytes,6,opt,name=swbom,proto3,oneof"` // Software BOM information
+func (*SoftwarePackage) ProtoMessage() {}
+
+func (x *SoftwarePackage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type QueryData_Version struct {
-	Version *VersionInfo `protobuf:"bytes,7,opt,name=version,proto3,oneof"` // Version information
+// Deprecated: Use SoftwarePackage.ProtoReflect.Descriptor instead.
+func (*SoftwarePackage) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SoftwarePackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SoftwarePackage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SoftwarePackage) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *SoftwarePackage) GetInstallDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InstallDate
+	}
+	return nil
+}
+
+func (x *SoftwarePackage) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *SoftwarePackage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SoftwarePackage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SoftwarePackage) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
 }
 
-type QueryData_AllInfo struct {
-	AllInfo *AllInfo `protobuf:"bytes,8,opt,name=all_info,json=allInfo,proto3,oneof"` // All information combined
+func (x *SoftwarePackage) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
 }
 
-func (*QueryData_Hardware) isQueryData_Values() {}
-
-func (*QueryData_Firmware) isQueryData_Values() {}
-
-func (*QueryData_OsInfo) isQueryData_Values() {}
-
-func (*QueryData_Swbom) isQueryData_Values() {}
-
-func (*QueryData_Version) isQueryData_Values() {}
-
-func (*QueryData_AllInfo) isQueryData_Values() {}
-
-// Hardware information structure
-type HardwareInfo struct {
+type StreamSoftwareBOMRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CpuId               string `protobuf:"bytes,1,opt,name=cpu_id,json=cpuId,proto3" json:"cpu_id,omitempty"`                                             // cpuId
-	TotalPhysicalMemory string `protobuf:"bytes,2,opt,name=total_physical_memory,json=totalPhysicalMemory,proto3" json:"total_physical_memory,omitempty"` // totalPhysicalMemory
-	DiskInformation     string `protobuf:"bytes,3,opt,name=disk_information,json=diskInformation,proto3" json:"disk_information,omitempty"`               // diskInformation
-	SystemManufacturer  string `protobuf:"bytes,4,opt,name=system_manufacturer,json=systemManufacturer,proto3" json:"system_manufacturer,omitempty"`      // systemManufacturer
-	SystemProductName   string `protobuf:"bytes,5,opt,name=system_product_name,json=systemProductName,proto3" json:"system_product_name,omitempty"`       // systemProductName
+	PageSize      int32      `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`               // Packages per chunk; 0 uses the default of 100
+	SinceRevision string     `protobuf:"bytes,2,opt,name=since_revision,json=sinceRevision,proto3" json:"since_revision,omitempty"` // Return only the changes since this SBOM revision
+	Format        SBOMFormat `protobuf:"varint,3,opt,name=format,proto3,enum=inbd.v1.SBOMFormat" json:"format,omitempty"`
 }
 
-func (x *HardwareInfo) Reset() {
-	*x = HardwareInfo{}
+func (x *StreamSoftwareBOMRequest) Reset() {
+	*x = StreamSoftwareBOMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[18]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HardwareInfo) String() string {
+func (x *StreamSoftwareBOMRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HardwareInfo) ProtoMessage() {}
+func (*StreamSoftwareBOMRequest) ProtoMessage() {}
 
-func (x *HardwareInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[18]
+func (x *StreamSoftwareBOMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,74 +4495,69 @@ func (x *HardwareInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HardwareInfo.ProtoReflect.Descriptor instead.
-func (*HardwareInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *HardwareInfo) GetCpuId() string {
-	if x != nil {
-		return x.CpuId
-	}
-	return ""
-}
-
-func (x *HardwareInfo) GetTotalPhysicalMemory() string {
-	if x != nil {
-		return x.TotalPhysicalMemory
-	}
-	return ""
+// Deprecated: Use StreamSoftwareBOMRequest.ProtoReflect.Descriptor instead.
+func (*StreamSoftwareBOMRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *HardwareInfo) GetDiskInformation() string {
+func (x *StreamSoftwareBOMRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.DiskInformation
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *HardwareInfo) GetSystemManufacturer() string {
+func (x *StreamSoftwareBOMRequest) GetSinceRevision() string {
 	if x != nil {
-		return x.SystemManufacturer
+		return x.SinceRevision
 	}
 	return ""
 }
 
-func (x *HardwareInfo) GetSystemProductName() string {
+func (x *StreamSoftwareBOMRequest) GetFormat() SBOMFormat {
 	if x != nil {
-		return x.SystemProductName
+		return x.Format
 	}
-	return ""
+	return SBOMFormat_SBOM_FORMAT_UNSPECIFIED
 }
 
-// Firmware information structure
-type FirmwareInfo struct {
+// A page of a streamed software BOM
+type SoftwareBOMChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BiosVendor      string                 `protobuf:"bytes,1,opt,name=bios_vendor,json=biosVendor,proto3" json:"bios_vendor,omitempty"`                  // biosVendor
-	BiosVersion     string                 `protobuf:"bytes,2,opt,name=bios_version,json=biosVersion,proto3" json:"bios_version,omitempty"`               // biosVersion
-	BiosReleaseDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=bios_release_date,json=biosReleaseDate,proto3" json:"bios_release_date,omitempty"` // biosReleaseDate
-}
-
-func (x *FirmwareInfo) Reset() {
-	*x = FirmwareInfo{}
+	StatusCode          int32                    `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`                            // HTTP-style status code
+	Error               string                   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                                                         // Error message if any
+	Revision            string                   `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`                                                   // Revision of the software BOM; the same in every chunk
+	Page                int32                    `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                                                          // Page number, starting at 1
+	TotalPages          int32                    `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`                            // Number of pages in the stream
+	TotalPackages       int32                    `protobuf:"varint,6,opt,name=total_packages,json=totalPackages,proto3" json:"total_packages,omitempty"`                   // Number of installed packages
+	Delta               bool                     `protobuf:"varint,7,opt,name=delta,proto3" json:"delta,omitempty"`                                                        // Whether the stream holds the changes since since_revision
+	Packages            []*SoftwarePackage       `protobuf:"bytes,8,rep,name=packages,proto3" json:"packages,omitempty"`                                                   // Packages of this page
+	Changes             []*SoftwarePackageChange `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty"`                                                     // Changes of this page, in delta mode
+	Document            []byte                   `protobuf:"bytes,10,opt,name=document,proto3" json:"document,omitempty"`                                                  // Part of the SPDX or CycloneDX document; concatenate all parts
+	CollectionTimestamp *timestamppb.Timestamp   `protobuf:"bytes,11,opt,name=collection_timestamp,json=collectionTimestamp,proto3" json:"collection_timestamp,omitempty"` // When the software BOM was collected
+	CollectionMethod    string                   `protobuf:"bytes,12,opt,name=collection_method,json=collectionMethod,proto3" json:"collection_method,omitempty"`          // How the software BOM was collected
+}
+
+func (x *SoftwareBOMChunk) Reset() {
+	*x = SoftwareBOMChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[19]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FirmwareInfo) String() string {
+func (x *SoftwareBOMChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FirmwareInfo) ProtoMessage() {}
+func (*SoftwareBOMChunk) ProtoMessage() {}
 
-func (x *FirmwareInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[19]
+func (x *SoftwareBOMChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,108 +4568,123 @@ func (x *FirmwareInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FirmwareInfo.ProtoReflect.Descriptor instead.
-func (*FirmwareInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SoftwareBOMChunk.ProtoReflect.Descriptor instead.
+func (*SoftwareBOMChunk) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *FirmwareInfo) GetBiosVendor() string {
+func (x *SoftwareBOMChunk) GetStatusCode() int32 {
 	if x != nil {
-		return x.BiosVendor
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *SoftwareBOMChunk) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-func (x *FirmwareInfo) GetBiosVersion() string {
+func (x *SoftwareBOMChunk) GetRevision() string {
 	if x != nil {
-		return x.BiosVersion
+		return x.Revision
 	}
 	return ""
 }
 
-func (x *FirmwareInfo) GetBiosReleaseDate() *timestamppb.Timestamp {
+func (x *SoftwareBOMChunk) GetPage() int32 {
 	if x != nil {
-		return x.BiosReleaseDate
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-// Operating system information structure
-type OSInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SoftwareBOMChunk) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
 
-	OsInformation string `protobuf:"bytes,1,opt,name=os_information,json=osInformation,proto3" json:"os_information,omitempty"` // OS information
+func (x *SoftwareBOMChunk) GetTotalPackages() int32 {
+	if x != nil {
+		return x.TotalPackages
+	}
+	return 0
 }
 
-func (x *OSInfo) Reset() {
-	*x = OSInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SoftwareBOMChunk) GetDelta() bool {
+	if x != nil {
+		return x.Delta
 	}
+	return false
 }
 
-func (x *OSInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SoftwareBOMChunk) GetPackages() []*SoftwarePackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
 }
 
-func (*OSInfo) ProtoMessage() {}
+func (x *SoftwareBOMChunk) GetChanges() []*SoftwarePackageChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
 
-func (x *OSInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SoftwareBOMChunk) GetDocument() []byte {
+	if x != nil {
+		return x.Document
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use OSInfo.ProtoReflect.Descriptor instead.
-func (*OSInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{20}
+func (x *SoftwareBOMChunk) GetCollectionTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectionTimestamp
+	}
+	return nil
 }
 
-func (x *OSInfo) GetOsInformation() string {
+func (x *SoftwareBOMChunk) GetCollectionMethod() string {
 	if x != nil {
-		return x.OsInformation
+		return x.CollectionMethod
 	}
 	return ""
 }
 
-// Software BOM information structure
-type SWBOMInfo struct {
+// A package added, removed or changed since an earlier software BOM revision
+type SoftwarePackageChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Packages            []*SoftwarePackage     `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`                                                  // List of installed software packages
-	CollectionTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=collection_timestamp,json=collectionTimestamp,proto3" json:"collection_timestamp,omitempty"` // When SWBOM was collected
-	CollectionMethod    string                 `protobuf:"bytes,3,opt,name=collection_method,json=collectionMethod,proto3" json:"collection_method,omitempty"`          // How SWBOM was collected
+	ChangeType      SoftwarePackageChange_ChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=inbd.v1.SoftwarePackageChange_ChangeType" json:"change_type,omitempty"`
+	Package         *SoftwarePackage                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`                                        // The package; the earlier package if it was removed
+	PreviousVersion string                           `protobuf:"bytes,3,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"` // Earlier version of a changed package
 }
 
-func (x *SWBOMInfo) Reset() {
-	*x = SWBOMInfo{}
+func (x *SoftwarePackageChange) Reset() {
+	*x = SoftwarePackageChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[21]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SWBOMInfo) String() string {
+func (x *SoftwarePackageChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SWBOMInfo) ProtoMessage() {}
+func (*SoftwarePackageChange) ProtoMessage() {}
 
-func (x *SWBOMInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[21]
+func (x *SoftwarePackageChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,64 +4695,61 @@ func (x *SWBOMInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SWBOMInfo.ProtoReflect.Descriptor instead.
-func (*SWBOMInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SoftwarePackageChange.ProtoReflect.Descriptor instead.
+func (*SoftwarePackageChange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SWBOMInfo) GetPackages() []*SoftwarePackage {
+func (x *SoftwarePackageChange) GetChangeType() SoftwarePackageChange_ChangeType {
 	if x != nil {
-		return x.Packages
+		return x.ChangeType
 	}
-	return nil
+	return SoftwarePackageChange_CHANGE_TYPE_UNSPECIFIED
 }
 
-func (x *SWBOMInfo) GetCollectionTimestamp() *timestamppb.Timestamp {
+func (x *SoftwarePackageChange) GetPackage() *SoftwarePackage {
 	if x != nil {
-		return x.CollectionTimestamp
+		return x.Package
 	}
 	return nil
 }
 
-func (x *SWBOMInfo) GetCollectionMethod() string {
+func (x *SoftwarePackageChange) GetPreviousVersion() string {
 	if x != nil {
-		return x.CollectionMethod
+		return x.PreviousVersion
 	}
 	return ""
 }
 
-type SoftwarePackage struct {
+// Version information structure
+type VersionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // Package name
-	Version      string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                            // Package version
-	Vendor       string                 `protobuf:"bytes,3,opt,name=vendor,proto3" json:"vendor,omitempty"`                              // Package vendor/publisher
-	InstallDate  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=install_date,json=installDate,proto3" json:"install_date,omitempty"` // Installation date
-	License      string                 `protobuf:"bytes,5,opt,name=license,proto3" json:"license,omitempty"`                            // License information
-	Description  string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                    // Package description
-	Type         string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                  // Package type (deb, rpm, mender, etc.)
-	Architecture string                 `protobuf:"bytes,8,opt,name=architecture,proto3" json:"architecture,omitempty"`                  // Package architecture
+	Version           string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                                // Version number
+	InbmVersionCommit string                 `protobuf:"bytes,2,opt,name=inbm_version_commit,json=inbmVersionCommit,proto3" json:"inbm_version_commit,omitempty"` // INBM version commit
+	BuildDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`                           // Build date
+	GitCommit         string                 `protobuf:"bytes,4,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`                           // Git commit hash
 }
 
-func (x *SoftwarePackage) Reset() {
-	*x = SoftwarePackage{}
+func (x *VersionInfo) Reset() {
+	*x = VersionInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[22]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SoftwarePackage) String() string {
+func (x *VersionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoftwarePackage) ProtoMessage() {}
+func (*VersionInfo) ProtoMessage() {}
 
-func (x *SoftwarePackage) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[22]
+func (x *VersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1661,96 +4760,126 @@ func (x *SoftwarePackage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoftwarePackage.ProtoReflect.Descriptor instead.
-func (*SoftwarePackage) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *SoftwarePackage) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use VersionInfo.ProtoReflect.Descriptor instead.
+func (*VersionInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SoftwarePackage) GetVersion() string {
+func (x *VersionInfo) GetVersion() string {
 	if x != nil {
 		return x.Version
 	}
 	return ""
 }
 
-func (x *SoftwarePackage) GetVendor() string {
+func (x *VersionInfo) GetInbmVersionCommit() string {
 	if x != nil {
-		return x.Vendor
+		return x.InbmVersionCommit
 	}
 	return ""
 }
 
-func (x *SoftwarePackage) GetInstallDate() *timestamppb.Timestamp {
+func (x *VersionInfo) GetBuildDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.InstallDate
+		return x.BuildDate
 	}
 	return nil
 }
 
-func (x *SoftwarePackage) GetLicense() string {
+func (x *VersionInfo) GetGitCommit() string {
 	if x != nil {
-		return x.License
+		return x.GitCommit
 	}
 	return ""
 }
 
-func (x *SoftwarePackage) GetDescription() string {
-	if x != nil {
-		return x.Description
+// Power capabilities and pending power actions
+type PowerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Capabilities   *PowerCapabilitiesInfo `protobuf:"bytes,1,opt,name=capabilities,proto3" json:"capabilities,omitempty"`                           // Supported power actions
+	PendingActions []*PowerActionInfo     `protobuf:"bytes,2,rep,name=pending_actions,json=pendingActions,proto3" json:"pending_actions,omitempty"` // Scheduled actions, earliest first
+}
+
+func (x *PowerInfo) Reset() {
+	*x = PowerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SoftwarePackage) GetType() string {
+func (x *PowerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerInfo) ProtoMessage() {}
+
+func (x *PowerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerInfo.ProtoReflect.Descriptor instead.
+func (*PowerInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PowerInfo) GetCapabilities() *PowerCapabilitiesInfo {
 	if x != nil {
-		return x.Type
+		return x.Capabilities
 	}
-	return ""
+	return nil
 }
 
-func (x *SoftwarePackage) GetArchitecture() string {
+func (x *PowerInfo) GetPendingActions() []*PowerActionInfo {
 	if x != nil {
-		return x.Architecture
+		return x.PendingActions
 	}
-	return ""
+	return nil
 }
 
-// Version information structure
-type VersionInfo struct {
+// A scheduled power action
+type PowerActionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version           string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                                // Version number
-	InbmVersionCommit string                 `protobuf:"bytes,2,opt,name=inbm_version_commit,json=inbmVersionCommit,proto3" json:"inbm_version_commit,omitempty"` // INBM version commit
-	BuildDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`                           // Build date
-	GitCommit         string                 `protobuf:"bytes,4,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`                           // Git commit hash
+	ActionId      string                           `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`                            // ID of the action
+	Action        SetPowerStateRequest_PowerAction `protobuf:"varint,2,opt,name=action,proto3,enum=inbd.v1.SetPowerStateRequest_PowerAction" json:"action,omitempty"` // Action to perform
+	ScheduledTime *timestamppb.Timestamp           `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`             // When the action will be performed
+	RequestedTime *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=requested_time,json=requestedTime,proto3" json:"requested_time,omitempty"`             // When the action was requested
+	RequestedBy   string                           `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`                   // Caller that requested the action, e.g. uid 0
+	VetoedReason  string                           `protobuf:"bytes,6,opt,name=vetoed_reason,json=vetoedReason,proto3" json:"vetoed_reason,omitempty"`                // Why the action was postponed the last time it was due; empty if it was not
 }
 
-func (x *VersionInfo) Reset() {
-	*x = VersionInfo{}
+func (x *PowerActionInfo) Reset() {
+	*x = PowerActionInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[23]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VersionInfo) String() string {
+func (x *PowerActionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionInfo) ProtoMessage() {}
+func (*PowerActionInfo) ProtoMessage() {}
 
-func (x *VersionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[23]
+func (x *PowerActionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1761,35 +4890,49 @@ func (x *VersionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionInfo.ProtoReflect.Descriptor instead.
-func (*VersionInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PowerActionInfo.ProtoReflect.Descriptor instead.
+func (*PowerActionInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *VersionInfo) GetVersion() string {
+func (x *PowerActionInfo) GetActionId() string {
 	if x != nil {
-		return x.Version
+		return x.ActionId
 	}
 	return ""
 }
 
-func (x *VersionInfo) GetInbmVersionCommit() string {
+func (x *PowerActionInfo) GetAction() SetPowerStateRequest_PowerAction {
 	if x != nil {
-		return x.InbmVersionCommit
+		return x.Action
 	}
-	return ""
+	return SetPowerStateRequest_POWER_ACTION_UNSPECIFIED
 }
 
-func (x *VersionInfo) GetBuildDate() *timestamppb.Timestamp {
+func (x *PowerActionInfo) GetScheduledTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BuildDate
+		return x.ScheduledTime
 	}
 	return nil
 }
 
-func (x *VersionInfo) GetGitCommit() string {
+func (x *PowerActionInfo) GetRequestedTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GitCommit
+		return x.RequestedTime
+	}
+	return nil
+}
+
+func (x *PowerActionInfo) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PowerActionInfo) GetVetoedReason() string {
+	if x != nil {
+		return x.VetoedReason
 	}
 	return ""
 }
@@ -1809,7 +4952,7 @@ type PowerCapabilitiesInfo struct {
 func (x *PowerCapabilitiesInfo) Reset() {
 	*x = PowerCapabilitiesInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[24]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1822,7 +4965,7 @@ func (x *PowerCapabilitiesInfo) String() string {
 func (*PowerCapabilitiesInfo) ProtoMessage() {}
 
 func (x *PowerCapabilitiesInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[24]
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1835,7 +4978,7 @@ func (x *PowerCapabilitiesInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerCapabilitiesInfo.ProtoReflect.Descriptor instead.
 func (*PowerCapabilitiesInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{24}
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *PowerCapabilitiesInfo) GetShutdown() bool {
@@ -1891,7 +5034,7 @@ type AllInfo struct {
 func (x *AllInfo) Reset() {
 	*x = AllInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[25]
+		mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1904,7 +5047,7 @@ func (x *AllInfo) String() string {
 func (*AllInfo) ProtoMessage() {}
 
 func (x *AllInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[25]
+	mi := &file_pkg_api_inbd_v1_inbd_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,7 +5060,7 @@ func (x *AllInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllInfo.ProtoReflect.Descriptor instead.
 func (*AllInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{25}
+	return file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *AllInfo) GetHardware() *HardwareInfo {
@@ -1978,116 +5121,244 @@ var file_pkg_api_inbd_v1_inbd_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69,
 	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x03, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x06,
 	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x69,
 	0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x77, 0x65,
 	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x82,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x59, 0x0a, 0x0b,
-	0x50, 0x6f, 0x77, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x50,
-	0x4f, 0x57, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x4f, 0x57,
-	0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12,
-	0x16, 0x0a, 0x12, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x43, 0x59, 0x43, 0x4c, 0x45, 0x10, 0x02, 0x22, 0xd9, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x5c, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a,
-	0xba, 0x48, 0x47, 0xba, 0x01, 0x41, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x72,
-	0x6c, 0x12, 0x18, 0x75, 0x72, 0x6c, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61,
-	0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x2e, 0x1a, 0x1a, 0x74, 0x68, 0x69,
-	0x73, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
-	0x69, 0x73, 0x55, 0x72, 0x69, 0x28, 0x29, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
-	0x45, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0d,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x2a, 0x05, 0x18, 0x80, 0x9a, 0x9e, 0x01, 0x52,
+	0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x41, 0x0a,
+	0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x74,
-	0x5f, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
-	0x6f, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x00, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x22, 0xab, 0x04, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x4a, 0xba, 0x48, 0x47, 0xba, 0x01, 0x41, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f,
-	0x75, 0x72, 0x6c, 0x12, 0x18, 0x75, 0x72, 0x6c, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65,
-	0x20, 0x61, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x2e, 0x1a, 0x1a, 0x74,
-	0x68, 0x69, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69,
-	0x73, 0x2e, 0x69, 0x73, 0x55, 0x72, 0x69, 0x28, 0x29, 0xc8, 0x01, 0x00, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x12, 0x45, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x66,
-	0x74, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01,
-	0x01, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0d,
-	0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74,
-	0x12, 0x41, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x48, 0x1b, 0x92, 0x01, 0x18, 0x18, 0x01,
-	0x22, 0x14, 0x72, 0x12, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x4f,
-	0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x4f, 0x57,
-	0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10,
-	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4d, 0x4f,
-	0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x02,
-	0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4d, 0x4f, 0x44,
-	0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10,
-	0x03, 0x22, 0x5f, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0b, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x25, 0xba, 0x48, 0x22, 0x92, 0x01, 0x1f, 0x18, 0x01, 0x22, 0x1b, 0x72, 0x19, 0x10, 0x01, 0x18,
-	0xf4, 0x03, 0x32, 0x12, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f,
-	0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x2f, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x96, 0x02, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x25, 0xba, 0x48, 0x22, 0x92, 0x01, 0x1f, 0x18, 0x01, 0x22, 0x1b, 0x72, 0x19,
-	0x10, 0x01, 0x18, 0xf4, 0x03, 0x32, 0x12, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
-	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x2f, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x22, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x0b, 0x67, 0x70, 0x67, 0x5f, 0x6b, 0x65, 0x79,
-	0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0xba, 0x48, 0x47, 0xba,
-	0x01, 0x41, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x12, 0x18, 0x75,
-	0x72, 0x6c, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x2e, 0x1a, 0x1a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3d, 0x3d,
-	0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x73, 0x55, 0x72,
-	0x69, 0x28, 0x29, 0xc8, 0x01, 0x00, 0x52, 0x09, 0x67, 0x70, 0x67, 0x4b, 0x65, 0x79, 0x55, 0x72,
-	0x69, 0x12, 0x28, 0x0a, 0x0c, 0x67, 0x70, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52,
-	0x0a, 0x67, 0x70, 0x67, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6e, 0x0a, 0x1e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x28, 0x0a, 0x0c, 0x67, 0x70, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52,
-	0x0a, 0x67, 0x70, 0x67, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x0e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0x4e, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x70, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0xad, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f,
+	0x46, 0x46, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14,
+	0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x53,
+	0x50, 0x45, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x49, 0x42, 0x45, 0x52, 0x4e, 0x41, 0x54, 0x45,
+	0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x5f, 0x52, 0x45, 0x42, 0x4f, 0x4f, 0x54, 0x10, 0x05,
+	0x22, 0x37, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x19, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34,
+	0x0a, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x65, 0x64, 0x22, 0xe0, 0x03, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5c,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0xba, 0x48, 0x47,
+	0xba, 0x01, 0x41, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x18,
+	0x75, 0x72, 0x6c, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x2e, 0x1a, 0x1a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3d,
+	0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x73, 0x55,
+	0x72, 0x69, 0x28, 0x29, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x45, 0x0a, 0x0c,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x72, 0x65,
+	0x62, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x6f, 0x4e, 0x6f,
+	0x74, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x2d, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x00, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x12, 0x84, 0x01, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5d, 0xba, 0x48, 0x5a, 0xba, 0x01,
+	0x57, 0x0a, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x23, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20,
+	0x61, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x2e, 0x1a, 0x1a, 0x74, 0x68,
+	0x69, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x69, 0x73, 0x55, 0x72, 0x69, 0x28, 0x29, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x22, 0xe0, 0x05, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0xba, 0x48, 0x47, 0xba, 0x01, 0x41, 0x0a, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x75, 0x72, 0x6c, 0x20, 0x6d, 0x75, 0x73,
+	0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x55, 0x52, 0x4c,
+	0x2e, 0x1a, 0x1a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x73, 0x55, 0x72, 0x69, 0x28, 0x29, 0xc8, 0x01, 0x00,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x45, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52,
+	0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x69, 0x6e, 0x62,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x0b, 0xba,
+	0x48, 0x08, 0xc8, 0x01, 0x01, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x62, 0x6f, 0x6f,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x52, 0x65,
+	0x62, 0x6f, 0x6f, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x48, 0x1b, 0x92,
+	0x01, 0x18, 0x18, 0x01, 0x22, 0x14, 0x72, 0x12, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x00, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x84, 0x01,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5d, 0xba, 0x48, 0x5a, 0xba, 0x01, 0x57, 0x0a, 0x14,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x12, 0x23, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x2e, 0x1a, 0x1a, 0x74, 0x68, 0x69, 0x73, 0x20,
+	0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x73,
+	0x55, 0x72, 0x69, 0x28, 0x29, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x4f,
+	0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x44,
+	0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x4f, 0x57,
+	0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c,
+	0x4f, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x03, 0x22, 0x88, 0x02, 0x0a, 0x0e, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x73, 0x22, 0x35, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x5f, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x25, 0xba,
+	0x48, 0x22, 0x92, 0x01, 0x1f, 0x18, 0x01, 0x22, 0x1b, 0x72, 0x19, 0x10, 0x01, 0x18, 0xf4, 0x03,
+	0x32, 0x12, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x3a, 0x2f, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0xe1, 0x02, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3d, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x25, 0xba, 0x48, 0x22, 0x92, 0x01, 0x1f, 0x18, 0x01, 0x22, 0x1b, 0x72, 0x19, 0x10, 0x01,
+	0x18, 0xf4, 0x03, 0x32, 0x12, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x3a, 0x2f, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x22, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x0b, 0x67, 0x70, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0xba, 0x48, 0x47, 0xba, 0x01, 0x41,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x12, 0x18, 0x75, 0x72, 0x6c,
+	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x20, 0x55, 0x52, 0x4c, 0x2e, 0x1a, 0x1a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x27,
+	0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x73, 0x55, 0x72, 0x69, 0x28,
+	0x29, 0xc8, 0x01, 0x00, 0x52, 0x09, 0x67, 0x70, 0x67, 0x4b, 0x65, 0x79, 0x55, 0x72, 0x69, 0x12,
+	0x28, 0x0a, 0x0c, 0x67, 0x70, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52, 0x0a, 0x67,
+	0x70, 0x67, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x6e, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0c, 0x67, 0x70,
+	0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52, 0x0a, 0x67, 0x70, 0x67, 0x4b, 0x65, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe4, 0x02, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xba, 0x48, 0x20, 0x72, 0x1e, 0x32,
+	0x1c, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x72, 0x69,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x01, 0x52, 0x04, 0x75, 0x72, 0x69, 0x73, 0x12, 0x20, 0x0a, 0x06, 0x73, 0x75, 0x69, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x01, 0x52, 0x06, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a,
+	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x0e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x5f,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x49, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
 	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14,
 	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xf5, 0x01, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x03, 0x75, 0x72,
 	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0xba, 0x48, 0x47, 0xba, 0x01, 0x41, 0x0a,
 	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x12, 0x18, 0x75, 0x72, 0x69, 0x20,
@@ -2099,88 +5370,194 @@ var file_pkg_api_inbd_v1_inbd_proto_rawDesc = []byte{
 	0xc8, 0x01, 0x00, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d,
 	0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52, 0x0d,
-	0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x62, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x3a, 0xba, 0x48, 0x37, 0xba, 0x01, 0x31, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x17, 0x70, 0x61, 0x74, 0x68, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e,
-	0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x1a, 0x0a, 0x74, 0x68,
-	0x69, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x22, 0x62, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x3a, 0xba, 0x48, 0x37, 0xba, 0x01, 0x31, 0x0a, 0x0a, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x17, 0x70, 0x61, 0x74, 0x68, 0x20, 0x6d, 0x75,
-	0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
-	0x1a, 0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0xc8, 0x01, 0x01, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x65, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xba, 0x48, 0x37, 0xba,
-	0x01, 0x31, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x17,
-	0x70, 0x61, 0x74, 0x68, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65,
-	0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x1a, 0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d,
-	0x20, 0x27, 0x27, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x65, 0x0a, 0x13,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x3a, 0xba, 0x48, 0x37, 0xba, 0x01, 0x31, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x17, 0x70, 0x61, 0x74, 0x68, 0x20, 0x6d, 0x75, 0x73, 0x74,
-	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x1a, 0x0a,
-	0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x22, 0x7b, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x94, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xba,
-	0x48, 0x0a, 0xc8, 0x01, 0x01, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x06, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x86, 0x03, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x68,
-	0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65,
-	0x12, 0x33, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72,
-	0x6d, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x72,
-	0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x77, 0x62, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x57, 0x42, 0x4f, 0x4d,
-	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x73, 0x77, 0x62, 0x6f, 0x6d, 0x12, 0x30, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x2d, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x08,
-	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0c, 0x48, 0x61, 0x72,
+	0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2d, 0x0a,
+	0x0e, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x00, 0x52, 0x0d, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x69, 0x22, 0xd5, 0x01, 0x0a,
+	0x20, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x5c, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a,
+	0xba, 0x48, 0x47, 0xba, 0x01, 0x41, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x72,
+	0x69, 0x12, 0x18, 0x75, 0x72, 0x69, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61,
+	0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x2e, 0x1a, 0x1a, 0x74, 0x68, 0x69,
+	0x73, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x69, 0x73, 0x55, 0x72, 0x69, 0x28, 0x29, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x24, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x00, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x22, 0xac, 0x01, 0x0a, 0x21, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xba, 0x48, 0x37, 0xba, 0x01, 0x31, 0x0a, 0x0a, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x17, 0x70, 0x61, 0x74, 0x68, 0x20,
+	0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x1a, 0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0xc8, 0x01,
+	0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xce, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0a, 0x6a, 0x73, 0x6f, 0x6e,
+	0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x00, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x3a,
+	0x77, 0xba, 0x48, 0x74, 0x1a, 0x72, 0x0a, 0x12, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6f, 0x72, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20,
+	0x6f, 0x72, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x1a, 0x2c, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0x29, 0x20, 0x21, 0x3d,
+	0x20, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0x29, 0x22, 0x65, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x65,
+	0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xba,
+	0x48, 0x37, 0xba, 0x01, 0x31, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x17, 0x70, 0x61, 0x74, 0x68, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x62, 0x65, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x1a, 0x0a, 0x74, 0x68, 0x69, 0x73,
+	0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x65, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xba, 0x48, 0x37, 0xba, 0x01, 0x31, 0x0a, 0x0a, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x17, 0x70, 0x61, 0x74, 0x68, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x1a, 0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0xc8, 0x01, 0x01,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x88, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x6b, 0x0a, 0x11, 0x44, 0x69,
+	0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52,
+	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x0b, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc2, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x66, 0x66,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x72,
+	0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69,
+	0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3c, 0x0a, 0x15, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7b, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x0c,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x69,
+	0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20,
+	0x00, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x62,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xc1, 0x05, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x33, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x72,
+	0x64, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x68, 0x61, 0x72,
+	0x64, 0x77, 0x61, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
+	0x52, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x73,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e,
+	0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x06,
+	0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x77, 0x62, 0x6f, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x57, 0x42, 0x4f, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x73, 0x77, 0x62,
+	0x6f, 0x6d, 0x12, 0x30, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x52, 0x0a, 0x13, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x48, 0x00, 0x52, 0x12, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x62,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x48, 0x00, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x39, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x76, 0x75, 0x6c, 0x6e,
+	0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x75, 0x6c, 0x6e,
+	0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x48,
+	0x00, 0x52, 0x0f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x08,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x90, 0x04, 0x0a, 0x0c, 0x48, 0x61, 0x72,
 	0x64, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x70, 0x75,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x70, 0x75, 0x49, 0x64,
 	0x12, 0x32, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63,
@@ -2195,128 +5572,462 @@ var file_pkg_api_inbd_v1_inbd_proto_rawDesc = []byte{
 	0x12, 0x2e, 0x0a, 0x13, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
 	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73,
 	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x69, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x69, 0x6f, 0x73, 0x56, 0x65, 0x6e, 0x64,
-	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6f, 0x73, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x11, 0x62, 0x69, 0x6f, 0x73, 0x5f, 0x72, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x62, 0x69,
-	0x6f, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x2f, 0x0a,
-	0x06, 0x4f, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x73, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbd,
-	0x01, 0x0a, 0x09, 0x53, 0x57, 0x42, 0x4f, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x08,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x12, 0x4d, 0x0a, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x12, 0x27, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x70, 0x63, 0x69, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x63,
+	0x69, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x03, 0x74, 0x70, 0x6d, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x50, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x74, 0x70, 0x6d, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x12, 0x3c, 0x0a,
+	0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0d, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x08,
+	0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x69, 0x72, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6d, 0x61, 0x72,
+	0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0xcf, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x62, 0x70, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x70, 0x65, 0x65, 0x64, 0x4d, 0x62, 0x70, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x63, 0x69,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x22, 0xb2, 0x02, 0x0a, 0x09, 0x50, 0x43,
+	0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73,
+	0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73,
+	0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x55,
+	0x0a, 0x07, 0x54, 0x50, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xcb, 0x02, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6e, 0x6b, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72,
+	0x6d, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x5f, 0x6d, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x4d, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x4d, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61,
+	0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x69, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x69, 0x6f, 0x73, 0x56,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x6f, 0x73, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6f,
+	0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x11, 0x62, 0x69, 0x6f, 0x73,
+	0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0f, 0x62, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x22, 0x6c, 0x0a, 0x16, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xf3,
+	0x02, 0x0a, 0x11, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x77, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x77, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x66, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x77, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x77,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x1b, 0x6c, 0x6f, 0x77, 0x65, 0x73,
+	0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x77, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x6c, 0x6f,
+	0x77, 0x65, 0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x77, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x70, 0x73, 0x75, 0x6c,
+	0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63,
+	0x61, 0x70, 0x73, 0x75, 0x6c, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a,
+	0x1f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x33,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x87, 0x02, 0x0a, 0x13, 0x56, 0x75,
+	0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x76, 0x69,
+	0x73, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x64,
+	0x76, 0x69, 0x73, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x62,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x14, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x76, 0x73, 0x73, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x63, 0x76, 0x73, 0x73, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x2f, 0x0a, 0x06, 0x4f,
+	0x53, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a,
+	0x09, 0x53, 0x57, 0x42, 0x4f, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69,
+	0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x4d, 0x0a, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xb4, 0x02, 0x0a,
+	0x0f, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12,
+	0x3d, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f,
+	0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x42, 0x4f, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x35, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x42, 0x4f, 0x4d, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xdf, 0x03, 0x0a, 0x10, 0x53, 0x6f, 0x66, 0x74,
+	0x77, 0x61, 0x72, 0x65, 0x42, 0x4f, 0x4d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
+	0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a,
+	0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2b, 0x0a, 0x11,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x15, 0x53, 0x6f,
+	0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x32, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x77,
+	0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x72,
+	0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44,
+	0x10, 0x03, 0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13,
+	0x69, 0x6e, 0x62, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x62, 0x6d, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x93,
-	0x02, 0x0a, 0x0f, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e,
-	0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f,
-	0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x62,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbf, 0x02, 0x0a, 0x0f,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x69,
+	0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x41, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e,
-	0x0a, 0x13, 0x69, 0x6e, 0x62, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x62,
-	0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69, 0x74,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67,
-	0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x15, 0x50, 0x6f, 0x77,
-	0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x2b,
-	0x0a, 0x11, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x6a,
-	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x70, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0xeb, 0x02, 0x0a, 0x07,
-	0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77,
-	0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x62, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x66, 0x69,
-	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69,
-	0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x28, 0x0a,
-	0x07, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x06, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
-	0x77, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x11, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x77, 0x62, 0x6f, 0x6d, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x57, 0x42, 0x4f, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x77, 0x62, 0x6f, 0x6d,
-	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x2a, 0xbe, 0x01, 0x0a, 0x0b, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x51, 0x55, 0x45,
-	0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x51, 0x55, 0x45, 0x52, 0x59,
-	0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x41, 0x52, 0x44, 0x57, 0x41, 0x52, 0x45,
-	0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x52, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a,
-	0x0f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x53,
-	0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x42, 0x4f, 0x4d, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x51, 0x55,
-	0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49,
-	0x4f, 0x4e, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x06, 0x32, 0x8b, 0x07, 0x0a, 0x0a, 0x49,
-	0x6e, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72,
-	0x65, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x65, 0x74, 0x6f,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x76, 0x65, 0x74, 0x6f, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb0, 0x01,
+	0x0a, 0x15, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x75, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x68, 0x75, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x69, 0x62, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4a, 0x73, 0x6f, 0x6e,
+	0x22, 0xeb, 0x02, 0x0a, 0x07, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x31, 0x0a, 0x08,
+	0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x12,
+	0x31, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x53,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x12,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73,
+	0x77, 0x62, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x62,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x57, 0x42, 0x4f, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x73, 0x77, 0x62, 0x6f, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x2a, 0xd7,
+	0x02, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x18, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x41, 0x52,
+	0x44, 0x57, 0x41, 0x52, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x51, 0x55, 0x45, 0x52, 0x59,
+	0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x52, 0x4d, 0x57, 0x41, 0x52, 0x45,
+	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x51, 0x55, 0x45, 0x52, 0x59,
+	0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x42, 0x4f, 0x4d, 0x10, 0x04, 0x12,
+	0x18, 0x0a, 0x14, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x51, 0x55, 0x45,
+	0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x06, 0x12,
+	0x24, 0x0a, 0x20, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x46, 0x49, 0x52, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45,
+	0x4e, 0x54, 0x53, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x10,
+	0x08, 0x12, 0x1b, 0x0a, 0x17, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x09, 0x12, 0x20,
+	0x0a, 0x1c, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56,
+	0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x49, 0x45, 0x53, 0x10, 0x0a,
+	0x12, 0x16, 0x0a, 0x12, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x0b, 0x2a, 0x7e, 0x0a, 0x0a, 0x53, 0x42, 0x4f, 0x4d,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x42, 0x4f, 0x4d, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x42, 0x4f, 0x4d, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x53, 0x10, 0x01, 0x12, 0x19, 0x0a,
+	0x15, 0x53, 0x42, 0x4f, 0x4d, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x50, 0x44,
+	0x58, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x42, 0x4f, 0x4d,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x44,
+	0x58, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x03, 0x32, 0xcd, 0x0d, 0x0a, 0x0a, 0x49, 0x6e, 0x62,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x12,
+	0x24, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x62,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x63, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x53, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
 	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x49, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4f, 0x53, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x41,
-	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
-	0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x2e,
-	0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x55, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6e,
+	0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x26,
+	0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x41, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e,
 	0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64,
@@ -2338,19 +6049,52 @@ var file_pkg_api_inbd_v1_inbd_proto_rawDesc = []byte{
 	0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69,
 	0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
-	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x62, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e,
+	0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x69,
+	0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x36, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x69, 0x6e, 0x62, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50,
-	0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x69, 0x6e, 0x62, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x6e,
+	0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e,
+	0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72,
+	0x65, 0x42, 0x4f, 0x4d, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x42, 0x4f, 0x4d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x42, 0x4f, 0x4d, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x19, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x75, 0x6c, 0x6e,
+	0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
+	0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69,
+	0x6e, 0x62, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x75, 0x6c, 0x6e, 0x65,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x65, 0x64, 0x67, 0x65,
 	0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x6e,
@@ -2372,94 +6116,189 @@ func file_pkg_api_inbd_v1_inbd_proto_rawDescGZIP() []byte {
 	return file_pkg_api_inbd_v1_inbd_proto_rawDescData
 }
 
-var file_pkg_api_inbd_v1_inbd_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_pkg_api_inbd_v1_inbd_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_pkg_api_inbd_v1_inbd_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_pkg_api_inbd_v1_inbd_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_pkg_api_inbd_v1_inbd_proto_goTypes = []interface{}{
 	(QueryOption)(0),                              // 0: inbd.v1.QueryOption
-	(SetPowerStateRequest_PowerAction)(0),         // 1: inbd.v1.SetPowerStateRequest.PowerAction
-	(UpdateSystemSoftwareRequest_DownloadMode)(0), // 2: inbd.v1.UpdateSystemSoftwareRequest.DownloadMode
-	(*SetPowerStateRequest)(nil),                  // 3: inbd.v1.SetPowerStateRequest
-	(*UpdateFirmwareRequest)(nil),                 // 4: inbd.v1.UpdateFirmwareRequest
-	(*UpdateSystemSoftwareRequest)(nil),           // 5: inbd.v1.UpdateSystemSoftwareRequest
-	(*UpdateOSSourceRequest)(nil),                 // 6: inbd.v1.UpdateOSSourceRequest
-	(*AddApplicationSourceRequest)(nil),           // 7: inbd.v1.AddApplicationSourceRequest
-	(*RemoveApplicationSourceRequest)(nil),        // 8: inbd.v1.RemoveApplicationSourceRequest
-	(*UpdateResponse)(nil),                        // 9: inbd.v1.UpdateResponse
-	(*SetPowerStateResponse)(nil),                 // 10: inbd.v1.SetPowerStateResponse
-	(*LoadConfigRequest)(nil),                     // 11: inbd.v1.LoadConfigRequest
-	(*GetConfigRequest)(nil),                      // 12: inbd.v1.GetConfigRequest
-	(*SetConfigRequest)(nil),                      // 13: inbd.v1.SetConfigRequest
-	(*AppendConfigRequest)(nil),                   // 14: inbd.v1.AppendConfigRequest
-	(*RemoveConfigRequest)(nil),                   // 15: inbd.v1.RemoveConfigRequest
-	(*ConfigResponse)(nil),                        // 16: inbd.v1.ConfigResponse
-	(*GetConfigResponse)(nil),                     // 17: inbd.v1.GetConfigResponse
-	(*QueryRequest)(nil),                          // 18: inbd.v1.QueryRequest
-	(*QueryResponse)(nil),                         // 19: inbd.v1.QueryResponse
-	(*QueryData)(nil),                             // 20: inbd.v1.QueryData
-	(*HardwareInfo)(nil),                          // 21: inbd.v1.HardwareInfo
-	(*FirmwareInfo)(nil),                          // 22: inbd.v1.FirmwareInfo
-	(*OSInfo)(nil),                                // 23: inbd.v1.OSInfo
-	(*SWBOMInfo)(nil),                             // 24: inbd.v1.SWBOMInfo
-	(*SoftwarePackage)(nil),                       // 25: inbd.v1.SoftwarePackage
-	(*VersionInfo)(nil),                           // 26: inbd.v1.VersionInfo
-	(*PowerCapabilitiesInfo)(nil),                 // 27: inbd.v1.PowerCapabilitiesInfo
-	(*AllInfo)(nil),                               // 28: inbd.v1.AllInfo
-	(*timestamppb.Timestamp)(nil),                 // 29: google.protobuf.Timestamp
+	(SBOMFormat)(0),                               // 1: inbd.v1.SBOMFormat
+	(SetPowerStateRequest_PowerAction)(0),         // 2: inbd.v1.SetPowerStateRequest.PowerAction
+	(UpdateSystemSoftwareRequest_DownloadMode)(0), // 3: inbd.v1.UpdateSystemSoftwareRequest.DownloadMode
+	(SoftwarePackageChange_ChangeType)(0),         // 4: inbd.v1.SoftwarePackageChange.ChangeType
+	(*SetPowerStateRequest)(nil),                  // 5: inbd.v1.SetPowerStateRequest
+	(*CancelPowerActionRequest)(nil),              // 6: inbd.v1.CancelPowerActionRequest
+	(*CancelPowerActionResponse)(nil),             // 7: inbd.v1.CancelPowerActionResponse
+	(*UpdateFirmwareRequest)(nil),                 // 8: inbd.v1.UpdateFirmwareRequest
+	(*UpdateSystemSoftwareRequest)(nil),           // 9: inbd.v1.UpdateSystemSoftwareRequest
+	(*StagedArtifact)(nil),                        // 10: inbd.v1.StagedArtifact
+	(*ListStagedArtifactsRequest)(nil),            // 11: inbd.v1.ListStagedArtifactsRequest
+	(*ListStagedArtifactsResponse)(nil),           // 12: inbd.v1.ListStagedArtifactsResponse
+	(*DeleteStagedArtifactRequest)(nil),           // 13: inbd.v1.DeleteStagedArtifactRequest
+	(*DeleteStagedArtifactResponse)(nil),          // 14: inbd.v1.DeleteStagedArtifactResponse
+	(*UpdateOSSourceRequest)(nil),                 // 15: inbd.v1.UpdateOSSourceRequest
+	(*AddApplicationSourceRequest)(nil),           // 16: inbd.v1.AddApplicationSourceRequest
+	(*RemoveApplicationSourceRequest)(nil),        // 17: inbd.v1.RemoveApplicationSourceRequest
+	(*ApplicationSource)(nil),                     // 18: inbd.v1.ApplicationSource
+	(*ListApplicationSourcesRequest)(nil),         // 19: inbd.v1.ListApplicationSourcesRequest
+	(*ListApplicationSourcesResponse)(nil),        // 20: inbd.v1.ListApplicationSourcesResponse
+	(*UpdateResponse)(nil),                        // 21: inbd.v1.UpdateResponse
+	(*SetPowerStateResponse)(nil),                 // 22: inbd.v1.SetPowerStateResponse
+	(*LoadConfigRequest)(nil),                     // 23: inbd.v1.LoadConfigRequest
+	(*LoadVulnerabilityDatabaseRequest)(nil),      // 24: inbd.v1.LoadVulnerabilityDatabaseRequest
+	(*LoadVulnerabilityDatabaseResponse)(nil),     // 25: inbd.v1.LoadVulnerabilityDatabaseResponse
+	(*GetConfigRequest)(nil),                      // 26: inbd.v1.GetConfigRequest
+	(*SetConfigRequest)(nil),                      // 27: inbd.v1.SetConfigRequest
+	(*AppendConfigRequest)(nil),                   // 28: inbd.v1.AppendConfigRequest
+	(*RemoveConfigRequest)(nil),                   // 29: inbd.v1.RemoveConfigRequest
+	(*GetConfigHistoryRequest)(nil),               // 30: inbd.v1.GetConfigHistoryRequest
+	(*GetConfigHistoryResponse)(nil),              // 31: inbd.v1.GetConfigHistoryResponse
+	(*ConfigRevision)(nil),                        // 32: inbd.v1.ConfigRevision
+	(*DiffConfigRequest)(nil),                     // 33: inbd.v1.DiffConfigRequest
+	(*DiffConfigResponse)(nil),                    // 34: inbd.v1.DiffConfigResponse
+	(*ConfigChange)(nil),                          // 35: inbd.v1.ConfigChange
+	(*RollbackConfigRequest)(nil),                 // 36: inbd.v1.RollbackConfigRequest
+	(*ConfigResponse)(nil),                        // 37: inbd.v1.ConfigResponse
+	(*GetConfigResponse)(nil),                     // 38: inbd.v1.GetConfigResponse
+	(*QueryRequest)(nil),                          // 39: inbd.v1.QueryRequest
+	(*QueryResponse)(nil),                         // 40: inbd.v1.QueryResponse
+	(*QueryData)(nil),                             // 41: inbd.v1.QueryData
+	(*HardwareInfo)(nil),                          // 42: inbd.v1.HardwareInfo
+	(*DiskInfo)(nil),                              // 43: inbd.v1.DiskInfo
+	(*NetworkInterface)(nil),                      // 44: inbd.v1.NetworkInterface
+	(*PCIDevice)(nil),                             // 45: inbd.v1.PCIDevice
+	(*TPMInfo)(nil),                               // 46: inbd.v1.TPMInfo
+	(*MemoryModule)(nil),                          // 47: inbd.v1.MemoryModule
+	(*FirmwareInfo)(nil),                          // 48: inbd.v1.FirmwareInfo
+	(*FirmwareComponentsInfo)(nil),                // 49: inbd.v1.FirmwareComponentsInfo
+	(*FirmwareComponent)(nil),                     // 50: inbd.v1.FirmwareComponent
+	(*AuditLogInfo)(nil),                          // 51: inbd.v1.AuditLogInfo
+	(*AuditLogEntry)(nil),                         // 52: inbd.v1.AuditLogEntry
+	(*ProvenanceInfo)(nil),                        // 53: inbd.v1.ProvenanceInfo
+	(*ProvenanceRecord)(nil),                      // 54: inbd.v1.ProvenanceRecord
+	(*VulnerabilitiesInfo)(nil),                   // 55: inbd.v1.VulnerabilitiesInfo
+	(*VulnerabilityFinding)(nil),                  // 56: inbd.v1.VulnerabilityFinding
+	(*OSInfo)(nil),                                // 57: inbd.v1.OSInfo
+	(*SWBOMInfo)(nil),                             // 58: inbd.v1.SWBOMInfo
+	(*SoftwarePackage)(nil),                       // 59: inbd.v1.SoftwarePackage
+	(*StreamSoftwareBOMRequest)(nil),              // 60: inbd.v1.StreamSoftwareBOMRequest
+	(*SoftwareBOMChunk)(nil),                      // 61: inbd.v1.SoftwareBOMChunk
+	(*SoftwarePackageChange)(nil),                 // 62: inbd.v1.SoftwarePackageChange
+	(*VersionInfo)(nil),                           // 63: inbd.v1.VersionInfo
+	(*PowerInfo)(nil),                             // 64: inbd.v1.PowerInfo
+	(*PowerActionInfo)(nil),                       // 65: inbd.v1.PowerActionInfo
+	(*PowerCapabilitiesInfo)(nil),                 // 66: inbd.v1.PowerCapabilitiesInfo
+	(*AllInfo)(nil),                               // 67: inbd.v1.AllInfo
+	(*timestamppb.Timestamp)(nil),                 // 68: google.protobuf.Timestamp
 }
 var file_pkg_api_inbd_v1_inbd_proto_depIdxs = []int32{
-	1,  // 0: inbd.v1.SetPowerStateRequest.action:type_name -> inbd.v1.SetPowerStateRequest.PowerAction
-	29, // 1: inbd.v1.UpdateFirmwareRequest.release_date:type_name -> google.protobuf.Timestamp
-	29, // 2: inbd.v1.UpdateSystemSoftwareRequest.release_date:type_name -> google.protobuf.Timestamp
-	2,  // 3: inbd.v1.UpdateSystemSoftwareRequest.mode:type_name -> inbd.v1.UpdateSystemSoftwareRequest.DownloadMode
-	0,  // 4: inbd.v1.QueryRequest.option:type_name -> inbd.v1.QueryOption
-	20, // 5: inbd.v1.QueryResponse.data:type_name -> inbd.v1.QueryData
-	29, // 6: inbd.v1.QueryData.timestamp:type_name -> google.protobuf.Timestamp
-	21, // 7: inbd.v1.QueryData.hardware:type_name -> inbd.v1.HardwareInfo
-	22, // 8: inbd.v1.QueryData.firmware:type_name -> inbd.v1.FirmwareInfo
-	23, // 9: inbd.v1.QueryData.os_info:type_name -> inbd.v1.OSInfo
-	24, // 10: inbd.v1.QueryData.swbom:type_name -> inbd.v1.SWBOMInfo
-	26, // 11: inbd.v1.QueryData.version:type_name -> inbd.v1.VersionInfo
-	28, // 12: inbd.v1.QueryData.all_info:type_name -> inbd.v1.AllInfo
-	29, // 13: inbd.v1.FirmwareInfo.bios_release_date:type_name -> google.protobuf.Timestamp
-	25, // 14: inbd.v1.SWBOMInfo.packages:type_name -> inbd.v1.SoftwarePackage
-	29, // 15: inbd.v1.SWBOMInfo.collection_timestamp:type_name -> google.protobuf.Timestamp
-	29, // 16: inbd.v1.SoftwarePackage.install_date:type_name -> google.protobuf.Timestamp
-	29, // 17: inbd.v1.VersionInfo.build_date:type_name -> google.protobuf.Timestamp
-	21, // 18: inbd.v1.AllInfo.hardware:type_name -> inbd.v1.HardwareInfo
-	22, // 19: inbd.v1.AllInfo.firmware:type_name -> inbd.v1.FirmwareInfo
-	23, // 20: inbd.v1.AllInfo.os_info:type_name -> inbd.v1.OSInfo
-	26, // 21: inbd.v1.AllInfo.version:type_name -> inbd.v1.VersionInfo
-	27, // 22: inbd.v1.AllInfo.power_capabilities:type_name -> inbd.v1.PowerCapabilitiesInfo
-	24, // 23: inbd.v1.AllInfo.swbom:type_name -> inbd.v1.SWBOMInfo
-	5,  // 24: inbd.v1.InbService.UpdateSystemSoftware:input_type -> inbd.v1.UpdateSystemSoftwareRequest
-	6,  // 25: inbd.v1.InbService.UpdateOSSource:input_type -> inbd.v1.UpdateOSSourceRequest
-	7,  // 26: inbd.v1.InbService.AddApplicationSource:input_type -> inbd.v1.AddApplicationSourceRequest
-	8,  // 27: inbd.v1.InbService.RemoveApplicationSource:input_type -> inbd.v1.RemoveApplicationSourceRequest
-	11, // 28: inbd.v1.InbService.LoadConfig:input_type -> inbd.v1.LoadConfigRequest
-	12, // 29: inbd.v1.InbService.GetConfig:input_type -> inbd.v1.GetConfigRequest
-	13, // 30: inbd.v1.InbService.SetConfig:input_type -> inbd.v1.SetConfigRequest
-	14, // 31: inbd.v1.InbService.AppendConfig:input_type -> inbd.v1.AppendConfigRequest
-	15, // 32: inbd.v1.InbService.RemoveConfig:input_type -> inbd.v1.RemoveConfigRequest
-	4,  // 33: inbd.v1.InbService.UpdateFirmware:input_type -> inbd.v1.UpdateFirmwareRequest
-	18, // 34: inbd.v1.InbService.Query:input_type -> inbd.v1.QueryRequest
-	3,  // 35: inbd.v1.InbService.SetPowerState:input_type -> inbd.v1.SetPowerStateRequest
-	9,  // 36: inbd.v1.InbService.UpdateSystemSoftware:output_type -> inbd.v1.UpdateResponse
-	9,  // 37: inbd.v1.InbService.UpdateOSSource:output_type -> inbd.v1.UpdateResponse
-	9,  // 38: inbd.v1.InbService.AddApplicationSource:output_type -> inbd.v1.UpdateResponse
-	9,  // 39: inbd.v1.InbService.RemoveApplicationSource:output_type -> inbd.v1.UpdateResponse
-	16, // 40: inbd.v1.InbService.LoadConfig:output_type -> inbd.v1.ConfigResponse
-	17, // 41: inbd.v1.InbService.GetConfig:output_type -> inbd.v1.GetConfigResponse
-	16, // 42: inbd.v1.InbService.SetConfig:output_type -> inbd.v1.ConfigResponse
-	16, // 43: inbd.v1.InbService.AppendConfig:output_type -> inbd.v1.ConfigResponse
-	16, // 44: inbd.v1.InbService.RemoveConfig:output_type -> inbd.v1.ConfigResponse
-	9,  // 45: inbd.v1.InbService.UpdateFirmware:output_type -> inbd.v1.UpdateResponse
-	19, // 46: inbd.v1.InbService.Query:output_type -> inbd.v1.QueryResponse
-	10, // 47: inbd.v1.InbService.SetPowerState:output_type -> inbd.v1.SetPowerStateResponse
-	36, // [36:48] is the sub-list for method output_type
-	24, // [24:36] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	2,  // 0: inbd.v1.SetPowerStateRequest.action:type_name -> inbd.v1.SetPowerStateRequest.PowerAction
+	68, // 1: inbd.v1.SetPowerStateRequest.scheduled_time:type_name -> google.protobuf.Timestamp
+	65, // 2: inbd.v1.CancelPowerActionResponse.canceled:type_name -> inbd.v1.PowerActionInfo
+	68, // 3: inbd.v1.UpdateFirmwareRequest.release_date:type_name -> google.protobuf.Timestamp
+	68, // 4: inbd.v1.UpdateSystemSoftwareRequest.release_date:type_name -> google.protobuf.Timestamp
+	3,  // 5: inbd.v1.UpdateSystemSoftwareRequest.mode:type_name -> inbd.v1.UpdateSystemSoftwareRequest.DownloadMode
+	68, // 6: inbd.v1.StagedArtifact.staged_time:type_name -> google.protobuf.Timestamp
+	10, // 7: inbd.v1.ListStagedArtifactsResponse.artifacts:type_name -> inbd.v1.StagedArtifact
+	18, // 8: inbd.v1.AddApplicationSourceRequest.application_source:type_name -> inbd.v1.ApplicationSource
+	18, // 9: inbd.v1.ListApplicationSourcesResponse.sources:type_name -> inbd.v1.ApplicationSource
+	68, // 10: inbd.v1.SetPowerStateResponse.scheduled_time:type_name -> google.protobuf.Timestamp
+	32, // 11: inbd.v1.GetConfigHistoryResponse.revisions:type_name -> inbd.v1.ConfigRevision
+	68, // 12: inbd.v1.ConfigRevision.time:type_name -> google.protobuf.Timestamp
+	35, // 13: inbd.v1.DiffConfigResponse.changes:type_name -> inbd.v1.ConfigChange
+	0,  // 14: inbd.v1.QueryRequest.option:type_name -> inbd.v1.QueryOption
+	41, // 15: inbd.v1.QueryResponse.data:type_name -> inbd.v1.QueryData
+	68, // 16: inbd.v1.QueryData.timestamp:type_name -> google.protobuf.Timestamp
+	42, // 17: inbd.v1.QueryData.hardware:type_name -> inbd.v1.HardwareInfo
+	48, // 18: inbd.v1.QueryData.firmware:type_name -> inbd.v1.FirmwareInfo
+	57, // 19: inbd.v1.QueryData.os_info:type_name -> inbd.v1.OSInfo
+	58, // 20: inbd.v1.QueryData.swbom:type_name -> inbd.v1.SWBOMInfo
+	63, // 21: inbd.v1.QueryData.version:type_name -> inbd.v1.VersionInfo
+	67, // 22: inbd.v1.QueryData.all_info:type_name -> inbd.v1.AllInfo
+	49, // 23: inbd.v1.QueryData.firmware_components:type_name -> inbd.v1.FirmwareComponentsInfo
+	51, // 24: inbd.v1.QueryData.audit_log:type_name -> inbd.v1.AuditLogInfo
+	53, // 25: inbd.v1.QueryData.provenance:type_name -> inbd.v1.ProvenanceInfo
+	55, // 26: inbd.v1.QueryData.vulnerabilities:type_name -> inbd.v1.VulnerabilitiesInfo
+	64, // 27: inbd.v1.QueryData.power:type_name -> inbd.v1.PowerInfo
+	43, // 28: inbd.v1.HardwareInfo.disks:type_name -> inbd.v1.DiskInfo
+	44, // 29: inbd.v1.HardwareInfo.network_interfaces:type_name -> inbd.v1.NetworkInterface
+	45, // 30: inbd.v1.HardwareInfo.pci_devices:type_name -> inbd.v1.PCIDevice
+	46, // 31: inbd.v1.HardwareInfo.tpm:type_name -> inbd.v1.TPMInfo
+	47, // 32: inbd.v1.HardwareInfo.memory_modules:type_name -> inbd.v1.MemoryModule
+	68, // 33: inbd.v1.FirmwareInfo.bios_release_date:type_name -> google.protobuf.Timestamp
+	50, // 34: inbd.v1.FirmwareComponentsInfo.components:type_name -> inbd.v1.FirmwareComponent
+	52, // 35: inbd.v1.AuditLogInfo.entries:type_name -> inbd.v1.AuditLogEntry
+	68, // 36: inbd.v1.AuditLogEntry.time:type_name -> google.protobuf.Timestamp
+	54, // 37: inbd.v1.ProvenanceInfo.records:type_name -> inbd.v1.ProvenanceRecord
+	68, // 38: inbd.v1.ProvenanceRecord.time:type_name -> google.protobuf.Timestamp
+	68, // 39: inbd.v1.VulnerabilitiesInfo.database_loaded:type_name -> google.protobuf.Timestamp
+	56, // 40: inbd.v1.VulnerabilitiesInfo.findings:type_name -> inbd.v1.VulnerabilityFinding
+	59, // 41: inbd.v1.SWBOMInfo.packages:type_name -> inbd.v1.SoftwarePackage
+	68, // 42: inbd.v1.SWBOMInfo.collection_timestamp:type_name -> google.protobuf.Timestamp
+	68, // 43: inbd.v1.SoftwarePackage.install_date:type_name -> google.protobuf.Timestamp
+	1,  // 44: inbd.v1.StreamSoftwareBOMRequest.format:type_name -> inbd.v1.SBOMFormat
+	59, // 45: inbd.v1.SoftwareBOMChunk.packages:type_name -> inbd.v1.SoftwarePackage
+	62, // 46: inbd.v1.SoftwareBOMChunk.changes:type_name -> inbd.v1.SoftwarePackageChange
+	68, // 47: inbd.v1.SoftwareBOMChunk.collection_timestamp:type_name -> google.protobuf.Timestamp
+	4,  // 48: inbd.v1.SoftwarePackageChange.change_type:type_name -> inbd.v1.SoftwarePackageChange.ChangeType
+	59, // 49: inbd.v1.SoftwarePackageChange.package:type_name -> inbd.v1.SoftwarePackage
+	68, // 50: inbd.v1.VersionInfo.build_date:type_name -> google.protobuf.Timestamp
+	66, // 51: inbd.v1.PowerInfo.capabilities:type_name -> inbd.v1.PowerCapabilitiesInfo
+	65, // 52: inbd.v1.PowerInfo.pending_actions:type_name -> inbd.v1.PowerActionInfo
+	2,  // 53: inbd.v1.PowerActionInfo.action:type_name -> inbd.v1.SetPowerStateRequest.PowerAction
+	68, // 54: inbd.v1.PowerActionInfo.scheduled_time:type_name -> google.protobuf.Timestamp
+	68, // 55: inbd.v1.PowerActionInfo.requested_time:type_name -> google.protobuf.Timestamp
+	42, // 56: inbd.v1.AllInfo.hardware:type_name -> inbd.v1.HardwareInfo
+	48, // 57: inbd.v1.AllInfo.firmware:type_name -> inbd.v1.FirmwareInfo
+	57, // 58: inbd.v1.AllInfo.os_info:type_name -> inbd.v1.OSInfo
+	63, // 59: inbd.v1.AllInfo.version:type_name -> inbd.v1.VersionInfo
+	66, // 60: inbd.v1.AllInfo.power_capabilities:type_name -> inbd.v1.PowerCapabilitiesInfo
+	58, // 61: inbd.v1.AllInfo.swbom:type_name -> inbd.v1.SWBOMInfo
+	9,  // 62: inbd.v1.InbService.UpdateSystemSoftware:input_type -> inbd.v1.UpdateSystemSoftwareRequest
+	11, // 63: inbd.v1.InbService.ListStagedArtifacts:input_type -> inbd.v1.ListStagedArtifactsRequest
+	13, // 64: inbd.v1.InbService.DeleteStagedArtifact:input_type -> inbd.v1.DeleteStagedArtifactRequest
+	15, // 65: inbd.v1.InbService.UpdateOSSource:input_type -> inbd.v1.UpdateOSSourceRequest
+	16, // 66: inbd.v1.InbService.AddApplicationSource:input_type -> inbd.v1.AddApplicationSourceRequest
+	17, // 67: inbd.v1.InbService.RemoveApplicationSource:input_type -> inbd.v1.RemoveApplicationSourceRequest
+	19, // 68: inbd.v1.InbService.ListApplicationSources:input_type -> inbd.v1.ListApplicationSourcesRequest
+	23, // 69: inbd.v1.InbService.LoadConfig:input_type -> inbd.v1.LoadConfigRequest
+	26, // 70: inbd.v1.InbService.GetConfig:input_type -> inbd.v1.GetConfigRequest
+	27, // 71: inbd.v1.InbService.SetConfig:input_type -> inbd.v1.SetConfigRequest
+	28, // 72: inbd.v1.InbService.AppendConfig:input_type -> inbd.v1.AppendConfigRequest
+	29, // 73: inbd.v1.InbService.RemoveConfig:input_type -> inbd.v1.RemoveConfigRequest
+	30, // 74: inbd.v1.InbService.GetConfigHistory:input_type -> inbd.v1.GetConfigHistoryRequest
+	33, // 75: inbd.v1.InbService.DiffConfig:input_type -> inbd.v1.DiffConfigRequest
+	36, // 76: inbd.v1.InbService.RollbackConfig:input_type -> inbd.v1.RollbackConfigRequest
+	8,  // 77: inbd.v1.InbService.UpdateFirmware:input_type -> inbd.v1.UpdateFirmwareRequest
+	39, // 78: inbd.v1.InbService.Query:input_type -> inbd.v1.QueryRequest
+	5,  // 79: inbd.v1.InbService.SetPowerState:input_type -> inbd.v1.SetPowerStateRequest
+	6,  // 80: inbd.v1.InbService.CancelPowerAction:input_type -> inbd.v1.CancelPowerActionRequest
+	60, // 81: inbd.v1.InbService.StreamSoftwareBOM:input_type -> inbd.v1.StreamSoftwareBOMRequest
+	24, // 82: inbd.v1.InbService.LoadVulnerabilityDatabase:input_type -> inbd.v1.LoadVulnerabilityDatabaseRequest
+	21, // 83: inbd.v1.InbService.UpdateSystemSoftware:output_type -> inbd.v1.UpdateResponse
+	12, // 84: inbd.v1.InbService.ListStagedArtifacts:output_type -> inbd.v1.ListStagedArtifactsResponse
+	14, // 85: inbd.v1.InbService.DeleteStagedArtifact:output_type -> inbd.v1.DeleteStagedArtifactResponse
+	21, // 86: inbd.v1.InbService.UpdateOSSource:output_type -> inbd.v1.UpdateResponse
+	21, // 87: inbd.v1.InbService.AddApplicationSource:output_type -> inbd.v1.UpdateResponse
+	21, // 88: inbd.v1.InbService.RemoveApplicationSource:output_type -> inbd.v1.UpdateResponse
+	20, // 89: inbd.v1.InbService.ListApplicationSources:output_type -> inbd.v1.ListApplicationSourcesResponse
+	37, // 90: inbd.v1.InbService.LoadConfig:output_type -> inbd.v1.ConfigResponse
+	38, // 91: inbd.v1.InbService.GetConfig:output_type -> inbd.v1.GetConfigResponse
+	37, // 92: inbd.v1.InbService.SetConfig:output_type -> inbd.v1.ConfigResponse
+	37, // 93: inbd.v1.InbService.AppendConfig:output_type -> inbd.v1.ConfigResponse
+	37, // 94: inbd.v1.InbService.RemoveConfig:output_type -> inbd.v1.ConfigResponse
+	31, // 95: inbd.v1.InbService.GetConfigHistory:output_type -> inbd.v1.GetConfigHistoryResponse
+	34, // 96: inbd.v1.InbService.DiffConfig:output_type -> inbd.v1.DiffConfigResponse
+	37, // 97: inbd.v1.InbService.RollbackConfig:output_type -> inbd.v1.ConfigResponse
+	21, // 98: inbd.v1.InbService.UpdateFirmware:output_type -> inbd.v1.UpdateResponse
+	40, // 99: inbd.v1.InbService.Query:output_type -> inbd.v1.QueryResponse
+	22, // 100: inbd.v1.InbService.SetPowerState:output_type -> inbd.v1.SetPowerStateResponse
+	7,  // 101: inbd.v1.InbService.CancelPowerAction:output_type -> inbd.v1.CancelPowerActionResponse
+	61, // 102: inbd.v1.InbService.StreamSoftwareBOM:output_type -> inbd.v1.SoftwareBOMChunk
+	25, // 103: inbd.v1.InbService.LoadVulnerabilityDatabase:output_type -> inbd.v1.LoadVulnerabilityDatabaseResponse
+	83, // [83:104] is the sub-list for method output_type
+	62, // [62:83] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_pkg_api_inbd_v1_inbd_proto_init() }
@@ -2480,8 +6319,212 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFirmwareRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPowerActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPowerActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFirmwareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSystemSoftwareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StagedArtifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStagedArtifactsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStagedArtifactsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStagedArtifactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStagedArtifactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOSSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddApplicationSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveApplicationSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApplicationSourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApplicationSourcesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPowerStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2492,8 +6535,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSystemSoftwareRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadVulnerabilityDatabaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2504,8 +6547,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOSSourceRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadVulnerabilityDatabaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2516,8 +6559,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddApplicationSourceRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2528,8 +6571,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveApplicationSourceRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2540,8 +6583,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateResponse); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2552,8 +6595,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPowerStateResponse); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2564,8 +6607,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadConfigRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2576,8 +6619,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2588,8 +6631,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetConfigRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2600,8 +6643,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppendConfigRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2612,8 +6655,8 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveConfigRequest); i {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2624,7 +6667,31 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfigResponse); i {
 			case 0:
 				return &v.state
@@ -2636,7 +6703,7 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetConfigResponse); i {
 			case 0:
 				return &v.state
@@ -2648,7 +6715,7 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryRequest); i {
 			case 0:
 				return &v.state
@@ -2660,7 +6727,7 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryResponse); i {
 			case 0:
 				return &v.state
@@ -2672,7 +6739,7 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryData); i {
 			case 0:
 				return &v.state
@@ -2684,7 +6751,7 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HardwareInfo); i {
 			case 0:
 				return &v.state
@@ -2696,7 +6763,67 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PCIDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TPMInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryModule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FirmwareInfo); i {
 			case 0:
 				return &v.state
@@ -2708,7 +6835,103 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirmwareComponentsInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirmwareComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvenanceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvenanceRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilitiesInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilityFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OSInfo); i {
 			case 0:
 				return &v.state
@@ -2720,7 +6943,7 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SWBOMInfo); i {
 			case 0:
 				return &v.state
@@ -2732,7 +6955,7 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SoftwarePackage); i {
 			case 0:
 				return &v.state
@@ -2744,7 +6967,43 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamSoftwareBOMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoftwareBOMChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoftwarePackageChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VersionInfo); i {
 			case 0:
 				return &v.state
@@ -2756,7 +7015,31 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerActionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PowerCapabilitiesInfo); i {
 			case 0:
 				return &v.state
@@ -2768,7 +7051,7 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_inbd_v1_inbd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_inbd_v1_inbd_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllInfo); i {
 			case 0:
 				return &v.state
@@ -2781,21 +7064,26 @@ func file_pkg_api_inbd_v1_inbd_proto_init() {
 			}
 		}
 	}
-	file_pkg_api_inbd_v1_inbd_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_pkg_api_inbd_v1_inbd_proto_msgTypes[36].OneofWrappers = []interface{}{
 		(*QueryData_Hardware)(nil),
 		(*QueryData_Firmware)(nil),
 		(*QueryData_OsInfo)(nil),
 		(*QueryData_Swbom)(nil),
 		(*QueryData_Version)(nil),
 		(*QueryData_AllInfo)(nil),
+		(*QueryData_FirmwareComponents)(nil),
+		(*QueryData_AuditLog)(nil),
+		(*QueryData_Provenance)(nil),
+		(*QueryData_Vulnerabilities)(nil),
+		(*QueryData_Power)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_api_inbd_v1_inbd_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   26,
+			NumEnums:      5,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,17 +26,26 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type InbServiceClient interface {
 	UpdateSystemSoftware(ctx context.Context, in *UpdateSystemSoftwareRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	ListStagedArtifacts(ctx context.Context, in *ListStagedArtifactsRequest, opts ...grpc.CallOption) (*ListStagedArtifactsResponse, error)
+	DeleteStagedArtifact(ctx context.Context, in *DeleteStagedArtifactRequest, opts ...grpc.CallOption) (*DeleteStagedArtifactResponse, error)
 	UpdateOSSource(ctx context.Context, in *UpdateOSSourceRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	AddApplicationSource(ctx context.Context, in *AddApplicationSourceRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	RemoveApplicationSource(ctx context.Context, in *RemoveApplicationSourceRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	ListApplicationSources(ctx context.Context, in *ListApplicationSourcesRequest, opts ...grpc.CallOption) (*ListApplicationSourcesResponse, error)
 	LoadConfig(ctx context.Context, in *LoadConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
 	SetConfig(ctx context.Context, in *SetConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	AppendConfig(ctx context.Context, in *AppendConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	RemoveConfig(ctx context.Context, in *RemoveConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+	GetConfigHistory(ctx context.Context, in *GetConfigHistoryRequest, opts ...grpc.CallOption) (*GetConfigHistoryResponse, error)
+	DiffConfig(ctx context.Context, in *DiffConfigRequest, opts ...grpc.CallOption) (*DiffConfigResponse, error)
+	RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	UpdateFirmware(ctx context.Context, in *UpdateFirmwareRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	SetPowerState(ctx context.Context, in *SetPowerStateRequest, opts ...grpc.CallOption) (*SetPowerStateResponse, error)
+	CancelPowerAction(ctx context.Context, in *CancelPowerActionRequest, opts ...grpc.CallOption) (*CancelPowerActionResponse, error)
+	StreamSoftwareBOM(ctx context.Context, in *StreamSoftwareBOMRequest, opts ...grpc.CallOption) (InbService_StreamSoftwareBOMClient, error)
+	LoadVulnerabilityDatabase(ctx context.Context, in *LoadVulnerabilityDatabaseRequest, opts ...grpc.CallOption) (*LoadVulnerabilityDatabaseResponse, error)
 }
 
 type inbServiceClient struct {
@@ -56,6 +65,24 @@ func (c *inbServiceClient) UpdateSystemSoftware(ctx context.Context, in *UpdateS
 	return out, nil
 }
 
+func (c *inbServiceClient) ListStagedArtifacts(ctx context.Context, in *ListStagedArtifactsRequest, opts ...grpc.CallOption) (*ListStagedArtifactsResponse, error) {
+	out := new(ListStagedArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/ListStagedArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inbServiceClient) DeleteStagedArtifact(ctx context.Context, in *DeleteStagedArtifactRequest, opts ...grpc.CallOption) (*DeleteStagedArtifactResponse, error) {
+	out := new(DeleteStagedArtifactResponse)
+	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/DeleteStagedArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inbServiceClient) UpdateOSSource(ctx context.Context, in *UpdateOSSourceRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
 	out := new(UpdateResponse)
 	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/UpdateOSSource", in, out, opts...)
@@ -83,6 +110,15 @@ func (c *inbServiceClient) RemoveApplicationSource(ctx context.Context, in *Remo
 	return out, nil
 }
 
+func (c *inbServiceClient) ListApplicationSources(ctx context.Context, in *ListApplicationSourcesRequest, opts ...grpc.CallOption) (*ListApplicationSourcesResponse, error) {
+	out := new(ListApplicationSourcesResponse)
+	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/ListApplicationSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inbServiceClient) LoadConfig(ctx context.Context, in *LoadConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
 	out := new(ConfigResponse)
 	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/LoadConfig", in, out, opts...)
@@ -128,6 +164,33 @@ func (c *inbServiceClient) RemoveConfig(ctx context.Context, in *RemoveConfigReq
 	return out, nil
 }
 
+func (c *inbServiceClient) GetConfigHistory(ctx context.Context, in *GetConfigHistoryRequest, opts ...grpc.CallOption) (*GetConfigHistoryResponse, error) {
+	out := new(GetConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/GetConfigHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inbServiceClient) DiffConfig(ctx context.Context, in *DiffConfigRequest, opts ...grpc.CallOption) (*DiffConfigResponse, error) {
+	out := new(DiffConfigResponse)
+	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/DiffConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inbServiceClient) RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
+	out := new(ConfigResponse)
+	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/RollbackConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inbServiceClient) UpdateFirmware(ctx context.Context, in *UpdateFirmwareRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
 	out := new(UpdateResponse)
 	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/UpdateFirmware", in, out, opts...)
@@ -155,22 +218,81 @@ func (c *inbServiceClient) SetPowerState(ctx context.Context, in *SetPowerStateR
 	return out, nil
 }
 
+func (c *inbServiceClient) CancelPowerAction(ctx context.Context, in *CancelPowerActionRequest, opts ...grpc.CallOption) (*CancelPowerActionResponse, error) {
+	out := new(CancelPowerActionResponse)
+	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/CancelPowerAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inbServiceClient) StreamSoftwareBOM(ctx context.Context, in *StreamSoftwareBOMRequest, opts ...grpc.CallOption) (InbService_StreamSoftwareBOMClient, error) {
+	stream, err := c.cc.NewStream(ctx, &InbService_ServiceDesc.Streams[0], "/inbd.v1.InbService/StreamSoftwareBOM", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &inbServiceStreamSoftwareBOMClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InbService_StreamSoftwareBOMClient interface {
+	Recv() (*SoftwareBOMChunk, error)
+	grpc.ClientStream
+}
+
+type inbServiceStreamSoftwareBOMClient struct {
+	grpc.ClientStream
+}
+
+func (x *inbServiceStreamSoftwareBOMClient) Recv() (*SoftwareBOMChunk, error) {
+	m := new(SoftwareBOMChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *inbServiceClient) LoadVulnerabilityDatabase(ctx context.Context, in *LoadVulnerabilityDatabaseRequest, opts ...grpc.CallOption) (*LoadVulnerabilityDatabaseResponse, error) {
+	out := new(LoadVulnerabilityDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/inbd.v1.InbService/LoadVulnerabilityDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InbServiceServer is the server API for InbService service.
 // All implementations must embed UnimplementedInbServiceServer
 // for forward compatibility
 type InbServiceServer interface {
 	UpdateSystemSoftware(context.Context, *UpdateSystemSoftwareRequest) (*UpdateResponse, error)
+	ListStagedArtifacts(context.Context, *ListStagedArtifactsRequest) (*ListStagedArtifactsResponse, error)
+	DeleteStagedArtifact(context.Context, *DeleteStagedArtifactRequest) (*DeleteStagedArtifactResponse, error)
 	UpdateOSSource(context.Context, *UpdateOSSourceRequest) (*UpdateResponse, error)
 	AddApplicationSource(context.Context, *AddApplicationSourceRequest) (*UpdateResponse, error)
 	RemoveApplicationSource(context.Context, *RemoveApplicationSourceRequest) (*UpdateResponse, error)
+	ListApplicationSources(context.Context, *ListApplicationSourcesRequest) (*ListApplicationSourcesResponse, error)
 	LoadConfig(context.Context, *LoadConfigRequest) (*ConfigResponse, error)
 	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
 	SetConfig(context.Context, *SetConfigRequest) (*ConfigResponse, error)
 	AppendConfig(context.Context, *AppendConfigRequest) (*ConfigResponse, error)
 	RemoveConfig(context.Context, *RemoveConfigRequest) (*ConfigResponse, error)
+	GetConfigHistory(context.Context, *GetConfigHistoryRequest) (*GetConfigHistoryResponse, error)
+	DiffConfig(context.Context, *DiffConfigRequest) (*DiffConfigResponse, error)
+	RollbackConfig(context.Context, *RollbackConfigRequest) (*ConfigResponse, error)
 	UpdateFirmware(context.Context, *UpdateFirmwareRequest) (*UpdateResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	SetPowerState(context.Context, *SetPowerStateRequest) (*SetPowerStateResponse, error)
+	CancelPowerAction(context.Context, *CancelPowerActionRequest) (*CancelPowerActionResponse, error)
+	StreamSoftwareBOM(*StreamSoftwareBOMRequest, InbService_StreamSoftwareBOMServer) error
+	LoadVulnerabilityDatabase(context.Context, *LoadVulnerabilityDatabaseRequest) (*LoadVulnerabilityDatabaseResponse, error)
 	mustEmbedUnimplementedInbServiceServer()
 }
 
@@ -181,6 +303,12 @@ type UnimplementedInbServiceServer struct {
 func (UnimplementedInbServiceServer) UpdateSystemSoftware(context.Context, *UpdateSystemSoftwareRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSystemSoftware not implemented")
 }
+func (UnimplementedInbServiceServer) ListStagedArtifacts(context.Context, *ListStagedArtifactsRequest) (*ListStagedArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStagedArtifacts not implemented")
+}
+func (UnimplementedInbServiceServer) DeleteStagedArtifact(context.Context, *DeleteStagedArtifactRequest) (*DeleteStagedArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStagedArtifact not implemented")
+}
 func (UnimplementedInbServiceServer) UpdateOSSource(context.Context, *UpdateOSSourceRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOSSource not implemented")
 }
@@ -190,6 +318,9 @@ func (UnimplementedInbServiceServer) AddApplicationSource(context.Context, *AddA
 func (UnimplementedInbServiceServer) RemoveApplicationSource(context.Context, *RemoveApplicationSourceRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveApplicationSource not implemented")
 }
+func (UnimplementedInbServiceServer) ListApplicationSources(context.Context, *ListApplicationSourcesRequest) (*ListApplicationSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplicationSources not implemented")
+}
 func (UnimplementedInbServiceServer) LoadConfig(context.Context, *LoadConfigRequest) (*ConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadConfig not implemented")
 }
@@ -205,6 +336,15 @@ func (UnimplementedInbServiceServer) AppendConfig(context.Context, *AppendConfig
 func (UnimplementedInbServiceServer) RemoveConfig(context.Context, *RemoveConfigRequest) (*ConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveConfig not implemented")
 }
+func (UnimplementedInbServiceServer) GetConfigHistory(context.Context, *GetConfigHistoryRequest) (*GetConfigHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigHistory not implemented")
+}
+func (UnimplementedInbServiceServer) DiffConfig(context.Context, *DiffConfigRequest) (*DiffConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffConfig not implemented")
+}
+func (UnimplementedInbServiceServer) RollbackConfig(context.Context, *RollbackConfigRequest) (*ConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfig not implemented")
+}
 func (UnimplementedInbServiceServer) UpdateFirmware(context.Context, *UpdateFirmwareRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateFirmware not implemented")
 }
@@ -214,6 +354,15 @@ func (UnimplementedInbServiceServer) Query(context.Context, *QueryRequest) (*Que
 func (UnimplementedInbServiceServer) SetPowerState(context.Context, *SetPowerStateRequest) (*SetPowerStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPowerState not implemented")
 }
+func (UnimplementedInbServiceServer) CancelPowerAction(context.Context, *CancelPowerActionRequest) (*CancelPowerActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPowerAction not implemented")
+}
+func (UnimplementedInbServiceServer) StreamSoftwareBOM(*StreamSoftwareBOMRequest, InbService_StreamSoftwareBOMServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSoftwareBOM not implemented")
+}
+func (UnimplementedInbServiceServer) LoadVulnerabilityDatabase(context.Context, *LoadVulnerabilityDatabaseRequest) (*LoadVulnerabilityDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadVulnerabilityDatabase not implemented")
+}
 func (UnimplementedInbServiceServer) mustEmbedUnimplementedInbServiceServer() {}
 
 // UnsafeInbServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -245,6 +394,42 @@ func _InbService_UpdateSystemSoftware_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InbService_ListStagedArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStagedArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InbServiceServer).ListStagedArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inbd.v1.InbService/ListStagedArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InbServiceServer).ListStagedArtifacts(ctx, req.(*ListStagedArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InbService_DeleteStagedArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStagedArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InbServiceServer).DeleteStagedArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inbd.v1.InbService/DeleteStagedArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InbServiceServer).DeleteStagedArtifact(ctx, req.(*DeleteStagedArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InbService_UpdateOSSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateOSSourceRequest)
 	if err := dec(in); err != nil {
@@ -299,6 +484,24 @@ func _InbService_RemoveApplicationSource_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InbService_ListApplicationSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InbServiceServer).ListApplicationSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inbd.v1.InbService/ListApplicationSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InbServiceServer).ListApplicationSources(ctx, req.(*ListApplicationSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InbService_LoadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoadConfigRequest)
 	if err := dec(in); err != nil {
@@ -389,6 +592,60 @@ func _InbService_RemoveConfig_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InbService_GetConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InbServiceServer).GetConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inbd.v1.InbService/GetConfigHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InbServiceServer).GetConfigHistory(ctx, req.(*GetConfigHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InbService_DiffConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InbServiceServer).DiffConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inbd.v1.InbService/DiffConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InbServiceServer).DiffConfig(ctx, req.(*DiffConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InbService_RollbackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InbServiceServer).RollbackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inbd.v1.InbService/RollbackConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InbServiceServer).RollbackConfig(ctx, req.(*RollbackConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InbService_UpdateFirmware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateFirmwareRequest)
 	if err := dec(in); err != nil {
@@ -443,6 +700,63 @@ func _InbService_SetPowerState_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InbService_CancelPowerAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPowerActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InbServiceServer).CancelPowerAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inbd.v1.InbService/CancelPowerAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InbServiceServer).CancelPowerAction(ctx, req.(*CancelPowerActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InbService_StreamSoftwareBOM_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSoftwareBOMRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InbServiceServer).StreamSoftwareBOM(m, &inbServiceStreamSoftwareBOMServer{stream})
+}
+
+type InbService_StreamSoftwareBOMServer interface {
+	Send(*SoftwareBOMChunk) error
+	grpc.ServerStream
+}
+
+type inbServiceStreamSoftwareBOMServer struct {
+	grpc.ServerStream
+}
+
+func (x *inbServiceStreamSoftwareBOMServer) Send(m *SoftwareBOMChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _InbService_LoadVulnerabilityDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadVulnerabilityDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InbServiceServer).LoadVulnerabilityDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inbd.v1.InbService/LoadVulnerabilityDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InbServiceServer).LoadVulnerabilityDatabase(ctx, req.(*LoadVulnerabilityDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InbService_ServiceDesc is the grpc.ServiceDesc for InbService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -454,6 +768,14 @@ var InbService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSystemSoftware",
 			Handler:    _InbService_UpdateSystemSoftware_Handler,
 		},
+		{
+			MethodName: "ListStagedArtifacts",
+			Handler:    _InbService_ListStagedArtifacts_Handler,
+		},
+		{
+			MethodName: "DeleteStagedArtifact",
+			Handler:    _InbService_DeleteStagedArtifact_Handler,
+		},
 		{
 			MethodName: "UpdateOSSource",
 			Handler:    _InbService_UpdateOSSource_Handler,
@@ -466,6 +788,10 @@ var InbService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveApplicationSource",
 			Handler:    _InbService_RemoveApplicationSource_Handler,
 		},
+		{
+			MethodName: "ListApplicationSources",
+			Handler:    _InbService_ListApplicationSources_Handler,
+		},
 		{
 			MethodName: "LoadConfig",
 			Handler:    _InbService_LoadConfig_Handler,
@@ -486,6 +812,18 @@ var InbService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveConfig",
 			Handler:    _InbService_RemoveConfig_Handler,
 		},
+		{
+			MethodName: "GetConfigHistory",
+			Handler:    _InbService_GetConfigHistory_Handler,
+		},
+		{
+			MethodName: "DiffConfig",
+			Handler:    _InbService_DiffConfig_Handler,
+		},
+		{
+			MethodName: "RollbackConfig",
+			Handler:    _InbService_RollbackConfig_Handler,
+		},
 		{
 			MethodName: "UpdateFirmware",
 			Handler:    _InbService_UpdateFirmware_Handler,
@@ -498,7 +836,21 @@ var InbService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPowerState",
 			Handler:    _InbService_SetPowerState_Handler,
 		},
+		{
+			MethodName: "CancelPowerAction",
+			Handler:    _InbService_CancelPowerAction_Handler,
+		},
+		{
+			MethodName: "LoadVulnerabilityDatabase",
+			Handler:    _InbService_LoadVulnerabilityDatabase_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSoftwareBOM",
+			Handler:       _InbService_StreamSoftwareBOM_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/inbd/v1/inbd.proto",
 }
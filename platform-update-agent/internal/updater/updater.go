@@ -347,6 +347,11 @@ func (u *UpdateController) VerifyUpdate(logPath string, granularLogPath string)
 	case "PENDING":
 		log.Infof("OS update status %s, update time: %s", updateStatus.Status, updateStatus.Time)
 		return pb.UpdateStatus_STATUS_TYPE_STARTED, updateLog, updateStatus.Time, nil
+	case "ROLLED_BACK":
+		// The update failed post-reboot verification and INBM restored the pre-update snapshot.
+		// The node runs the previous OS version, so the update is reported as failed.
+		log.Infof("OS update status %s, update time: %s", updateStatus.Status, updateStatus.Time)
+		return pb.UpdateStatus_STATUS_TYPE_FAILED, updateLog, updateStatus.Time, fmt.Errorf("update rolled back: %s", updateStatus.Error)
 	}
 
 	return pb.UpdateStatus_STATUS_TYPE_FAILED, updateLog, updateStatus.Time, fmt.Errorf("status of the last OS update is unknown. Please verify logs")
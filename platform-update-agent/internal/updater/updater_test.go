@@ -380,6 +380,33 @@ func Test_VerifyUpdate_handleStatusFailed(t *testing.T) {
 	assert.ErrorContains(t, err, "anyError")
 }
 
+func Test_VerifyUpdate_handleStatusRolledBack(t *testing.T) {
+
+	logFile, err := os.CreateTemp("/tmp", "inbc.log")
+	require.NoError(t, err)
+
+	defer logFile.Close()
+
+	_, err = logFile.WriteString(`{"Status":"ROLLED_BACK", "Time":"anyTime", "Error":"networkcheck"}`)
+	require.NoError(t, err)
+
+	granularLogFile, logErr := os.CreateTemp("/tmp", "inbc-log.log")
+	require.NoError(t, logErr)
+
+	defer granularLogFile.Close()
+
+	_, logErr = granularLogFile.WriteString(`{"UpdateLog":[{"StatusDetail.Status":"ROLLED_BACK","FailureReason":"networkcheck"}]}`)
+	require.NoError(t, logErr)
+
+	sut := (&UpdateController{}).VerifyUpdate
+	status, log, time, err := sut(logFile.Name(), granularLogFile.Name())
+
+	assert.Equal(t, pb.UpdateStatus_STATUS_TYPE_FAILED, status)
+	assert.Equal(t, `{"UpdateLog":[{"StatusDetail.Status":"ROLLED_BACK","FailureReason":"networkcheck"}]}`, log)
+	assert.Equal(t, "anyTime", time)
+	assert.ErrorContains(t, err, "update rolled back: networkcheck")
+}
+
 func Test_VerifyUpdate_handleStatusPending(t *testing.T) {
 
 	logFile, err := os.CreateTemp("/tmp", "inbc.log")
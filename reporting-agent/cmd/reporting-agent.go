@@ -4,8 +4,13 @@
 package main
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/open-edge-platform/edge-node-agents/common/pkg/status"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -13,6 +18,7 @@ import (
 
 	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/collector"
 	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/daemon"
 	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
 	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/sender"
 )
@@ -23,14 +29,17 @@ func main() {
 		Short: "Reporting Service Agent",
 		Run:   runAgent,
 	}
-	rootCmd.Flags().StringP("config", "c", "", "path to config file")
+	rootCmd.PersistentFlags().StringP("config", "c", "", "path to config file")
 	rootCmd.Flags().BoolP("short", "s", false, "collect only identity, uptime and kubernetes data")
+	rootCmd.AddCommand(&cobra.Command{
+		Use:   "daemon",
+		Short: "Run as a daemon scheduling full and short collections",
+		Run:   runDaemon,
+	})
 	_ = rootCmd.Execute() //nolint:errcheck // Ignoring error as it will be handled in the command execution
 }
 
 func runAgent(cmd *cobra.Command, _ []string) {
-	start := time.Now()
-
 	log := createLogger()
 	// flushes buffer, if any
 	defer log.Sync() //nolint:errcheck // Ignoring error as it doesn't make sense to handle it during shutdown
@@ -38,8 +47,72 @@ func runAgent(cmd *cobra.Command, _ []string) {
 	configLoader := config.NewConfigLoader(log)
 	cfg := configLoader.Load(cmd)
 
+	sinks, err := sender.NewSinks(log, cfg)
+	if err != nil {
+		log.Errorf("Failed to configure sinks: %v", err)
+		return
+	}
+
 	shortMode, _ := cmd.Flags().GetBool("short") //nolint:errcheck // Ignoring error, if something goes wrong, full data will be collected anyway
+	if err := collectAndSend(cmd.Context(), log, cfg, collector.NewCollector(log), sinks, shortMode); err != nil {
+		log.Errorf("Failed to send data to some sinks: %v", err)
+	} else {
+		log.Info("Data successfully sent to all sinks.")
+	}
+}
+
+func runDaemon(cmd *cobra.Command, _ []string) {
+	log := createLogger()
+	// flushes buffer, if any
+	defer log.Sync() //nolint:errcheck // Ignoring error as it doesn't make sense to handle it during shutdown
+
+	configLoader := config.NewConfigLoader(log)
+	cfg := configLoader.Load(cmd)
+
+	sinks, err := sender.NewSinks(log, cfg)
+	if err != nil {
+		log.Errorf("Failed to configure sinks: %v", err)
+		return
+	}
+
 	coll := collector.NewCollector(log)
+	collection := func(shortMode bool) func(context.Context) error {
+		return func(ctx context.Context) error {
+			return collectAndSend(ctx, log, cfg, coll, sinks, shortMode)
+		}
+	}
+
+	machineID, err := daemon.ReadMachineID()
+	if err != nil {
+		log.Warnw("Deriving jitter from hostname", "err", err)
+		machineID, _ = os.Hostname() //nolint:errcheck // Ignoring error, an empty name still gives a valid jitter
+	}
+	d, err := daemon.New(log, []daemon.Job{
+		{Name: "full", Interval: cfg.Daemon.FullInterval, Run: collection(false), Supersedes: []string{"short"}},
+		{Name: "short", Interval: cfg.Daemon.ShortInterval, Run: collection(true)},
+	}, daemon.Jitter(machineID, cfg.Daemon.MaxJitter), cfg.Daemon.MaxLoadPerCPU)
+	if err != nil {
+		log.Errorf("Failed to configure daemon: %v", err)
+		return
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	statusClient, err := status.InitClient(cfg.Daemon.StatusEndpoint)
+	if err != nil {
+		log.Errorf("Failed to initialize status client: %v", err)
+	} else {
+		go d.ReportStatus(ctx, statusClient)
+	}
+
+	d.Run(ctx)
+}
+
+// collectAndSend collects the full or short data and sends it to every sink, until ctx is done.
+func collectAndSend(ctx context.Context, log *zap.SugaredLogger, cfg config.Config, coll collector.Collector, sinks []sender.Sink, shortMode bool) error {
+	start := time.Now()
+
 	var dataCollected model.Root
 	if shortMode {
 		log.Info("Agent started in short mode.")
@@ -49,15 +122,11 @@ func runAgent(cmd *cobra.Command, _ []string) {
 		dataCollected = coll.CollectData(cfg)
 	}
 
-	log.Infow("Agent finished collecting data.", "duration", time.Since(start).String())
+	collectedAt := time.Now()
+	log.Infow("Agent finished collecting data.", "duration", collectedAt.Sub(start).String())
 
-	// Send to backend
-	sndr := sender.NewBackendSender("/etc/edge-node/metrics/endpoint", "/etc/edge-node/metrics/token")
-	if err := sndr.Send(cfg, &dataCollected); err != nil {
-		log.Errorf("Failed to send data to backend: %v", err)
-	} else {
-		log.Info("Data successfully sent to backend.")
-	}
+	// Send to every configured sink
+	return sender.SendAll(ctx, log, cfg, sinks, &dataCollected, collectedAt)
 }
 
 // createLogger initializes a new logger with a lumberjack writer for log rotation.
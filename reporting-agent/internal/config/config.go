@@ -5,16 +5,28 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
 
+// Sink types selectable in SinkConfig.Type.
+const (
+	SinkLoki  = "loki"
+	SinkHTTPS = "https"
+	SinkOTLP  = "otlp"
+	SinkFile  = "file"
+)
+
 // Config holds the configuration for the application.
 type Config struct {
 	K8s     K8sConfig     `mapstructure:"k8s"`
 	Backend BackendConfig `mapstructure:"backend"`
+	Sinks   []SinkConfig  `mapstructure:"sinks"`
+	Spool   SpoolConfig   `mapstructure:"spool"`
+	Daemon  DaemonConfig  `mapstructure:"daemon"`
 }
 
 // K8sConfig holds Kubernetes-related configuration paths.
@@ -30,6 +42,50 @@ type BackendConfig struct {
 	Backoff BackendBackoffConfig `mapstructure:"backoff"`
 }
 
+// SinkConfig selects and configures one destination of the collected data.
+type SinkConfig struct {
+	// Type is one of loki, https, otlp or file.
+	Type string `mapstructure:"type"`
+	// EndpointPath is the file holding the Loki push endpoint URL.
+	EndpointPath string `mapstructure:"endpointPath"`
+	// TokenPath is the file holding the "username:password" basic auth credentials for loki, or an optional
+	// bearer token for https and otlp.
+	TokenPath string `mapstructure:"tokenPath"`
+	// Endpoint is the https or otlp endpoint URL, e.g. "https://collector:4318/v1/logs" for otlp.
+	Endpoint string `mapstructure:"endpoint"`
+	// Headers are added to every https or otlp request.
+	Headers map[string]string `mapstructure:"headers"`
+	// Path is the file the file sink appends one JSON line per report to.
+	Path string `mapstructure:"path"`
+	// MaxBytes bounds the size of the file of the file sink; when a report would exceed it, the file is moved to
+	// Path + ".1", replacing the previous one. Zero takes the default of 10 MiB.
+	MaxBytes int64 `mapstructure:"maxBytes"`
+}
+
+// SpoolConfig holds the limits of the on-disk spool of undelivered reports.
+type SpoolConfig struct {
+	// Dir holds one subdirectory per sink. An empty Dir disables the spool.
+	Dir string `mapstructure:"dir"`
+	// MaxAge drops reports collected longer ago.
+	MaxAge time.Duration `mapstructure:"maxAge"`
+	// MaxBytes bounds the spool size of each sink; the oldest reports are dropped first.
+	MaxBytes int64 `mapstructure:"maxBytes"`
+}
+
+// DaemonConfig holds the schedule of the daemon mode.
+type DaemonConfig struct {
+	// FullInterval is the interval of the full collections.
+	FullInterval time.Duration `mapstructure:"fullInterval"`
+	// ShortInterval is the interval of the short collections.
+	ShortInterval time.Duration `mapstructure:"shortInterval"`
+	// MaxJitter bounds the per-node delay of the first collections, derived from the machine ID.
+	MaxJitter time.Duration `mapstructure:"maxJitter"`
+	// MaxLoadPerCPU postpones collections while the 1-minute load average per CPU is above it; 0 disables the check.
+	MaxLoadPerCPU float64 `mapstructure:"maxLoadPerCPU"`
+	// StatusEndpoint is the node-agent status server the last-run status is reported to.
+	StatusEndpoint string `mapstructure:"statusEndpoint"`
+}
+
 // BackendBackoffConfig holds backoff configuration for backend communication.
 type BackendBackoffConfig struct {
 	MaxTries uint `mapstructure:"maxTries"`
@@ -56,6 +112,14 @@ func (cl *Loader) Load(cmd *cobra.Command) Config {
 	v.SetDefault("k8s.rke2KubectlPath", defCfg.K8s.Rke2KubectlPath)
 	v.SetDefault("k8s.rke2KubeConfigPath", defCfg.K8s.Rke2KubeConfigPath)
 	v.SetDefault("backend.backoff.maxTries", defCfg.Backend.Backoff.MaxTries)
+	v.SetDefault("spool.dir", defCfg.Spool.Dir)
+	v.SetDefault("spool.maxAge", defCfg.Spool.MaxAge)
+	v.SetDefault("spool.maxBytes", defCfg.Spool.MaxBytes)
+	v.SetDefault("daemon.fullInterval", defCfg.Daemon.FullInterval)
+	v.SetDefault("daemon.shortInterval", defCfg.Daemon.ShortInterval)
+	v.SetDefault("daemon.maxJitter", defCfg.Daemon.MaxJitter)
+	v.SetDefault("daemon.maxLoadPerCPU", defCfg.Daemon.MaxLoadPerCPU)
+	v.SetDefault("daemon.statusEndpoint", defCfg.Daemon.StatusEndpoint)
 
 	if configPath == "" {
 		cl.log.Infow("No config file provided, using default configuration", "config", defCfg)
@@ -75,6 +139,10 @@ func (cl *Loader) Load(cmd *cobra.Command) Config {
 		return defCfg
 	}
 
+	if len(cfg.Sinks) == 0 {
+		cfg.Sinks = defCfg.Sinks
+	}
+
 	cl.log.Infow("Final configuration used", "config", cfg)
 	return cfg
 }
@@ -92,5 +160,24 @@ func setDefaults() Config {
 				MaxTries: 20,
 			},
 		},
+		Sinks: []SinkConfig{
+			{
+				Type:         SinkLoki,
+				EndpointPath: "/etc/edge-node/metrics/endpoint",
+				TokenPath:    "/etc/edge-node/metrics/token",
+			},
+		},
+		Spool: SpoolConfig{
+			Dir:      "/var/lib/edge-node/reporting-agent/spool",
+			MaxAge:   72 * time.Hour,
+			MaxBytes: 10 << 20,
+		},
+		Daemon: DaemonConfig{
+			FullInterval:   24 * time.Hour,
+			ShortInterval:  time.Hour,
+			MaxJitter:      time.Hour,
+			MaxLoadPerCPU:  2,
+			StatusEndpoint: "unix:///run/node-agent/node-agent.sock",
+		},
 	}
 }
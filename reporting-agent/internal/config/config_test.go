@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
@@ -43,6 +44,72 @@ backend:
 	require.Equal(t, "/custom/rke2", cfg.K8s.Rke2KubectlPath, "Load should load rke2KubectlPath from file")
 	require.Equal(t, "/custom/rke2.yaml", cfg.K8s.Rke2KubeConfigPath, "Load should load rke2KubeConfigPath from file")
 	require.Equal(t, uint(5), cfg.Backend.Backoff.MaxTries, "Should load MaxTries from file")
+	require.Equal(t, setDefaults().Sinks, cfg.Sinks, "Load should default to the loki sink when no sinks are configured")
+}
+
+// TestLoad_Sinks checks that Load loads multiple sinks from a file.
+func TestLoad_Sinks(t *testing.T) {
+	tmpFile := createTempConfigFile(t, `
+sinks:
+  - type: loki
+    endpointPath: "/custom/endpoint"
+    tokenPath: "/custom/token"
+  - type: otlp
+    endpoint: "https://collector:4318/v1/logs"
+    headers:
+      x-tenant: edge
+  - type: file
+    path: "/var/lib/reporting-agent/reports.jsonl"
+`)
+
+	cmd := newFakeCobraCmd(t, tmpFile)
+	cl := NewConfigLoader(zaptest.NewLogger(t).Sugar())
+	cfg := cl.Load(cmd)
+	require.Equal(t, []SinkConfig{
+		{Type: SinkLoki, EndpointPath: "/custom/endpoint", TokenPath: "/custom/token"},
+		{Type: SinkOTLP, Endpoint: "https://collector:4318/v1/logs", Headers: map[string]string{"x-tenant": "edge"}},
+		{Type: SinkFile, Path: "/var/lib/reporting-agent/reports.jsonl"},
+	}, cfg.Sinks, "Load should load sinks from file")
+	require.Equal(t, uint(20), cfg.Backend.Backoff.MaxTries, "MaxTries should keep its default")
+}
+
+// TestLoad_Spool checks that Load loads the spool limits from a file and keeps the defaults of the others.
+func TestLoad_Spool(t *testing.T) {
+	tmpFile := createTempConfigFile(t, `
+spool:
+  maxAge: 24h
+  maxBytes: 1048576
+`)
+
+	cmd := newFakeCobraCmd(t, tmpFile)
+	cl := NewConfigLoader(zaptest.NewLogger(t).Sugar())
+	cfg := cl.Load(cmd)
+	require.Equal(t, SpoolConfig{
+		Dir:      "/var/lib/edge-node/reporting-agent/spool",
+		MaxAge:   24 * time.Hour,
+		MaxBytes: 1 << 20,
+	}, cfg.Spool, "Load should load the spool limits from file")
+}
+
+// TestLoad_Daemon checks that Load loads the daemon schedule from a file and keeps the defaults of the others.
+func TestLoad_Daemon(t *testing.T) {
+	tmpFile := createTempConfigFile(t, `
+daemon:
+  shortInterval: 30m
+  maxJitter: 10m
+  maxLoadPerCPU: 1.5
+`)
+
+	cmd := newFakeCobraCmd(t, tmpFile)
+	cl := NewConfigLoader(zaptest.NewLogger(t).Sugar())
+	cfg := cl.Load(cmd)
+	require.Equal(t, DaemonConfig{
+		FullInterval:   24 * time.Hour,
+		ShortInterval:  30 * time.Minute,
+		MaxJitter:      10 * time.Minute,
+		MaxLoadPerCPU:  1.5,
+		StatusEndpoint: "unix:///run/node-agent/node-agent.sock",
+	}, cfg.Daemon, "Load should load the daemon schedule from file")
 }
 
 // TestLoad_FileUnreadable checks that Load returns defaults if config file is unreadable.
@@ -74,6 +141,17 @@ func TestSetDefaults(t *testing.T) {
 	require.Equal(t, "/var/lib/rancher/rke2/bin/kubectl", def.K8s.Rke2KubectlPath, "SetDefaults should set correct Rke2KubectlPath")
 	require.Equal(t, "/etc/rancher/rke2/rke2.yaml", def.K8s.Rke2KubeConfigPath, "SetDefaults should set correct Rke2KubeConfigPath")
 	require.Equal(t, uint(20), def.Backend.Backoff.MaxTries, "SetDefaults should set correct MaxTries")
+	require.Equal(t, []SinkConfig{{
+		Type:         SinkLoki,
+		EndpointPath: "/etc/edge-node/metrics/endpoint",
+		TokenPath:    "/etc/edge-node/metrics/token",
+	}}, def.Sinks, "SetDefaults should set the loki sink")
+	require.Equal(t, "/var/lib/edge-node/reporting-agent/spool", def.Spool.Dir, "SetDefaults should set correct spool Dir")
+	require.Equal(t, 72*time.Hour, def.Spool.MaxAge, "SetDefaults should set correct spool MaxAge")
+	require.Equal(t, int64(10<<20), def.Spool.MaxBytes, "SetDefaults should set correct spool MaxBytes")
+	require.Equal(t, 24*time.Hour, def.Daemon.FullInterval, "SetDefaults should set correct daemon FullInterval")
+	require.Equal(t, time.Hour, def.Daemon.ShortInterval, "SetDefaults should set correct daemon ShortInterval")
+	require.Equal(t, "unix:///run/node-agent/node-agent.sock", def.Daemon.StatusEndpoint, "SetDefaults should set correct daemon StatusEndpoint")
 }
 
 // FuzzLoad checks that Loader.Load never panics and always returns a Config for random config files.
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package daemon schedules the collections of the long-running reporting agent.
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// loadRetryDelay postpones a collection skipped because of a heavy load.
+const loadRetryDelay = 5 * time.Minute
+
+// maxLoadPostpone bounds how long a collection is postponed because of a heavy load; it then runs regardless, so
+// that a node that is always busy still reports. It is a variable for testing.
+var maxLoadPostpone = time.Hour
+
+var (
+	// MachineIDPath is the systemd machine ID file, the source of the per-node jitter.
+	MachineIDPath = "/etc/machine-id"
+	// LoadAvgPath is the kernel load average file.
+	LoadAvgPath = "/proc/loadavg"
+)
+
+// Job is a collection run on its own interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+	// Supersedes names the jobs whose data this job also delivers; a run of this job postpones them by their
+	// interval.
+	Supersedes []string
+}
+
+type scheduledJob struct {
+	Job
+	next time.Time
+	// postponedSince is when the job was first postponed because of a heavy load, zero if it was not.
+	postponedSince time.Time
+}
+
+// Daemon runs the jobs one at a time, each first after the per-node jitter and then on its interval.
+type Daemon struct {
+	log           *zap.SugaredLogger
+	jobs          []*scheduledJob
+	jitter        time.Duration
+	maxLoadPerCPU float64
+
+	mu      sync.Mutex
+	lastRun time.Time
+	lastErr error
+}
+
+// New creates a daemon running the jobs, in the given order of priority when several are due.
+func New(log *zap.SugaredLogger, jobs []Job, jitter time.Duration, maxLoadPerCPU float64) (*Daemon, error) {
+	d := &Daemon{log: log, jitter: jitter, maxLoadPerCPU: maxLoadPerCPU}
+	for _, job := range jobs {
+		if job.Interval <= 0 {
+			return nil, fmt.Errorf("invalid interval %v of %s collection", job.Interval, job.Name)
+		}
+		d.jobs = append(d.jobs, &scheduledJob{Job: job})
+	}
+	if len(d.jobs) == 0 {
+		return nil, errors.New("no collections to schedule")
+	}
+	return d, nil
+}
+
+// Run schedules the jobs until the context is done.
+func (d *Daemon) Run(ctx context.Context) {
+	start := time.Now().Add(d.jitter)
+	for _, job := range d.jobs {
+		job.next = start
+	}
+	d.log.Infow("Daemon started", "jitter", d.jitter.String(), "firstRun", start)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		job := d.jobs[0]
+		for _, other := range d.jobs[1:] {
+			if other.next.Before(job.next) {
+				job = other
+			}
+		}
+		timer.Reset(time.Until(job.next))
+		select {
+		case <-ctx.Done():
+			d.log.Info("Daemon stopped.")
+			return
+		case <-timer.C:
+		}
+
+		if load, overloaded := d.overloaded(); overloaded {
+			if job.postponedSince.IsZero() {
+				job.postponedSince = time.Now()
+			}
+			if time.Since(job.postponedSince) < maxLoadPostpone {
+				job.next = time.Now().Add(min(loadRetryDelay, job.Interval))
+				d.log.Warnw("Collection postponed, system under heavy load", "collection", job.Name, "loadPerCPU", load, "next", job.next)
+				continue
+			}
+			d.log.Warnw("Collection started under heavy load, postponed for too long", "collection", job.Name, "loadPerCPU", load,
+				"postponedSince", job.postponedSince)
+		}
+		job.postponedSince = time.Time{}
+
+		d.log.Infow("Collection started", "collection", job.Name)
+		err := job.Run(ctx)
+		now := time.Now()
+		d.mu.Lock()
+		d.lastRun, d.lastErr = now, err
+		d.mu.Unlock()
+		if err != nil {
+			d.log.Errorw("Collection failed", "collection", job.Name, "err", err)
+		}
+
+		job.next = now.Add(job.Interval)
+		for _, other := range d.jobs {
+			if slices.Contains(job.Supersedes, other.Name) {
+				other.next = now.Add(other.Interval)
+			}
+		}
+	}
+}
+
+// LastRun returns the time and the error of the last collection; the time is zero before the first one.
+func (d *Daemon) LastRun() (time.Time, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.lastRun, d.lastErr
+}
+
+// overloaded reports whether the 1-minute load average per CPU exceeds the limit.
+func (d *Daemon) overloaded() (float64, bool) {
+	if d.maxLoadPerCPU <= 0 {
+		return 0, false
+	}
+	load, err := loadPerCPU()
+	if err != nil {
+		d.log.Warnw("Failed to read load average, collecting anyway", "err", err)
+		return 0, false
+	}
+	return load, load > d.maxLoadPerCPU
+}
+
+func loadPerCPU() (float64, error) {
+	content, err := os.ReadFile(LoadAvgPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read load average: %w", err)
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return 0, errors.New("empty load average")
+	}
+	load, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid load average: %w", err)
+	}
+	return load / float64(runtime.NumCPU()), nil
+}
+
+// ReadMachineID returns the systemd machine ID.
+func ReadMachineID() (string, error) {
+	content, err := os.ReadFile(MachineIDPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read machine ID: %w", err)
+	}
+	machineID := strings.TrimSpace(string(content))
+	if machineID == "" {
+		return "", errors.New("empty machine ID")
+	}
+	return machineID, nil
+}
+
+// Jitter derives a stable delay in [0, maxJitter) from the machine ID, spreading the collections of a fleet.
+func Jitter(machineID string, maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write([]byte(machineID)) //nolint:errcheck // hash.Hash.Write never returns an error
+	return time.Duration(h.Sum64() % uint64(maxJitter))
+}
@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package daemon
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestJitter checks that the jitter is stable per machine ID and bounded.
+func TestJitter(t *testing.T) {
+	first := Jitter("4c4c4544-0036-4b10-8052-b4c04f4d4d32", time.Hour)
+	require.Equal(t, first, Jitter("4c4c4544-0036-4b10-8052-b4c04f4d4d32", time.Hour), "Jitter should be stable for a machine ID")
+	require.NotEqual(t, first, Jitter("0d5b1c36a4f94a6aa1a4e3ab5c0fd2e1", time.Hour), "Jitter should differ between machine IDs")
+	for _, machineID := range []string{"", "a", "b", "machine"} {
+		jitter := Jitter(machineID, time.Minute)
+		require.GreaterOrEqual(t, jitter, time.Duration(0), "Jitter should not be negative")
+		require.Less(t, jitter, time.Minute, "Jitter should stay below the maximum")
+	}
+	require.Zero(t, Jitter("machine", 0), "Jitter should be zero without a maximum")
+}
+
+// TestReadMachineID checks that the machine ID is read and trimmed.
+func TestReadMachineID(t *testing.T) {
+	MachineIDPath = filepath.Join(t.TempDir(), "machine-id")
+	defer func() { MachineIDPath = "/etc/machine-id" }()
+
+	_, err := ReadMachineID()
+	require.ErrorContains(t, err, "failed to read machine ID", "Should error if the file is missing")
+
+	require.NoError(t, os.WriteFile(MachineIDPath, []byte("\n"), 0600))
+	_, err = ReadMachineID()
+	require.ErrorContains(t, err, "empty machine ID", "Should error if the file is empty")
+
+	require.NoError(t, os.WriteFile(MachineIDPath, []byte("0d5b1c36a4f94a6aa1a4e3ab5c0fd2e1\n"), 0600))
+	machineID, err := ReadMachineID()
+	require.NoError(t, err, "Should read the machine ID")
+	require.Equal(t, "0d5b1c36a4f94a6aa1a4e3ab5c0fd2e1", machineID)
+}
+
+// TestNewInvalid checks that New rejects invalid schedules.
+func TestNewInvalid(t *testing.T) {
+	_, err := New(zaptest.NewLogger(t).Sugar(), []Job{{Name: "full", Interval: 0}}, 0, 0)
+	require.ErrorContains(t, err, "invalid interval 0s of full collection", "Should reject a zero interval")
+
+	_, err = New(zaptest.NewLogger(t).Sugar(), nil, 0, 0)
+	require.ErrorContains(t, err, "no collections to schedule", "Should reject an empty schedule")
+}
+
+// TestRun checks that a full collection runs first and postpones the short collection it supersedes.
+func TestRun(t *testing.T) {
+	var mu sync.Mutex
+	runs := []string{}
+	job := func(name string, err error) func(context.Context) error {
+		return func(context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			runs = append(runs, name)
+			return err
+		}
+	}
+	d, err := New(zaptest.NewLogger(t).Sugar(), []Job{
+		{Name: "full", Interval: time.Hour, Run: job("full", nil), Supersedes: []string{"short"}},
+		{Name: "short", Interval: 100 * time.Millisecond, Run: job("short", errors.New("sink failed"))},
+	}, 10*time.Millisecond, 0)
+	require.NoError(t, err, "New should accept the jobs")
+
+	lastRun, lastErr := d.LastRun()
+	require.Zero(t, lastRun, "No collection should have run yet")
+	require.NoError(t, lastErr)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+	d.Run(ctx)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{"full", "short", "short"}, runs, "Full collection should run first and postpone the short one")
+	lastRun, lastErr = d.LastRun()
+	require.NotZero(t, lastRun, "Last run should be recorded")
+	require.ErrorContains(t, lastErr, "sink failed", "Last error should be recorded")
+}
+
+// TestRunHeavyLoad checks that collections are postponed while the system is under heavy load.
+func TestRunHeavyLoad(t *testing.T) {
+	LoadAvgPath = filepath.Join(t.TempDir(), "loadavg")
+	defer func() { LoadAvgPath = "/proc/loadavg" }()
+	require.NoError(t, os.WriteFile(LoadAvgPath, []byte("100000.00 90000.00 80000.00 3/1234 5678\n"), 0600))
+
+	runs := 0
+	d, err := New(zaptest.NewLogger(t).Sugar(), []Job{
+		{Name: "short", Interval: 10 * time.Millisecond, Run: func(context.Context) error { runs++; return nil }},
+	}, 0, 2)
+	require.NoError(t, err, "New should accept the jobs")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	d.Run(ctx)
+	require.Zero(t, runs, "Collections should be postponed under heavy load")
+
+	load, err := loadPerCPU()
+	require.NoError(t, err, "Should read the load average")
+	require.Greater(t, load, 2.0)
+}
+
+// TestRunHeavyLoadMaxPostpone checks that a collection postponed for too long runs despite the heavy load.
+func TestRunHeavyLoadMaxPostpone(t *testing.T) {
+	LoadAvgPath = filepath.Join(t.TempDir(), "loadavg")
+	defer func() { LoadAvgPath = "/proc/loadavg" }()
+	require.NoError(t, os.WriteFile(LoadAvgPath, []byte("100000.00 90000.00 80000.00 3/1234 5678\n"), 0600))
+	maxLoadPostpone = 50 * time.Millisecond
+	defer func() { maxLoadPostpone = time.Hour }()
+
+	var mu sync.Mutex
+	runs := []time.Time{}
+	d, err := New(zaptest.NewLogger(t).Sugar(), []Job{
+		{Name: "short", Interval: 20 * time.Millisecond, Run: func(context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			runs = append(runs, time.Now())
+			return nil
+		}},
+	}, 0, 2)
+	require.NoError(t, err, "New should accept the jobs")
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+	d.Run(ctx)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, runs, "Collections should run once postponed for too long")
+	require.GreaterOrEqual(t, runs[0].Sub(start), maxLoadPostpone, "The first collection should have been postponed")
+}
+
+// TestReportStatus checks that the status follows the result of the last collection.
+func TestReportStatus(t *testing.T) {
+	d, err := New(zaptest.NewLogger(t).Sugar(), []Job{{Name: "short", Interval: time.Hour}}, 0, 0)
+	require.NoError(t, err, "New should accept the jobs")
+	client := &fakeStatusClient{interval: 10 * time.Millisecond}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 35*time.Millisecond)
+	defer cancel()
+	d.ReportStatus(ctx, client)
+	require.Positive(t, client.ready, "Ready should be reported before the first collection")
+	require.Zero(t, client.notReady)
+
+	d.lastErr = errors.New("sink failed")
+	client.ready = 0
+	ctx, cancel = context.WithTimeout(context.Background(), 35*time.Millisecond)
+	defer cancel()
+	d.ReportStatus(ctx, client)
+	require.Zero(t, client.ready)
+	require.Positive(t, client.notReady, "NotReady should be reported after a failed collection")
+}
+
+type fakeStatusClient struct {
+	interval time.Duration
+	ready    int
+	notReady int
+}
+
+func (c *fakeStatusClient) SendStatusReady(_ context.Context, agentName string) error {
+	if agentName == AgentName {
+		c.ready++
+	}
+	return nil
+}
+
+func (c *fakeStatusClient) SendStatusNotReady(_ context.Context, agentName string) error {
+	if agentName == AgentName {
+		c.notReady++
+	}
+	return nil
+}
+
+func (c *fakeStatusClient) GetStatusInterval(_ context.Context, _ string) (time.Duration, error) {
+	return c.interval, nil
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+)
+
+// AgentName identifies the agent to the status server.
+const AgentName = "reporting-agent"
+
+// defaultStatusInterval is used when the status server does not provide its interval.
+const defaultStatusInterval = 10 * time.Second
+
+// StatusClient reports the agent status, implemented by the common status client.
+type StatusClient interface {
+	SendStatusReady(ctx context.Context, agentName string) error
+	SendStatusNotReady(ctx context.Context, agentName string) error
+	GetStatusInterval(ctx context.Context, agentName string) (time.Duration, error)
+}
+
+// ReportStatus reports Ready on the interval of the status server while the last collection succeeded, and NotReady
+// after a failed one, until the context is done.
+func (d *Daemon) ReportStatus(ctx context.Context, client StatusClient) {
+	op := func() (time.Duration, error) {
+		interval, err := client.GetStatusInterval(ctx, AgentName)
+		if err != nil {
+			d.log.Errorw("Failed to get status interval", "err", err)
+		}
+		return interval, err
+	}
+	// High number of retries as retries would mostly indicate a problem with the status server
+	interval, err := backoff.Retry(ctx, op, backoff.WithBackOff(backoff.NewExponentialBackOff()), backoff.WithMaxTries(30))
+	if err != nil || interval <= 0 {
+		d.log.Warnw("Defaulting status interval", "interval", defaultStatusInterval.String())
+		interval = defaultStatusInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		d.sendStatus(ctx, client)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Daemon) sendStatus(ctx context.Context, client StatusClient) {
+	if _, lastErr := d.LastRun(); lastErr != nil {
+		if err := client.SendStatusNotReady(ctx, AgentName); err != nil {
+			d.log.Errorw("Failed to send status not ready", "err", err)
+		}
+		return
+	}
+	if err := client.SendStatusReady(ctx, AgentName); err != nil {
+		d.log.Errorw("Failed to send status ready", "err", err)
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
+)
+
+// defaultFileMaxBytes is the size the file of the file sink is rotated at, unless configured otherwise.
+const defaultFileMaxBytes = 10 << 20
+
+// FileSender appends the model.Root as one JSON line to a local file; it is the file sink.
+type FileSender struct {
+	path string
+	// maxBytes is the size the file is rotated at; zero never rotates it.
+	maxBytes int64
+}
+
+// Name returns the sink type.
+func (*FileSender) Name() string {
+	return config.SinkFile
+}
+
+// Send appends the provided model.Root to the configured file.
+func (s *FileSender) Send(_ context.Context, _ config.Config, data *model.Root, _ time.Time) error {
+	line, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data for file: %w", err)
+	}
+
+	line = append(line, '\n')
+	if err := s.rotate(len(line)); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open sink file: %w", err)
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write sink file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close sink file: %w", err)
+	}
+	return nil
+}
+
+// rotate moves the file to path + ".1", replacing the previous one, if appending n bytes would grow it beyond
+// maxBytes. A report larger than maxBytes is still written, alone in a new file.
+func (s *FileSender) rotate(n int) error {
+	if s.maxBytes <= 0 {
+		return nil
+	}
+	info, err := os.Stat(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat sink file: %w", err)
+	}
+	if info.Size() == 0 || info.Size()+int64(n) <= s.maxBytes {
+		return nil
+	}
+	if err := os.Rename(s.path, s.path+".1"); err != nil {
+		return fmt.Errorf("failed to rotate sink file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
+)
+
+// HTTPSender posts the model.Root as a plain JSON document to an https endpoint; it is the https sink.
+type HTTPSender struct {
+	endpoint   string
+	tokenPath  string
+	headers    map[string]string
+	httpClient *http.Client
+	auditLog   *zap.SugaredLogger
+}
+
+// Name returns the sink type.
+func (*HTTPSender) Name() string {
+	return config.SinkHTTPS
+}
+
+// Send posts the provided model.Root to the configured endpoint.
+func (s *HTTPSender) Send(ctx context.Context, cfg config.Config, data *model.Root, _ time.Time) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data for backend: %w", err)
+	}
+	return s.sendPayload(ctx, s.Name(), payload, cfg.Backend)
+}
+
+// sendPayload posts the payload with the configured headers and bearer token.
+func (s *HTTPSender) sendPayload(ctx context.Context, sink string, payload []byte, backendCfg config.BackendConfig) error {
+	token, err := readBearerToken(s.tokenPath)
+	if err != nil {
+		return err
+	}
+
+	err = postJSON(ctx, s.httpClient, s.endpoint, payload, backendCfg.Backoff.MaxTries, func(req *http.Request) {
+		for key, value := range s.headers {
+			req.Header.Set(key, value)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	s.auditLog.Infow("Payload sent", "sink", sink, "payload", string(payload))
+	return nil
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
+)
+
+// OTLPSender exports the model.Root as one log record over OTLP/HTTP with the JSON encoding; it is the otlp sink.
+type OTLPSender struct {
+	*HTTPSender
+}
+
+// Name returns the sink type.
+func (*OTLPSender) Name() string {
+	return config.SinkOTLP
+}
+
+// Send exports the provided model.Root, timestamped with collectedAt, to the configured OTLP logs endpoint.
+func (s *OTLPSender) Send(ctx context.Context, cfg config.Config, data *model.Root, collectedAt time.Time) error {
+	payload, err := buildOTLPPayload(data, collectedAt)
+	if err != nil {
+		return err
+	}
+	return s.sendPayload(ctx, s.Name(), payload, cfg.Backend)
+}
+
+// The subset of the OTLP ExportLogsServiceRequest JSON mapping used by the sink.
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano string          `json:"timeUnixNano"`
+	SeverityText string          `json:"severityText"`
+	Body         otlpValue       `json:"body"`
+	Attributes   []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	} `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpLogsRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+// buildOTLPPayload builds the OTLP logs request carrying the marshaled model.Root as the log body.
+func buildOTLPPayload(data *model.Root, timestamp time.Time) ([]byte, error) {
+	logJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data for backend: %w", err)
+	}
+
+	scopeLogs := otlpScopeLogs{
+		LogRecords: []otlpLogRecord{{
+			TimeUnixNano: strconv.FormatInt(timestamp.UnixNano(), 10),
+			SeverityText: "INFO",
+			Body:         otlpValue{StringValue: string(logJSON)},
+			Attributes:   []otlpAttribute{{Key: "group_id", Value: otlpValue{StringValue: data.Identity.GroupID}}},
+		}},
+	}
+	scopeLogs.Scope.Name = "reporting-agent"
+	resourceLogs := otlpResourceLogs{ScopeLogs: []otlpScopeLogs{scopeLogs}}
+	resourceLogs.Resource.Attributes = []otlpAttribute{{Key: "service.name", Value: otlpValue{StringValue: "reporting-service"}}}
+
+	payload, err := json.Marshal(otlpLogsRequest{ResourceLogs: []otlpResourceLogs{resourceLogs}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OTLP payload: %w", err)
+	}
+	return payload, nil
+}
@@ -25,7 +25,7 @@ import (
 	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
 )
 
-// BackendSender holds configuration for backend communication.
+// BackendSender pushes the data to Loki; it is the loki sink.
 type BackendSender struct {
 	endpointPath string
 	tokenPath    string
@@ -43,8 +43,13 @@ func NewBackendSender(endpointPath, tokenPath string) *BackendSender {
 	}
 }
 
-// Send sends the provided model.Root as a log entry to the backend using configured paths.
-func (s *BackendSender) Send(cfg config.Config, data *model.Root) error {
+// Name returns the sink type.
+func (*BackendSender) Name() string {
+	return config.SinkLoki
+}
+
+// Send sends the provided model.Root as a log entry, timestamped with collectedAt, to the backend using configured paths.
+func (s *BackendSender) Send(ctx context.Context, cfg config.Config, data *model.Root, collectedAt time.Time) error {
 	endpoint, err := s.readEndpointURL()
 	if err != nil {
 		return err
@@ -55,12 +60,12 @@ func (s *BackendSender) Send(cfg config.Config, data *model.Root) error {
 		return err
 	}
 
-	payload, err := buildPayload(data)
+	payload, err := buildPayload(data, collectedAt)
 	if err != nil {
 		return err
 	}
 
-	return s.sendRequest(endpoint, username, password, payload, cfg.Backend)
+	return s.sendRequest(ctx, endpoint, username, password, payload, cfg.Backend)
 }
 
 // readEndpointURL reads the endpoint URL from the configured file path and validates it.
@@ -70,18 +75,22 @@ func (s *BackendSender) readEndpointURL() (endpoint string, err error) {
 		return "", fmt.Errorf("failed to read endpoint file: %w", err)
 	}
 
+	return endpoint, validateEndpoint(endpoint)
+}
+
+// validateEndpoint checks that the endpoint is an https URL with a host.
+func validateEndpoint(endpoint string) error {
 	parsed, err := url.ParseRequestURI(endpoint)
 	if err != nil {
-		return "", fmt.Errorf("invalid endpoint URL: %w", err)
+		return fmt.Errorf("invalid endpoint URL: %w", err)
 	}
 	if parsed.Scheme != "https" {
-		return "", fmt.Errorf("invalid endpoint scheme: %s (only https is allowed)", parsed.Scheme)
+		return fmt.Errorf("invalid endpoint scheme: %s (only https is allowed)", parsed.Scheme)
 	}
 	if parsed.Host == "" {
-		return "", fmt.Errorf("invalid endpoint URL, missing host: %s", endpoint)
+		return fmt.Errorf("invalid endpoint URL, missing host: %s", endpoint)
 	}
-
-	return endpoint, nil
+	return nil
 }
 
 // readAuthCredentials reads the username and password from the configured file path.
@@ -111,14 +120,14 @@ func (s *BackendSender) readAuthCredentials() (username, password string, err er
 	return parts[0], parts[1], nil
 }
 
-// buildPayload builds the backend payload for the log entry.
-func buildPayload(data *model.Root) ([]byte, error) {
+// buildPayload builds the backend payload for the log entry collected at collectedAt.
+func buildPayload(data *model.Root, collectedAt time.Time) ([]byte, error) {
 	logJSON, err := json.Marshal(data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal data for backend: %w", err)
 	}
 
-	nowNano := strconv.FormatInt(time.Now().UnixNano(), 10)
+	collectedNano := strconv.FormatInt(collectedAt.UnixNano(), 10)
 	payload := map[string]interface{}{
 		"streams": []interface{}{
 			map[string]interface{}{
@@ -127,7 +136,7 @@ func buildPayload(data *model.Root) ([]byte, error) {
 					"group_id":     data.Identity.GroupID,
 				},
 				"values": [][]string{
-					{nowNano, string(logJSON)},
+					{collectedNano, string(logJSON)},
 				},
 			},
 		},
@@ -142,18 +151,34 @@ func buildPayload(data *model.Root) ([]byte, error) {
 }
 
 // sendRequest sends the HTTP request to the backend.
-func (s *BackendSender) sendRequest(endpoint, username, password string, payload []byte, backendCfg config.BackendConfig) error {
+func (s *BackendSender) sendRequest(ctx context.Context, endpoint, username, password string, payload []byte, backendCfg config.BackendConfig) error {
+	err := postJSON(ctx, s.httpClient, endpoint, payload, backendCfg.Backoff.MaxTries, func(req *http.Request) {
+		req.Header.Set("X-Scope-OrgID", "reporting-v1")
+		req.SetBasicAuth(username, password)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Log the payload to audit log on success
+	s.auditLog.Infow("Payload sent", "sink", s.Name(), "payload", string(payload))
+
+	return nil
+}
+
+// postJSON posts the JSON payload, retrying failed requests and non-2xx statuses up to maxTries times or until ctx
+// is done.
+func postJSON(ctx context.Context, client *http.Client, endpoint string, payload []byte, maxTries uint, setHeaders func(req *http.Request)) error {
 	op := func() (int, error) {
-		req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewBuffer(payload))
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(payload))
 		if err != nil {
 			return http.StatusInternalServerError, fmt.Errorf("failed to create backend request: %w", err)
 		}
 
 		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("X-Scope-OrgID", "reporting-v1")
-		req.SetBasicAuth(username, password)
+		setHeaders(req)
 
-		resp, err := s.httpClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return http.StatusInternalServerError, fmt.Errorf("failed to send request to backend: %w", err)
 		}
@@ -165,14 +190,10 @@ func (s *BackendSender) sendRequest(endpoint, username, password string, payload
 		return resp.StatusCode, nil
 	}
 
-	_, err := backoff.Retry(context.Background(), op, backoff.WithBackOff(backoff.NewExponentialBackOff()), backoff.WithMaxTries(backendCfg.Backoff.MaxTries))
+	_, err := backoff.Retry(ctx, op, backoff.WithBackOff(backoff.NewExponentialBackOff()), backoff.WithMaxTries(maxTries))
 	if err != nil {
 		return fmt.Errorf("failed to send payload after retries: %w", err)
 	}
-
-	// Log the payload to audit log on success
-	s.auditLog.Infow("Payload sent", "payload", string(payload))
-
 	return nil
 }
 
@@ -5,6 +5,7 @@ package sender
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
@@ -58,7 +60,7 @@ func TestSendSuccess(t *testing.T) {
 			},
 		},
 	}
-	err := sender.Send(cfg, data)
+	err := sender.Send(context.Background(), cfg, data, time.Now())
 	require.NoError(t, err, "Send should succeed when everything is correct")
 }
 
@@ -70,7 +72,7 @@ func TestSendEndpointFileError(t *testing.T) {
 	require.NoError(t, os.WriteFile(tokenFile, []byte("user:pass"), 0640), "Should write token file")
 
 	sender := NewBackendSender(endpointFile, tokenFile)
-	err := sender.Send(config.Config{}, &model.Root{})
+	err := sender.Send(context.Background(), config.Config{}, &model.Root{}, time.Now())
 	require.ErrorContains(t, err, "failed to read endpoint file", "Should error if endpoint file is missing")
 }
 
@@ -83,7 +85,7 @@ func TestSendEndpointInvalidURL(t *testing.T) {
 	require.NoError(t, os.WriteFile(tokenFile, []byte("user:pass"), 0640), "Should write token file")
 
 	sender := NewBackendSender(endpointFile, tokenFile)
-	err := sender.Send(config.Config{}, &model.Root{})
+	err := sender.Send(context.Background(), config.Config{}, &model.Root{}, time.Now())
 	require.Error(t, err, "Should error if endpoint file is not a valid URL")
 	require.Contains(t, err.Error(), "invalid URI", "Should return parse error for invalid URL")
 }
@@ -96,7 +98,7 @@ func TestSendEndpointInvalidScheme(t *testing.T) {
 	require.NoError(t, os.WriteFile(tokenFile, []byte("user:pass"), 0640), "Should write token file")
 
 	sender := NewBackendSender(endpointFile, tokenFile)
-	err := sender.Send(config.Config{}, &model.Root{})
+	err := sender.Send(context.Background(), config.Config{}, &model.Root{}, time.Now())
 	require.ErrorContains(t, err, "invalid endpoint scheme", "Should error if endpoint scheme is not https")
 }
 
@@ -108,7 +110,7 @@ func TestSendEndpointMissingHost(t *testing.T) {
 	require.NoError(t, os.WriteFile(tokenFile, []byte("user:pass"), 0640), "Should write token file")
 
 	sender := NewBackendSender(endpointFile, tokenFile)
-	err := sender.Send(config.Config{}, &model.Root{})
+	err := sender.Send(context.Background(), config.Config{}, &model.Root{}, time.Now())
 	require.ErrorContains(t, err, "invalid endpoint URL, missing host", "Should error if endpoint host is missing")
 }
 
@@ -120,7 +122,7 @@ func TestSendTokenFileError(t *testing.T) {
 	require.NoError(t, os.WriteFile(endpointFile, []byte("https://localhost:12345"), 0640), "Should write endpoint file")
 
 	sender := NewBackendSender(endpointFile, tokenFile)
-	err := sender.Send(config.Config{}, &model.Root{})
+	err := sender.Send(context.Background(), config.Config{}, &model.Root{}, time.Now())
 	require.ErrorContains(t, err, "failed to read token file", "Should error if token file is missing")
 }
 
@@ -133,7 +135,7 @@ func TestSendTokenInvalidFormat(t *testing.T) {
 	require.NoError(t, os.WriteFile(tokenFile, []byte("notcolon"), 0640), "Should write invalid token file")
 
 	sender := NewBackendSender(endpointFile, tokenFile)
-	err := sender.Send(config.Config{}, &model.Root{})
+	err := sender.Send(context.Background(), config.Config{}, &model.Root{}, time.Now())
 	require.ErrorContains(t, err, "invalid token format", "Should error if token file is not username:password")
 }
 
@@ -148,7 +150,7 @@ func TestSendTokenTooLongUsernameOrPassword(t *testing.T) {
 	longUser := strings.Repeat("a", 257)
 	require.NoError(t, os.WriteFile(tokenFile1, []byte(longUser+":pass"), 0640), "Should write token file")
 	sender1 := NewBackendSender(endpointFile, tokenFile1)
-	err := sender1.Send(config.Config{}, &model.Root{})
+	err := sender1.Send(context.Background(), config.Config{}, &model.Root{}, time.Now())
 	require.ErrorContains(t, err, "username too long", "Should error if username is too long")
 
 	// Password too long
@@ -156,7 +158,7 @@ func TestSendTokenTooLongUsernameOrPassword(t *testing.T) {
 	longPass := strings.Repeat("b", 257)
 	require.NoError(t, os.WriteFile(tokenFile2, []byte("user:"+longPass), 0640), "Should write token file")
 	sender2 := NewBackendSender(endpointFile, tokenFile2)
-	err = sender2.Send(config.Config{}, &model.Root{})
+	err = sender2.Send(context.Background(), config.Config{}, &model.Root{}, time.Now())
 	require.ErrorContains(t, err, "password too long", "Should error if password is too long")
 }
 
@@ -165,10 +167,11 @@ func TestBuildPayloadSuccess(t *testing.T) {
 	data := &model.Root{
 		Identity: model.Identity{GroupID: "gid"},
 	}
-	payload, err := buildPayload(data)
+	payload, err := buildPayload(data, time.Unix(1700000000, 5))
 	require.NoError(t, err, "BuildPayload should not return error for valid data")
 	require.Contains(t, string(payload), `"streams"`, "Payload should contain streams")
 	require.Contains(t, string(payload), `\"gid\"`, "Payload should contain marshaled model.Root data")
+	require.Contains(t, string(payload), `["1700000000000000005",`, "Payload should be timestamped with the collection time")
 }
 
 // TestSendRequestSuccess checks that sendRequest returns nil on 2xx response.
@@ -187,7 +190,7 @@ func TestSendRequestSuccess(t *testing.T) {
 			MaxTries: 2,
 		},
 	}
-	err := sender.sendRequest("http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
+	err := sender.sendRequest(context.Background(), "http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
 	require.NoError(t, err, "SendRequest should succeed on 2xx response")
 }
 
@@ -199,7 +202,7 @@ func TestSendRequestCreateError(t *testing.T) {
 			MaxTries: 2,
 		},
 	}
-	err := sender.sendRequest(":", "user", "pass", []byte("{}"), backendCfg) // invalid URL
+	err := sender.sendRequest(context.Background(), ":", "user", "pass", []byte("{}"), backendCfg) // invalid URL
 	require.ErrorContains(t, err, "failed to create backend request", "Should error if request creation fails")
 }
 
@@ -216,10 +219,32 @@ func TestSendRequestHTTPError(t *testing.T) {
 			MaxTries: 2,
 		},
 	}
-	err := sender.sendRequest("http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
+	err := sender.sendRequest(context.Background(), "http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
 	require.ErrorContains(t, err, "failed to send request to backend", "Should error if HTTP client fails")
 }
 
+// TestSendRequestCanceled checks that sendRequest stops retrying once the context is done.
+func TestSendRequestCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	tries := 0
+	client := &http.Client{
+		Transport: roundTripFuncErr(func(req *http.Request) (*http.Response, error) {
+			tries++
+			cancel()
+			return nil, req.Context().Err()
+		}),
+	}
+	sender := newTestBackendSenderWithClient(t, "endpoint", "token", client)
+	backendCfg := config.BackendConfig{
+		Backoff: config.BackendBackoffConfig{
+			MaxTries: 20,
+		},
+	}
+	err := sender.sendRequest(ctx, "http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
+	require.ErrorIs(t, err, context.Canceled, "Should error with the context error")
+	require.Equal(t, 1, tries, "Should not retry once the context is done")
+}
+
 // TestSendRequestNon2xxStatus checks that sendRequest returns error if backend returns non-2xx status.
 func TestSendRequestNon2xxStatus(t *testing.T) {
 	client := &http.Client{
@@ -237,7 +262,7 @@ func TestSendRequestNon2xxStatus(t *testing.T) {
 			MaxTries: 2,
 		},
 	}
-	err := sender.sendRequest("http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
+	err := sender.sendRequest(context.Background(), "http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
 	require.ErrorContains(t, err, "non-2xx status returned", "Should error if backend returns non-2xx status")
 }
 
@@ -260,7 +285,7 @@ func TestSendRequestBackoffFailure(t *testing.T) {
 			MaxTries: 3,
 		},
 	}
-	err := sender.sendRequest("http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
+	err := sender.sendRequest(context.Background(), "http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
 	require.ErrorContains(t, err, "non-2xx status returned", "Should error after maxTries exceeded")
 	require.GreaterOrEqual(t, failCount, 3, "Should attempt at least maxTries times")
 }
@@ -291,7 +316,7 @@ func TestSendRequestBackoffEventuallySuccess(t *testing.T) {
 			MaxTries: 5,
 		},
 	}
-	err := sender.sendRequest("http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
+	err := sender.sendRequest(context.Background(), "http://localhost:12345", "user", "pass", []byte("{}"), backendCfg)
 	require.NoError(t, err, "SendRequest should succeed after retries when a 2xx is eventually returned")
 	require.Equal(t, 3, failCount, "Should stop retrying after first 2xx response")
 }
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/open-edge-platform/edge-node-agents/common/pkg/utils"
+	"go.uber.org/zap"
+
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
+)
+
+// Sink delivers the collected data to one destination.
+type Sink interface {
+	// Name returns the sink type, used in logs.
+	Name() string
+	// Send delivers the data collected at collectedAt, retrying as configured in cfg.Backend until ctx is done.
+	Send(ctx context.Context, cfg config.Config, data *model.Root, collectedAt time.Time) error
+}
+
+// NewSinks creates the sinks configured in cfg.Sinks, each spooling its undelivered reports under cfg.Spool.Dir.
+// All sinks share one audit log.
+func NewSinks(log *zap.SugaredLogger, cfg config.Config) ([]Sink, error) {
+	sinks, err := newSinks(cfg, &http.Client{}, createAuditLogger())
+	if err != nil {
+		return nil, err
+	}
+	for i, sink := range sinks {
+		sinks[i] = withSpool(log, cfg.Spool, cfg.Sinks[i], sink)
+	}
+	return sinks, nil
+}
+
+func newSinks(cfg config.Config, client *http.Client, auditLog *zap.SugaredLogger) ([]Sink, error) {
+	sinks := make([]Sink, 0, len(cfg.Sinks))
+	for i, sinkCfg := range cfg.Sinks {
+		sink, err := newSink(sinkCfg, client, auditLog)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sink %d: %w", i, err)
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+func newSink(sinkCfg config.SinkConfig, client *http.Client, auditLog *zap.SugaredLogger) (Sink, error) {
+	switch sinkCfg.Type {
+	case config.SinkLoki:
+		if sinkCfg.EndpointPath == "" || sinkCfg.TokenPath == "" {
+			return nil, errors.New("loki sink requires endpointPath and tokenPath")
+		}
+		return &BackendSender{
+			endpointPath: sinkCfg.EndpointPath,
+			tokenPath:    sinkCfg.TokenPath,
+			httpClient:   client,
+			auditLog:     auditLog,
+		}, nil
+	case config.SinkHTTPS, config.SinkOTLP:
+		if err := validateEndpoint(sinkCfg.Endpoint); err != nil {
+			return nil, fmt.Errorf("%s sink: %w", sinkCfg.Type, err)
+		}
+		sender := &HTTPSender{
+			endpoint:   sinkCfg.Endpoint,
+			tokenPath:  sinkCfg.TokenPath,
+			headers:    sinkCfg.Headers,
+			httpClient: client,
+			auditLog:   auditLog,
+		}
+		if sinkCfg.Type == config.SinkOTLP {
+			return &OTLPSender{sender}, nil
+		}
+		return sender, nil
+	case config.SinkFile:
+		if sinkCfg.Path == "" {
+			return nil, errors.New("file sink requires path")
+		}
+		if sinkCfg.MaxBytes < 0 {
+			return nil, errors.New("file sink maxBytes cannot be negative")
+		}
+		maxBytes := sinkCfg.MaxBytes
+		if maxBytes == 0 {
+			maxBytes = defaultFileMaxBytes
+		}
+		return &FileSender{path: sinkCfg.Path, maxBytes: maxBytes}, nil
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", sinkCfg.Type)
+	}
+}
+
+// SendAll sends the same data to every sink, logging the outcome of each, and returns the joined errors of the
+// sinks that failed.
+func SendAll(ctx context.Context, log *zap.SugaredLogger, cfg config.Config, sinks []Sink, data *model.Root, collectedAt time.Time) error {
+	var errs []error
+	for _, sink := range sinks {
+		if err := sink.Send(ctx, cfg, data, collectedAt); err != nil {
+			log.Errorw("Failed to send data", "sink", sink.Name(), "err", err)
+			errs = append(errs, fmt.Errorf("%s sink: %w", sink.Name(), err))
+			continue
+		}
+		log.Infow("Data successfully sent", "sink", sink.Name())
+	}
+	return errors.Join(errs...)
+}
+
+// readBearerToken reads the optional bearer token from the file path.
+func readBearerToken(tokenPath string) (string, error) {
+	if tokenPath == "" {
+		return "", nil
+	}
+	token, err := utils.ReadFileTrimmed(tokenPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	if token == "" {
+		return "", errors.New("token cannot be empty")
+	}
+	return token, nil
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
+)
+
+// TestNewSinks checks that NewSinks creates a sink of every configured type.
+func TestNewSinks(t *testing.T) {
+	cfg := config.Config{
+		Sinks: []config.SinkConfig{
+			{Type: config.SinkLoki, EndpointPath: "endpoint", TokenPath: "token"},
+			{Type: config.SinkHTTPS, Endpoint: "https://reports.example.com/ingest"},
+			{Type: config.SinkOTLP, Endpoint: "https://collector:4318/v1/logs"},
+			{Type: config.SinkFile, Path: "/var/lib/reporting-agent/reports.jsonl"},
+		},
+	}
+	sinks, err := newSinks(cfg, &http.Client{}, zaptest.NewLogger(t).Sugar())
+	require.NoError(t, err, "NewSinks should accept valid sink configurations")
+
+	names := []string{}
+	for _, sink := range sinks {
+		names = append(names, sink.Name())
+	}
+	require.Equal(t, []string{"loki", "https", "otlp", "file"}, names, "Sinks should be created in configuration order")
+}
+
+// TestNewSinksInvalid checks that NewSinks rejects unknown types and incomplete configurations.
+func TestNewSinksInvalid(t *testing.T) {
+	tests := map[string]struct {
+		sink config.SinkConfig
+		err  string
+	}{
+		"unknown type":        {config.SinkConfig{Type: "kafka"}, `unknown sink type "kafka"`},
+		"loki without token":  {config.SinkConfig{Type: config.SinkLoki, EndpointPath: "endpoint"}, "loki sink requires endpointPath and tokenPath"},
+		"https without https": {config.SinkConfig{Type: config.SinkHTTPS, Endpoint: "http://example.com"}, "invalid endpoint scheme"},
+		"otlp without host":   {config.SinkConfig{Type: config.SinkOTLP, Endpoint: "https:///v1/logs"}, "missing host"},
+		"file without path":   {config.SinkConfig{Type: config.SinkFile}, "file sink requires path"},
+		"file negative size":  {config.SinkConfig{Type: config.SinkFile, Path: "reports.jsonl", MaxBytes: -1}, "file sink maxBytes cannot be negative"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newSinks(config.Config{Sinks: []config.SinkConfig{tc.sink}}, &http.Client{}, zaptest.NewLogger(t).Sugar())
+			require.ErrorContains(t, err, tc.err, "NewSinks should reject the sink configuration")
+		})
+	}
+}
+
+// TestHTTPSenderSend verifies that the https sink posts the plain model.Root with the bearer token and headers.
+func TestHTTPSenderSend(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret\n"), 0640), "Should write token file")
+
+	client := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) *http.Response {
+			require.Equal(t, "https://reports.example.com/ingest", req.URL.String(), "Endpoint should match")
+			require.Equal(t, "Bearer secret", req.Header.Get("Authorization"), "Bearer token should be set")
+			require.Equal(t, "edge", req.Header.Get("X-Tenant"), "Configured header should be set")
+			require.Equal(t, "application/json", req.Header.Get("Content-Type"), "Content-Type header should match")
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err, "Should read request body without error")
+			var root model.Root
+			require.NoError(t, json.Unmarshal(body, &root), "Payload should be a plain model.Root")
+			require.Equal(t, "gid", root.Identity.GroupID, "Payload should contain the data")
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(""))}
+		}),
+	}
+	sinks, err := newSinks(config.Config{Sinks: []config.SinkConfig{{
+		Type:      config.SinkHTTPS,
+		Endpoint:  "https://reports.example.com/ingest",
+		TokenPath: tokenFile,
+		Headers:   map[string]string{"X-Tenant": "edge"},
+	}}}, client, zaptest.NewLogger(t).Sugar())
+	require.NoError(t, err, "NewSinks should accept the https sink")
+
+	err = sinks[0].Send(context.Background(), testBackendConfig(), &model.Root{Identity: model.Identity{GroupID: "gid"}}, time.Now())
+	require.NoError(t, err, "Send should succeed on 2xx response")
+}
+
+// TestHTTPSenderTokenFileError checks that the https sink fails if the configured token file is missing.
+func TestHTTPSenderTokenFileError(t *testing.T) {
+	sender := &HTTPSender{
+		endpoint:   "https://reports.example.com/ingest",
+		tokenPath:  filepath.Join(t.TempDir(), "not-exist-token"),
+		httpClient: &http.Client{},
+		auditLog:   zaptest.NewLogger(t).Sugar(),
+	}
+	err := sender.Send(context.Background(), testBackendConfig(), &model.Root{}, time.Now())
+	require.ErrorContains(t, err, "failed to read token file", "Should error if token file is missing")
+}
+
+// TestOTLPSenderSend verifies that the otlp sink exports the model.Root as an OTLP log record.
+func TestOTLPSenderSend(t *testing.T) {
+	client := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) *http.Response {
+			require.Equal(t, "https://collector:4318/v1/logs", req.URL.String(), "Endpoint should match")
+			require.Empty(t, req.Header.Get("Authorization"), "No token should be sent without tokenPath")
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err, "Should read request body without error")
+			require.Contains(t, string(body), `"resourceLogs"`, "Payload should be an OTLP logs request")
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(""))}
+		}),
+	}
+	sinks, err := newSinks(config.Config{Sinks: []config.SinkConfig{{
+		Type:     config.SinkOTLP,
+		Endpoint: "https://collector:4318/v1/logs",
+	}}}, client, zaptest.NewLogger(t).Sugar())
+	require.NoError(t, err, "NewSinks should accept the otlp sink")
+
+	err = sinks[0].Send(context.Background(), testBackendConfig(), &model.Root{Identity: model.Identity{GroupID: "gid"}}, time.Now())
+	require.NoError(t, err, "Send should succeed on 2xx response")
+}
+
+// TestBuildOTLPPayload checks the OTLP log record built from model.Root.
+func TestBuildOTLPPayload(t *testing.T) {
+	timestamp := time.Unix(1700000000, 5)
+	payload, err := buildOTLPPayload(&model.Root{Identity: model.Identity{GroupID: "gid"}}, timestamp)
+	require.NoError(t, err, "buildOTLPPayload should not return error for valid data")
+
+	var request otlpLogsRequest
+	require.NoError(t, json.Unmarshal(payload, &request), "Payload should be valid JSON")
+	require.Len(t, request.ResourceLogs, 1, "Payload should contain one resource")
+	resource := request.ResourceLogs[0]
+	require.Equal(t, []otlpAttribute{{Key: "service.name", Value: otlpValue{StringValue: "reporting-service"}}}, resource.Resource.Attributes)
+	require.Len(t, resource.ScopeLogs, 1, "Payload should contain one scope")
+	require.Equal(t, "reporting-agent", resource.ScopeLogs[0].Scope.Name)
+	require.Len(t, resource.ScopeLogs[0].LogRecords, 1, "Payload should contain one log record")
+	record := resource.ScopeLogs[0].LogRecords[0]
+	require.Equal(t, "1700000000000000005", record.TimeUnixNano, "Timestamp should be in nanoseconds")
+	require.Equal(t, []otlpAttribute{{Key: "group_id", Value: otlpValue{StringValue: "gid"}}}, record.Attributes)
+	require.Contains(t, record.Body.StringValue, `"gid"`, "Body should contain marshaled model.Root data")
+}
+
+// TestFileSenderSend checks that the file sink appends one JSON line per report.
+func TestFileSenderSend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reports.jsonl")
+	sender := &FileSender{path: path}
+
+	require.NoError(t, sender.Send(context.Background(), config.Config{}, &model.Root{Identity: model.Identity{GroupID: "first"}}, time.Now()), "First Send should succeed")
+	require.NoError(t, sender.Send(context.Background(), config.Config{}, &model.Root{Identity: model.Identity{GroupID: "second"}}, time.Now()), "Second Send should succeed")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err, "Should read sink file")
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	require.Len(t, lines, 2, "Each report should be one line")
+	var root model.Root
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &root), "Each line should be a model.Root")
+	require.Equal(t, "second", root.Identity.GroupID, "Reports should be appended in order")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err, "Should stat sink file")
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm(), "Sink file should be readable by the owner only")
+}
+
+// TestFileSenderRotate checks that the file sink moves its file aside before a report would exceed maxBytes.
+func TestFileSenderRotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reports.jsonl")
+	line, err := json.Marshal(&model.Root{Identity: model.Identity{GroupID: "report"}})
+	require.NoError(t, err, "Should marshal report")
+	sender := &FileSender{path: path, maxBytes: int64(2 * (len(line) + 1))}
+
+	for range 3 {
+		require.NoError(t, sender.Send(context.Background(), config.Config{}, &model.Root{Identity: model.Identity{GroupID: "report"}}, time.Now()), "Send should succeed")
+	}
+
+	rotated, err := os.ReadFile(path + ".1")
+	require.NoError(t, err, "Should read rotated sink file")
+	require.Equal(t, 2, strings.Count(string(rotated), "\n"), "Rotated file should hold the reports up to maxBytes")
+	content, err := os.ReadFile(path)
+	require.NoError(t, err, "Should read sink file")
+	require.Equal(t, 1, strings.Count(string(content), "\n"), "Sink file should start over after rotation")
+}
+
+// TestNewSinksFileDefaultMaxBytes checks that the file sink is rotated at the default size unless configured.
+func TestNewSinksFileDefaultMaxBytes(t *testing.T) {
+	sinks, err := newSinks(config.Config{Sinks: []config.SinkConfig{{Type: config.SinkFile, Path: "reports.jsonl"}}}, &http.Client{}, zaptest.NewLogger(t).Sugar())
+	require.NoError(t, err, "NewSinks should accept the file sink")
+	require.Equal(t, int64(defaultFileMaxBytes), sinks[0].(*FileSender).maxBytes, "File sink should default maxBytes")
+}
+
+// TestFileSenderSendError checks that the file sink fails if the file cannot be opened.
+func TestFileSenderSendError(t *testing.T) {
+	sender := &FileSender{path: filepath.Join(t.TempDir(), "missing", "reports.jsonl")}
+	err := sender.Send(context.Background(), config.Config{}, &model.Root{}, time.Now())
+	require.ErrorContains(t, err, "failed to open sink file", "Should error if the sink file cannot be opened")
+}
+
+// TestSendAll checks that every sink receives the data even if another sink fails.
+func TestSendAll(t *testing.T) {
+	tmpDir := t.TempDir()
+	first := &FileSender{path: filepath.Join(tmpDir, "first.jsonl")}
+	failing := &FileSender{path: filepath.Join(tmpDir, "missing", "failing.jsonl")}
+	last := &FileSender{path: filepath.Join(tmpDir, "last.jsonl")}
+
+	err := SendAll(context.Background(), zaptest.NewLogger(t).Sugar(), config.Config{}, []Sink{first, failing, last}, &model.Root{}, time.Now())
+	require.ErrorContains(t, err, "file sink: failed to open sink file", "SendAll should return the error of the failing sink")
+	require.FileExists(t, first.path, "Sinks before the failing one should receive the data")
+	require.FileExists(t, last.path, "Sinks after the failing one should receive the data")
+}
+
+// testBackendConfig returns a config retrying twice.
+func testBackendConfig() config.Config {
+	return config.Config{
+		Backend: config.BackendConfig{
+			Backoff: config.BackendBackoffConfig{
+				MaxTries: 2,
+			},
+		},
+	}
+}
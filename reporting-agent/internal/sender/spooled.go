@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/spool"
+)
+
+// spooledSink stores the reports its sink fails to deliver and delivers them, oldest first, before the next report.
+type spooledSink struct {
+	Sink
+	log   *zap.SugaredLogger
+	spool *spool.Spool
+}
+
+// Send delivers the spooled reports and then the new one. Once a delivery fails, the remaining reports stay spooled
+// and the new one is spooled without trying it.
+func (s *spooledSink) Send(ctx context.Context, cfg config.Config, data *model.Root, collectedAt time.Time) error {
+	entries, err := s.spool.Entries()
+	if err != nil {
+		s.log.Errorw("Failed to read spool", "sink", s.Name(), "err", err)
+	}
+	for _, entry := range entries {
+		if err := s.Sink.Send(ctx, cfg, entry.Data, entry.CollectedAt); err != nil {
+			return s.add(data, collectedAt, fmt.Errorf("failed to deliver spooled report collected at %s: %w",
+				entry.CollectedAt.Format(time.RFC3339), err))
+		}
+		if err := s.spool.Remove(entry); err != nil {
+			s.log.Errorw("Failed to remove delivered spool entry", "sink", s.Name(), "err", err)
+		}
+		s.log.Infow("Spooled report delivered", "sink", s.Name(), "collectedAt", entry.CollectedAt)
+	}
+
+	if err := s.Sink.Send(ctx, cfg, data, collectedAt); err != nil {
+		return s.add(data, collectedAt, err)
+	}
+	return nil
+}
+
+// add spools the undelivered report and returns the delivery error, joined with the spool error if any.
+func (s *spooledSink) add(data *model.Root, collectedAt time.Time, sendErr error) error {
+	if err := s.spool.Add(collectedAt, data); err != nil {
+		return errors.Join(sendErr, fmt.Errorf("failed to spool report: %w", err))
+	}
+	s.log.Warnw("Report spooled for the next run", "sink", s.Name(), "collectedAt", collectedAt)
+	return sendErr
+}
+
+// withSpool wraps the sink in a spool under cfg.Spool.Dir, keyed by the sink destination so that reordering the
+// sinks keeps their spools. Without a usable spool directory, the sink is returned as is.
+func withSpool(log *zap.SugaredLogger, spoolCfg config.SpoolConfig, sinkCfg config.SinkConfig, sink Sink) Sink {
+	if spoolCfg.Dir == "" {
+		return sink
+	}
+	destination := sha256.Sum256([]byte(sinkCfg.EndpointPath + "\x00" + sinkCfg.Endpoint + "\x00" + sinkCfg.Path))
+	dir := filepath.Join(spoolCfg.Dir, sinkCfg.Type+"-"+hex.EncodeToString(destination[:])[:12])
+	s, err := spool.New(log, dir, spoolCfg.MaxAge, spoolCfg.MaxBytes)
+	if err != nil {
+		log.Errorw("Spool disabled", "sink", sink.Name(), "err", err)
+		return sink
+	}
+	return &spooledSink{Sink: sink, log: log, spool: s}
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/config"
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
+)
+
+// TestSpooledSinkDrainsOldestFirst checks that undelivered reports are spooled and delivered oldest first, with their
+// original collection timestamp, before the next report.
+func TestSpooledSinkDrainsOldestFirst(t *testing.T) {
+	tmpDir := t.TempDir()
+	endpointFile := filepath.Join(tmpDir, "endpoint")
+	tokenFile := filepath.Join(tmpDir, "token")
+	require.NoError(t, os.WriteFile(endpointFile, []byte("https://localhost:12345"), 0640), "Should write endpoint file")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("user:pass"), 0640), "Should write token file")
+
+	online := false
+	delivered := [][]string{}
+	client := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) *http.Response {
+			if !online {
+				return &http.Response{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway", Body: io.NopCloser(bytes.NewBufferString(""))}
+			}
+			var payload struct {
+				Streams []struct {
+					Values [][]string `json:"values"`
+				} `json:"streams"`
+			}
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err, "Should read request body without error")
+			require.NoError(t, json.Unmarshal(body, &payload), "Payload should be a Loki push request")
+			delivered = append(delivered, payload.Streams[0].Values[0])
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(bytes.NewBufferString(""))}
+		}),
+	}
+
+	cfg := testBackendConfig()
+	cfg.Sinks = []config.SinkConfig{{Type: config.SinkLoki, EndpointPath: endpointFile, TokenPath: tokenFile}}
+	cfg.Spool = config.SpoolConfig{Dir: filepath.Join(tmpDir, "spool"), MaxAge: time.Hour, MaxBytes: 1 << 20}
+	log := zaptest.NewLogger(t).Sugar()
+	sinks, err := newSinks(cfg, client, log)
+	require.NoError(t, err, "NewSinks should accept the loki sink")
+	sink := withSpool(log, cfg.Spool, cfg.Sinks[0], sinks[0])
+
+	first := time.Now().Add(-2 * time.Minute)
+	second := time.Now().Add(-time.Minute)
+	require.Error(t, sink.Send(context.Background(), cfg, &model.Root{Identity: model.Identity{GroupID: "first"}}, first), "Send should fail while offline")
+	require.Error(t, sink.Send(context.Background(), cfg, &model.Root{Identity: model.Identity{GroupID: "second"}}, second), "Send should fail while offline")
+	require.Empty(t, delivered, "Nothing should be delivered while offline")
+
+	online = true
+	third := time.Now()
+	require.NoError(t, sink.Send(context.Background(), cfg, &model.Root{Identity: model.Identity{GroupID: "third"}}, third), "Send should succeed once online")
+	require.Len(t, delivered, 3, "Spooled reports should be delivered before the new one")
+	for i, collectedAt := range []time.Time{first, second, third} {
+		require.Equal(t, strconv.FormatInt(collectedAt.UnixNano(), 10), delivered[i][0], "Reports should keep their collection timestamp")
+	}
+	require.Contains(t, delivered[0][1], `"first"`, "Oldest report should be delivered first")
+	require.Contains(t, delivered[2][1], `"third"`, "New report should be delivered last")
+
+	delivered = nil
+	require.NoError(t, sink.Send(context.Background(), cfg, &model.Root{}, time.Now()), "Send should succeed")
+	require.Len(t, delivered, 1, "Delivered reports should be removed from the spool")
+}
+
+// TestWithSpoolDisabled checks that sinks are not wrapped without a spool directory.
+func TestWithSpoolDisabled(t *testing.T) {
+	sink := &FileSender{path: "reports.jsonl"}
+	require.Same(t, sink, withSpool(zaptest.NewLogger(t).Sugar(), config.SpoolConfig{}, config.SinkConfig{Type: config.SinkFile}, sink))
+}
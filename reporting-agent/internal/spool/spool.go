@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package spool keeps undelivered reports on disk until a later run delivers them.
+package spool
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
+)
+
+const (
+	entryVersion = 1
+	entrySuffix  = ".json"
+)
+
+// Entry is a spooled report.
+type Entry struct {
+	CollectedAt time.Time
+	Data        *model.Root
+	path        string
+}
+
+// entryFile is the on-disk format of an entry. Checksum is the hex SHA-256 of Data.
+type entryFile struct {
+	Version     int             `json:"version"`
+	CollectedAt time.Time       `json:"collectedAt"`
+	Checksum    string          `json:"checksum"`
+	Data        json.RawMessage `json:"data"`
+}
+
+// Spool stores reports as one file each in a directory, dropping the oldest once the reports exceed maxBytes in
+// total and those collected more than maxAge ago.
+type Spool struct {
+	log      *zap.SugaredLogger
+	dir      string
+	maxAge   time.Duration
+	maxBytes int64
+}
+
+// New creates the spool directory, if needed, and returns the spool.
+func New(log *zap.SugaredLogger, dir string, maxAge time.Duration, maxBytes int64) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory: %w", err)
+	}
+	return &Spool{log: log, dir: dir, maxAge: maxAge, maxBytes: maxBytes}, nil
+}
+
+// Add stores the report collected at collectedAt and applies the limits.
+func (s *Spool) Add(collectedAt time.Time, data *model.Root) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spooled data: %w", err)
+	}
+	sum := sha256.Sum256(raw)
+	content, err := json.Marshal(entryFile{
+		Version:     entryVersion,
+		CollectedAt: collectedAt,
+		Checksum:    hex.EncodeToString(sum[:]),
+		Data:        raw,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal spool entry: %w", err)
+	}
+
+	// Write to a temporary file first so that a crash never leaves a partial entry behind.
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create spool entry: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write spool entry: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync spool entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close spool entry: %w", err)
+	}
+	// The zero-padded nanosecond timestamp makes the name order the collection order.
+	name := fmt.Sprintf("%020d%s", collectedAt.UnixNano(), entrySuffix)
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, name)); err != nil {
+		return fmt.Errorf("failed to store spool entry: %w", err)
+	}
+
+	_, err = s.Entries()
+	return err
+}
+
+// Entries returns the spooled reports, oldest first. Expired, corrupted and over the size limit entries are
+// removed.
+func (s *Spool) Entries() ([]Entry, error) {
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool directory: %w", err)
+	}
+
+	entries := []Entry{}
+	sizes := []int64{}
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || strings.HasPrefix(dirEntry.Name(), ".") || !strings.HasSuffix(dirEntry.Name(), entrySuffix) {
+			continue
+		}
+		path := filepath.Join(s.dir, dirEntry.Name())
+		entry, size, err := readEntry(path)
+		if err != nil {
+			s.log.Warnw("Dropping corrupted spool entry", "file", path, "err", err)
+			s.remove(path)
+			continue
+		}
+		if s.maxAge > 0 && time.Since(entry.CollectedAt) > s.maxAge {
+			s.log.Warnw("Dropping expired spool entry", "file", path, "collectedAt", entry.CollectedAt)
+			s.remove(path)
+			continue
+		}
+		entries = append(entries, entry)
+		sizes = append(sizes, size)
+	}
+
+	// ReadDir sorts by name, i.e. oldest first; drop the oldest until the rest fits.
+	var total int64
+	for _, size := range sizes {
+		total += size
+	}
+	for s.maxBytes > 0 && total > s.maxBytes && len(entries) > 0 {
+		s.log.Warnw("Dropping spool entry over the size limit", "file", entries[0].path, "collectedAt", entries[0].CollectedAt)
+		s.remove(entries[0].path)
+		total -= sizes[0]
+		entries, sizes = slices.Delete(entries, 0, 1), slices.Delete(sizes, 0, 1)
+	}
+	return entries, nil
+}
+
+// Remove deletes a delivered entry.
+func (s *Spool) Remove(entry Entry) error {
+	if err := os.Remove(entry.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove spool entry: %w", err)
+	}
+	return nil
+}
+
+func (s *Spool) remove(path string) {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		s.log.Errorw("Failed to remove spool entry", "file", path, "err", err)
+	}
+}
+
+// readEntry reads and verifies the entry file, returning its size.
+func readEntry(path string) (Entry, int64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return Entry{}, 0, err
+	}
+	var file entryFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return Entry{}, 0, fmt.Errorf("invalid entry: %w", err)
+	}
+	if file.Version != entryVersion {
+		return Entry{}, 0, fmt.Errorf("unsupported entry version %d", file.Version)
+	}
+	sum := sha256.Sum256(file.Data)
+	if hex.EncodeToString(sum[:]) != file.Checksum {
+		return Entry{}, 0, errors.New("checksum mismatch")
+	}
+	var data model.Root
+	if err := json.Unmarshal(file.Data, &data); err != nil {
+		return Entry{}, 0, fmt.Errorf("invalid entry data: %w", err)
+	}
+	return Entry{CollectedAt: file.CollectedAt, Data: &data, path: path}, int64(len(content)), nil
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package spool
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/open-edge-platform/edge-node-agents/reporting-agent/internal/model"
+)
+
+// TestAddEntries checks that spooled reports are returned oldest first with their collection time.
+func TestAddEntries(t *testing.T) {
+	s := newTestSpool(t, 0, 0)
+	now := time.Now().Truncate(time.Second)
+
+	require.NoError(t, s.Add(now.Add(-time.Hour), report("second")), "Add should succeed")
+	require.NoError(t, s.Add(now.Add(-2*time.Hour), report("first")), "Add should succeed")
+	require.NoError(t, s.Add(now, report("third")), "Add should succeed")
+
+	entries, err := s.Entries()
+	require.NoError(t, err, "Entries should succeed")
+	require.Len(t, entries, 3, "All reports should be spooled")
+	require.Equal(t, "first", entries[0].Data.Identity.GroupID, "Oldest report should come first")
+	require.Equal(t, "second", entries[1].Data.Identity.GroupID)
+	require.Equal(t, "third", entries[2].Data.Identity.GroupID)
+	require.True(t, now.Add(-2*time.Hour).Equal(entries[0].CollectedAt), "Collection time should be kept")
+
+	require.NoError(t, s.Remove(entries[0]), "Remove should succeed")
+	entries, err = s.Entries()
+	require.NoError(t, err, "Entries should succeed")
+	require.Len(t, entries, 2, "Removed report should not be returned")
+	require.Equal(t, "second", entries[0].Data.Identity.GroupID)
+}
+
+// TestEntriesCorrupted checks that entries failing the integrity check are dropped.
+func TestEntriesCorrupted(t *testing.T) {
+	s := newTestSpool(t, 0, 0)
+	now := time.Now()
+	require.NoError(t, s.Add(now.Add(-time.Minute), report("tampered")), "Add should succeed")
+	require.NoError(t, s.Add(now, report("intact")), "Add should succeed")
+
+	entries, err := s.Entries()
+	require.NoError(t, err, "Entries should succeed")
+	content, err := os.ReadFile(entries[0].path)
+	require.NoError(t, err, "Should read spool entry")
+	require.NoError(t, os.WriteFile(entries[0].path, []byte(strings.Replace(string(content), "tampered", "modified", 1)), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(s.dir, "00000000000000000001.json"), []byte("{truncated"), 0600))
+
+	entries, err = s.Entries()
+	require.NoError(t, err, "Entries should succeed")
+	require.Len(t, entries, 1, "Corrupted entries should be dropped")
+	require.Equal(t, "intact", entries[0].Data.Identity.GroupID)
+
+	files, err := os.ReadDir(s.dir)
+	require.NoError(t, err, "Should read spool directory")
+	require.Len(t, files, 1, "Corrupted entries should be removed from disk")
+}
+
+// TestEntriesMaxAge checks that reports collected longer ago than the maximum age are dropped.
+func TestEntriesMaxAge(t *testing.T) {
+	s := newTestSpool(t, 24*time.Hour, 0)
+	now := time.Now()
+	require.NoError(t, s.Add(now.Add(-25*time.Hour), report("expired")), "Add should succeed")
+	require.NoError(t, s.Add(now.Add(-23*time.Hour), report("kept")), "Add should succeed")
+
+	entries, err := s.Entries()
+	require.NoError(t, err, "Entries should succeed")
+	require.Len(t, entries, 1, "Expired report should be dropped")
+	require.Equal(t, "kept", entries[0].Data.Identity.GroupID)
+}
+
+// TestAddMaxBytes checks that the oldest reports are dropped once the spool exceeds its size limit.
+func TestAddMaxBytes(t *testing.T) {
+	probe := newTestSpool(t, 0, 0)
+	require.NoError(t, probe.Add(time.Now(), report("p")), "Add should succeed")
+	entries, err := probe.Entries()
+	require.NoError(t, err, "Entries should succeed")
+	info, err := os.Stat(entries[0].path)
+	require.NoError(t, err, "Should stat spool entry")
+
+	s := newTestSpool(t, 0, 2*info.Size())
+	now := time.Now()
+	for i, groupID := range []string{"a", "b", "c"} {
+		require.NoError(t, s.Add(now.Add(time.Duration(i)*time.Second), report(groupID)), "Add should succeed")
+	}
+
+	entries, err = s.Entries()
+	require.NoError(t, err, "Entries should succeed")
+	require.Len(t, entries, 2, "Spool should hold only what fits")
+	require.Equal(t, "b", entries[0].Data.Identity.GroupID, "Oldest report should be dropped first")
+	require.Equal(t, "c", entries[1].Data.Identity.GroupID)
+}
+
+// TestNewError checks that New fails if the spool directory cannot be created.
+func TestNewError(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0600))
+	_, err := New(zaptest.NewLogger(t).Sugar(), filepath.Join(file, "spool"), 0, 0)
+	require.ErrorContains(t, err, "failed to create spool directory", "New should fail if the directory cannot be created")
+}
+
+// newTestSpool creates a spool in a temporary directory.
+func newTestSpool(t *testing.T, maxAge time.Duration, maxBytes int64) *Spool {
+	s, err := New(zaptest.NewLogger(t).Sugar(), filepath.Join(t.TempDir(), "spool"), maxAge, maxBytes)
+	require.NoError(t, err, "New should succeed")
+	return s
+}
+
+// report returns a model.Root identified by the group ID.
+func report(groupID string) *model.Root {
+	return &model.Root{Identity: model.Identity{GroupID: groupID}}
+}